@@ -48,6 +48,11 @@ func (o *OriginData) SaveState(dir string) (string, error) {
 	return statePath, file.WriteFile(statePath, o.StateBytes())
 }
 
+// State returns the downloaded BeaconState value.
+func (o *OriginData) State() state.BeaconState {
+	return o.st
+}
+
 // StateBytes returns the ssz-encoded bytes of the downloaded BeaconState value.
 func (o *OriginData) StateBytes() []byte {
 	return o.sb
@@ -36,6 +36,7 @@ const (
 	getStatePath             = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath       = "/eth/v1/node/version"
 	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getDepositSnapshotPath   = "/eth/v1/beacon/deposit_snapshot"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -335,6 +336,20 @@ func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*apimiddleware.B
 	return poolResponse, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 deposit tree snapshot of the deposits finalized by the beacon node.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	body, err := c.Get(ctx, getDepositSnapshotPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	ds := &depositSnapshotResponse{}
+	dataWrapper := &struct{ Data *depositSnapshotResponse }{Data: ds}
+	if err = json.Unmarshal(body, dataWrapper); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetDepositSnapshot")
+	}
+	return ds.DepositSnapshot()
+}
+
 type forkResponse struct {
 	PreviousVersion string `json:"previous_version"`
 	CurrentVersion  string `json:"current_version"`
@@ -394,3 +409,45 @@ func (fsr *forkScheduleResponse) OrderedForkSchedule() (forks.OrderedSchedule, e
 	sort.Sort(ofs)
 	return ofs, nil
 }
+
+type depositSnapshotResponse struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+func (d *depositSnapshotResponse) DepositSnapshot() (*ethpb.DepositSnapshot, error) {
+	finalized := make([][]byte, len(d.Finalized))
+	for i, f := range d.Finalized {
+		h, err := hexutil.Decode(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode finalized hash %d", i)
+		}
+		finalized[i] = h
+	}
+	depositRoot, err := hexutil.Decode(d.DepositRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit root")
+	}
+	depositCount, err := strconv.ParseUint(d.DepositCount, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse deposit count")
+	}
+	executionHash, err := hexutil.Decode(d.ExecutionBlockHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode execution block hash")
+	}
+	executionDepth, err := strconv.ParseUint(d.ExecutionBlockHeight, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse execution block height")
+	}
+	return &ethpb.DepositSnapshot{
+		Finalized:      finalized,
+		DepositRoot:    depositRoot,
+		DepositCount:   depositCount,
+		ExecutionHash:  executionHash,
+		ExecutionDepth: executionDepth,
+	}, nil
+}
@@ -1,6 +1,10 @@
 package beacon
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
 	"net/url"
 	"testing"
 
@@ -137,3 +141,24 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDepositSnapshot(t *testing.T) {
+	body := `{"data":{"finalized":["0x0102","0x0304"],"deposit_root":"0x05","deposit_count":"10","execution_block_hash":"0x06","execution_block_height":"42"}}`
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		res := &http.Response{Request: req}
+		if req.URL.Path == getDepositSnapshotPath {
+			res.StatusCode = http.StatusOK
+			res.Body = io.NopCloser(bytes.NewBufferString(body))
+		}
+		return res, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+	snapshot, err := c.GetDepositSnapshot(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, [][]byte{{1, 2}, {3, 4}}, snapshot.Finalized)
+	require.DeepEqual(t, []byte{5}, snapshot.DepositRoot)
+	require.Equal(t, uint64(10), snapshot.DepositCount)
+	require.DeepEqual(t, []byte{6}, snapshot.ExecutionHash)
+	require.Equal(t, uint64(42), snapshot.ExecutionDepth)
+}
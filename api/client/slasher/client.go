@@ -0,0 +1,65 @@
+package slasher
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+	slasherhttp "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/slasher"
+)
+
+const getSlashingsPath = "/prysm/v1/slasher/slashings"
+
+// Client provides a collection of helper methods for calling the slasher endpoints of a beacon node.
+type Client struct {
+	*client.Client
+}
+
+// NewClient returns a new Client that includes functions for REST calls to the slasher APIs.
+func NewClient(host string, opts ...client.ClientOpt) (*Client, error) {
+	c, err := client.NewClient(host, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{c}, nil
+}
+
+// GetSlashings retrieves a page of the evidence of the slashings detected by the slasher. The page token
+// is the next page token of the previous page, or an empty string for the first page.
+func (c *Client) GetSlashings(ctx context.Context, pageToken string, pageSize uint64) (*slasherhttp.SlashingsResponse, error) {
+	params := url.Values{}
+	params.Set("page_size", strconv.FormatUint(pageSize, 10))
+	if pageToken != "" {
+		params.Set("page_token", pageToken)
+	}
+	b, err := c.Get(ctx, getSlashingsPath, client.WithQueryParams(params))
+	if err != nil {
+		return nil, err
+	}
+	resp := &slasherhttp.SlashingsResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to parse slashings response")
+	}
+	return resp, nil
+}
+
+// GetAllSlashings retrieves the evidence of all the slashings detected by the slasher, requesting
+// pages of the given size.
+func (c *Client) GetAllSlashings(ctx context.Context, pageSize uint64) ([]*slasherhttp.SlashingEvidence, error) {
+	evidence := make([]*slasherhttp.SlashingEvidence, 0)
+	pageToken := ""
+	for {
+		resp, err := c.GetSlashings(ctx, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, resp.Data...)
+		if resp.NextPageToken == "" {
+			return evidence, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,65 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+	slasherhttp "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/slasher"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type testRT struct {
+	rt func(*http.Request) (*http.Response, error)
+}
+
+func (rt *testRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.rt != nil {
+		return rt.rt(req)
+	}
+	return nil, errors.New("RoundTripper not implemented")
+}
+
+var _ http.RoundTripper = &testRT{}
+
+func TestClient_GetAllSlashings(t *testing.T) {
+	const total = 5
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != getSlashingsPath {
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(&bytes.Buffer{})}, nil
+		}
+		pageSize, err := strconv.Atoi(req.URL.Query().Get("page_size"))
+		require.NoError(t, err)
+		start := 1
+		if token := req.URL.Query().Get("page_token"); token != "" {
+			start, err = strconv.Atoi(token)
+			require.NoError(t, err)
+		}
+		resp := &slasherhttp.SlashingsResponse{Data: make([]*slasherhttp.SlashingEvidence, 0)}
+		for id := start; id <= total && len(resp.Data) < pageSize; id++ {
+			resp.Data = append(resp.Data, &slasherhttp.SlashingEvidence{Id: strconv.Itoa(id)})
+		}
+		if next := start + pageSize; next <= total {
+			resp.NextPageToken = strconv.Itoa(next)
+		}
+		b, err := json.Marshal(resp)
+		require.NoError(t, err)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(b))}, nil
+	}}
+
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+	evidence, err := c.GetAllSlashings(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, total, len(evidence))
+	for i, ev := range evidence {
+		assert.Equal(t, strconv.Itoa(i+1), ev.Id)
+	}
+}
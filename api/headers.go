@@ -1,7 +1,10 @@
 package api
 
 const (
-	VersionHeader        = "Eth-Consensus-Version"
-	JsonMediaType        = "application/json"
-	OctetStreamMediaType = "application/octet-stream"
+	VersionHeader                 = "Eth-Consensus-Version"
+	ExecutionPayloadBlindedHeader = "Eth-Execution-Payload-Blinded"
+	ExecutionPayloadValueHeader   = "Eth-Execution-Payload-Value"
+	ConsensusBlockValueHeader     = "Eth-Consensus-Block-Value"
+	JsonMediaType                 = "application/json"
+	OctetStreamMediaType          = "application/octet-stream"
 )
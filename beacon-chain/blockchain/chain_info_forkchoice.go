@@ -15,11 +15,14 @@ func (s *Service) CachedHeadRoot() [32]byte {
 	return s.cfg.ForkChoiceStore.CachedHeadRoot()
 }
 
-// GetProposerHead returns the corresponding value from forkchoice
+// GetProposerHead returns the corresponding value from forkchoice and
+// notifies the late block reorg decision it is based on.
 func (s *Service) GetProposerHead() [32]byte {
 	s.cfg.ForkChoiceStore.RLock()
-	defer s.cfg.ForkChoiceStore.RUnlock()
-	return s.cfg.ForkChoiceStore.GetProposerHead()
+	decision := s.cfg.ForkChoiceStore.ProposerHeadDecision()
+	s.cfg.ForkChoiceStore.RUnlock()
+	s.notifyLateBlockReorgDecision(decision)
+	return decision.ProposerHead()
 }
 
 // SetForkChoiceGenesisTime sets the genesis time in Forkchoice
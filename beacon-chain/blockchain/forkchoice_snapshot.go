@@ -0,0 +1,90 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// runForkChoiceSnapshotTasks saves a snapshot of the fork choice store at the start of every epoch,
+// so that it can be restored after a restart.
+func (s *Service) runForkChoiceSnapshotTasks() {
+	if !features.Get().EnableForkChoicePersistence {
+		return
+	}
+	if err := s.waitForSync(); err != nil {
+		log.WithError(err).Error("failed to wait for initial sync")
+		return
+	}
+
+	ticker := slots.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !slots.IsEpochStart(slot) {
+				continue
+			}
+			if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+				log.WithError(err).Error("Could not save fork choice snapshot")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// saveForkChoiceSnapshot saves a snapshot of the current fork choice store to the database.
+func (s *Service) saveForkChoiceSnapshot(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveForkChoiceSnapshot")
+	defer span.End()
+
+	s.cfg.ForkChoiceStore.RLock()
+	snapshot, err := s.cfg.ForkChoiceStore.Snapshot(ctx)
+	s.cfg.ForkChoiceStore.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "could not snapshot fork choice")
+	}
+	return s.cfg.BeaconDB.SaveForkChoiceSnapshot(ctx, snapshot)
+}
+
+// restoreForkChoiceSnapshot restores the fork choice store from the snapshot saved in the database,
+// if it was taken at the given finalized checkpoint. The fork choice store is kept as is if there is
+// no such snapshot or it can not be restored. The caller must hold the fork choice lock.
+func (s *Service) restoreForkChoiceSnapshot(ctx context.Context, finalized *ethpb.Checkpoint) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.restoreForkChoiceSnapshot")
+	defer span.End()
+
+	snapshot, err := s.cfg.BeaconDB.ForkChoiceSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not get fork choice snapshot")
+		return
+	}
+	if snapshot == nil {
+		return
+	}
+	cp := snapshot.FinalizedCheckpoint
+	if cp == nil || cp.Epoch != finalized.Epoch || !bytes.Equal(cp.Root, finalized.Root) {
+		log.Debug("Ignoring fork choice snapshot taken at a different finalized checkpoint")
+		return
+	}
+	headRoot := bytesutil.ToBytes32(snapshot.HeadRoot)
+	if !s.cfg.BeaconDB.HasBlock(ctx, headRoot) {
+		log.WithField("headRoot", fmt.Sprintf("%#x", headRoot)).Warn("Ignoring fork choice snapshot with unknown head block")
+		return
+	}
+	if err := s.cfg.ForkChoiceStore.RestoreSnapshot(ctx, snapshot); err != nil {
+		log.WithError(err).Warn("Could not restore fork choice snapshot, rebuilding fork choice from the finalized checkpoint")
+		return
+	}
+	log.WithField("nodeCount", s.cfg.ForkChoiceStore.NodeCount()).Info("Restored fork choice from snapshot")
+}
@@ -0,0 +1,76 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_SaveAndRestoreForkChoiceSnapshot(t *testing.T) {
+	hook := logTest.NewGlobal()
+	service, tr := minimalTestService(t)
+	ctx := tr.ctx
+	balancesByRoot := func(context.Context, [32]byte) ([]uint64, error) { return []uint64{32, 32}, nil }
+	tr.fcs.SetBalancesByRooter(balancesByRoot)
+	require.NoError(t, tr.fcs.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Root: params.BeaconConfig().ZeroHash}))
+
+	cp := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	st, root, err := prepareForkchoiceState(ctx, 0, params.BeaconConfig().ZeroHash, [32]byte{}, [32]byte{'z'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, tr.fcs.InsertNode(ctx, st, root))
+	parentRoot := params.BeaconConfig().ZeroHash
+	for i := 1; i <= 2; i++ {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = 1
+		blk.Block.ParentRoot = parentRoot[:]
+		util.SaveBlock(t, ctx, tr.db, blk)
+		blkRoot, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		st, root, err := prepareForkchoiceState(ctx, blk.Block.Slot, blkRoot, parentRoot, [32]byte{byte(i)}, cp, cp)
+		require.NoError(t, err)
+		require.NoError(t, tr.fcs.InsertNode(ctx, st, root))
+		parentRoot = blkRoot
+	}
+	head, err := tr.fcs.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, parentRoot, head)
+
+	require.NoError(t, service.saveForkChoiceSnapshot(ctx))
+	snapshot, err := tr.db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	require.Equal(t, 3, len(snapshot.Nodes))
+
+	t.Run("different finalized checkpoint", func(t *testing.T) {
+		fcs := doublylinkedtree.New()
+		fcs.SetBalancesByRooter(balancesByRoot)
+		service.cfg.ForkChoiceStore = fcs
+		service.restoreForkChoiceSnapshot(ctx, &ethpb.Checkpoint{Epoch: 1, Root: params.BeaconConfig().ZeroHash[:]})
+		require.Equal(t, 0, fcs.NodeCount())
+	})
+	t.Run("invalid justified balances", func(t *testing.T) {
+		fcs := doublylinkedtree.New()
+		fcs.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) { return []uint64{32}, nil })
+		service.cfg.ForkChoiceStore = fcs
+		service.restoreForkChoiceSnapshot(ctx, cp)
+		require.Equal(t, 0, fcs.NodeCount())
+		require.LogsContain(t, hook, "Could not restore fork choice snapshot")
+	})
+	t.Run("restored", func(t *testing.T) {
+		fcs := doublylinkedtree.New()
+		fcs.SetBalancesByRooter(balancesByRoot)
+		service.cfg.ForkChoiceStore = fcs
+		service.restoreForkChoiceSnapshot(ctx, cp)
+		require.Equal(t, 3, fcs.NodeCount())
+		require.Equal(t, true, fcs.HasNode(parentRoot))
+		require.Equal(t, parentRoot, fcs.CachedHeadRoot())
+		require.LogsContain(t, hook, "Restored fork choice from snapshot")
+	})
+}
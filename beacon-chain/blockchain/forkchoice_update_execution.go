@@ -6,12 +6,17 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -102,8 +107,9 @@ func (s *Service) shouldOverrideFCU(newHeadRoot [32]byte, proposingSlot primitiv
 	}
 	currentSlot := s.CurrentSlot()
 	if proposingSlot == currentSlot {
-		proposerHead := s.cfg.ForkChoiceStore.GetProposerHead()
-		if proposerHead != newHeadRoot {
+		decision := s.cfg.ForkChoiceStore.ProposerHeadDecision()
+		s.notifyLateBlockReorgDecision(decision)
+		if decision.ProposerHead() != newHeadRoot {
 			return true
 		}
 		log.WithFields(logrus.Fields{
@@ -113,7 +119,9 @@ func (s *Service) shouldOverrideFCU(newHeadRoot [32]byte, proposingSlot primitiv
 			params.BeaconConfig().SecondsPerSlot)
 		lateBlockFailedAttemptSecondThreshold.Inc()
 	} else {
-		if s.cfg.ForkChoiceStore.ShouldOverrideFCU() {
+		decision := s.cfg.ForkChoiceStore.OverrideFCUDecision()
+		s.notifyLateBlockReorgDecision(decision)
+		if decision.Reorg {
 			return true
 		}
 		secs, err := slots.SecondsSinceSlotStart(currentSlot,
@@ -132,3 +140,32 @@ func (s *Service) shouldOverrideFCU(newHeadRoot [32]byte, proposingSlot primitiv
 	}
 	return false
 }
+
+// notifyLateBlockReorgDecision sends the decision of the late block reorg
+// heuristic on the state feed.
+func (s *Service) notifyLateBlockReorgDecision(d *forkchoicetypes.ReorgDecision) {
+	log.WithFields(logrus.Fields{
+		"stage":        d.Stage,
+		"proposalSlot": d.ProposalSlot,
+		"headRoot":     fmt.Sprintf("%#x", d.HeadRoot),
+		"headWeight":   d.HeadWeight,
+		"parentWeight": d.ParentWeight,
+		"reorg":        d.Reorg,
+		"reason":       d.Reason,
+	}).Debug("Late block reorg decision")
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.LateBlockReorg,
+		Data: &ethpb.LateBlockReorgEvent{
+			Stage:           d.Stage,
+			ProposalSlot:    uint64(d.ProposalSlot),
+			HeadRoot:        bytesutil.SafeCopyBytes(d.HeadRoot[:]),
+			HeadSlot:        uint64(d.HeadSlot),
+			ParentRoot:      bytesutil.SafeCopyBytes(d.ParentRoot[:]),
+			HeadWeight:      d.HeadWeight,
+			ParentWeight:    d.ParentWeight,
+			CommitteeWeight: d.CommitteeWeight,
+			Reorg:           d.Reorg,
+			Reason:          d.Reason,
+		},
+	})
+}
@@ -0,0 +1,197 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientBootstrapDeadline bounds the regeneration of the finalized state a bootstrap is derived from.
+const lightClientBootstrapDeadline = 20 * time.Second
+
+// LightClientFetcher retrieves the latest light client updates derived from the canonical chain.
+type LightClientFetcher interface {
+	LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate
+}
+
+// lightClientUpdates holds the latest finality and optimistic updates derived from the canonical chain.
+type lightClientUpdates struct {
+	sync.RWMutex
+	finality   *ethpb.LightClientFinalityUpdate
+	optimistic *ethpb.LightClientOptimisticUpdate
+}
+
+// LightClientFinalityUpdate returns the latest light client finality update, or nil if none was derived yet.
+func (s *Service) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.finality
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update, or nil if none was derived yet.
+func (s *Service) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.optimistic
+}
+
+// processLightClientUpdate saves the light client update of a block which became head in the background, the
+// broadcast of the derived updates is delayed within the signature slot.
+func (s *Service) processLightClientUpdate(signed interfaces.ReadOnlySignedBeaconBlock, postState state.BeaconState) {
+	ctx, cancel := context.WithTimeout(s.ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second)
+	defer cancel()
+	if err := s.saveLightClientUpdate(ctx, signed, postState); err != nil {
+		log.WithError(err).Error("Could not save light client update")
+	}
+}
+
+// processLightClientBootstrap saves the light client bootstrap of a new finalized checkpoint in the background.
+func (s *Service) processLightClientBootstrap(root [32]byte) {
+	ctx, cancel := context.WithTimeout(s.ctx, lightClientBootstrapDeadline)
+	defer cancel()
+	if err := s.saveLightClientBootstrap(ctx, root); err != nil {
+		log.WithError(err).Error("Could not save light client bootstrap")
+	}
+}
+
+// saveLightClientUpdate derives the light client update of a canonical block, which signs its parent with its
+// sync aggregate. The update is saved if it is the best one of its sync committee period, and the finality and
+// optimistic updates derived from it are broadcast if they are newer than the latest ones.
+func (s *Service) saveLightClientUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock, postState state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveLightClientUpdate")
+	defer span.End()
+
+	attestedRoot := signed.Block().ParentRoot()
+	attestedBlock, err := s.getBlock(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested block")
+	}
+	if attestedBlock.Version() < version.Altair {
+		return nil
+	}
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested state")
+	}
+	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
+	finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root)
+	if finalizedRoot == params.BeaconConfig().ZeroHash {
+		finalizedBlock, err = s.cfg.BeaconDB.GenesisBlock(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get genesis block")
+		}
+	} else if s.hasBlock(ctx, finalizedRoot) {
+		finalizedBlock, err = s.getBlock(ctx, finalizedRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized block")
+		}
+	}
+
+	update, err := lightclient.NewUpdate(ctx, postState, signed, attestedState, attestedBlock, finalizedBlock)
+	if errors.Is(err, lightclient.ErrNotEnoughParticipants) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	period := lightclient.SyncCommitteePeriodAtSlot(update.AttestedHeader.Beacon.Slot)
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrap(err, "could not get best light client update")
+	}
+	if best == nil || lightclient.IsBetterUpdate(update, best) {
+		if err := s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update); err != nil {
+			return errors.Wrap(err, "could not save light client update")
+		}
+	}
+
+	finality, optimistic := s.setLatestLightClientUpdates(update)
+	if finality == nil && optimistic == nil {
+		return nil
+	}
+	// Peers ignore updates received before a third of their signature slot has elapsed, so that the
+	// block at the signature slot has had the time to propagate.
+	broadcastTime, err := slots.ToTime(uint64(s.genesisTime.Unix()), update.SignatureSlot)
+	if err != nil {
+		return err
+	}
+	broadcastTime = broadcastTime.Add(time.Duration(params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot) * time.Second)
+	select {
+	case <-time.After(time.Until(broadcastTime)):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	var msgs []proto.Message
+	if finality != nil {
+		msgs = append(msgs, finality)
+	}
+	if optimistic != nil {
+		msgs = append(msgs, optimistic)
+	}
+	for _, msg := range msgs {
+		if err := s.cfg.P2p.Broadcast(ctx, msg); err != nil {
+			log.WithError(err).Error("Could not broadcast light client update")
+		}
+	}
+	return nil
+}
+
+// setLatestLightClientUpdates replaces the latest finality and optimistic updates with the ones derived from
+// the given update, if they are newer. It returns the updates which were replaced, nil otherwise. The rules
+// match the gossip conditions of the light client topics, so that the updates are forwarded by peers.
+func (s *Service) setLatestLightClientUpdates(update *ethpb.LightClientUpdate) (*ethpb.LightClientFinalityUpdate, *ethpb.LightClientOptimisticUpdate) {
+	s.lcUpdates.Lock()
+	defer s.lcUpdates.Unlock()
+
+	var finality *ethpb.LightClientFinalityUpdate
+	if lightclient.IsFinalityUpdate(update) {
+		u := lightclient.FinalityUpdateFromUpdate(update)
+		if lightclient.IsNewerFinalityUpdate(u, s.lcUpdates.finality) {
+			finality = u
+			s.lcUpdates.finality = u
+		}
+	}
+	var optimistic *ethpb.LightClientOptimisticUpdate
+	if u := lightclient.OptimisticUpdateFromUpdate(update); lightclient.IsNewerOptimisticUpdate(u, s.lcUpdates.optimistic) {
+		optimistic = u
+		s.lcUpdates.optimistic = u
+	}
+	return finality, optimistic
+}
+
+// saveLightClientBootstrap saves the light client bootstrap of a finalized checkpoint root.
+func (s *Service) saveLightClientBootstrap(ctx context.Context, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveLightClientBootstrap")
+	defer span.End()
+
+	blk, err := s.getBlock(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if blk.Version() < version.Altair {
+		return nil
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	bootstrap, err := lightclient.NewBootstrap(ctx, st, blk)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client bootstrap")
+	}
+	return s.cfg.BeaconDB.SaveLightClientBootstrap(ctx, root, bootstrap)
+}
@@ -0,0 +1,130 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	p2pTesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// lightClientTestBlock returns an altair block and a state which is consistent with being its post state.
+func lightClientTestBlock(t *testing.T, slot primitives.Slot, parentRoot []byte, participants int, finalized *ethpb.Checkpoint) (interfaces.ReadOnlySignedBeaconBlock, state.BeaconState) {
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	if finalized != nil {
+		require.NoError(t, st.SetFinalizedCheckpoint(finalized))
+	}
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = parentRoot
+	for i := 0; i < participants; i++ {
+		blk.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(uint64(i), true)
+	}
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: parentRoot,
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	signed, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	return signed, st
+}
+
+func TestService_saveLightClientUpdate(t *testing.T) {
+	ctx := context.Background()
+	broadcaster := &p2pTesting.MockBroadcaster{}
+	s, req := minimalTestService(t, WithP2PBroadcaster(broadcaster))
+	s.genesisTime = time.Now().Add(-time.Hour)
+
+	finalizedBlk, _ := lightClientTestBlock(t, 8, make([]byte, 32), 0, nil)
+	finalizedRoot, err := finalizedBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	cp := &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}
+	attestedBlk, attestedState := lightClientTestBlock(t, 40, finalizedRoot[:], 0, cp)
+	attestedRoot, err := attestedBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	blk, st := lightClientTestBlock(t, 41, attestedRoot[:], 400, nil)
+
+	require.NoError(t, req.db.SaveBlock(ctx, finalizedBlk))
+	require.NoError(t, req.db.SaveBlock(ctx, attestedBlk))
+	require.NoError(t, req.db.SaveState(ctx, attestedState, attestedRoot))
+
+	require.NoError(t, s.saveLightClientUpdate(ctx, blk, st))
+	update, err := req.db.LightClientUpdate(ctx, 0)
+	require.NoError(t, err)
+	require.NotNil(t, update)
+	assert.Equal(t, primitives.Slot(41), update.SignatureSlot)
+
+	finality := s.LightClientFinalityUpdate()
+	require.NotNil(t, finality)
+	assert.Equal(t, primitives.Slot(8), finality.FinalizedHeader.Beacon.Slot)
+	optimistic := s.LightClientOptimisticUpdate()
+	require.NotNil(t, optimistic)
+	assert.Equal(t, primitives.Slot(40), optimistic.AttestedHeader.Beacon.Slot)
+	require.Equal(t, 2, len(broadcaster.BroadcastMessages))
+	assert.DeepEqual(t, finality, broadcaster.BroadcastMessages[0])
+	assert.DeepEqual(t, optimistic, broadcaster.BroadcastMessages[1])
+
+	// A block without enough sync committee participants has no update.
+	other, otherState := lightClientTestBlock(t, 42, attestedRoot[:], 0, nil)
+	require.NoError(t, s.saveLightClientUpdate(ctx, other, otherState))
+	assert.Equal(t, 2, len(broadcaster.BroadcastMessages))
+}
+
+func TestService_setLatestLightClientUpdates(t *testing.T) {
+	s := &Service{}
+	newUpdate := func(attestedSlot, finalizedSlot primitives.Slot, participants int, finality bool) *ethpb.LightClientUpdate {
+		u := util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+			AttestedHeader:  &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: attestedSlot}},
+			FinalizedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: finalizedSlot}},
+		})
+		for i := 0; i < participants; i++ {
+			u.SyncAggregate.SyncCommitteeBits.SetBitAt(uint64(i), true)
+		}
+		if finality {
+			u.FinalityBranch[0] = []byte{'a'}
+		}
+		return u
+	}
+
+	finality, optimistic := s.setLatestLightClientUpdates(newUpdate(10, 0, 100, false))
+	assert.Equal(t, true, finality == nil)
+	require.NotNil(t, optimistic)
+
+	finality, optimistic = s.setLatestLightClientUpdates(newUpdate(20, 8, 100, true))
+	require.NotNil(t, finality)
+	require.NotNil(t, optimistic)
+
+	// Older attested header, same finalized header without a supermajority.
+	finality, optimistic = s.setLatestLightClientUpdates(newUpdate(15, 8, 100, true))
+	assert.Equal(t, true, finality == nil)
+	assert.Equal(t, true, optimistic == nil)
+
+	// Same finalized header with a supermajority.
+	finality, optimistic = s.setLatestLightClientUpdates(newUpdate(21, 8, 400, true))
+	require.NotNil(t, finality)
+	require.NotNil(t, optimistic)
+	assert.Equal(t, primitives.Slot(21), s.LightClientFinalityUpdate().AttestedHeader.Beacon.Slot)
+
+	finality, _ = s.setLatestLightClientUpdates(newUpdate(22, 8, 500, true))
+	assert.Equal(t, true, finality == nil)
+	finality, _ = s.setLatestLightClientUpdates(newUpdate(23, 16, 100, true))
+	require.NotNil(t, finality)
+	assert.Equal(t, primitives.Slot(16), s.LightClientFinalityUpdate().FinalizedHeader.Beacon.Slot)
+}
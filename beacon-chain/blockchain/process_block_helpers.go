@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -144,6 +146,9 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 			log.WithError(err).Error("could not migrate to cold")
 		}
 	}()
+	if features.Get().EnableRewardsIndexer {
+		go s.indexFinalizedRewards(cp)
+	}
 	return nil
 }
 
@@ -246,10 +251,33 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) {
 	// Prune deposits which have already been finalized, the below method prunes all pending deposits (non-inclusive) up
 	// to the provided eth1 deposit index.
 	s.cfg.DepositCache.PrunePendingDeposits(ctx, int64(eth1DepositIndex)) // lint:ignore uintcast -- Deposit index should not exceed int64 in your lifetime.
+	// The deposit tree can only be finalized once all the deposits of the finalized eth1 data
+	// have been processed, as the tree is finalized up to the eth1 data's execution block.
+	if finalizedState.Eth1DepositIndex() == finalizedState.Eth1Data().DepositCount {
+		if err = s.finalizeDepositTree(ctx, finalizedState.Eth1Data()); err != nil {
+			log.WithError(err).Error("could not finalize deposit tree")
+		}
+	}
 
 	log.WithField("duration", time.Since(startTime).String()).Debug("Finalized deposit insertion completed")
 }
 
+// finalizeDepositTree finalizes the deposit tree of the deposit cache up to the execution block
+// of the provided eth1 data.
+func (s *Service) finalizeDepositTree(ctx context.Context, eth1Data *ethpb.Eth1Data) error {
+	if s.cfg.ExecutionEngineCaller == nil {
+		return errors.New("no execution engine caller")
+	}
+	blk, err := s.cfg.ExecutionEngineCaller.ExecutionBlockByHash(ctx, common.BytesToHash(eth1Data.BlockHash), false /* no txs */)
+	if err != nil {
+		return errors.Wrap(err, "could not get execution block")
+	}
+	if blk == nil || blk.Number == nil {
+		return errors.New("execution block not found")
+	}
+	return s.cfg.DepositCache.FinalizeDepositTree(ctx, eth1Data.DepositCount, bytesutil.ToBytes32(eth1Data.BlockHash), blk.Number.Uint64())
+}
+
 // This ensures that the input root defaults to using genesis root instead of zero hashes. This is needed for handling
 // fork choice justification routine.
 func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
@@ -0,0 +1,77 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// BlobReceiver interface defines the methods of chain service for receiving new
+// blob sidecars.
+type BlobReceiver interface {
+	ReceiveBlob(ctx context.Context, blob *ethpb.BlobSidecar) error
+}
+
+// ReceiveBlob saves the blob sidecar to the database and notifies the processing of its block,
+// which may be waiting on the availability of the blob.
+func (s *Service) ReceiveBlob(ctx context.Context, blob *ethpb.BlobSidecar) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlob")
+	defer span.End()
+
+	if err := s.cfg.BeaconDB.SaveBlobSidecar(ctx, []*ethpb.BlobSidecar{blob}); err != nil {
+		return err
+	}
+	root, err := blob.SignedBlockHeader.Header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.blobNotifiers.notifyIndex(root, blob.Index)
+	return nil
+}
+
+// blobNotifierMap keeps a channel per block root being checked for data availability. Received blob
+// indices are sent on the channel of their block root, if one exists. The zero value is ready to use.
+type blobNotifierMap struct {
+	sync.Mutex
+	notifiers map[[32]byte]chan uint64
+}
+
+// forRoot returns the channel receiving the blob indices of the given block root, creating it if needed.
+func (bn *blobNotifierMap) forRoot(root [32]byte) chan uint64 {
+	bn.Lock()
+	defer bn.Unlock()
+	if bn.notifiers == nil {
+		bn.notifiers = make(map[[32]byte]chan uint64)
+	}
+	c, ok := bn.notifiers[root]
+	if !ok {
+		c = make(chan uint64, fieldparams.MaxBlobsPerBlock)
+		bn.notifiers[root] = c
+	}
+	return c
+}
+
+// notifyIndex sends the blob index on the channel of the given block root. It never blocks, as the
+// channel has room for every blob of a block and a full channel means the index was already notified.
+func (bn *blobNotifierMap) notifyIndex(root [32]byte, idx uint64) {
+	bn.Lock()
+	defer bn.Unlock()
+	c, ok := bn.notifiers[root]
+	if !ok {
+		return
+	}
+	select {
+	case c <- idx:
+	default:
+	}
+}
+
+// delete removes the channel of the given block root.
+func (bn *blobNotifierMap) delete(root [32]byte) {
+	bn.Lock()
+	defer bn.Unlock()
+	delete(bn.notifiers, root)
+}
@@ -0,0 +1,144 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func denebBlockWithCommitments(t *testing.T, n int) (interfaces.ReadOnlySignedBeaconBlock, [32]byte) {
+	b := util.NewBeaconBlockDeneb()
+	b.Block.Body.BlobKzgCommitments = make([][]byte, n)
+	for i := range b.Block.Body.BlobKzgCommitments {
+		b.Block.Body.BlobKzgCommitments[i] = make([]byte, 48)
+	}
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return wsb, root
+}
+
+func blobSidecarsForBlock(t *testing.T, blk interfaces.ReadOnlySignedBeaconBlock, n int) []*ethpb.BlobSidecar {
+	header, err := blk.Header()
+	require.NoError(t, err)
+	scs := make([]*ethpb.BlobSidecar, n)
+	for i := range scs {
+		scs[i] = util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: uint64(i), SignedBlockHeader: header})
+	}
+	return scs
+}
+
+func TestService_isDataAvailable(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pre deneb", func(t *testing.T) {
+		s, _ := minimalTestService(t)
+		wsb, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		require.NoError(t, s.isDataAvailable(ctx, [32]byte{'a'}, wsb))
+	})
+	t.Run("no commitments", func(t *testing.T) {
+		s, _ := minimalTestService(t)
+		s.genesisTime = time.Now()
+		wsb, root := denebBlockWithCommitments(t, 0)
+		require.NoError(t, s.isDataAvailable(ctx, root, wsb))
+	})
+	t.Run("outside of the retention window", func(t *testing.T) {
+		s, _ := minimalTestService(t)
+		epochs := params.BeaconConfig().MinEpochsForBlobsSidecarsRequest + 1
+		s.genesisTime = time.Now().Add(-time.Duration(uint64(epochs)*uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+		wsb, root := denebBlockWithCommitments(t, 2)
+		require.NoError(t, s.isDataAvailable(ctx, root, wsb))
+	})
+	t.Run("sidecars in db", func(t *testing.T) {
+		s, req := minimalTestService(t)
+		s.genesisTime = time.Now()
+		wsb, root := denebBlockWithCommitments(t, 2)
+		require.NoError(t, req.db.SaveBlobSidecar(ctx, blobSidecarsForBlock(t, wsb, 2)))
+		require.NoError(t, s.isDataAvailable(ctx, root, wsb))
+	})
+	t.Run("sidecars received while waiting", func(t *testing.T) {
+		s, req := minimalTestService(t)
+		s.genesisTime = time.Now()
+		wsb, root := denebBlockWithCommitments(t, 2)
+		scs := blobSidecarsForBlock(t, wsb, 2)
+		require.NoError(t, req.db.SaveBlobSidecar(ctx, scs[:1]))
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- s.isDataAvailable(ctx, root, wsb)
+		}()
+		// Wait for the availability check to register its notifier before the sidecar arrives.
+		require.NoError(t, waitForNotifier(s, root))
+		require.NoError(t, s.ReceiveBlob(ctx, scs[1]))
+		require.NoError(t, <-errCh)
+	})
+	t.Run("missing sidecars", func(t *testing.T) {
+		s, _ := minimalTestService(t)
+		s.genesisTime = time.Now()
+		wsb, root := denebBlockWithCommitments(t, 2)
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		require.ErrorContains(t, "2 of 2 blob sidecars", s.isDataAvailable(ctx, root, wsb))
+	})
+}
+
+func TestService_areDataAvailable(t *testing.T) {
+	ctx := context.Background()
+	s, req := minimalTestService(t)
+	s.genesisTime = time.Now()
+	capella, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+	require.NoError(t, err)
+	available, availableRoot := denebBlockWithCommitments(t, 2)
+	require.NoError(t, req.db.SaveBlobSidecar(ctx, blobSidecarsForBlock(t, available, 2)))
+	blks := []interfaces.ReadOnlySignedBeaconBlock{capella, available}
+	roots := [][32]byte{{'a'}, availableRoot}
+	require.NoError(t, s.areDataAvailable(ctx, blks, roots))
+
+	// The sidecars of a batch are not awaited.
+	missing, missingRoot := denebBlockWithCommitments(t, 3)
+	require.NoError(t, req.db.SaveBlobSidecar(ctx, blobSidecarsForBlock(t, missing, 1)))
+	blks = append(blks, missing)
+	roots = append(roots, missingRoot)
+	require.ErrorContains(t, "2 of 3 blob sidecars", s.areDataAvailable(ctx, blks, roots))
+}
+
+func waitForNotifier(s *Service, root [32]byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for {
+		s.blobNotifiers.Lock()
+		_, ok := s.blobNotifiers.notifiers[root]
+		s.blobNotifiers.Unlock()
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestService_ReceiveBlob(t *testing.T) {
+	ctx := context.Background()
+	s, req := minimalTestService(t)
+	wsb, root := denebBlockWithCommitments(t, 1)
+	sc := blobSidecarsForBlock(t, wsb, 1)[0]
+	nc := s.blobNotifiers.forRoot(root)
+
+	require.NoError(t, s.ReceiveBlob(ctx, sc))
+	require.Equal(t, uint64(0), <-nc)
+	scs, err := req.db.BlobSidecarsByRoot(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(scs))
+}
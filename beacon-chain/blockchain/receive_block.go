@@ -10,8 +10,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	coreTime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
@@ -68,6 +70,11 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 	if err != nil {
 		return err
 	}
+	// The state transition modifies the pre state, the rewards of the block are computed from a copy.
+	var rewardsPreState state.BeaconState
+	if features.Get().EnableRewardsIndexer && blockCopy.Version() >= version.Altair {
+		rewardsPreState = preState.Copy()
+	}
 	eg, _ := errgroup.WithContext(ctx)
 	var postState state.BeaconState
 	eg.Go(func() error {
@@ -85,6 +92,12 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		}
 		return nil
 	})
+	eg.Go(func() error {
+		if err := s.isDataAvailable(ctx, blockRoot, blockCopy); err != nil {
+			return errors.Wrap(err, "could not validate blob data availability")
+		}
+		return nil
+	})
 	if err := eg.Wait(); err != nil {
 		return err
 	}
@@ -100,6 +113,23 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		tracing.AnnotateError(span, err)
 		return err
 	}
+	if features.Get().EnableLightClient && blockCopy.Version() >= version.Altair {
+		s.headLock.RLock()
+		isHead := s.headRoot() == blockRoot
+		s.headLock.RUnlock()
+		if isHead {
+			go s.processLightClientUpdate(blockCopy, postState)
+		}
+	}
+	// The rewards of the canonical blocks are kept until their epoch is finalized and indexed.
+	if rewardsPreState != nil {
+		s.headLock.RLock()
+		isHead := s.headRoot() == blockRoot
+		s.headLock.RUnlock()
+		if isHead {
+			s.queueBlockRewards(rewardsPreState, blockCopy, blockRoot)
+		}
+	}
 	if coreTime.CurrentEpoch(postState) > currentEpoch {
 		headSt, err := s.HeadState(ctx)
 		if err != nil {
@@ -126,6 +156,9 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 			s.insertFinalizedDeposits(depCtx, finalized.Root)
 			cancel()
 		}()
+		if features.Get().EnableLightClient {
+			go s.processLightClientBootstrap(finalized.Root)
+		}
 	}
 
 	// If slasher is configured, forward the attestations in the block via an event feed for processing.
@@ -168,6 +201,81 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 	return nil
 }
 
+// isDataAvailable blocks until every blob sidecar committed to by the block has been received, or the
+// context is done. Blocks from before deneb, or outside of the blob retention window, are always available.
+func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed interfaces.ReadOnlySignedBeaconBlock) error {
+	// Register for notifications before looking up the db, so that a sidecar saved in between is not missed.
+	nc := s.blobNotifiers.forRoot(root)
+	defer s.blobNotifiers.delete(root)
+
+	missing, total, err := s.missingBlobIndices(ctx, root, signed)
+	if err != nil {
+		return err
+	}
+	for len(missing) > 0 {
+		select {
+		case idx := <-nc:
+			delete(missing, idx)
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "%d of %d blob sidecars of block %#x are still missing", len(missing), total, root)
+		}
+	}
+	return nil
+}
+
+// areDataAvailable checks that the blob sidecars committed to by every block of the batch are stored. Unlike
+// isDataAvailable it does not wait for sidecars, as the sidecars of a batch are fetched along with its blocks.
+func (s *Service) areDataAvailable(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, roots [][32]byte) error {
+	for i, b := range blks {
+		missing, total, err := s.missingBlobIndices(ctx, roots[i], b)
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			return errors.Errorf("%d of %d blob sidecars of block %#x are missing", len(missing), total, roots[i])
+		}
+	}
+	return nil
+}
+
+// missingBlobIndices returns the indices of the blob sidecars committed to by the block which are not stored,
+// along with the number of commitments. Nothing is missing for blocks from before deneb, or outside of the blob
+// retention window.
+func (s *Service) missingBlobIndices(ctx context.Context, root [32]byte, signed interfaces.ReadOnlySignedBeaconBlock) (map[uint64]bool, int, error) {
+	if signed.Version() < version.Deneb {
+		return nil, 0, nil
+	}
+	block := signed.Block()
+	if !withinDAPeriod(slots.ToEpoch(block.Slot()), slots.ToEpoch(s.CurrentSlot())) {
+		return nil, 0, nil
+	}
+	commitments, err := block.Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get KZG commitments")
+	}
+	if len(commitments) == 0 {
+		return nil, 0, nil
+	}
+	missing := make(map[uint64]bool, len(commitments))
+	for i := range commitments {
+		missing[uint64(i)] = true
+	}
+	scs, err := s.cfg.BeaconDB.BlobSidecarsByRoot(ctx, root)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, 0, errors.Wrap(err, "could not get blob sidecars")
+	}
+	for _, sc := range scs {
+		delete(missing, sc.Index)
+	}
+	return missing, len(commitments), nil
+}
+
+// withinDAPeriod returns true if the blobs of a block from the given epoch must be available, that is
+// if the block is within MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS of the current epoch.
+func withinDAPeriod(block, current primitives.Epoch) bool {
+	return block+params.BeaconConfig().MinEpochsForBlobsSidecarsRequest >= current
+}
+
 // ReceiveBlockBatch processes the whole block batch at once, assuming the block batch is linear ,transitioning
 // the state, performing batch verification of all collected signatures and then performing the appropriate
 // actions for a block post-transition.
@@ -175,6 +283,12 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []interfaces.Rea
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlockBatch")
 	defer span.End()
 
+	if err := s.areDataAvailable(ctx, blocks, blkRoots); err != nil {
+		err := errors.Wrap(err, "could not validate blob data availability")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
 	s.cfg.ForkChoiceStore.Lock()
 	defer s.cfg.ForkChoiceStore.Unlock()
 
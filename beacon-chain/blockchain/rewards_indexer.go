@@ -0,0 +1,632 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// blockRewardsCacheSize covers the blocks of the epochs between two finalized checkpoints, along with their forks.
+	blockRewardsCacheSize = 256
+	// maxPendingEpochRewards bounds the epoch transitions whose rewards are kept until they are indexed, as each of
+	// them holds the rewards of every validator. The rewards of the epochs beyond it, during long periods without
+	// finality, are replayed when indexed.
+	maxPendingEpochRewards = 4
+	// rewardsQueueSize bounds the canonical blocks waiting for their rewards to be computed. The rewards of the blocks
+	// which don't fit are replayed when indexed.
+	rewardsQueueSize = 8
+)
+
+// rewardsIndexer keeps the rewards of the recently processed canonical blocks and epoch transitions until their
+// epoch is finalized and indexed. Its lock serializes the indexing of the finalized epochs.
+type rewardsIndexer struct {
+	sync.Mutex
+	blocks     *lru.Cache
+	epochsLock sync.RWMutex
+	epochs     map[epochRewardsKey]*altair.EpochRewards
+	queue      chan *rewardsJob
+}
+
+// epochRewardsKey identifies an epoch transition by the last block of the epoch and the epoch. The last block of
+// several epochs is the same when the epochs following the first one are empty.
+type epochRewardsKey struct {
+	root  [32]byte
+	epoch primitives.Epoch
+}
+
+// rewardsJob is a canonical block whose rewards are computed in the background, from a copy of the state of its
+// parent.
+type rewardsJob struct {
+	preState state.BeaconState
+	block    interfaces.ReadOnlySignedBeaconBlock
+	root     [32]byte
+}
+
+func newRewardsIndexer() *rewardsIndexer {
+	return &rewardsIndexer{
+		blocks: lruwrpr.New(blockRewardsCacheSize),
+		epochs: make(map[epochRewardsKey]*altair.EpochRewards),
+		queue:  make(chan *rewardsJob, rewardsQueueSize),
+	}
+}
+
+// epochRewards returns the rewards applied by the processing of the end of the epoch on the chain of the given
+// block root, which is the last block of the epoch.
+func (r *rewardsIndexer) epochRewards(root [32]byte, epoch primitives.Epoch) (*altair.EpochRewards, bool) {
+	r.epochsLock.RLock()
+	defer r.epochsLock.RUnlock()
+	rewards, ok := r.epochs[epochRewardsKey{root: root, epoch: epoch}]
+	return rewards, ok
+}
+
+// addEpochRewards keeps the rewards of an epoch transition until the epoch is indexed, unless too many epochs are
+// already waiting to be indexed.
+func (r *rewardsIndexer) addEpochRewards(root [32]byte, rewards *altair.EpochRewards) {
+	r.epochsLock.Lock()
+	defer r.epochsLock.Unlock()
+	if len(r.epochs) >= maxPendingEpochRewards {
+		log.WithField("epoch", rewards.Epoch).Debug("Too many epochs waiting to be indexed, rewards will be replayed")
+		return
+	}
+	r.epochs[epochRewardsKey{root: root, epoch: rewards.Epoch}] = rewards
+}
+
+// pruneEpochRewards drops the rewards of the epoch transitions up to the given indexed epoch, including the ones
+// of the forks which were not finalized.
+func (r *rewardsIndexer) pruneEpochRewards(indexed primitives.Epoch) {
+	r.epochsLock.Lock()
+	defer r.epochsLock.Unlock()
+	for k := range r.epochs {
+		if k.epoch <= indexed {
+			delete(r.epochs, k)
+		}
+	}
+}
+
+// queueBlockRewards queues a canonical block for its rewards to be computed in the background. The block is dropped
+// when the queue is full, its rewards are then replayed when its epoch is indexed.
+func (s *Service) queueBlockRewards(preState state.BeaconState, signed interfaces.ReadOnlySignedBeaconBlock, root [32]byte) {
+	select {
+	case s.rewardsIndexer.queue <- &rewardsJob{preState: preState, block: signed, root: root}:
+	default:
+		log.WithField("slot", signed.Block().Slot()).Debug("Rewards queue is full, block rewards will be replayed")
+	}
+}
+
+// runRewardsTasks computes the rewards of the queued canonical blocks, one block at a time.
+func (s *Service) runRewardsTasks() {
+	if !features.Get().EnableRewardsIndexer {
+		return
+	}
+	for {
+		select {
+		case j := <-s.rewardsIndexer.queue:
+			s.cacheBlockRewards(j.preState, j.block, j.root)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// cacheBlockRewards computes the rewards of the proposer and of the sync committee members for a block, from a copy
+// of the state of its parent, and keeps them until the epoch of the block is indexed. When the block is the first
+// one of an epoch, the rewards applied by the processing of the end of the epoch of its parent are kept as well.
+func (s *Service) cacheBlockRewards(preState state.BeaconState, signed interfaces.ReadOnlySignedBeaconBlock, root [32]byte) {
+	ctx, cancel := context.WithTimeout(s.ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second)
+	defer cancel()
+	blk := signed.Block()
+	parentRoot := blk.ParentRoot()
+	st := preState
+	if slots.ToEpoch(blk.Slot()) > slots.ToEpoch(st.Slot()) {
+		var err error
+		st, err = s.cacheEpochRewards(ctx, st, parentRoot)
+		if err != nil {
+			log.WithError(err).WithField("slot", blk.Slot()).Error("Could not compute epoch rewards")
+			return
+		}
+	}
+	st, err := transition.ProcessSlotsUsingNextSlotCache(ctx, st, parentRoot[:], blk.Slot())
+	if err != nil {
+		log.WithError(err).WithField("slot", blk.Slot()).Error("Could not compute block rewards")
+		return
+	}
+	byIndex := make(map[primitives.ValidatorIndex]*ethpb.ValidatorEpochRewards)
+	if err := addBlockRewards(ctx, st, signed, rewardsRecorder(byIndex, slots.ToEpoch(blk.Slot()))); err != nil {
+		log.WithError(err).WithField("slot", blk.Slot()).Error("Could not compute block rewards")
+		return
+	}
+	s.rewardsIndexer.blocks.Add(root, byIndex)
+}
+
+// cacheEpochRewards computes the attestation rewards and penalties applied by the processing of the end of the epoch
+// of the given state, which is the state of the last block of the epoch, and keeps them until the epoch is indexed.
+// It returns the state advanced to the last slot of the epoch.
+func (s *Service) cacheEpochRewards(ctx context.Context, st state.BeaconState, root [32]byte) (state.BeaconState, error) {
+	epoch := slots.ToEpoch(st.Slot())
+	// Attestations are not rewarded at the end of the genesis epoch.
+	if st.Version() < version.Altair || epoch == params.BeaconConfig().GenesisEpoch {
+		return st, nil
+	}
+	end, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() < end {
+		st, err = transition.ProcessSlots(ctx, st, end)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process slots")
+		}
+	}
+	rewards, err := endOfEpochRewards(ctx, st.Copy())
+	if err != nil {
+		return nil, err
+	}
+	s.rewardsIndexer.addEpochRewards(root, rewards)
+	return st, nil
+}
+
+// indexFinalizedRewards indexes in the background the rewards of the epochs before the given finalized checkpoint
+// which are not indexed yet, in order. The indexing starts from the epoch following the last indexed one, within
+// the rewards retention window. The indexing stops at the first epoch which can't be indexed, so that no epoch is
+// skipped, it is retried with the next finalized checkpoint. A checkpoint finalized while epochs are being indexed
+// is skipped, its epochs are indexed along with the ones of the next finalized checkpoint.
+func (s *Service) indexFinalizedRewards(cp *ethpb.Checkpoint) {
+	if !s.rewardsIndexer.TryLock() {
+		return
+	}
+	defer s.rewardsIndexer.Unlock()
+	if cp.Epoch == 0 {
+		return
+	}
+	last := cp.Epoch - 1
+	first, err := s.firstUnindexedRewardsEpoch(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get the last indexed rewards epoch")
+		return
+	}
+	if first > last {
+		s.rewardsIndexer.pruneEpochRewards(last)
+		return
+	}
+	chain, err := s.canonicalBlocksSince(s.ctx, bytesutil.ToBytes32(cp.Root), first)
+	if err != nil {
+		log.WithError(err).Error("Could not get the finalized blocks to index rewards")
+		return
+	}
+	// The epoch is indexed before the end of the next one.
+	epochDuration := time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	for epoch := first; epoch <= last; epoch++ {
+		ctx, cancel := context.WithTimeout(s.ctx, epochDuration)
+		start := time.Now()
+		n, err := s.saveEpochRewards(ctx, chain, epoch)
+		cancel()
+		if err != nil {
+			log.WithError(err).WithField("epoch", epoch).Error("Could not index validator rewards")
+			return
+		}
+		s.rewardsIndexer.pruneEpochRewards(epoch)
+		log.WithFields(logrus.Fields{
+			"epoch":      epoch,
+			"validators": n,
+			"duration":   time.Since(start),
+		}).Debug("Indexed validator rewards")
+	}
+}
+
+// firstUnindexedRewardsEpoch returns the epoch following the last indexed one. Epochs before altair, epochs which
+// are already out of the rewards retention window and, for a node synced from a checkpoint, epochs up to the one of
+// the origin checkpoint block are not indexed.
+func (s *Service) firstUnindexedRewardsEpoch(ctx context.Context) (primitives.Epoch, error) {
+	first := params.BeaconConfig().AltairForkEpoch
+	current := slots.ToEpoch(s.CurrentSlot())
+	if retention := primitives.Epoch(features.Get().RewardsRetentionEpochs); retention > 0 && current >= retention && current-retention+1 > first {
+		first = current - retention + 1
+	}
+	originRoot, err := s.cfg.BeaconDB.OriginCheckpointBlockRoot(ctx)
+	switch {
+	case err == nil:
+		// The blocks before the origin checkpoint block may not be available.
+		origin, err := s.getBlock(ctx, originRoot)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get origin checkpoint block")
+		}
+		if e := slots.ToEpoch(origin.Block().Slot()) + 1; e > first {
+			first = e
+		}
+	case !errors.Is(err, db.ErrNotFoundOriginBlockRoot):
+		return 0, errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	indexed, err := s.cfg.BeaconDB.LastValidatorRewardsEpoch(ctx)
+	if errors.Is(err, db.ErrNotFound) {
+		return first, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if indexed+1 > first {
+		first = indexed + 1
+	}
+	return first, nil
+}
+
+// blockRef identifies a block of the canonical chain.
+type blockRef struct {
+	root [32]byte
+	slot primitives.Slot
+}
+
+// canonicalBlocksSince returns in slot order the blocks of the chain of the given root since the start of an epoch,
+// preceded by the last block before the epoch. The genesis block is not proposed, it is the block before the genesis
+// epoch.
+func (s *Service) canonicalBlocksSince(ctx context.Context, root [32]byte, epoch primitives.Epoch) ([]blockRef, error) {
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	var chain []blockRef
+	for {
+		blk, err := s.getBlock(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, blockRef{root: root, slot: blk.Block().Slot()})
+		if blk.Block().Slot() < start || blk.Block().Slot() == 0 {
+			break
+		}
+		root = blk.Block().ParentRoot()
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
+
+// epochBlocks returns the blocks of the chain proposed during an epoch, along with the last block before them. The
+// chain starts with a block before the epoch.
+func epochBlocks(chain []blockRef, epoch primitives.Epoch) ([]blockRef, blockRef, error) {
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, blockRef{}, err
+	}
+	end, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return nil, blockRef{}, err
+	}
+	lo := sort.Search(len(chain), func(i int) bool { return chain[i].slot >= start && chain[i].slot > 0 })
+	hi := sort.Search(len(chain), func(i int) bool { return chain[i].slot > end })
+	if lo == 0 {
+		return nil, blockRef{}, fmt.Errorf("no block before epoch %d", epoch)
+	}
+	return chain[lo:hi], chain[lo-1], nil
+}
+
+// saveEpochRewards saves the rewards and penalties of the validators during an epoch of the given chain. The rewards
+// captured while processing the blocks and the end of the epoch are used when available, otherwise the blocks of
+// the epoch are replayed. It returns the number of validators the rewards were saved for. Epochs before altair are
+// not indexed.
+func (s *Service) saveEpochRewards(ctx context.Context, chain []blockRef, epoch primitives.Epoch) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveEpochRewards")
+	defer span.End()
+
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		return 0, nil
+	}
+	blocks, base, err := epochBlocks(chain, epoch)
+	if err != nil {
+		return 0, err
+	}
+	rewards, ok := s.cachedEpochRewards(blocks, base, epoch)
+	if !ok {
+		rewards, err = s.replayEpochRewards(ctx, blocks, base, epoch)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if err := s.cfg.BeaconDB.SaveValidatorEpochRewards(ctx, epoch, rewards); err != nil {
+		return 0, errors.Wrap(err, "could not save validator rewards")
+	}
+	return len(rewards), nil
+}
+
+// cachedEpochRewards returns the rewards and penalties of the validators during an epoch from the rewards captured
+// while processing its blocks and its end. It returns false when some of them are not cached, for instance after a
+// restart or for the blocks imported by batch.
+func (s *Service) cachedEpochRewards(blocks []blockRef, base blockRef, epoch primitives.Epoch) ([]*ethpb.ValidatorEpochRewards, bool) {
+	byIndex := make(map[primitives.ValidatorIndex]*ethpb.ValidatorEpochRewards)
+	rewardsOf := rewardsRecorder(byIndex, epoch)
+	for _, b := range blocks {
+		v, ok := s.rewardsIndexer.blocks.Get(b.root)
+		if !ok {
+			return nil, false
+		}
+		blockRewards, ok := v.(map[primitives.ValidatorIndex]*ethpb.ValidatorEpochRewards)
+		if !ok {
+			return nil, false
+		}
+		for idx, r := range blockRewards {
+			total := rewardsOf(idx)
+			total.ProposerReward += r.ProposerReward
+			total.SyncCommitteeReward += r.SyncCommitteeReward
+			total.SyncCommitteePenalty += r.SyncCommitteePenalty
+		}
+	}
+	// Attestations are not rewarded at the end of the genesis epoch.
+	if epoch > params.BeaconConfig().GenesisEpoch {
+		last := base
+		if len(blocks) > 0 {
+			last = blocks[len(blocks)-1]
+		}
+		epochRewards, ok := s.rewardsIndexer.epochRewards(last.root, epoch)
+		if !ok {
+			return nil, false
+		}
+		addAttestationRewards(epochRewards, rewardsOf)
+	}
+	return sortedRewards(byIndex), true
+}
+
+// replayEpochRewards replays the blocks of an epoch on top of the state of the last block before the epoch, and
+// returns the rewards and penalties of the validators during the epoch.
+func (s *Service) replayEpochRewards(ctx context.Context, blocks []blockRef, base blockRef, epoch primitives.Epoch) ([]*ethpb.ValidatorEpochRewards, error) {
+	st, err := s.cfg.StateGen.StateByRoot(ctx, base.root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state before epoch")
+	}
+	signed := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blocks))
+	for _, b := range blocks {
+		blk, err := s.getBlock(ctx, b.root)
+		if err != nil {
+			return nil, err
+		}
+		signed = append(signed, blk)
+	}
+	return epochRewards(ctx, st, signed, epoch)
+}
+
+// epochRewards replays the blocks of an epoch on top of the state of the last block before the epoch, and returns
+// the rewards and penalties of the validators during the epoch, ordered by validator index. Validators without any
+// reward or penalty are omitted. The attestation rewards and penalties are the ones applied by the processing of
+// the end of the epoch, for the attestations of the previous epoch.
+func epochRewards(
+	ctx context.Context,
+	st state.BeaconState,
+	epochBlocks []interfaces.ReadOnlySignedBeaconBlock,
+	epoch primitives.Epoch,
+) ([]*ethpb.ValidatorEpochRewards, error) {
+	byIndex := make(map[primitives.ValidatorIndex]*ethpb.ValidatorEpochRewards)
+	rewardsOf := rewardsRecorder(byIndex, epoch)
+
+	var err error
+	for _, blk := range epochBlocks {
+		st, err = transition.ProcessSlots(ctx, st, blk.Block().Slot())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process slots")
+		}
+		if err := addBlockRewards(ctx, st, blk, rewardsOf); err != nil {
+			return nil, err
+		}
+		st, err = transition.ProcessBlockForStateRoot(ctx, st, blk)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process block")
+		}
+	}
+	end, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() < end {
+		st, err = transition.ProcessSlots(ctx, st, end)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process slots")
+		}
+	}
+	// Attestations are not rewarded at the end of the genesis epoch.
+	if epoch > params.BeaconConfig().GenesisEpoch {
+		epochRewards, err := endOfEpochRewards(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+		addAttestationRewards(epochRewards, rewardsOf)
+	}
+	return sortedRewards(byIndex), nil
+}
+
+// rewardsRecorder returns a function returning the rewards of a validator during an epoch, which are added to the
+// given map the first time they are requested.
+func rewardsRecorder(
+	byIndex map[primitives.ValidatorIndex]*ethpb.ValidatorEpochRewards,
+	epoch primitives.Epoch,
+) func(primitives.ValidatorIndex) *ethpb.ValidatorEpochRewards {
+	return func(idx primitives.ValidatorIndex) *ethpb.ValidatorEpochRewards {
+		r, ok := byIndex[idx]
+		if !ok {
+			r = &ethpb.ValidatorEpochRewards{Epoch: epoch, ValidatorIndex: idx}
+			byIndex[idx] = r
+		}
+		return r
+	}
+}
+
+// sortedRewards returns the rewards of the map ordered by validator index.
+func sortedRewards(byIndex map[primitives.ValidatorIndex]*ethpb.ValidatorEpochRewards) []*ethpb.ValidatorEpochRewards {
+	rewards := make([]*ethpb.ValidatorEpochRewards, 0, len(byIndex))
+	for _, r := range byIndex {
+		rewards = append(rewards, r)
+	}
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].ValidatorIndex < rewards[j].ValidatorIndex
+	})
+	return rewards
+}
+
+// addBlockRewards adds the rewards of the proposer of a block and the rewards and penalties of the sync committee
+// members for its sync aggregate, given the state at the slot of the block before the block is processed.
+func addBlockRewards(
+	ctx context.Context,
+	st state.BeaconState,
+	blk interfaces.ReadOnlySignedBeaconBlock,
+	rewardsOf func(primitives.ValidatorIndex) *ethpb.ValidatorEpochRewards,
+) error {
+	opsReward, err := blockOperationsReward(ctx, st, blk)
+	if err != nil {
+		return err
+	}
+	syncReward, err := addSyncAggregateRewards(st, blk, rewardsOf)
+	if err != nil {
+		return err
+	}
+	rewardsOf(blk.Block().ProposerIndex()).ProposerReward += opsReward + syncReward
+	return nil
+}
+
+// blockOperationsReward returns the reward of the proposer of a block for the slashings and attestations it
+// includes, by processing them on a copy of the state at the slot of the block.
+func blockOperationsReward(ctx context.Context, st state.BeaconState, blk interfaces.ReadOnlySignedBeaconBlock) (uint64, error) {
+	proposerIndex := blk.Block().ProposerIndex()
+	body := blk.Block().Body()
+	st = st.Copy()
+	before, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return 0, err
+	}
+	st, err = blocks.ProcessProposerSlashings(ctx, st, body.ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not process proposer slashings")
+	}
+	st, err = blocks.ProcessAttesterSlashings(ctx, st, body.AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not process attester slashings")
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not process attestations")
+	}
+	after, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return 0, err
+	}
+	// The proposer is penalized rather than rewarded if it includes its own slashing.
+	if after < before {
+		return 0, nil
+	}
+	return after - before, nil
+}
+
+// addSyncAggregateRewards adds the rewards and penalties of the sync committee members for the sync aggregate of a
+// block, and returns the reward of the proposer for including it.
+func addSyncAggregateRewards(
+	st state.BeaconState,
+	blk interfaces.ReadOnlySignedBeaconBlock,
+	rewardsOf func(primitives.ValidatorIndex) *ethpb.ValidatorEpochRewards,
+) (uint64, error) {
+	sa, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return 0, err
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return 0, err
+	}
+	if committee == nil {
+		return 0, errors.New("nil current sync committee in state")
+	}
+	if sa.SyncCommitteeBits.Len() > uint64(len(committee.Pubkeys)) {
+		return 0, errors.New("bits length exceeds committee length")
+	}
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return 0, err
+	}
+	proposerReward, participantReward, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		return 0, err
+	}
+	var earned uint64
+	for i := uint64(0); i < sa.SyncCommitteeBits.Len(); i++ {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[i]))
+		if !ok {
+			return 0, errors.New("validator public key does not exist in state")
+		}
+		if sa.SyncCommitteeBits.BitAt(i) {
+			rewardsOf(idx).SyncCommitteeReward += participantReward
+			earned += proposerReward
+		} else {
+			rewardsOf(idx).SyncCommitteePenalty += participantReward
+		}
+	}
+	return earned, nil
+}
+
+// endOfEpochRewards returns the attestation rewards and penalties applied by the processing of the end of the epoch
+// of the state, without applying them.
+func endOfEpochRewards(ctx context.Context, st state.BeaconState) (*altair.EpochRewards, error) {
+	vals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize precompute validators")
+	}
+	vals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process epoch participation")
+	}
+	// The inactivity leak depends on the finalized checkpoint, which is updated before the rewards are applied.
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bal)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process justification")
+	}
+	st, vals, err = altair.ProcessInactivityScores(ctx, st, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process inactivity updates")
+	}
+	deltas, err := altair.AttestationsDelta(st, bal, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestations delta")
+	}
+	return altair.NewEpochRewards(st, deltas, vals)
+}
+
+// addAttestationRewards adds the attestation rewards and penalties applied by the processing of the end of an
+// epoch, splitting the inactivity leak penalty from the target penalty.
+func addAttestationRewards(
+	epochRewards *altair.EpochRewards,
+	rewardsOf func(primitives.ValidatorIndex) *ethpb.ValidatorEpochRewards,
+) {
+	for i, d := range epochRewards.Deltas {
+		if d.SourceReward == 0 && d.SourcePenalty == 0 && d.TargetReward == 0 && d.TargetPenalty == 0 && d.HeadReward == 0 {
+			continue
+		}
+		inactivityPenalty := epochRewards.InactivityPenalties[i]
+		r := rewardsOf(primitives.ValidatorIndex(i))
+		r.SourceReward = d.SourceReward
+		r.SourcePenalty = d.SourcePenalty
+		r.TargetReward = d.TargetReward
+		r.TargetPenalty = d.TargetPenalty - inactivityPenalty
+		r.HeadReward = d.HeadReward
+		r.InactivityPenalty = inactivityPenalty
+	}
+}
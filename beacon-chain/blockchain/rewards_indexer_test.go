@@ -0,0 +1,265 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+// generateAltairEpochs returns the blocks of the first epochs of an altair chain, along with the genesis state.
+// Every other block of the last epoch includes a full sync aggregate.
+func generateAltairEpochs(t *testing.T, epochs primitives.Epoch) (state.BeaconState, []interfaces.ReadOnlySignedBeaconBlock) {
+	ctx := context.Background()
+	genesis, keys := util.DeterministicGenesisStateAltair(t, 64)
+	c, err := altair.NextSyncCommittee(ctx, genesis)
+	require.NoError(t, err)
+	require.NoError(t, genesis.SetCurrentSyncCommittee(c))
+	require.NoError(t, genesis.SetNextSyncCommittee(c))
+	st := genesis.Copy()
+	end := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epochs))
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	for slot := primitives.Slot(1); slot < end; slot++ {
+		conf := util.DefaultBlockGenConfig()
+		conf.FullSyncAggregate = slot+params.BeaconConfig().SlotsPerEpoch >= end && slot%2 == 0
+		b, err := util.GenerateFullBlockAltair(st, keys, conf, slot)
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, wsb)
+		require.NoError(t, err)
+		blks = append(blks, wsb)
+	}
+	return genesis, blks
+}
+
+func TestEpochRewards(t *testing.T) {
+	ctx := context.Background()
+	genesis, blks := generateAltairEpochs(t, 2)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	// Replay the blocks of the genesis epoch to get the state of the last block before epoch 1.
+	base := genesis.Copy()
+	var err error
+	for _, b := range blks[:spe-1] {
+		base, err = transition.ExecuteStateTransition(ctx, base, b)
+		require.NoError(t, err)
+	}
+	epochStart, err := transition.ProcessSlots(ctx, base.Copy(), spe)
+	require.NoError(t, err)
+	epochEnd := epochStart.Copy()
+	for _, b := range blks[spe-1:] {
+		epochEnd, err = transition.ExecuteStateTransition(ctx, epochEnd, b)
+		require.NoError(t, err)
+	}
+	// Apply the processing of the end of epoch 1.
+	epochEnd, err = transition.ProcessSlots(ctx, epochEnd, 2*spe)
+	require.NoError(t, err)
+
+	rewards, err := epochRewards(ctx, base, blks[spe-1:], 1)
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(rewards))
+
+	var proposerRewards, syncRewards, syncPenalties, targetRewards, headRewards uint64
+	byIndex := make(map[primitives.ValidatorIndex]int64)
+	for i, r := range rewards {
+		if i > 0 {
+			require.Equal(t, true, rewards[i-1].ValidatorIndex < r.ValidatorIndex)
+		}
+		assert.Equal(t, primitives.Epoch(1), r.Epoch)
+		proposerRewards += r.ProposerReward
+		syncRewards += r.SyncCommitteeReward
+		syncPenalties += r.SyncCommitteePenalty
+		targetRewards += r.TargetReward
+		headRewards += r.HeadReward
+		byIndex[r.ValidatorIndex] = int64(r.SourceReward+r.TargetReward+r.HeadReward+r.ProposerReward+r.SyncCommitteeReward) -
+			int64(r.SourcePenalty+r.TargetPenalty+r.InactivityPenalty+r.SyncCommitteePenalty)
+	}
+	assert.NotEqual(t, uint64(0), proposerRewards)
+	assert.NotEqual(t, uint64(0), syncRewards)
+	assert.NotEqual(t, uint64(0), syncPenalties)
+	assert.NotEqual(t, uint64(0), targetRewards)
+	assert.NotEqual(t, uint64(0), headRewards)
+
+	// The rewards and penalties account for the balance changes of the validators during the epoch.
+	for i := 0; i < epochEnd.NumValidators(); i++ {
+		before, err := epochStart.BalanceAtIndex(primitives.ValidatorIndex(i))
+		require.NoError(t, err)
+		after, err := epochEnd.BalanceAtIndex(primitives.ValidatorIndex(i))
+		require.NoError(t, err)
+		assert.Equal(t, int64(after)-int64(before), byIndex[primitives.ValidatorIndex(i)], "validator %d", i)
+	}
+}
+
+func TestService_saveEpochRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	service, tr := minimalTestService(t)
+	genesis, blks := generateAltairEpochs(t, 2)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	// Every block of the chain is saved, along with the state of the last block of the genesis epoch.
+	st := genesis.Copy()
+	var root [32]byte
+	var err error
+	for i, b := range blks {
+		st, err = transition.ExecuteStateTransition(ctx, st, b)
+		require.NoError(t, err)
+		require.NoError(t, tr.db.SaveBlock(ctx, b))
+		root, err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		if primitives.Slot(i) == spe-2 {
+			require.NoError(t, tr.db.SaveState(ctx, st, root))
+		}
+	}
+
+	chain, err := service.canonicalBlocksSince(ctx, root, 1)
+	require.NoError(t, err)
+	require.Equal(t, int(spe)+1, len(chain))
+	n, err := service.saveEpochRewards(ctx, chain, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, 0, n)
+	saved, err := tr.db.ValidatorEpochRewards(ctx, 1, 1, 0, nil, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, n, len(saved))
+
+	// Epochs before altair are not indexed.
+	cfg.AltairForkEpoch = 2
+	params.OverrideBeaconConfig(cfg)
+	n, err = service.saveEpochRewards(ctx, chain, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestService_indexFinalizedRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	resetCfg := features.InitWithReset(&features.Flags{EnableRewardsIndexer: true})
+	defer resetCfg()
+	ctx := context.Background()
+	service, tr := minimalTestService(t)
+	genesis, blks := generateAltairEpochs(t, 2)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	// The rewards of the blocks are captured while they are processed, and no state is saved.
+	st := genesis.Copy()
+	var base state.BeaconState
+	var root [32]byte
+	var err error
+	for i, b := range blks {
+		root, err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		service.cacheBlockRewards(st.Copy(), b, root)
+		st, err = transition.ExecuteStateTransition(ctx, st, b)
+		require.NoError(t, err)
+		require.NoError(t, tr.db.SaveBlock(ctx, b))
+		if primitives.Slot(i) == spe-2 {
+			base = st.Copy()
+		}
+	}
+	// The rewards of the end of epoch 1 are captured along with the first block of epoch 2.
+	_, err = service.cacheEpochRewards(ctx, st.Copy(), root)
+	require.NoError(t, err)
+
+	// The captured rewards are the same as the replayed ones.
+	chain, err := service.canonicalBlocksSince(ctx, root, 1)
+	require.NoError(t, err)
+	epochBlks, epochBase, err := epochBlocks(chain, 1)
+	require.NoError(t, err)
+	require.Equal(t, int(spe), len(epochBlks))
+	cached, ok := service.cachedEpochRewards(epochBlks, epochBase, 1)
+	require.Equal(t, true, ok)
+	replayed, err := epochRewards(ctx, base, blks[spe-1:], 1)
+	require.NoError(t, err)
+	require.DeepEqual(t, replayed, cached)
+
+	// The finalized epochs following the last indexed one are indexed.
+	require.NoError(t, tr.db.SaveValidatorEpochRewards(ctx, 0, []*ethpb.ValidatorEpochRewards{{Epoch: 0, ProposerReward: 1}}))
+	first, err := service.firstUnindexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(1), first)
+	service.indexFinalizedRewards(&ethpb.Checkpoint{Epoch: 2, Root: root[:]})
+	saved, err := tr.db.ValidatorEpochRewards(ctx, 1, 1, 0, nil, 1000)
+	require.NoError(t, err)
+	require.Equal(t, len(cached), len(saved))
+	for i := range saved {
+		assert.Equal(t, true, proto.Equal(cached[i], saved[i]), "validator %d", saved[i].ValidatorIndex)
+	}
+	first, err = service.firstUnindexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(2), first)
+	// Only the epochs waiting to be indexed are kept.
+	_, ok = service.rewardsIndexer.epochRewards(root, 1)
+	assert.Equal(t, false, ok)
+}
+
+func TestService_firstUnindexedRewardsEpoch_Origin(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	service, tr := minimalTestService(t)
+	// The origin checkpoint block root is saved by the checkpoint sync, the database interface doesn't expose it.
+	beaconDB, ok := tr.db.(*kv.Store)
+	require.Equal(t, true, ok)
+
+	first, err := service.firstUnindexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(0), first)
+
+	// A node synced from a checkpoint starts with the epoch following the one of the origin checkpoint block.
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch + 1
+	util.SaveBlock(t, ctx, beaconDB, b)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOriginCheckpointBlockRoot(ctx, root))
+	first, err = service.firstUnindexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(4), first)
+
+	// The epochs which are already indexed are skipped.
+	require.NoError(t, beaconDB.SaveValidatorEpochRewards(ctx, 5, []*ethpb.ValidatorEpochRewards{{Epoch: 5, ProposerReward: 1}}))
+	first, err = service.firstUnindexedRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(6), first)
+}
+
+func TestEpochBlocks(t *testing.T) {
+	spe := params.BeaconConfig().SlotsPerEpoch
+	chain := []blockRef{{slot: 0}, {slot: 1}, {slot: spe - 1}, {slot: 3*spe + 1}}
+	blks, base, err := epochBlocks(chain, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(blks))
+	assert.Equal(t, primitives.Slot(0), base.slot)
+	// Epochs without blocks have the last block before them as base.
+	blks, base, err = epochBlocks(chain, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blks))
+	assert.Equal(t, spe-1, base.slot)
+	blks, base, err = epochBlocks(chain, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(blks))
+	assert.Equal(t, spe-1, base.slot)
+	_, _, err = epochBlocks(chain[2:], 0)
+	require.ErrorContains(t, "no block before epoch 0", err)
+}
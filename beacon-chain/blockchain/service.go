@@ -60,6 +60,9 @@ type Service struct {
 	clockSetter          startup.ClockSetter
 	clockWaiter          startup.ClockWaiter
 	syncComplete         chan struct{}
+	blobNotifiers        blobNotifierMap
+	lcUpdates            lightClientUpdates
+	rewardsIndexer       *rewardsIndexer
 }
 
 // config options for the service.
@@ -99,6 +102,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		checkpointStateCache: cache.NewCheckpointStateCache(),
 		initSyncBlocks:       make(map[[32]byte]interfaces.ReadOnlySignedBeaconBlock),
 		cfg:                  &config{ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache()},
+		rewardsIndexer:       newRewardsIndexer(),
 	}
 	for _, opt := range opts {
 		if err := opt(srv); err != nil {
@@ -131,6 +135,8 @@ func (s *Service) Start() {
 	}
 	s.spawnProcessAttestationsRoutine()
 	go s.runLateBlockTasks()
+	go s.runForkChoiceSnapshotTasks()
+	go s.runRewardsTasks()
 }
 
 // Stop the blockchain service's main event loop and associated goroutines.
@@ -149,6 +155,11 @@ func (s *Service) Stop() error {
 	} else {
 		s.headLock.RUnlock()
 	}
+	if features.Get().EnableForkChoicePersistence {
+		if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+			log.WithError(err).Error("Could not save fork choice snapshot")
+		}
+	}
 	// Save initial sync cached blocks to the DB before stop.
 	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
 }
@@ -237,6 +248,9 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 			}
 		}
 	}
+	if features.Get().EnableForkChoicePersistence {
+		s.restoreForkChoiceSnapshot(s.ctx, finalized)
+	}
 	// not attempting to save initial sync blocks here, because there shouldn't be any until
 	// after the statefeed.Initialized event is fired (below)
 	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
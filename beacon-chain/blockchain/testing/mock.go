@@ -69,6 +69,9 @@ type ChainService struct {
 	OptimisticCheckRootReceived [32]byte
 	FinalizedRoots              map[[32]byte]bool
 	OptimisticRoots             map[[32]byte]bool
+	BlobsReceived               []*ethpb.BlobSidecar
+	LCFinalityUpdate            *ethpb.LightClientFinalityUpdate
+	LCOptimisticUpdate          *ethpb.LightClientOptimisticUpdate
 }
 
 func (s *ChainService) Ancestor(ctx context.Context, root []byte, slot primitives.Slot) ([]byte, error) {
@@ -263,6 +266,25 @@ func (s *ChainService) ReceiveBlock(ctx context.Context, block interfaces.ReadOn
 	return nil
 }
 
+// ReceiveBlob mocks ReceiveBlob method in chain service.
+func (s *ChainService) ReceiveBlob(ctx context.Context, b *ethpb.BlobSidecar) error {
+	s.BlobsReceived = append(s.BlobsReceived, b)
+	if s.DB != nil {
+		return s.DB.SaveBlobSidecar(ctx, []*ethpb.BlobSidecar{b})
+	}
+	return nil
+}
+
+// LightClientFinalityUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	return s.LCFinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	return s.LCOptimisticUpdate
+}
+
 // HeadSlot mocks HeadSlot method in chain service.
 func (s *ChainService) HeadSlot() primitives.Slot {
 	if s.State == nil {
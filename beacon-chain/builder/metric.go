@@ -28,3 +28,42 @@ var (
 		},
 	)
 )
+
+const (
+	getHeaderMethod          = "get_header"
+	submitBlindedBlockMethod = "submit_blinded_block"
+	registerValidatorMethod  = "register_validator"
+	statusMethod             = "status"
+)
+
+var (
+	relayLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_latency_milliseconds",
+			Help:    "Captures RPC latency of the requests to each relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay", "method"},
+	)
+	relayErrorCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_errors_total",
+			Help: "Count the number of failed requests or invalid responses of each relay",
+		},
+		[]string{"relay", "method"},
+	)
+	relayBidCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_bids_total",
+			Help: "Count the number of valid bids received from each relay",
+		},
+		[]string{"relay"},
+	)
+	relayBidWonCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_bids_won_total",
+			Help: "Count the number of bids of each relay which were the highest, the win rate of a relay is the ratio to builder_relay_bids_total",
+		},
+		[]string{"relay"},
+	)
+)
@@ -1,6 +1,9 @@
 package builder
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
@@ -13,25 +16,39 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var opts []Option
+	for _, endpoint := range c.StringSlice(flags.MevRelayEndpoint.Name) {
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		opts = append(opts, WithBuilderClient(client))
 	}
-	opts := []Option{
-		WithBuilderClient(client),
+	if c.IsSet(flags.MevRelayGetHeaderTimeout.Name) {
+		opts = append(opts, WithGetHeaderTimeout(c.Duration(flags.MevRelayGetHeaderTimeout.Name)))
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a relay client to the beacon chain builder service. The option can be
+// given once per relay.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
+		return nil
+	}
+}
+
+// WithGetHeaderTimeout sets the deadline for the relays to answer a header request.
+func WithGetHeaderTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		if timeout <= 0 {
+			return fmt.Errorf("relay get header timeout must be positive, got %s", timeout)
+		}
+		s.cfg.getHeaderTimeout = timeout
 		return nil
 	}
 }
@@ -0,0 +1,290 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultGetHeaderTimeout is the default deadline for the configured relays to answer a header request.
+// Bids received after the deadline are dropped.
+const defaultGetHeaderTimeout = 950 * time.Millisecond
+
+// winningBidRetention is the number of slots a winning bid is remembered for, so that the blinded
+// block built from it is submitted to the relay which offered it.
+const winningBidRetention = primitives.Slot(2)
+
+var errNoValidBid = errors.New("no relay returned a valid bid")
+
+// relay is a builder client of a single relay, with a name used in logs and metrics.
+type relay struct {
+	client builder.BuilderClient
+	name   string
+}
+
+func newRelay(c builder.BuilderClient) *relay {
+	return &relay{client: c, name: relayName(c.NodeURL())}
+}
+
+// relayName strips the scheme, credentials and path from a relay url, so that it can be used as a
+// metric label without leaking secrets.
+func relayName(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+	return u.Host
+}
+
+// relayBid is the bid of a relay which passed validation.
+type relayBid struct {
+	relay     *relay
+	bid       builder.SignedBid
+	value     *big.Int
+	blockHash [32]byte
+}
+
+// winningBid records which relay offered the header of a block hash.
+type winningBid struct {
+	slot  primitives.Slot
+	relay *relay
+}
+
+// winningBids remembers the relays of the winning bids of the recent slots.
+type winningBids struct {
+	sync.Mutex
+	bids map[[32]byte]winningBid
+}
+
+func (w *winningBids) add(slot primitives.Slot, blockHash [32]byte, r *relay) {
+	w.Lock()
+	defer w.Unlock()
+	if w.bids == nil {
+		w.bids = make(map[[32]byte]winningBid)
+	}
+	for h, b := range w.bids {
+		if b.slot+winningBidRetention < slot {
+			delete(w.bids, h)
+		}
+	}
+	w.bids[blockHash] = winningBid{slot: slot, relay: r}
+}
+
+func (w *winningBids) relay(blockHash [32]byte) (*relay, bool) {
+	w.Lock()
+	defer w.Unlock()
+	b, ok := w.bids[blockHash]
+	return b.relay, ok
+}
+
+// selectRelays returns the configured relays whose host is one of the given relays, every configured relay
+// when none is given. The relays which are not configured are skipped with a warning.
+func (s *Service) selectRelays(names []string) []*relay {
+	if len(names) == 0 {
+		return s.relays
+	}
+	wanted := make(map[string]bool, len(names))
+	for _, n := range names {
+		wanted[relayName(n)] = true
+	}
+	selected := make([]*relay, 0, len(names))
+	for _, r := range s.relays {
+		if wanted[r.name] {
+			selected = append(selected, r)
+			delete(wanted, r.name)
+		}
+	}
+	for n := range wanted {
+		log.WithField("relay", n).Warn("Requested relay is not configured, see --http-mev-relay")
+	}
+	return selected
+}
+
+// bestBid requests a header from every given relay in parallel and returns the highest valid bid received
+// before the deadline. Every relay is given the same deadline, a slow relay can not delay the others.
+func (s *Service) bestBid(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte, relays []*relay) (*relayBid, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.getHeaderTimeout)
+	defer cancel()
+
+	bids := make(chan *relayBid, len(relays))
+	var wg sync.WaitGroup
+	for _, r := range relays {
+		wg.Add(1)
+		go func(r *relay) {
+			defer wg.Done()
+			start := time.Now()
+			sb, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+			relayLatency.WithLabelValues(r.name, getHeaderMethod).Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayErrorCount.WithLabelValues(r.name, getHeaderMethod).Inc()
+				log.WithError(err).WithField("relay", r.name).Debug("Could not get header from relay")
+				return
+			}
+			b, err := validateBid(sb, parentHash)
+			if err != nil {
+				relayErrorCount.WithLabelValues(r.name, getHeaderMethod).Inc()
+				log.WithError(err).WithField("relay", r.name).Warn("Relay returned an invalid bid")
+				return
+			}
+			b.relay = r
+			relayBidCount.WithLabelValues(r.name).Inc()
+			bids <- b
+		}(r)
+	}
+	wg.Wait()
+	close(bids)
+
+	candidates := make([]*relayBid, 0, len(relays))
+	for b := range bids {
+		candidates = append(candidates, b)
+	}
+	// The signature is only checked for the best bids, so that a single verification is
+	// needed when the relays behave.
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].value.Cmp(candidates[j].value) > 0
+	})
+	for _, b := range candidates {
+		if err := validateBidSignature(b.bid); err != nil {
+			relayErrorCount.WithLabelValues(b.relay.name, getHeaderMethod).Inc()
+			log.WithError(err).WithField("relay", b.relay.name).Warn("Relay returned a bid with an invalid signature")
+			continue
+		}
+		relayBidWonCount.WithLabelValues(b.relay.name).Inc()
+		s.winningBids.add(slot, b.blockHash, b.relay)
+		log.WithFields(log.Fields{
+			"relay":     b.relay.name,
+			"value":     b.value.String(),
+			"blockHash": fmt.Sprintf("%#x", b.blockHash),
+			"bids":      len(candidates),
+			"slot":      slot,
+		}).Debug("Selected relay bid")
+		return b, nil
+	}
+	return nil, errNoValidBid
+}
+
+// validateBid checks the content of a bid. The signature is checked separately, see validateBidSignature.
+func validateBid(sb builder.SignedBid, parentHash [32]byte) (*relayBid, error) {
+	if sb == nil || sb.IsNil() {
+		return nil, errors.New("nil bid")
+	}
+	bid, err := sb.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	if bid.IsNil() {
+		return nil, errors.New("nil bid")
+	}
+	v := bytesutil.LittleEndianBytesToBigInt(bid.Value())
+	if v.Sign() == 0 {
+		return nil, errors.New("bid value is zero")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, errors.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	return &relayBid{bid: sb, value: v, blockHash: bytesutil.ToBytes32(header.BlockHash())}, nil
+}
+
+func validateBidSignature(sb builder.SignedBid) error {
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return err
+	}
+	bid, err := sb.Message()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid")
+	}
+	return signing.VerifySigningRoot(bid, bid.Pubkey(), sb.Signature(), d)
+}
+
+// submitToRelays submits a blinded block whose winning relay is unknown, e.g. after a restart, to all
+// relays in parallel. The first payload returned is used.
+func (s *Service) submitToRelays(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		payload interfaces.ExecutionData
+		err     error
+	}
+	results := make(chan result, len(s.relays))
+	for _, r := range s.relays {
+		go func(r *relay) {
+			p, err := s.submitToRelay(ctx, r, b)
+			results <- result{payload: p, err: err}
+		}(r)
+	}
+	var err error
+	for range s.relays {
+		res := <-results
+		if res.err == nil {
+			return res.payload, nil
+		}
+		err = res.err
+	}
+	return nil, err
+}
+
+func (s *Service) submitToRelay(ctx context.Context, r *relay, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error) {
+	start := time.Now()
+	p, err := r.client.SubmitBlindedBlock(ctx, b)
+	relayLatency.WithLabelValues(r.name, submitBlindedBlockMethod).Observe(float64(time.Since(start).Milliseconds()))
+	if err != nil {
+		relayErrorCount.WithLabelValues(r.name, submitBlindedBlockMethod).Inc()
+		return nil, errors.Wrapf(err, "could not submit blinded block to relay %s", r.name)
+	}
+	return p, nil
+}
+
+// registerWithRelays registers the validators with every relay in parallel. An error is only returned
+// if no relay accepted the registrations.
+func (s *Service) registerWithRelays(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make(chan error, len(s.relays))
+	for _, r := range s.relays {
+		go func(r *relay) {
+			start := time.Now()
+			err := r.client.RegisterValidator(ctx, reg)
+			relayLatency.WithLabelValues(r.name, registerValidatorMethod).Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayErrorCount.WithLabelValues(r.name, registerValidatorMethod).Inc()
+				log.WithError(err).WithField("relay", r.name).Error("Could not register validators with relay")
+				err = errors.Wrapf(err, "relay %s", r.name)
+			}
+			errs <- err
+		}(r)
+	}
+	var err error
+	registered := false
+	for range s.relays {
+		if e := <-errs; e != nil {
+			err = e
+		} else {
+			registered = true
+		}
+	}
+	if registered {
+		return nil
+	}
+	return err
+}
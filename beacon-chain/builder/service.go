@@ -2,6 +2,7 @@ package builder
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"time"
 
@@ -25,23 +26,26 @@ var ErrNoBuilder = errors.New("builder endpoint not configured")
 // BlockBuilder defines the interface for interacting with the block builder
 type BlockBuilder interface {
 	SubmitBlindedBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error)
-	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error)
+	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte, relays []string) (builder.SignedBid, error)
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	BidRelay(blockHash [32]byte) string
 	Configured() bool
 }
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients   []builder.BuilderClient
+	beaconDB         db.HeadAccessDatabase
+	headFetcher      blockchain.HeadFetcher
+	getHeaderTimeout time.Duration
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []*relay
+	winningBids       *winningBids
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
@@ -51,27 +55,34 @@ type Service struct {
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:         ctx,
+		cancel:      cancel,
+		cfg:         &config{getHeaderTimeout: defaultGetHeaderTimeout},
+		winningBids: &winningBids{},
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		r := newRelay(c)
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("relay", r.name).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", r.name).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -86,7 +97,8 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the builder relay network. The block is submitted to the
+// relay which offered its header, or to all relays if it is unknown.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -94,42 +106,57 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return nil, ErrNoBuilder
 	}
-
-	return s.c.SubmitBlindedBlock(ctx, b)
+	if b == nil || b.IsNil() {
+		return nil, errors.New("nil blinded block")
+	}
+	payload, err := b.Block().Body().Execution()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get execution header")
+	}
+	if r, ok := s.winningBids.relay(bytesutil.ToBytes32(payload.BlockHash())); ok {
+		return s.submitToRelay(ctx, r, b)
+	}
+	log.WithField("blockHash", fmt.Sprintf("%#x", payload.BlockHash())).Warn("Relay of the blinded block is unknown, submitting to all relays")
+	return s.submitToRelays(ctx, b)
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
-func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
+// GetHeader retrieves the header for a given slot and parent hash from the builder relay network. The relays
+// are requested in parallel, and the highest valid bid is returned. When relays are given, only the configured
+// relays with one of these hosts are requested.
+func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte, relays []string) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
 	start := time.Now()
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	b, err := s.bestBid(ctx, slot, parentHash, pubKey, s.selectRelays(relays))
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	return b.bid, nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if !s.Configured() {
 		return nil
 	}
 
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
+// RegisterValidator registers a validator with every relay of the builder relay network.
 // It also saves the registration object to the DB.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
@@ -138,7 +165,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return ErrNoBuilder
 	}
 
@@ -163,7 +190,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerWithRelays(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -190,9 +217,19 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 	}
 }
 
+// BidRelay returns the name of the relay which offered the header of the given block hash, or an empty
+// string if the header was not offered by a relay recently.
+func (s *Service) BidRelay(blockHash [32]byte) string {
+	r, ok := s.winningBids.relay(blockHash)
+	if !ok {
+		return ""
+	}
+	return r.name
+}
+
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -201,9 +238,10 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.client.Status(ctx); err != nil {
+					relayErrorCount.WithLabelValues(r.name, statusMethod).Inc()
+					log.WithError(err).WithField("relay", r.name).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
@@ -32,9 +32,15 @@ type MockBuilderService struct {
 	RegistrationCache     *cache.RegistrationCache
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	Relay                 string
 	Cfg                   *Config
 }
 
+// BidRelay for mocking.
+func (s *MockBuilderService) BidRelay(_ [32]byte) string {
+	return s.Relay
+}
+
 // Configured for mocking.
 func (s *MockBuilderService) Configured() bool {
 	return s.HasConfigured
@@ -57,7 +63,7 @@ func (s *MockBuilderService) SubmitBlindedBlock(_ context.Context, _ interfaces.
 }
 
 // GetHeader for mocking.
-func (s *MockBuilderService) GetHeader(_ context.Context, slot primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+func (s *MockBuilderService) GetHeader(_ context.Context, slot primitives.Slot, _ [32]byte, _ [48]byte, _ []string) (builder.SignedBid, error) {
 	if slots.ToEpoch(slot) >= params.BeaconConfig().CapellaForkEpoch || s.BidCapella != nil {
 		return builder.WrappedSignedBuilderBidCapella(s.BidCapella)
 	}
@@ -14,9 +14,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
-	"github.com/prysmaticlabs/prysm/v4/config/params"
-	"github.com/prysmaticlabs/prysm/v4/container/trie"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
@@ -37,12 +36,13 @@ type DepositFetcher interface {
 	DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte)
 	FinalizedDeposits(ctx context.Context) *FinalizedDeposits
 	NonFinalizedDeposits(ctx context.Context, lastFinalizedIndex int64, untilBlk *big.Int) []*ethpb.Deposit
+	DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error)
 }
 
-// FinalizedDeposits stores the trie of deposits that have been included
-// in the beacon state up to the latest finalized checkpoint.
+// FinalizedDeposits stores the EIP-4881 deposit tree of deposits that have been
+// included in the beacon state up to the latest finalized checkpoint.
 type FinalizedDeposits struct {
-	Deposits        *trie.SparseMerkleTrie
+	Deposits        *depositsnapshot.DepositTree
 	MerkleTrieIndex int64
 }
 
@@ -55,25 +55,56 @@ type DepositCache struct {
 	finalizedDeposits *FinalizedDeposits
 	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
 	depositsLock      sync.RWMutex
+	// The snapshot the cache was bootstrapped from, if any. Deposits covered by
+	// the snapshot are not held in the cache.
+	bootstrapSnapshot *ethpb.DepositSnapshot
 }
 
 // New instantiates a new deposit cache
 func New() (*DepositCache, error) {
-	finalizedDepositsTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-	if err != nil {
-		return nil, err
-	}
-
 	// finalizedDeposits.MerkleTrieIndex is initialized to -1 because it represents the index of the last trie item.
 	// Inserting the first item into the trie will set the value of the index to 0.
 	return &DepositCache{
 		pendingDeposits:   []*ethpb.DepositContainer{},
 		deposits:          []*ethpb.DepositContainer{},
 		depositsByKey:     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer{},
-		finalizedDeposits: &FinalizedDeposits{Deposits: finalizedDepositsTrie, MerkleTrieIndex: -1},
+		finalizedDeposits: &FinalizedDeposits{Deposits: depositsnapshot.New(), MerkleTrieIndex: -1},
 	}, nil
 }
 
+// InsertDepositSnapshot bootstraps the cache from an EIP-4881 deposit snapshot, so that deposits
+// finalized in the snapshot do not have to be processed again. This can only be done before
+// any deposit is inserted into the cache.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	_, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if len(dc.deposits) != 0 || dc.finalizedDeposits.MerkleTrieIndex != -1 {
+		return errors.New("cannot insert a deposit snapshot into a non-empty deposit cache")
+	}
+	tree, err := depositsnapshot.NewFromSnapshot(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit tree from snapshot")
+	}
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        tree,
+		MerkleTrieIndex: int64(snapshot.DepositCount) - 1,
+	}
+	dc.bootstrapSnapshot = snapshot
+	return nil
+}
+
+// firstDepositIndex returns the index of the first deposit held in the cache,
+// which is only different from 0 if the cache was bootstrapped from a snapshot.
+func (dc *DepositCache) firstDepositIndex() int64 {
+	if dc.bootstrapSnapshot == nil {
+		return 0
+	}
+	return int64(dc.bootstrapSnapshot.DepositCount)
+}
+
 // InsertDeposit into the database. If deposit or block number are nil
 // then this method does nothing.
 func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum uint64, index int64, depositRoot [32]byte) error {
@@ -91,8 +122,8 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if int(index) != len(dc.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(dc.deposits), index)
+	if wanted := dc.firstDepositIndex() + int64(len(dc.deposits)); index != wanted {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wanted, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
@@ -129,6 +160,7 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*eth
 }
 
 // InsertFinalizedDeposits inserts deposits up to eth1DepositIndex (inclusive) into the finalized deposits cache.
+// The inserted deposits are only pruned from the deposit tree once FinalizeDepositTree is called.
 func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex int64) error {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertFinalizedDeposits")
 	defer span.End()
@@ -145,8 +177,8 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(dc.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(dc.deposits)) - 1
+	if depositCount := dc.firstDepositIndex() + int64(len(dc.deposits)); depositCount <= eth1DepositIndex {
+		eth1DepositIndex = depositCount - 1
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -177,6 +209,35 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	return nil
 }
 
+// FinalizeDepositTree prunes the first depositCount deposits from the finalized deposit tree, recording
+// the execution block they were finalized in. Only deposits already inserted with InsertFinalizedDeposits
+// can be pruned.
+func (dc *DepositCache) FinalizeDepositTree(ctx context.Context, depositCount uint64, executionHash [32]byte, executionHeight uint64) error {
+	_, span := trace.StartSpan(ctx, "DepositsCache.FinalizeDepositTree")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if int64(depositCount) > dc.finalizedDeposits.MerkleTrieIndex+1 {
+		return errors.Errorf("cannot finalize %d deposits when only %d deposits are finalized", depositCount, dc.finalizedDeposits.MerkleTrieIndex+1)
+	}
+	return dc.finalizedDeposits.Deposits.Finalize(depositCount, executionHash, executionHeight)
+}
+
+// DepositSnapshot returns the EIP-4881 snapshot of the finalized deposit tree.
+func (dc *DepositCache) DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	_, span := trace.StartSpan(ctx, "DepositsCache.DepositSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	snapshot, err := dc.finalizedDeposits.Deposits.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.ToProto(), nil
+}
+
 // AllDepositContainers returns all historical deposit containers.
 func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*ethpb.DepositContainer {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.AllDepositContainers")
@@ -233,9 +294,13 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		// Deposits finalized in the snapshot the cache was bootstrapped from are not held in the cache.
+		if dc.bootstrapSnapshot != nil && dc.bootstrapSnapshot.ExecutionDepth <= blockHeight.Uint64() {
+			return dc.bootstrapSnapshot.DepositCount, bytesutil.ToBytes32(dc.bootstrapSnapshot.DepositRoot)
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return uint64(dc.firstDepositIndex()) + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -260,7 +325,7 @@ func (dc *DepositCache) DepositByPubkey(ctx context.Context, pubKey []byte) (*et
 	return deposit, blockNum
 }
 
-// FinalizedDeposits returns the finalized deposits trie.
+// FinalizedDeposits returns a copy of the finalized deposits tree.
 func (dc *DepositCache) FinalizedDeposits(ctx context.Context) *FinalizedDeposits {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.FinalizedDeposits")
 	defer span.End()
@@ -302,6 +367,8 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
+	// Deposits covered by a bootstrap snapshot are not held in the cache.
+	untilDepositIndex -= dc.firstDepositIndex()
 	if untilDepositIndex >= int64(len(dc.deposits)) {
 		untilDepositIndex = int64(len(dc.deposits) - 1)
 	}
@@ -1056,3 +1056,106 @@ func makeDepositProof() [][]byte {
 	}
 	return proof
 }
+
+func depositContainersForTest(count int) []*ethpb.DepositContainer {
+	ctrs := make([]*ethpb.DepositContainer, count)
+	for i := range ctrs {
+		ctrs[i] = &ethpb.DepositContainer{
+			Deposit: &ethpb.Deposit{
+				Data: &ethpb.Deposit_Data{
+					PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+					WithdrawalCredentials: make([]byte, 32),
+					Signature:             make([]byte, 96),
+				},
+			},
+			Eth1BlockHeight: uint64(10 + i),
+			Index:           int64(i),
+		}
+	}
+	return ctrs
+}
+
+func TestFinalizeDepositTree(t *testing.T) {
+	ctx := context.Background()
+	dc, err := New()
+	require.NoError(t, err)
+	dc.InsertDepositContainers(ctx, depositContainersForTest(5))
+
+	_, err = dc.DepositSnapshot(ctx)
+	assert.ErrorContains(t, "empty execution block", err)
+
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 2))
+	assert.ErrorContains(t, "cannot finalize 4 deposits when only 3 deposits are finalized", dc.FinalizeDepositTree(ctx, 4, [32]byte{'a'}, 12))
+	rootBefore, err := dc.FinalizedDeposits(ctx).Deposits.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, dc.FinalizeDepositTree(ctx, 3, [32]byte{'a'}, 12))
+
+	snapshot, err := dc.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), snapshot.DepositCount)
+	assert.Equal(t, uint64(12), snapshot.ExecutionDepth)
+	assert.DeepEqual(t, rootBefore[:], snapshot.DepositRoot)
+
+	// Proofs of the non-finalized deposits can still be generated.
+	fd := dc.FinalizedDeposits(ctx)
+	for _, d := range dc.NonFinalizedDeposits(ctx, fd.MerkleTrieIndex, nil) {
+		h, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, fd.Deposits.Insert(h[:], fd.Deposits.NumOfItems()))
+	}
+	_, err = fd.Deposits.MerkleProof(3)
+	require.NoError(t, err)
+	_, err = fd.Deposits.MerkleProof(2)
+	assert.ErrorContains(t, "index should be greater than finalizedDeposits - 1", err)
+}
+
+func TestInsertDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	ctrs := depositContainersForTest(6)
+
+	source, err := New()
+	require.NoError(t, err)
+	source.InsertDepositContainers(ctx, ctrs[:4])
+	require.NoError(t, source.InsertFinalizedDeposits(ctx, 3))
+	require.NoError(t, source.FinalizeDepositTree(ctx, 4, [32]byte{'b'}, 13))
+	snapshot, err := source.DepositSnapshot(ctx)
+	require.NoError(t, err)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertDepositSnapshot(ctx, snapshot))
+	assert.ErrorContains(t, "non-empty deposit cache", dc.InsertDepositSnapshot(ctx, snapshot))
+	assert.Equal(t, int64(3), dc.FinalizedDeposits(ctx).MerkleTrieIndex)
+
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(13))
+	assert.Equal(t, uint64(4), count)
+	assert.DeepEqual(t, snapshot.DepositRoot, root[:])
+	count, _ = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(12))
+	assert.Equal(t, uint64(0), count)
+
+	assert.ErrorContains(t, "wanted deposit with index 4 to be inserted but received 0", dc.InsertDeposit(ctx, ctrs[0].Deposit, 10, 0, [32]byte{}))
+	for _, c := range ctrs[4:] {
+		require.NoError(t, dc.InsertDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, [32]byte{byte(c.Index)}))
+	}
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(15))
+	assert.Equal(t, uint64(6), count)
+	assert.Equal(t, [32]byte{5}, root)
+
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 5))
+	fd := dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(5), fd.MerkleTrieIndex)
+	var leaves [][]byte
+	for _, c := range ctrs {
+		h, err := c.Deposit.Data.HashTreeRoot()
+		require.NoError(t, err)
+		leaves = append(leaves, h[:])
+	}
+	generatedTrie, err := trie.GenerateTrieFromItems(leaves, params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	want, err := generatedTrie.HashTreeRoot()
+	require.NoError(t, err)
+	got, err := fd.Deposits.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.NoError(t, dc.PruneProofs(ctx, 5))
+}
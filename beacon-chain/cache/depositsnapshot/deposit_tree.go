@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/math"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
 var (
@@ -25,6 +26,8 @@ var (
 	ErrNoDeposits = errors.New("number of deposits should be greater than 0")
 	// ErrTooManyDeposits occurs when the number of deposits exceeds the capacity of the tree.
 	ErrTooManyDeposits = errors.New("number of deposits should not be greater than the capacity of the tree")
+	// ErrIndexOutOfRange occurs when the index is not less than the number of deposits in the tree.
+	ErrIndexOutOfRange = errors.New("index should be less than the number of deposits")
 )
 
 // DepositTree is the Merkle tree representation of deposits.
@@ -40,8 +43,6 @@ type executionBlock struct {
 }
 
 // New creates an empty deposit tree.
-//
-//nolint:unused
 func New() *DepositTree {
 	var leaves [][32]byte
 	merkle := create(leaves, DepositContractDepth)
@@ -52,10 +53,8 @@ func New() *DepositTree {
 	}
 }
 
-// getSnapshot returns a deposit tree snapshot.
-//
-//nolint:unused
-func (d *DepositTree) getSnapshot() (DepositTreeSnapshot, error) {
+// GetSnapshot returns a deposit tree snapshot.
+func (d *DepositTree) GetSnapshot() (DepositTreeSnapshot, error) {
 	if d.finalizedExecutionBlock == (executionBlock{}) {
 		return DepositTreeSnapshot{}, ErrEmptyExecutionBlock
 	}
@@ -65,8 +64,6 @@ func (d *DepositTree) getSnapshot() (DepositTreeSnapshot, error) {
 }
 
 // fromSnapshot returns a deposit tree from a deposit tree snapshot.
-//
-//nolint:unused
 func fromSnapshot(snapshot DepositTreeSnapshot) (DepositTree, error) {
 	root, err := snapshot.CalculateRoot()
 	if err != nil {
@@ -93,8 +90,6 @@ func fromSnapshot(snapshot DepositTreeSnapshot) (DepositTree, error) {
 }
 
 // finalize marks a deposit as finalized.
-//
-//nolint:unused
 func (d *DepositTree) finalize(eth1data *eth.Eth1Data, executionBlockHeight uint64) error {
 	var blockHash [32]byte
 	copy(blockHash[:], eth1data.BlockHash)
@@ -110,19 +105,17 @@ func (d *DepositTree) finalize(eth1data *eth.Eth1Data, executionBlockHeight uint
 }
 
 // getProof returns the Deposit tree proof.
-//
-//nolint:unused
 func (d *DepositTree) getProof(index uint64) ([32]byte, [][32]byte, error) {
 	if d.mixInLength <= 0 {
 		return [32]byte{}, nil, ErrInvalidMixInLength
 	}
 	finalizedDeposits, _ := d.tree.GetFinalized([][32]byte{})
-	if finalizedDeposits != 0 {
-		finalizedDeposits = finalizedDeposits - 1
-	}
-	if index <= finalizedDeposits {
+	if index < finalizedDeposits {
 		return [32]byte{}, nil, ErrInvalidIndex
 	}
+	if index >= d.mixInLength {
+		return [32]byte{}, nil, ErrIndexOutOfRange
+	}
 	leaf, proof := generateProof(d.tree, index, DepositContractDepth)
 	var mixInLength [32]byte
 	copy(mixInLength[:], bytesutil.Uint64ToBytesLittleEndian32(d.mixInLength))
@@ -131,16 +124,12 @@ func (d *DepositTree) getProof(index uint64) ([32]byte, [][32]byte, error) {
 }
 
 // getRoot returns the root of the deposit tree.
-//
-//nolint:unused
 func (d *DepositTree) getRoot() [32]byte {
 	root := d.tree.GetRoot()
 	return sha256.Sum256(append(root[:], bytesutil.Uint64ToBytesLittleEndian32(d.mixInLength)...))
 }
 
 // pushLeaf adds a new leaf to the tree.
-//
-//nolint:unused
 func (d *DepositTree) pushLeaf(leaf [32]byte) error {
 	var err error
 	d.tree, err = d.tree.PushLeaf(leaf, DepositContractDepth)
@@ -150,3 +139,123 @@ func (d *DepositTree) pushLeaf(leaf [32]byte) error {
 	d.mixInLength++
 	return nil
 }
+
+// NewFromSnapshot creates a deposit tree from the protobuf representation of a deposit tree snapshot,
+// such as the one served by the /eth/v1/beacon/deposit_snapshot endpoint.
+func NewFromSnapshot(snapshot *ethpb.DepositSnapshot) (*DepositTree, error) {
+	if snapshot == nil {
+		return nil, errors.New("nil deposit snapshot")
+	}
+	if len(snapshot.ExecutionHash) != 32 {
+		return nil, errors.Errorf("execution hash should be 32 bytes long, got %d", len(snapshot.ExecutionHash))
+	}
+	if len(snapshot.DepositRoot) != 32 {
+		return nil, errors.Errorf("deposit root should be 32 bytes long, got %d", len(snapshot.DepositRoot))
+	}
+	finalized := make([][32]byte, len(snapshot.Finalized))
+	for i, f := range snapshot.Finalized {
+		if len(f) != 32 {
+			return nil, errors.Errorf("finalized root at index %d should be 32 bytes long, got %d", i, len(f))
+		}
+		finalized[i] = bytesutil.ToBytes32(f)
+	}
+	block := executionBlock{
+		Hash:  bytesutil.ToBytes32(snapshot.ExecutionHash),
+		Depth: snapshot.ExecutionDepth,
+	}
+	// A snapshot without deposits is valid, it simply finalizes an empty tree.
+	if snapshot.DepositCount == 0 {
+		tree := New()
+		tree.finalizedExecutionBlock = block
+		return tree, nil
+	}
+	tree, err := fromSnapshot(DepositTreeSnapshot{
+		finalized:      finalized,
+		depositRoot:    bytesutil.ToBytes32(snapshot.DepositRoot),
+		depositCount:   snapshot.DepositCount,
+		executionBlock: block,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tree, nil
+}
+
+// Finalize marks the first depositCount deposits of the tree as finalized in the execution block
+// with the given hash and height, pruning the branches that are no longer needed to produce
+// proofs for the remaining deposits. Finalizing fewer deposits than are already finalized is a no-op.
+func (d *DepositTree) Finalize(depositCount uint64, executionHash [32]byte, executionHeight uint64) error {
+	if depositCount > d.mixInLength {
+		return errors.Errorf("cannot finalize %d deposits in a tree of %d deposits", depositCount, d.mixInLength)
+	}
+	finalized, _ := d.tree.GetFinalized([][32]byte{})
+	if depositCount < finalized || (depositCount == finalized && d.finalizedExecutionBlock != (executionBlock{})) {
+		return nil
+	}
+	if depositCount == 0 {
+		d.finalizedExecutionBlock = executionBlock{Hash: executionHash, Depth: executionHeight}
+		return nil
+	}
+	return d.finalize(&eth.Eth1Data{
+		DepositCount: depositCount,
+		BlockHash:    executionHash[:],
+	}, executionHeight)
+}
+
+// NumOfItems returns the number of deposits in the tree, including the finalized ones.
+func (d *DepositTree) NumOfItems() int {
+	return int(d.mixInLength)
+}
+
+// HashTreeRoot returns the deposit root of the tree, mixed in with the number of deposits.
+func (d *DepositTree) HashTreeRoot() ([32]byte, error) {
+	return d.getRoot(), nil
+}
+
+// Insert appends the deposit data root to the tree. As deposits can only be appended,
+// the index has to be equal to the number of deposits in the tree.
+func (d *DepositTree) Insert(item []byte, index int) error {
+	if index < 0 || uint64(index) != d.mixInLength {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", d.mixInLength, index)
+	}
+	if len(item) != 32 {
+		return errors.Errorf("deposit data root should be 32 bytes long, got %d", len(item))
+	}
+	return d.pushLeaf(bytesutil.ToBytes32(item))
+}
+
+// MerkleProof returns the Merkle proof of the deposit at the given index, mixed in with the
+// number of deposits. The proof can't be generated for finalized deposits.
+func (d *DepositTree) MerkleProof(index int) ([][]byte, error) {
+	if index < 0 {
+		return nil, ErrIndexOutOfRange
+	}
+	_, proof, err := d.getProof(uint64(index))
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]byte, len(proof))
+	for i := range proof {
+		result[i] = bytesutil.SafeCopyBytes(proof[i][:])
+	}
+	return result, nil
+}
+
+// Copy performs a deep copy of the tree.
+func (d *DepositTree) Copy() *DepositTree {
+	return &DepositTree{
+		tree:                    copyNode(d.tree),
+		mixInLength:             d.mixInLength,
+		finalizedExecutionBlock: d.finalizedExecutionBlock,
+	}
+}
+
+// copyNode copies the inner nodes of the tree, which are the only nodes
+// modified in place when pushing leaves or finalizing deposits.
+func copyNode(node MerkleTreeNode) MerkleTreeNode {
+	n, ok := node.(*InnerNode)
+	if !ok {
+		return node
+	}
+	return &InnerNode{left: copyNode(n.left), right: copyNode(n.right), root: n.root}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
 var (
@@ -14,8 +15,6 @@ var (
 
 // DepositTreeSnapshot represents the data used to create a
 // deposit tree given a snapshot.
-//
-//nolint:unused
 type DepositTreeSnapshot struct {
 	finalized      [][32]byte
 	depositRoot    [32]byte
@@ -43,9 +42,22 @@ func (ds *DepositTreeSnapshot) CalculateRoot() ([32]byte, error) {
 	return sha256.Sum256(append(root[:], bytesutil.Uint64ToBytesLittleEndian32(ds.depositCount)...)), nil
 }
 
+// ToProto returns the protobuf representation of the deposit tree snapshot.
+func (ds *DepositTreeSnapshot) ToProto() *ethpb.DepositSnapshot {
+	finalized := make([][]byte, len(ds.finalized))
+	for i := range ds.finalized {
+		finalized[i] = bytesutil.SafeCopyBytes(ds.finalized[i][:])
+	}
+	return &ethpb.DepositSnapshot{
+		Finalized:      finalized,
+		DepositRoot:    bytesutil.SafeCopyBytes(ds.depositRoot[:]),
+		DepositCount:   ds.depositCount,
+		ExecutionHash:  bytesutil.SafeCopyBytes(ds.executionBlock.Hash[:]),
+		ExecutionDepth: ds.executionBlock.Depth,
+	}
+}
+
 // fromTreeParts constructs the deposit tree from pre-existing data.
-//
-//nolint:unused
 func fromTreeParts(finalised [][32]byte, depositCount uint64, executionBlock executionBlock) (DepositTreeSnapshot, error) {
 	snapshot := DepositTreeSnapshot{
 		finalized:      finalised,
@@ -0,0 +1,156 @@
+package depositsnapshot
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/container/trie"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func depositLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		h := hash.Hash(bytesutil.Bytes8(uint64(i)))
+		leaves[i] = h[:]
+	}
+	return leaves
+}
+
+func TestDepositTree_MatchesSparseMerkleTrie(t *testing.T) {
+	leaves := depositLeaves(13)
+	sparse, err := trie.NewTrie(DepositContractDepth)
+	require.NoError(t, err)
+	tree := New()
+	for i, l := range leaves {
+		require.NoError(t, sparse.Insert(l, i))
+		require.NoError(t, tree.Insert(l, i))
+
+		want, err := sparse.HashTreeRoot()
+		require.NoError(t, err)
+		got, err := tree.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, sparse.NumOfItems(), tree.NumOfItems())
+	}
+	for i := range leaves {
+		want, err := sparse.MerkleProof(i)
+		require.NoError(t, err)
+		got, err := tree.MerkleProof(i)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, got)
+	}
+	_, err = tree.MerkleProof(len(leaves))
+	require.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestDepositTree_Insert_InvalidIndex(t *testing.T) {
+	tree := New()
+	leaves := depositLeaves(2)
+	require.NoError(t, tree.Insert(leaves[0], 0))
+	assert.ErrorContains(t, "wanted deposit with index 1 to be inserted but received 0", tree.Insert(leaves[1], 0))
+	assert.ErrorContains(t, "wanted deposit with index 1 to be inserted but received 2", tree.Insert(leaves[1], 2))
+}
+
+func TestDepositTree_Finalize(t *testing.T) {
+	leaves := depositLeaves(10)
+	sparse, err := trie.GenerateTrieFromItems(leaves, DepositContractDepth)
+	require.NoError(t, err)
+	tree := New()
+	for i, l := range leaves {
+		require.NoError(t, tree.Insert(l, i))
+	}
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+
+	executionHash := bytesutil.ToBytes32([]byte("execution block"))
+	require.NoError(t, tree.Finalize(5, executionHash, 100))
+	finalizedRoot, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, finalizedRoot)
+
+	_, err = tree.MerkleProof(4)
+	require.ErrorIs(t, err, ErrInvalidIndex)
+	for i := 5; i < len(leaves); i++ {
+		want, err := sparse.MerkleProof(i)
+		require.NoError(t, err)
+		got, err := tree.MerkleProof(i)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, got)
+	}
+
+	// Finalizing fewer deposits does not move the finalized execution block back.
+	require.NoError(t, tree.Finalize(3, [32]byte{'a'}, 50))
+	snapshot, err := tree.GetSnapshot()
+	require.NoError(t, err)
+	pb := snapshot.ToProto()
+	assert.Equal(t, uint64(5), pb.DepositCount)
+	assert.DeepEqual(t, executionHash[:], pb.ExecutionHash)
+	assert.Equal(t, uint64(100), pb.ExecutionDepth)
+
+	assert.ErrorContains(t, "cannot finalize 11 deposits in a tree of 10 deposits", tree.Finalize(11, executionHash, 100))
+}
+
+func TestNewFromSnapshot(t *testing.T) {
+	leaves := depositLeaves(10)
+	tree := New()
+	for i, l := range leaves {
+		require.NoError(t, tree.Insert(l, i))
+	}
+	require.NoError(t, tree.Finalize(7, [32]byte{'b'}, 42))
+	snapshot, err := tree.GetSnapshot()
+	require.NoError(t, err)
+
+	restored, err := NewFromSnapshot(snapshot.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, 7, restored.NumOfItems())
+	for i := 7; i < len(leaves); i++ {
+		require.NoError(t, restored.Insert(leaves[i], i))
+	}
+	want, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	got, err := restored.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	restoredSnapshot, err := restored.GetSnapshot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot.ToProto(), restoredSnapshot.ToProto())
+
+	invalid := snapshot.ToProto()
+	invalid.DepositRoot = make([]byte, 32)
+	_, err = NewFromSnapshot(invalid)
+	require.ErrorIs(t, err, ErrInvalidSnapshotRoot)
+
+	invalid = snapshot.ToProto()
+	invalid.ExecutionHash = []byte{1}
+	_, err = NewFromSnapshot(invalid)
+	assert.ErrorContains(t, "execution hash should be 32 bytes long", err)
+
+	empty, err := NewFromSnapshot(&ethpb.DepositSnapshot{DepositRoot: make([]byte, 32), ExecutionHash: make([]byte, 32)})
+	require.NoError(t, err)
+	assert.Equal(t, 0, empty.NumOfItems())
+}
+
+func TestDepositTree_Copy(t *testing.T) {
+	leaves := depositLeaves(4)
+	tree := New()
+	for i, l := range leaves[:3] {
+		require.NoError(t, tree.Insert(l, i))
+	}
+	cp := tree.Copy()
+	require.NoError(t, cp.Insert(leaves[3], 3))
+	assert.Equal(t, 3, tree.NumOfItems())
+	assert.Equal(t, 4, cp.NumOfItems())
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	cpRoot, err := cp.HashTreeRoot()
+	require.NoError(t, err)
+	assert.NotEqual(t, root, cpRoot)
+	require.NoError(t, tree.Insert(leaves[3], 3))
+	root, err = tree.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, cpRoot)
+}
@@ -58,8 +58,6 @@ func create(leaves [][32]byte, depth uint64) MerkleTreeNode {
 }
 
 // fromSnapshotParts creates a new Merkle tree from a list of finalized leaves, number of deposits and specified depth.
-//
-//nolint:unused
 func fromSnapshotParts(finalized [][32]byte, deposits uint64, level uint64) (_ MerkleTreeNode, err error) {
 	if len(finalized) < 1 || deposits == 0 {
 		return &ZeroNode{
@@ -96,8 +94,6 @@ func fromSnapshotParts(finalized [][32]byte, deposits uint64, level uint64) (_ M
 }
 
 // generateProof returns a merkle proof and root
-//
-//nolint:unused
 func generateProof(tree MerkleTreeNode, index uint64, depth uint64) ([32]byte, [][32]byte) {
 	var proof [][32]byte
 	node := tree
@@ -204,13 +200,20 @@ func (_ *LeafNode) Left() MerkleTreeNode {
 // InnerNode represents an inner node with two children and satisfies the MerkleTreeNode interface.
 type InnerNode struct {
 	left, right MerkleTreeNode
+	// root caches the root of the node, which is reset whenever a leaf is pushed below it.
+	root *[32]byte
 }
 
 // GetRoot returns the root of the Merkle tree.
 func (n *InnerNode) GetRoot() [32]byte {
+	if n.root != nil {
+		return *n.root
+	}
 	left := n.left.GetRoot()
 	right := n.right.GetRoot()
-	return hash.Hash(append(left[:], right[:]...))
+	root := hash.Hash(append(left[:], right[:]...))
+	n.root = &root
+	return root
 }
 
 // IsFull returns whether there is space left for deposits.
@@ -250,6 +253,7 @@ func (n *InnerNode) GetFinalized(result [][32]byte) (uint64, [][32]byte) {
 
 // PushLeaf adds a new leaf node at the next available zero node.
 func (n *InnerNode) PushLeaf(leaf [32]byte, depth uint64) (MerkleTreeNode, error) {
+	n.root = nil
 	if !n.left.IsFull() {
 		left, err := n.left.PushLeaf(leaf, depth-1)
 		if err == nil {
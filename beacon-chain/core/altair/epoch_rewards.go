@@ -0,0 +1,50 @@
+package altair
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/math"
+)
+
+// EpochRewards are the attestation rewards and penalties applied to the validators by the processing of the end of
+// an epoch, indexed by validator index. The inactivity penalties are the part of the target penalties which is due
+// to the inactivity scores. There are no deltas at the end of the genesis epoch.
+type EpochRewards struct {
+	Epoch               primitives.Epoch
+	Deltas              []*AttDelta
+	InactivityPenalties []uint64
+}
+
+// NewEpochRewards returns the rewards applied to the validators by the processing of the end of the epoch of the
+// state, given the attestation deltas and the precomputed validators after the inactivity updates.
+func NewEpochRewards(st state.BeaconState, deltas []*AttDelta, vals []*precompute.Validator) (*EpochRewards, error) {
+	rewards := &EpochRewards{Epoch: time.CurrentEpoch(st), Deltas: deltas}
+	if len(deltas) == 0 {
+		return rewards, nil
+	}
+	if len(deltas) != len(vals) {
+		return nil, errors.New("attestation deltas and validators have different lengths")
+	}
+	quotient, err := st.InactivityPenaltyQuotient()
+	if err != nil {
+		return nil, err
+	}
+	denominator := params.BeaconConfig().InactivityScoreBias * quotient
+	rewards.InactivityPenalties = make([]uint64, len(vals))
+	for i, v := range vals {
+		if v.IsPrevEpochTargetAttester && !v.IsSlashed {
+			continue
+		}
+		n, err := math.Mul64(v.CurrentEpochEffectiveBalance, v.InactivityScore)
+		if err != nil {
+			return nil, err
+		}
+		// Validators which are not eligible for rewards have no target penalty either.
+		rewards.InactivityPenalties[i] = math.Min(n/denominator, deltas[i].TargetPenalty)
+	}
+	return rewards, nil
+}
@@ -105,7 +105,14 @@ func VerifyExitAndSignature(
 	if err := verifyExitConditions(validator, currentSlot, exit); err != nil {
 		return err
 	}
-	domain, err := signing.Domain(fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit, genesisRoot)
+	var domain []byte
+	var err error
+	if slots.ToEpoch(currentSlot) >= params.BeaconConfig().DenebForkEpoch {
+		// EIP-7044: beginning in Deneb, voluntary exits are always signed with the Capella fork domain.
+		domain, err = signing.ComputeDomain(params.BeaconConfig().DomainVoluntaryExit, params.BeaconConfig().CapellaForkVersion, genesisRoot)
+	} else {
+		domain, err = signing.Domain(fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit, genesisRoot)
+	}
 	if err != nil {
 		return err
 	}
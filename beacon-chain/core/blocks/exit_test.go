@@ -221,11 +221,45 @@ func TestVerifyExitAndSignature(t *testing.T) {
 			},
 			wantErr: "signature did not verify",
 		},
+		{
+			name: "EIP-7044: signed with the Capella domain after Deneb",
+			args: args{
+				currentSlot: (params.BeaconConfig().SlotsPerEpoch * 2) + 1,
+			},
+			setup: func() (*ethpb.Validator, *ethpb.SignedVoluntaryExit, *ethpb.Fork, []byte, error) {
+				cfg := params.BeaconConfig().Copy()
+				cfg.DenebForkEpoch = 2
+				params.OverrideBeaconConfig(cfg)
+				// The fork following Deneb still verifies exits with the Capella domain.
+				fork := &ethpb.Fork{
+					PreviousVersion: params.BeaconConfig().DenebForkVersion,
+					CurrentVersion:  []byte{0x05, 0x00, 0x00, 0x00},
+					Epoch:           2,
+				}
+				signedExit := &ethpb.SignedVoluntaryExit{
+					Exit: &ethpb.VoluntaryExit{
+						Epoch:          2,
+						ValidatorIndex: 0,
+					},
+				}
+				bs, keys := util.DeterministicGenesisState(t, 1)
+				validator := bs.Validators()[0]
+				validator.ActivationEpoch = 1
+				domain, err := signing.ComputeDomain(params.BeaconConfig().DomainVoluntaryExit, params.BeaconConfig().CapellaForkVersion, bs.GenesisValidatorsRoot())
+				require.NoError(t, err)
+				root, err := signing.ComputeSigningRoot(signedExit.Exit, domain)
+				require.NoError(t, err)
+				signedExit.Signature = keys[0].Sign(root[:]).Marshal()
+				return validator, signedExit, fork, bs.GenesisValidatorsRoot(), nil
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := params.BeaconConfig().ShardCommitteePeriod
-			params.BeaconConfig().ShardCommitteePeriod = 0
+			params.SetupTestConfigCleanup(t)
+			cfg := params.BeaconConfig().Copy()
+			cfg.ShardCommitteePeriod = 0
+			params.OverrideBeaconConfig(cfg)
 			validator, signedExit, fork, genesisRoot, err := tt.setup()
 			require.NoError(t, err)
 			rvalidator, err := state_native.NewValidator(validator)
@@ -242,7 +276,6 @@ func TestVerifyExitAndSignature(t *testing.T) {
 			} else {
 				require.ErrorContains(t, tt.wantErr, err)
 			}
-			params.BeaconConfig().ShardCommitteePeriod = c // prevent contamination
 		})
 	}
 }
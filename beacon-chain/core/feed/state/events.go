@@ -27,6 +27,8 @@ const (
 	NewHead
 	// MissedSlot is sent when we need to notify users that a slot was missed.
 	MissedSlot
+	// LateBlockReorg is sent when deciding whether a proposer orphans a late head block.
+	LateBlockReorg
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
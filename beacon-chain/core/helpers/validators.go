@@ -225,6 +225,27 @@ func ValidatorChurnLimit(activeValidatorCount uint64) (uint64, error) {
 	return churnLimit, nil
 }
 
+// ValidatorActivationChurnLimit returns the maximum number of validators that can be activated in an epoch.
+// From Deneb the activation churn is additionally capped by MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT.
+//
+// Spec pseudocode definition:
+//
+//	def get_validator_activation_churn_limit(state: BeaconState) -> uint64:
+//	 """
+//	 Return the validator activation churn limit for the current epoch.
+//	 """
+//	 return min(MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT, get_validator_churn_limit(state))
+func ValidatorActivationChurnLimit(activeValidatorCount uint64) (uint64, error) {
+	churnLimit, err := ValidatorChurnLimit(activeValidatorCount)
+	if err != nil {
+		return 0, err
+	}
+	if churnLimit > params.BeaconConfig().MaxPerEpochActivationChurnLimit {
+		churnLimit = params.BeaconConfig().MaxPerEpochActivationChurnLimit
+	}
+	return churnLimit, nil
+}
+
 // BeaconProposerIndex returns proposer index of a current slot.
 //
 // Spec pseudocode definition:
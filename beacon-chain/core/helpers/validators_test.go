@@ -373,6 +373,22 @@ func TestChurnLimit_OK(t *testing.T) {
 	}
 }
 
+func TestActivationChurnLimit_OK(t *testing.T) {
+	tests := []struct {
+		activeValidatorCount uint64
+		wantedChurn          uint64
+	}{
+		{activeValidatorCount: 1000, wantedChurn: 4},
+		{activeValidatorCount: 1000000, wantedChurn: 8 /* capped by MaxPerEpochActivationChurnLimit */},
+		{activeValidatorCount: 2000000, wantedChurn: 8 /* capped by MaxPerEpochActivationChurnLimit */},
+	}
+	for _, test := range tests {
+		resultChurn, err := ValidatorActivationChurnLimit(test.activeValidatorCount)
+		require.NoError(t, err)
+		assert.Equal(t, test.wantedChurn, resultChurn, "ValidatorActivationChurnLimit(%d)", test.activeValidatorCount)
+	}
+}
+
 // Test basic functionality of ActiveValidatorIndices without caching. This test will need to be
 // rewritten when releasing some cache flag.
 func TestActiveValidatorIndices(t *testing.T) {
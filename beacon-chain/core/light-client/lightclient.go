@@ -0,0 +1,456 @@
+// Package light_client implements the light client server of the Altair specification, which derives
+// from imported blocks and their states the data that light clients need to follow the chain.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/full-node.md.
+package light_client
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+const (
+	// syncCommitteeBranchLength is floorlog2(CURRENT_SYNC_COMMITTEE_INDEX), which equals floorlog2(NEXT_SYNC_COMMITTEE_INDEX).
+	syncCommitteeBranchLength = 5
+	// finalityBranchLength is floorlog2(FINALIZED_ROOT_INDEX).
+	finalityBranchLength = 6
+	// MaxRequestLightClientUpdates is the maximum number of light client updates served in a single request.
+	MaxRequestLightClientUpdates = 128
+)
+
+// ErrNotEnoughParticipants is returned when the sync aggregate of a block has fewer participants than
+// MIN_SYNC_COMMITTEE_PARTICIPANTS, no light client update can be derived from such a block.
+var ErrNotEnoughParticipants = errors.New("not enough sync committee participants")
+
+var (
+	errPreAltair             = errors.New("light client data is not available before Altair")
+	errStateBlockMismatch    = errors.New("state does not match the block")
+	errNotParentBlock        = errors.New("attested block is not the parent of the block")
+	errFinalizedRootMismatch = errors.New("finalized block does not match the finalized checkpoint of the attested state")
+)
+
+// NewBootstrap creates the bootstrap of a light client trusting the root of the given block, from
+// the post state of that block.
+//
+// Spec code:
+// def create_light_client_bootstrap(state: BeaconState,
+//
+//	                              block: SignedBeaconBlock) -> LightClientBootstrap:
+//	assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+//
+//	assert state.slot == state.latest_block_header.slot
+//	header = state.latest_block_header.copy()
+//	header.state_root = hash_tree_root(state)
+//	assert hash_tree_root(header) == hash_tree_root(block.message)
+//
+//	return LightClientBootstrap(
+//	    header=block_to_light_client_header(block),
+//	    current_sync_committee=state.current_sync_committee,
+//	    current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX),
+//	)
+func NewBootstrap(ctx context.Context, st state.BeaconState, blk interfaces.ReadOnlySignedBeaconBlock) (*ethpb.LightClientBootstrap, error) {
+	if st.Version() < version.Altair {
+		return nil, errPreAltair
+	}
+	header, err := headerFromState(ctx, st, blk)
+	if err != nil {
+		return nil, err
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee")
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current sync committee proof")
+	}
+	return &ethpb.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}, nil
+}
+
+// NewUpdate creates the light client update of a block signing its parent, the attested block, with its
+// sync aggregate. The states are the post states of the block and of the attested block. The finalized
+// block is the block of the finalized checkpoint of the attested state, it may be nil when unknown.
+// Fields which cannot be proven are left zeroed, as in the specification.
+//
+// Spec code:
+// def create_light_client_update(state: BeaconState,
+//
+//	                           block: SignedBeaconBlock,
+//	                           attested_state: BeaconState,
+//	                           attested_block: SignedBeaconBlock,
+//	                           finalized_block: Optional[SignedBeaconBlock]) -> LightClientUpdate:
+//	assert compute_epoch_at_slot(attested_state.slot) >= ALTAIR_FORK_EPOCH
+//	assert sum(block.message.body.sync_aggregate.sync_committee_bits) >= MIN_SYNC_COMMITTEE_PARTICIPANTS
+//
+//	assert state.slot == state.latest_block_header.slot
+//	header = state.latest_block_header.copy()
+//	header.state_root = hash_tree_root(state)
+//	assert hash_tree_root(header) == hash_tree_root(block.message)
+//	update_signature_period = compute_sync_committee_period_at_slot(block.message.slot)
+//
+//	assert attested_state.slot == attested_state.latest_block_header.slot
+//	attested_header = attested_state.latest_block_header.copy()
+//	attested_header.state_root = hash_tree_root(attested_state)
+//	assert hash_tree_root(attested_header) == hash_tree_root(attested_block.message) == block.message.parent_root
+//	update_attested_period = compute_sync_committee_period_at_slot(attested_block.message.slot)
+//
+//	update = LightClientUpdate()
+//
+//	update.attested_header = block_to_light_client_header(attested_block)
+//
+//	# `next_sync_committee` is only useful if the message is signed by the current sync committee
+//	if update_attested_period == update_signature_period:
+//	    update.next_sync_committee = attested_state.next_sync_committee
+//	    update.next_sync_committee_branch = compute_merkle_proof_for_state(
+//	        attested_state, NEXT_SYNC_COMMITTEE_INDEX)
+//
+//	# Indicate finality whenever possible
+//	if finalized_block is not None:
+//	    if finalized_block.message.slot != GENESIS_SLOT:
+//	        update.finalized_header = block_to_light_client_header(finalized_block)
+//	        assert hash_tree_root(update.finalized_header.beacon) == attested_state.finalized_checkpoint.root
+//	    else:
+//	        assert attested_state.finalized_checkpoint.root == Bytes32()
+//	    update.finality_branch = compute_merkle_proof_for_state(
+//	        attested_state, FINALIZED_ROOT_INDEX)
+//
+//	update.sync_aggregate = block.message.body.sync_aggregate
+//	update.signature_slot = block.message.slot
+//
+//	return update
+func NewUpdate(
+	ctx context.Context,
+	st state.BeaconState,
+	blk interfaces.ReadOnlySignedBeaconBlock,
+	attestedState state.BeaconState,
+	attestedBlock interfaces.ReadOnlySignedBeaconBlock,
+	finalizedBlock interfaces.ReadOnlySignedBeaconBlock,
+) (*ethpb.LightClientUpdate, error) {
+	if attestedState.Version() < version.Altair || blk.Version() < version.Altair {
+		return nil, errPreAltair
+	}
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil, ErrNotEnoughParticipants
+	}
+	if _, err := headerFromState(ctx, st, blk); err != nil {
+		return nil, err
+	}
+	attestedHeader, err := headerFromState(ctx, attestedState, attestedBlock)
+	if err != nil {
+		return nil, err
+	}
+	attestedRoot, err := attestedHeader.Beacon.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	parentRoot := blk.Block().ParentRoot()
+	if attestedRoot != parentRoot {
+		return nil, errNotParentBlock
+	}
+
+	update := emptyUpdate()
+	update.AttestedHeader = attestedHeader
+	update.SyncAggregate = syncAggregate
+	update.SignatureSlot = blk.Block().Slot()
+
+	if SyncCommitteePeriodAtSlot(attestedBlock.Block().Slot()) == SyncCommitteePeriodAtSlot(blk.Block().Slot()) {
+		update.NextSyncCommittee, err = attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get next sync committee")
+		}
+		update.NextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute next sync committee proof")
+		}
+	}
+
+	if finalizedBlock != nil && !finalizedBlock.IsNil() {
+		finalizedCheckpoint := attestedState.FinalizedCheckpoint()
+		if finalizedBlock.Block().Slot() != params.BeaconConfig().GenesisSlot {
+			update.FinalizedHeader, err = blockHeader(finalizedBlock)
+			if err != nil {
+				return nil, err
+			}
+			finalizedRoot, err := update.FinalizedHeader.Beacon.HashTreeRoot()
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(finalizedRoot[:], finalizedCheckpoint.Root) {
+				return nil, errFinalizedRootMismatch
+			}
+		} else if !bytes.Equal(finalizedCheckpoint.Root, params.BeaconConfig().ZeroHash[:]) {
+			return nil, errFinalizedRootMismatch
+		}
+		update.FinalityBranch, err = attestedState.FinalizedRootProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute finalized root proof")
+		}
+	}
+	return update, nil
+}
+
+// FinalityUpdateFromUpdate returns the finality update derived from a light client update.
+//
+// Spec code:
+// def create_light_client_finality_update(update: LightClientUpdate) -> LightClientFinalityUpdate:
+//
+//	return LightClientFinalityUpdate(
+//	    attested_header=update.attested_header,
+//	    finalized_header=update.finalized_header,
+//	    finality_branch=update.finality_branch,
+//	    sync_aggregate=update.sync_aggregate,
+//	    signature_slot=update.signature_slot,
+//	)
+func FinalityUpdateFromUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientFinalityUpdate {
+	return &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+}
+
+// OptimisticUpdateFromUpdate returns the optimistic update derived from a light client update.
+//
+// Spec code:
+// def create_light_client_optimistic_update(update: LightClientUpdate) -> LightClientOptimisticUpdate:
+//
+//	return LightClientOptimisticUpdate(
+//	    attested_header=update.attested_header,
+//	    sync_aggregate=update.sync_aggregate,
+//	    signature_slot=update.signature_slot,
+//	)
+func OptimisticUpdateFromUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+}
+
+// IsBetterUpdate returns true if the new update is better than the old one, in which case it should
+// replace the old one as the best update of its sync committee period.
+//
+// Spec code:
+// def is_better_update(new_update: LightClientUpdate, old_update: LightClientUpdate) -> bool:
+//
+//	# Compare supermajority (> 2/3) sync committee participation
+//	max_active_participants = len(new_update.sync_aggregate.sync_committee_bits)
+//	new_num_active_participants = sum(new_update.sync_aggregate.sync_committee_bits)
+//	old_num_active_participants = sum(old_update.sync_aggregate.sync_committee_bits)
+//	new_has_supermajority = new_num_active_participants * 3 >= max_active_participants * 2
+//	old_has_supermajority = old_num_active_participants * 3 >= max_active_participants * 2
+//	if new_has_supermajority != old_has_supermajority:
+//	    return new_has_supermajority > old_has_supermajority
+//	if not new_has_supermajority and new_num_active_participants != old_num_active_participants:
+//	    return new_num_active_participants > old_num_active_participants
+//
+//	# Compare presence of relevant sync committee
+//	new_has_relevant_sync_committee = is_sync_committee_update(new_update) and (
+//	    compute_sync_committee_period_at_slot(new_update.attested_header.beacon.slot)
+//	    == compute_sync_committee_period_at_slot(new_update.signature_slot)
+//	)
+//	old_has_relevant_sync_committee = is_sync_committee_update(old_update) and (
+//	    compute_sync_committee_period_at_slot(old_update.attested_header.beacon.slot)
+//	    == compute_sync_committee_period_at_slot(old_update.signature_slot)
+//	)
+//	if new_has_relevant_sync_committee != old_has_relevant_sync_committee:
+//	    return new_has_relevant_sync_committee
+//
+//	# Compare indication of any finality
+//	new_has_finality = is_finality_update(new_update)
+//	old_has_finality = is_finality_update(old_update)
+//	if new_has_finality != old_has_finality:
+//	    return new_has_finality
+//
+//	# Compare sync committee finality
+//	if new_has_finality:
+//	    new_has_sync_committee_finality = (
+//	        compute_sync_committee_period_at_slot(new_update.finalized_header.beacon.slot)
+//	        == compute_sync_committee_period_at_slot(new_update.attested_header.beacon.slot)
+//	    )
+//	    old_has_sync_committee_finality = (
+//	        compute_sync_committee_period_at_slot(old_update.finalized_header.beacon.slot)
+//	        == compute_sync_committee_period_at_slot(old_update.attested_header.beacon.slot)
+//	    )
+//	    if new_has_sync_committee_finality != old_has_sync_committee_finality:
+//	        return new_has_sync_committee_finality
+//
+//	# Tiebreaker 1: Sync committee participation beyond supermajority
+//	if new_num_active_participants != old_num_active_participants:
+//	    return new_num_active_participants > old_num_active_participants
+//
+//	# Tiebreaker 2: Prefer older data (fewer changes to best)
+//	if new_update.attested_header.beacon.slot != old_update.attested_header.beacon.slot:
+//	    return new_update.attested_header.beacon.slot < old_update.attested_header.beacon.slot
+//	return new_update.signature_slot < old_update.signature_slot
+func IsBetterUpdate(newUpdate, oldUpdate *ethpb.LightClientUpdate) bool {
+	maxParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Len()
+	newParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newHasSupermajority := newParticipants*3 >= maxParticipants*2
+	oldHasSupermajority := oldParticipants*3 >= maxParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	newHasRelevantSyncCommittee := IsSyncCommitteeUpdate(newUpdate) &&
+		SyncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Beacon.Slot) == SyncCommitteePeriodAtSlot(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := IsSyncCommitteeUpdate(oldUpdate) &&
+		SyncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Beacon.Slot) == SyncCommitteePeriodAtSlot(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	newHasFinality := IsFinalityUpdate(newUpdate)
+	oldHasFinality := IsFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	if newHasFinality {
+		newHasSyncCommitteeFinality :=
+			SyncCommitteePeriodAtSlot(newUpdate.FinalizedHeader.Beacon.Slot) == SyncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Beacon.Slot)
+		oldHasSyncCommitteeFinality :=
+			SyncCommitteePeriodAtSlot(oldUpdate.FinalizedHeader.Beacon.Slot) == SyncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Beacon.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+	if newUpdate.AttestedHeader.Beacon.Slot != oldUpdate.AttestedHeader.Beacon.Slot {
+		return newUpdate.AttestedHeader.Beacon.Slot < oldUpdate.AttestedHeader.Beacon.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+// IsSyncCommitteeUpdate returns true if the update proves the next sync committee.
+func IsSyncCommitteeUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.NextSyncCommitteeBranch)
+}
+
+// IsFinalityUpdate returns true if the update proves a finalized header.
+func IsFinalityUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.FinalityBranch)
+}
+
+// IsNewerFinalityUpdate returns true if the update has a higher finalized slot than the latest finality update, or
+// the same finalized slot and a supermajority of sync committee participants while the latest one does not. These
+// are the conditions under which a finality update is forwarded on gossip.
+func IsNewerFinalityUpdate(update, latest *ethpb.LightClientFinalityUpdate) bool {
+	if latest == nil {
+		return true
+	}
+	if update.FinalizedHeader.Beacon.Slot != latest.FinalizedHeader.Beacon.Slot {
+		return update.FinalizedHeader.Beacon.Slot > latest.FinalizedHeader.Beacon.Slot
+	}
+	return hasSupermajority(update.SyncAggregate) && !hasSupermajority(latest.SyncAggregate)
+}
+
+// IsNewerOptimisticUpdate returns true if the update has a higher attested slot than the latest optimistic update.
+// This is the condition under which an optimistic update is forwarded on gossip.
+func IsNewerOptimisticUpdate(update, latest *ethpb.LightClientOptimisticUpdate) bool {
+	return latest == nil || update.AttestedHeader.Beacon.Slot > latest.AttestedHeader.Beacon.Slot
+}
+
+// SyncCommitteePeriodAtSlot returns the sync committee period of the given slot.
+func SyncCommitteePeriodAtSlot(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+func hasSupermajority(agg *ethpb.SyncAggregate) bool {
+	return agg.SyncCommitteeBits.Count()*3 >= agg.SyncCommitteeBits.Len()*2
+}
+
+// headerFromState returns the light client header of the block, after checking that the state is
+// the post state of that block.
+func headerFromState(ctx context.Context, st state.BeaconState, blk interfaces.ReadOnlySignedBeaconBlock) (*ethpb.LightClientHeader, error) {
+	latest := st.LatestBlockHeader()
+	if latest == nil || st.Slot() != latest.Slot {
+		return nil, errStateBlockMismatch
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+	header := ethpb.CopyBeaconBlockHeader(latest)
+	header.StateRoot = stateRoot[:]
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if headerRoot != blkRoot {
+		return nil, errStateBlockMismatch
+	}
+	return &ethpb.LightClientHeader{Beacon: header}, nil
+}
+
+func blockHeader(blk interfaces.ReadOnlySignedBeaconBlock) (*ethpb.LightClientHeader, error) {
+	header, err := blk.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header")
+	}
+	return &ethpb.LightClientHeader{Beacon: header.Header}, nil
+}
+
+// emptyUpdate returns an update with every field set to its zero value, so that it can be serialized.
+func emptyUpdate() *ethpb.LightClientUpdate {
+	return &ethpb.LightClientUpdate{
+		NextSyncCommittee: &ethpb.SyncCommittee{
+			Pubkeys:         emptyBranch(fieldparams.SyncCommitteeLength, fieldparams.BLSPubkeyLength),
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: emptyBranch(syncCommitteeBranchLength, fieldparams.RootLength),
+		FinalizedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   make([]byte, fieldparams.RootLength),
+		}},
+		FinalityBranch: emptyBranch(finalityBranchLength, fieldparams.RootLength),
+	}
+}
+
+func emptyBranch(n, size int) [][]byte {
+	b := make([][]byte, n)
+	for i := range b {
+		b[i] = make([]byte, size)
+	}
+	return b
+}
+
+func isEmptyBranch(branch [][]byte) bool {
+	for _, b := range branch {
+		if !bytes.Equal(b, params.BeaconConfig().ZeroHash[:]) {
+			return false
+		}
+	}
+	return true
+}
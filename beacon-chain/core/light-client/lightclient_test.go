@@ -0,0 +1,256 @@
+package light_client_test
+
+import (
+	"context"
+	"testing"
+
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/container/trie"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+const nextSyncCommitteeGeneralizedIndex = 55
+
+// newBlockAndPostState returns a block and a state which is consistent with being its post state.
+func newBlockAndPostState(t *testing.T, slot primitives.Slot, parentRoot []byte, participants int, finalized *ethpb.Checkpoint) (interfaces.ReadOnlySignedBeaconBlock, state.BeaconState) {
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	if finalized != nil {
+		require.NoError(t, st.SetFinalizedCheckpoint(finalized))
+	}
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = parentRoot
+	for i := 0; i < participants; i++ {
+		blk.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(uint64(i), true)
+	}
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: parentRoot,
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	signed, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	return signed, st
+}
+
+func TestNewBootstrap(t *testing.T) {
+	ctx := context.Background()
+	blk, st := newBlockAndPostState(t, 10, make([]byte, 32), 0, nil)
+
+	bootstrap, err := lightclient.NewBootstrap(ctx, st, blk)
+	require.NoError(t, err)
+	blkRoot, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := bootstrap.Header.Beacon.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blkRoot, headerRoot)
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, committee, bootstrap.CurrentSyncCommittee)
+	assert.Equal(t, 5, len(bootstrap.CurrentSyncCommitteeBranch))
+
+	other, _ := newBlockAndPostState(t, 11, make([]byte, 32), 0, nil)
+	_, err = lightclient.NewBootstrap(ctx, st, other)
+	require.ErrorContains(t, "state does not match the block", err)
+
+	phase0, err := util.NewBeaconState()
+	require.NoError(t, err)
+	_, err = lightclient.NewBootstrap(ctx, phase0, blk)
+	require.ErrorContains(t, "not available before Altair", err)
+}
+
+func TestNewUpdate(t *testing.T) {
+	ctx := context.Background()
+	finalizedBlk, _ := newBlockAndPostState(t, 8, make([]byte, 32), 0, nil)
+	finalizedRoot, err := finalizedBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	cp := &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}
+	attestedBlk, attestedState := newBlockAndPostState(t, 40, finalizedRoot[:], 0, cp)
+	attestedRoot, err := attestedBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	blk, st := newBlockAndPostState(t, 41, attestedRoot[:], 400, nil)
+
+	update, err := lightclient.NewUpdate(ctx, st, blk, attestedState, attestedBlk, finalizedBlk)
+	require.NoError(t, err)
+	headerRoot, err := update.AttestedHeader.Beacon.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, attestedRoot, headerRoot)
+	assert.Equal(t, primitives.Slot(41), update.SignatureSlot)
+	assert.Equal(t, uint64(400), update.SyncAggregate.SyncCommitteeBits.Count())
+
+	attestedStateRoot := update.AttestedHeader.Beacon.StateRoot
+	committee, err := attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	committeeRoot, err := committee.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, true, lightclient.IsSyncCommitteeUpdate(update))
+	assert.Equal(t, true, trie.VerifyMerkleProof(attestedStateRoot, committeeRoot[:], nextSyncCommitteeGeneralizedIndex, update.NextSyncCommitteeBranch))
+
+	finalizedHeaderRoot, err := update.FinalizedHeader.Beacon.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, finalizedRoot, finalizedHeaderRoot)
+	assert.Equal(t, true, lightclient.IsFinalityUpdate(update))
+	assert.Equal(t, true, trie.VerifyMerkleProof(attestedStateRoot, finalizedRoot[:], statenative.FinalizedRootGeneralizedIndex(), update.FinalityBranch))
+
+	// The update can be serialized, even without the optional fields.
+	update, err = lightclient.NewUpdate(ctx, st, blk, attestedState, attestedBlk, nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, lightclient.IsFinalityUpdate(update))
+	_, err = update.MarshalSSZ()
+	require.NoError(t, err)
+
+	_, err = lightclient.NewUpdate(ctx, st, blk, attestedState, attestedBlk, blk)
+	require.ErrorContains(t, "finalized block does not match", err)
+
+	_, err = lightclient.NewUpdate(ctx, attestedState, attestedBlk, attestedState, attestedBlk, nil)
+	require.ErrorContains(t, "not enough sync committee participants", err)
+
+	unrelated, unrelatedState := newBlockAndPostState(t, 41, make([]byte, 32), 400, nil)
+	_, err = lightclient.NewUpdate(ctx, unrelatedState, unrelated, attestedState, attestedBlk, nil)
+	require.ErrorContains(t, "not the parent of the block", err)
+}
+
+func TestNewUpdate_SignatureInNextPeriod(t *testing.T) {
+	ctx := context.Background()
+	lastSlot := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod)*params.BeaconConfig().SlotsPerEpoch - 1
+	attestedBlk, attestedState := newBlockAndPostState(t, lastSlot, make([]byte, 32), 0, nil)
+	attestedRoot, err := attestedBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	blk, st := newBlockAndPostState(t, lastSlot+1, attestedRoot[:], 400, nil)
+
+	update, err := lightclient.NewUpdate(ctx, st, blk, attestedState, attestedBlk, nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, lightclient.IsSyncCommitteeUpdate(update))
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	newUpdate := func(participants int, attestedSlot, signatureSlot, finalizedSlot primitives.Slot, syncCommittee, finality bool) *ethpb.LightClientUpdate {
+		blk := util.NewBeaconBlockAltair()
+		for i := 0; i < participants; i++ {
+			blk.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(uint64(i), true)
+		}
+		u := &ethpb.LightClientUpdate{
+			AttestedHeader:  &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: attestedSlot}},
+			FinalizedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: finalizedSlot}},
+			SyncAggregate:   blk.Block.Body.SyncAggregate,
+			SignatureSlot:   signatureSlot,
+		}
+		branch := [][]byte{make([]byte, 32)}
+		if syncCommittee {
+			u.NextSyncCommitteeBranch = [][]byte{{1}}
+		} else {
+			u.NextSyncCommitteeBranch = branch
+		}
+		if finality {
+			u.FinalityBranch = [][]byte{{1}}
+		} else {
+			u.FinalityBranch = branch
+		}
+		return u
+	}
+	periodSlots := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+
+	tests := []struct {
+		name     string
+		new, old *ethpb.LightClientUpdate
+		want     bool
+	}{
+		{
+			name: "supermajority wins",
+			new:  newUpdate(400, 10, 11, 0, false, false),
+			old:  newUpdate(300, 10, 11, 0, true, true),
+			want: true,
+		},
+		{
+			name: "more participants without supermajority",
+			new:  newUpdate(200, 10, 11, 0, false, false),
+			old:  newUpdate(100, 10, 11, 0, true, true),
+			want: true,
+		},
+		{
+			name: "relevant sync committee",
+			new:  newUpdate(400, 10, 11, 0, true, false),
+			old:  newUpdate(500, 10, 11, 0, false, true),
+			want: true,
+		},
+		{
+			name: "sync committee in another period is not relevant",
+			new:  newUpdate(400, periodSlots-1, periodSlots, 0, true, false),
+			old:  newUpdate(400, 10, 11, 0, true, false),
+			want: false,
+		},
+		{
+			name: "finality",
+			new:  newUpdate(400, 10, 11, 0, true, true),
+			old:  newUpdate(500, 10, 11, 0, true, false),
+			want: true,
+		},
+		{
+			name: "sync committee finality",
+			new:  newUpdate(400, periodSlots+10, periodSlots+11, periodSlots, true, true),
+			old:  newUpdate(500, periodSlots+10, periodSlots+11, 8, true, true),
+			want: true,
+		},
+		{
+			name: "more participants beyond supermajority",
+			new:  newUpdate(500, 10, 11, 0, true, true),
+			old:  newUpdate(400, 10, 11, 0, true, true),
+			want: true,
+		},
+		{
+			name: "older attested header",
+			new:  newUpdate(400, 10, 12, 0, true, true),
+			old:  newUpdate(400, 11, 12, 0, true, true),
+			want: true,
+		},
+		{
+			name: "older signature slot",
+			new:  newUpdate(400, 10, 12, 0, true, true),
+			old:  newUpdate(400, 10, 11, 0, true, true),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lightclient.IsBetterUpdate(tt.new, tt.old))
+		})
+	}
+}
+
+func TestIsNewerFinalityUpdate(t *testing.T) {
+	newUpdate := func(participants int, finalizedSlot primitives.Slot) *ethpb.LightClientFinalityUpdate {
+		agg := util.NewBeaconBlockAltair().Block.Body.SyncAggregate
+		for i := 0; i < participants; i++ {
+			agg.SyncCommitteeBits.SetBitAt(uint64(i), true)
+		}
+		return &ethpb.LightClientFinalityUpdate{
+			FinalizedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: finalizedSlot}},
+			SyncAggregate:   agg,
+		}
+	}
+	supermajority := int((params.BeaconConfig().SyncCommitteeSize*2 + 2) / 3)
+
+	assert.Equal(t, true, lightclient.IsNewerFinalityUpdate(newUpdate(1, 32), nil))
+	assert.Equal(t, true, lightclient.IsNewerFinalityUpdate(newUpdate(1, 64), newUpdate(supermajority, 32)))
+	assert.Equal(t, false, lightclient.IsNewerFinalityUpdate(newUpdate(supermajority, 32), newUpdate(1, 64)))
+	assert.Equal(t, true, lightclient.IsNewerFinalityUpdate(newUpdate(supermajority, 32), newUpdate(1, 32)))
+	assert.Equal(t, false, lightclient.IsNewerFinalityUpdate(newUpdate(supermajority+1, 32), newUpdate(supermajority, 32)))
+	assert.Equal(t, false, lightclient.IsNewerFinalityUpdate(newUpdate(2, 32), newUpdate(1, 32)))
+}
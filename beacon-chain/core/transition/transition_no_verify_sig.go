@@ -256,7 +256,7 @@ func ProcessOperationsNoVerifyAttsSigs(
 		if err != nil {
 			return nil, err
 		}
-	case version.Altair, version.Bellatrix, version.Capella:
+	case version.Altair, version.Bellatrix, version.Capella, version.Deneb:
 		state, err = altairOperations(ctx, state, signedBeaconBlock)
 		if err != nil {
 			return nil, err
@@ -322,6 +322,9 @@ func ProcessBlockForStateRoot(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not process execution data")
 		}
+		if err := b.VerifyBlobCommitmentCount(blk); err != nil {
+			return nil, err
+		}
 	}
 
 	randaoReveal := signed.Block().Body().RandaoReveal()
@@ -57,6 +57,21 @@ type ReadOnlyDatabase interface {
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	PrunedSlot(ctx context.Context) (primitives.Slot, error)
+	// Blob sidecar related methods.
+	BlobSidecarsByRoot(ctx context.Context, root [32]byte, indices ...uint64) ([]*ethpb.BlobSidecar, error)
+	BlobSidecarsBySlot(ctx context.Context, slot primitives.Slot, indices ...uint64) ([]*ethpb.BlobSidecar, error)
+	// Light client related methods.
+	LightClientUpdate(ctx context.Context, period uint64) (*ethpb.LightClientUpdate, error)
+	LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpb.LightClientUpdate, error)
+	LightClientBootstrap(ctx context.Context, root [32]byte) (*ethpb.LightClientBootstrap, error)
+	// Builder related methods.
+	BuilderDecisions(ctx context.Context, id primitives.ValidatorIndex, startSlot, endSlot primitives.Slot) ([]*ethpb.BuilderDecision, error)
+	// Fork choice related methods.
+	ForkChoiceSnapshot(ctx context.Context) (*ethpb.ForkChoiceSnapshot, error)
+	// Validator rewards related methods.
+	ValidatorEpochRewards(ctx context.Context, startEpoch, endEpoch primitives.Epoch, startIndex primitives.ValidatorIndex, indices []primitives.ValidatorIndex, limit int) ([]*ethpb.ValidatorEpochRewards, error)
+	LastValidatorRewardsEpoch(ctx context.Context) (primitives.Epoch, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -88,8 +103,21 @@ type NoHeadAccessDatabase interface {
 	// Fee recipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	// Blob sidecar related methods.
+	SaveBlobSidecar(ctx context.Context, sidecars []*ethpb.BlobSidecar) error
+	DeleteBlobSidecars(ctx context.Context, root [32]byte) error
+	// Light client related methods.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error
+	SaveLightClientBootstrap(ctx context.Context, root [32]byte, bootstrap *ethpb.LightClientBootstrap) error
+	// Builder related methods.
+	SaveBuilderDecision(ctx context.Context, decision *ethpb.BuilderDecision) error
+	// Fork choice related methods.
+	SaveForkChoiceSnapshot(ctx context.Context, snapshot *ethpb.ForkChoiceSnapshot) error
+	// Validator rewards related methods.
+	SaveValidatorEpochRewards(ctx context.Context, epoch primitives.Epoch, rewards []*ethpb.ValidatorEpochRewards) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint primitives.Slot) error
+	PruneHistory(ctx context.Context, cutoff, slotsPerArchivedPoint primitives.Slot) (int, error)
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -108,6 +136,7 @@ type HeadAccessDatabase interface {
 	// initialization method needed for origin checkpoint sync
 	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	BackfillFinalizedIndex(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, finalizedChildRoot [32]byte) error
 }
 
 // SlasherDatabase interface for persisting data related to detecting slashable offenses on Ethereum.
@@ -150,10 +179,23 @@ type SlasherDatabase interface {
 	PruneProposalsAtEpoch(
 		ctx context.Context, maxEpoch primitives.Epoch,
 	) (numPruned uint, err error)
+	PruneSlashingEvidenceAtEpoch(
+		ctx context.Context, maxEpoch primitives.Epoch,
+	) (numPruned uint, err error)
 	HighestAttestations(
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	SaveSlashingEvidence(
+		ctx context.Context, evidence []*slashertypes.SlashingEvidence,
+	) error
+	SlashingEvidence(
+		ctx context.Context, startId uint64, limit int,
+	) ([]*slashertypes.SlashingEvidence, error)
+	RescanCheckpoint(ctx context.Context) (*slashertypes.RescanCheckpoint, error)
+	SaveRescanCheckpoint(
+		ctx context.Context, checkpoint *slashertypes.RescanCheckpoint,
+	) error
 	DatabasePath() string
 	ClearDB() error
 }
@@ -0,0 +1,238 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var (
+	errEmptySidecars      = errors.New("no blob sidecars provided")
+	errNilSidecar         = errors.New("nil blob sidecar")
+	errMismatchedSidecars = errors.New("blob sidecars do not belong to the same block")
+	errInvalidBlobIndex   = errors.New("blob sidecar index exceeds max blobs per block")
+)
+
+// SaveBlobSidecar saves the blob sidecars of a single block. Each sidecar is stored under its own key,
+// so that sidecars received one at a time over gossip are written without touching the others. Sidecars
+// which are already in the database are kept. Once written, the sidecars which fall outside of the
+// MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS retention window, relative to the slot of the saved sidecars, are pruned.
+func (s *Store) SaveBlobSidecar(ctx context.Context, scs []*ethpb.BlobSidecar) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlobSidecar")
+	defer span.End()
+
+	slot, root, err := blobSidecarsSlotAndRoot(scs)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blobsBucket)
+		for _, sc := range scs {
+			key := blobSidecarKey(slot, root, sc.Index)
+			if bkt.Get(key) != nil {
+				continue
+			}
+			enc, err := encode(ctx, sc)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(key, enc); err != nil {
+				return err
+			}
+		}
+		if err := tx.Bucket(blobRootIndicesBucket).Put(root[:], bytesutil.SlotToBytesBigEndian(slot)); err != nil {
+			return err
+		}
+		return pruneBlobSidecars(tx, slot)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// BlobSidecarsByRoot retrieves the blob sidecars of the given block root, sorted by index.
+// If indices are provided, only the sidecars with a matching index are returned.
+// `ErrNotFoundBlobSidecars` is returned if no matching sidecar is found.
+func (s *Store) BlobSidecarsByRoot(ctx context.Context, root [32]byte, indices ...uint64) ([]*ethpb.BlobSidecar, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlobSidecarsByRoot")
+	defer span.End()
+
+	var scs []*ethpb.BlobSidecar
+	err := s.db.View(func(tx *bolt.Tx) error {
+		slot := tx.Bucket(blobRootIndicesBucket).Get(root[:])
+		if slot == nil {
+			return nil
+		}
+		var err error
+		scs, err = blobSidecarsWithPrefix(ctx, tx, append(bytesutil.SafeCopyBytes(slot), root[:]...), indices)
+		return err
+	})
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	if len(scs) == 0 {
+		return nil, errors.Wrapf(ErrNotFoundBlobSidecars, "block root %#x", root)
+	}
+	return scs, nil
+}
+
+// BlobSidecarsBySlot retrieves the blob sidecars of every block stored at the given slot, sorted by
+// block root and index. If indices are provided, only the sidecars with a matching index are returned.
+// `ErrNotFoundBlobSidecars` is returned if no matching sidecar is found.
+func (s *Store) BlobSidecarsBySlot(ctx context.Context, slot primitives.Slot, indices ...uint64) ([]*ethpb.BlobSidecar, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlobSidecarsBySlot")
+	defer span.End()
+
+	var scs []*ethpb.BlobSidecar
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		scs, err = blobSidecarsWithPrefix(ctx, tx, bytesutil.SlotToBytesBigEndian(slot), indices)
+		return err
+	})
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	if len(scs) == 0 {
+		return nil, errors.Wrapf(ErrNotFoundBlobSidecars, "slot %d", slot)
+	}
+	return scs, nil
+}
+
+// DeleteBlobSidecars removes the blob sidecars of the given block root. It is a no-op if none are stored.
+func (s *Store) DeleteBlobSidecars(ctx context.Context, root [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteBlobSidecars")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		idx := tx.Bucket(blobRootIndicesBucket)
+		slot := idx.Get(root[:])
+		if slot == nil {
+			return nil
+		}
+		prefix := append(bytesutil.SafeCopyBytes(slot), root[:]...)
+		bkt := tx.Bucket(blobsBucket)
+		var keys [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range keys {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return idx.Delete(root[:])
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// blobSidecarsWithPrefix decodes the sidecars whose key starts with the given prefix, in key order.
+// If indices are provided, only the sidecars with a matching index are decoded.
+func blobSidecarsWithPrefix(ctx context.Context, tx *bolt.Tx, prefix []byte, indices []uint64) ([]*ethpb.BlobSidecar, error) {
+	var scs []*ethpb.BlobSidecar
+	c := tx.Bucket(blobsBucket).Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		if !containsBlobIndex(indices, bytesutil.BytesToUint64BigEndian(k[40:])) {
+			continue
+		}
+		sc := &ethpb.BlobSidecar{}
+		if err := decode(ctx, v, sc); err != nil {
+			return nil, err
+		}
+		scs = append(scs, sc)
+	}
+	return scs, nil
+}
+
+// pruneBlobSidecars deletes the sidecars stored at slots older than the blob retention window, measured
+// back from the given slot, along with their root index. Keys are prefixed by their big endian slot, so the
+// cursor stops at the first key within the window.
+func pruneBlobSidecars(tx *bolt.Tx, slot primitives.Slot) error {
+	cfg := params.BeaconConfig()
+	retention := primitives.Slot(cfg.MinEpochsForBlobsSidecarsRequest.Mul(uint64(cfg.SlotsPerEpoch)))
+	if slot <= retention {
+		return nil
+	}
+	cutoff := slot - retention
+	bkt := tx.Bucket(blobsBucket)
+	idx := tx.Bucket(blobRootIndicesBucket)
+	var expired [][]byte
+	c := bkt.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if bytesutil.BytesToSlotBigEndian(k[:8]) >= cutoff {
+			break
+		}
+		expired = append(expired, bytesutil.SafeCopyBytes(k))
+	}
+	for _, k := range expired {
+		if err := bkt.Delete(k); err != nil {
+			return err
+		}
+		if err := idx.Delete(k[8:40]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blobSidecarsSlotAndRoot checks that the sidecars are well formed and belong to the same block,
+// returning the slot and root of that block.
+func blobSidecarsSlotAndRoot(scs []*ethpb.BlobSidecar) (primitives.Slot, [32]byte, error) {
+	if len(scs) == 0 {
+		return 0, [32]byte{}, errEmptySidecars
+	}
+	var slot primitives.Slot
+	var root [32]byte
+	for i, sc := range scs {
+		if sc == nil || sc.SignedBlockHeader == nil || sc.SignedBlockHeader.Header == nil {
+			return 0, [32]byte{}, errNilSidecar
+		}
+		if sc.Index >= fieldparams.MaxBlobsPerBlock {
+			return 0, [32]byte{}, errors.Wrapf(errInvalidBlobIndex, "index %d", sc.Index)
+		}
+		r, err := sc.SignedBlockHeader.Header.HashTreeRoot()
+		if err != nil {
+			return 0, [32]byte{}, err
+		}
+		if i == 0 {
+			slot, root = sc.SignedBlockHeader.Header.Slot, r
+			continue
+		}
+		if r != root {
+			return 0, [32]byte{}, errMismatchedSidecars
+		}
+	}
+	return slot, root, nil
+}
+
+// containsBlobIndex returns true if the index is in the given list, or if the list is empty.
+func containsBlobIndex(indices []uint64, index uint64) bool {
+	if len(indices) == 0 {
+		return true
+	}
+	for _, i := range indices {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+// blobSidecarKey is the slot in big endian followed by the block root and the big endian blob index, so that
+// sidecars are ordered by slot and the sidecars of a block are ordered by index.
+func blobSidecarKey(slot primitives.Slot, root [32]byte, index uint64) []byte {
+	key := append(bytesutil.SlotToBytesBigEndian(slot), root[:]...)
+	return append(key, bytesutil.Uint64ToBytesBigEndian(index)...)
+}
@@ -0,0 +1,134 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func testBlobSidecars(t *testing.T, slot primitives.Slot, proposer primitives.ValidatorIndex, count uint64) ([]*ethpb.BlobSidecar, [32]byte) {
+	header := util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{Slot: slot, ProposerIndex: proposer},
+	})
+	root, err := header.Header.HashTreeRoot()
+	require.NoError(t, err)
+	scs := make([]*ethpb.BlobSidecar, count)
+	for i := uint64(0); i < count; i++ {
+		scs[i] = util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: i, SignedBlockHeader: header})
+	}
+	return scs, root
+}
+
+func TestStore_BlobSidecars(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty", func(t *testing.T) {
+		db := setupDB(t)
+		require.ErrorIs(t, db.SaveBlobSidecar(ctx, nil), errEmptySidecars)
+	})
+	t.Run("not found", func(t *testing.T) {
+		db := setupDB(t)
+		_, err := db.BlobSidecarsByRoot(ctx, [32]byte{'a'})
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = db.BlobSidecarsBySlot(ctx, 1)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+	t.Run("save and retrieve", func(t *testing.T) {
+		db := setupDB(t)
+		scs, root := testBlobSidecars(t, 10, 1, 3)
+		require.NoError(t, db.SaveBlobSidecar(ctx, scs))
+
+		got, err := db.BlobSidecarsByRoot(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(got))
+		for i := range scs {
+			require.DeepSSZEqual(t, scs[i], got[i])
+		}
+		got, err = db.BlobSidecarsBySlot(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(got))
+
+		got, err = db.BlobSidecarsByRoot(ctx, root, 2, 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(got))
+		assert.Equal(t, uint64(0), got[0].Index)
+		assert.Equal(t, uint64(2), got[1].Index)
+
+		_, err = db.BlobSidecarsByRoot(ctx, root, 5)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+	t.Run("merges sidecars saved one at a time", func(t *testing.T) {
+		db := setupDB(t)
+		scs, root := testBlobSidecars(t, 10, 1, 3)
+		require.NoError(t, db.SaveBlobSidecar(ctx, scs[2:]))
+		require.NoError(t, db.SaveBlobSidecar(ctx, scs[:1]))
+		require.NoError(t, db.SaveBlobSidecar(ctx, scs[:1]))
+
+		got, err := db.BlobSidecarsByRoot(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(got))
+		assert.Equal(t, uint64(0), got[0].Index)
+		assert.Equal(t, uint64(2), got[1].Index)
+	})
+	t.Run("multiple blocks at the same slot", func(t *testing.T) {
+		db := setupDB(t)
+		scs, _ := testBlobSidecars(t, 10, 1, 2)
+		require.NoError(t, db.SaveBlobSidecar(ctx, scs))
+		scs, _ = testBlobSidecars(t, 10, 2, 1)
+		require.NoError(t, db.SaveBlobSidecar(ctx, scs))
+
+		got, err := db.BlobSidecarsBySlot(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(got))
+	})
+	t.Run("rejects sidecars of different blocks", func(t *testing.T) {
+		db := setupDB(t)
+		scs, _ := testBlobSidecars(t, 10, 1, 1)
+		other, _ := testBlobSidecars(t, 11, 1, 1)
+		require.ErrorIs(t, db.SaveBlobSidecar(ctx, append(scs, other...)), errMismatchedSidecars)
+	})
+	t.Run("rejects out of range index", func(t *testing.T) {
+		db := setupDB(t)
+		scs, _ := testBlobSidecars(t, 10, 1, 1)
+		scs[0].Index = 6
+		require.ErrorIs(t, db.SaveBlobSidecar(ctx, scs), errInvalidBlobIndex)
+	})
+	t.Run("delete", func(t *testing.T) {
+		db := setupDB(t)
+		scs, root := testBlobSidecars(t, 10, 1, 2)
+		require.NoError(t, db.SaveBlobSidecar(ctx, scs))
+		require.NoError(t, db.DeleteBlobSidecars(ctx, root))
+		_, err := db.BlobSidecarsByRoot(ctx, root)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+	t.Run("prunes sidecars outside of the retention window", func(t *testing.T) {
+		db := setupDB(t)
+		cfg := params.BeaconConfig()
+		retention := primitives.Slot(cfg.MinEpochsForBlobsSidecarsRequest.Mul(uint64(cfg.SlotsPerEpoch)))
+
+		old, oldRoot := testBlobSidecars(t, 1, 1, 1)
+		require.NoError(t, db.SaveBlobSidecar(ctx, old))
+		kept, keptRoot := testBlobSidecars(t, 2, 1, 1)
+		require.NoError(t, db.SaveBlobSidecar(ctx, kept))
+
+		latest, _ := testBlobSidecars(t, retention+2, 1, 1)
+		require.NoError(t, db.SaveBlobSidecar(ctx, latest))
+
+		_, err := db.BlobSidecarsByRoot(ctx, oldRoot)
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = db.BlobSidecarsByRoot(ctx, keptRoot)
+		require.NoError(t, err)
+		require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+			assert.Equal(t, true, tx.Bucket(blobRootIndicesBucket).Get(oldRoot[:]) == nil)
+			assert.Equal(t, false, tx.Bucket(blobRootIndicesBucket).Get(keptRoot[:]) == nil)
+			return nil
+		}))
+	})
+}
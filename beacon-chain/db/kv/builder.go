@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveBuilderDecision saves the decision between the builder and the local payload of a proposal. Decisions
+// are keyed by slot then proposer index, so that they can be scanned and pruned in slot order. Once written,
+// the decisions which fall outside of the MIN_EPOCHS_FOR_BLOCK_REQUESTS retention window, relative to the
+// slot of the saved decision, are pruned.
+func (s *Store) SaveBuilderDecision(ctx context.Context, decision *ethpb.BuilderDecision) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBuilderDecision")
+	defer span.End()
+
+	if decision == nil {
+		return errors.New("nil builder decision")
+	}
+	enc, err := encode(ctx, decision)
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(builderDecisionsBucket)
+		if err := bkt.Put(builderDecisionKey(decision.Slot, decision.ProposerIndex), enc); err != nil {
+			return err
+		}
+		return pruneBuilderDecisions(bkt, decision.Slot)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// BuilderDecisions retrieves the builder decisions of the proposals of a validator between the start and end
+// slots, inclusive. The decisions are ordered by slot.
+func (s *Store) BuilderDecisions(ctx context.Context, id primitives.ValidatorIndex, startSlot, endSlot primitives.Slot) ([]*ethpb.BuilderDecision, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BuilderDecisions")
+	defer span.End()
+
+	if startSlot > endSlot {
+		return nil, errInvalidSlotRange
+	}
+	decisions := make([]*ethpb.BuilderDecision, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(builderDecisionsBucket).Cursor()
+		end := bytesutil.Uint64ToBytesBigEndian(uint64(endSlot))
+		for k, v := c.Seek(builderDecisionKey(startSlot, 0)); k != nil && bytes.Compare(k[:8], end) <= 0; k, v = c.Next() {
+			if primitives.ValidatorIndex(bytesutil.BytesToUint64BigEndian(k[8:16])) != id {
+				continue
+			}
+			d := &ethpb.BuilderDecision{}
+			if err := decode(ctx, v, d); err != nil {
+				return err
+			}
+			decisions = append(decisions, d)
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return decisions, err
+}
+
+// pruneBuilderDecisions deletes the decisions of slots older than the block retention window, measured
+// back from the given slot. Keys are prefixed by their big endian slot, so the cursor seeks to the first key
+// within the window and walks back over the expired ones only.
+func pruneBuilderDecisions(bkt *bolt.Bucket, slot primitives.Slot) error {
+	cfg := params.BeaconConfig()
+	retention := primitives.Slot(params.BeaconNetworkConfig().MinEpochsForBlockRequests.Mul(uint64(cfg.SlotsPerEpoch)))
+	if slot <= retention {
+		return nil
+	}
+	var expired [][]byte
+	c := bkt.Cursor()
+	k, _ := c.Seek(builderDecisionKey(slot-retention, 0))
+	if k == nil {
+		k, _ = c.Last()
+	} else {
+		k, _ = c.Prev()
+	}
+	for ; k != nil; k, _ = c.Prev() {
+		expired = append(expired, bytesutil.SafeCopyBytes(k))
+	}
+	for _, k := range expired {
+		if err := bkt.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func builderDecisionKey(slot primitives.Slot, id primitives.ValidatorIndex) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(slot)), bytesutil.Uint64ToBytesBigEndian(uint64(id))...)
+}
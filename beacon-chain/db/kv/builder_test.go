@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_BuilderDecisions(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.ErrorContains(t, "nil builder decision", db.SaveBuilderDecision(ctx, nil))
+	for _, d := range []*ethpb.BuilderDecision{
+		{Slot: 10, ProposerIndex: 1, UseBuilder: true, BuilderValueGwei: 20, LocalValueGwei: 10, Relay: "relay", Reason: "builder_higher_value"},
+		{Slot: 12, ProposerIndex: 2, LocalValueGwei: 10, Reason: "no_builder_bid"},
+		{Slot: 15, ProposerIndex: 1, LocalValueGwei: 30, BuilderValueGwei: 20, Reason: "local_higher_value"},
+		{Slot: 20, ProposerIndex: 1, LocalValueGwei: 30, Reason: "bid_below_minimum"},
+	} {
+		require.NoError(t, db.SaveBuilderDecision(ctx, d))
+	}
+
+	decisions, err := db.BuilderDecisions(ctx, 1, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(decisions))
+	assert.Equal(t, primitives.Slot(10), decisions[0].Slot)
+	assert.Equal(t, "relay", decisions[0].Relay)
+	assert.Equal(t, primitives.Slot(15), decisions[1].Slot)
+	assert.Equal(t, primitives.Slot(20), decisions[2].Slot)
+
+	decisions, err = db.BuilderDecisions(ctx, 1, 11, 15)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decisions))
+	assert.Equal(t, "local_higher_value", decisions[0].Reason)
+
+	decisions, err = db.BuilderDecisions(ctx, 3, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(decisions))
+
+	_, err = db.BuilderDecisions(ctx, 1, 10, 9)
+	require.ErrorIs(t, err, errInvalidSlotRange)
+}
+
+func TestStore_BuilderDecisions_Pruned(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	cfg := params.BeaconConfig()
+	retention := primitives.Slot(params.BeaconNetworkConfig().MinEpochsForBlockRequests.Mul(uint64(cfg.SlotsPerEpoch)))
+	require.NoError(t, db.SaveBuilderDecision(ctx, &ethpb.BuilderDecision{Slot: 5, ProposerIndex: 1}))
+	require.NoError(t, db.SaveBuilderDecision(ctx, &ethpb.BuilderDecision{Slot: 10, ProposerIndex: 1}))
+	require.NoError(t, db.SaveBuilderDecision(ctx, &ethpb.BuilderDecision{Slot: 15, ProposerIndex: 2}))
+	require.NoError(t, db.SaveBuilderDecision(ctx, &ethpb.BuilderDecision{Slot: 20, ProposerIndex: 2}))
+	// The decisions of slots 5 and 10 fall out of the retention window, the ones from slot 15 are kept.
+	require.NoError(t, db.SaveBuilderDecision(ctx, &ethpb.BuilderDecision{Slot: 15 + retention, ProposerIndex: 3}))
+
+	decisions, err := db.BuilderDecisions(ctx, 1, 0, 15+retention)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(decisions))
+	decisions, err = db.BuilderDecisions(ctx, 2, 0, 15+retention)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(decisions))
+	decisions, err = db.BuilderDecisions(ctx, 3, 0, 15+retention)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(decisions))
+}
@@ -72,6 +72,12 @@ func isSSZStorageFormat(obj interface{}) bool {
 		return true
 	case *ethpb.ValidatorRegistrationV1:
 		return true
+	case *ethpb.BlobSidecar:
+		return true
+	case *ethpb.LightClientUpdate:
+		return true
+	case *ethpb.LightClientBootstrap:
+		return true
 	default:
 		return false
 	}
@@ -21,3 +21,12 @@ var ErrNotFoundBackfillBlockRoot = errors.Wrap(ErrNotFound, "BackfillBlockRoot")
 
 // ErrNotFoundFeeRecipient is a not found error specifically for the fee recipient getter
 var ErrNotFoundFeeRecipient = errors.Wrap(ErrNotFound, "fee recipient")
+
+// ErrNotFoundBlobSidecars is a not found error specifically for the blob sidecar getters
+var ErrNotFoundBlobSidecars = errors.Wrap(ErrNotFound, "blob sidecars")
+
+// ErrNotFoundValidatorRewards is a not found error specifically for the validator rewards getters
+var ErrNotFoundValidatorRewards = errors.Wrap(ErrNotFound, "validator rewards")
+
+// errNotConnectedToFinalized is raised when backfilled blocks do not form a chain leading to a finalized block
+var errNotConnectedToFinalized = errors.New("blocks are not connected to the finalized chain")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
@@ -219,3 +220,68 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 	tracing.AnnotateError(span, err)
 	return blk, err
 }
+
+// BackfillFinalizedIndex adds backfilled blocks to the finalized block roots index. Blocks older than the origin
+// checkpoint are finalized and canonical by definition. The blocks must be sorted by ascending slot and form a
+// chain, the highest one being the parent of the block with the given root, which is already in the database.
+func (s *Store) BackfillFinalizedIndex(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, finalizedChildRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillFinalizedIndex")
+	defer span.End()
+
+	if len(blks) == 0 {
+		return nil
+	}
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		if err := blocks.BeaconBlockIsNil(blk); err != nil {
+			return err
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+		if i > 0 && blk.Block().ParentRoot() != roots[i-1] {
+			return errors.Wrapf(errNotConnectedToFinalized, "parent of block at slot %d is not the previous block", blk.Block().Slot())
+		}
+	}
+	encs := make([][]byte, len(blks))
+	for i, blk := range blks {
+		childRoot := finalizedChildRoot
+		if i < len(blks)-1 {
+			childRoot = roots[i+1]
+		}
+		parentRoot := blk.Block().ParentRoot()
+		enc, err := encode(ctx, &ethpb.FinalizedBlockRootContainer{
+			ParentRoot: parentRoot[:],
+			ChildRoot:  childRoot[:],
+		})
+		if err != nil {
+			return err
+		}
+		encs[i] = enc
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		child := tx.Bucket(blocksBucket).Get(finalizedChildRoot[:])
+		if child == nil {
+			return errors.Wrapf(errNotConnectedToFinalized, "child block root %#x not found", finalizedChildRoot)
+		}
+		childBlk, err := unmarshalBlock(ctx, child)
+		if err != nil {
+			return err
+		}
+		if childBlk.Block().ParentRoot() != roots[len(roots)-1] {
+			return errors.Wrapf(errNotConnectedToFinalized, "highest block is not the parent of child block root %#x", finalizedChildRoot)
+		}
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for i := range roots {
+			if err := bkt.Put(roots[i][:], encs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
@@ -234,3 +234,32 @@ func makeBlocksAltair(t *testing.T, startIdx, num uint64, previousRoot [32]byte)
 	}
 	return ifaceBlocks
 }
+
+func TestStore_BackfillFinalizedIndex(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blks := makeBlocks(t, 0, 66, genesisBlockRoot)
+	origin := blks[len(blks)-1]
+	originRoot, err := origin.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, origin))
+	backfilled := blks[:len(blks)-1]
+
+	// The blocks must connect to the child block.
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, backfilled[:10], originRoot), errNotConnectedToFinalized)
+	// The blocks must form a chain.
+	broken := append([]interfaces.ReadOnlySignedBeaconBlock{backfilled[0]}, backfilled[2:]...)
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, broken, originRoot), errNotConnectedToFinalized)
+
+	require.NoError(t, db.SaveBlocks(ctx, backfilled))
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, backfilled, originRoot))
+	for i, blk := range backfilled {
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+		child, err := db.FinalizedChildBlock(ctx, root)
+		require.NoError(t, err)
+		require.DeepEqual(t, blks[i+1].Block().Slot(), child.Block().Slot())
+	}
+}
@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceSnapshot saves the snapshot of the fork choice store, replacing any previously saved snapshot.
+func (s *Store) SaveForkChoiceSnapshot(ctx context.Context, snapshot *ethpb.ForkChoiceSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceSnapshot")
+	defer span.End()
+
+	if snapshot == nil {
+		return errors.New("nil fork choice snapshot")
+	}
+	enc, err := encode(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(forkChoiceBucket).Put(forkChoiceSnapshotKey, enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// ForkChoiceSnapshot retrieves the saved snapshot of the fork choice store. It returns nil if no snapshot was saved.
+func (s *Store) ForkChoiceSnapshot(ctx context.Context) (*ethpb.ForkChoiceSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceSnapshot")
+	defer span.End()
+
+	var snapshot *ethpb.ForkChoiceSnapshot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(forkChoiceBucket).Get(forkChoiceSnapshotKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		snapshot = &ethpb.ForkChoiceSnapshot{}
+		return decode(ctx, enc, snapshot)
+	})
+	tracing.AnnotateError(span, err)
+	return snapshot, err
+}
@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_ForkChoiceSnapshot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	snapshot, err := db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.ForkChoiceSnapshot)(nil), snapshot)
+	require.ErrorContains(t, "nil fork choice snapshot", db.SaveForkChoiceSnapshot(ctx, nil))
+
+	want := &ethpb.ForkChoiceSnapshot{
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+		HeadRoot:            []byte{'a'},
+		Nodes:               []*ethpb.ForkChoiceSnapshotNode{{Slot: 64, Root: []byte{'b'}}},
+		Balances:            []uint64{32, 31},
+	}
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, want))
+	snapshot, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, snapshot)
+
+	want.HeadRoot = []byte{'c'}
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, want))
+	snapshot, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'c'}, snapshot.HeadRoot)
+}
@@ -122,6 +122,7 @@ var Buckets = [][]byte{
 	blockParentRootIndicesBucket,
 	finalizedBlockRootsIndexBucket,
 	blockRootValidatorHashesBucket,
+	blobRootIndicesBucket,
 	// State management service bucket.
 	newStateServiceCompatibleBucket,
 	// Migrations
@@ -129,6 +130,13 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+	blobsBucket,
+	lightClientUpdatesBucket,
+	lightClientBootstrapsBucket,
+	lightClientBootstrapSlotIndicesBucket,
+	builderDecisionsBucket,
+	forkChoiceBucket,
+	validatorRewardsBucket,
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -0,0 +1,167 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var errNilLightClientData = errors.New("nil light client data")
+
+// SaveLightClientUpdate saves the best light client update of the given sync committee period, replacing
+// the one which was saved previously for that period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	if update == nil || update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return errNilLightClientData
+	}
+	enc, err := encode(ctx, update)
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(lightClientUpdatesBucket).Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// LightClientUpdate retrieves the best light client update of the given sync committee period.
+// It returns nil if no update was saved for that period.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpb.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+
+	var update *ethpb.LightClientUpdate
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientUpdatesBucket).Get(bytesutil.Uint64ToBytesBigEndian(period))
+		if enc == nil {
+			return nil
+		}
+		update = &ethpb.LightClientUpdate{}
+		return decode(ctx, enc, update)
+	})
+	tracing.AnnotateError(span, err)
+	return update, err
+}
+
+// LightClientUpdates retrieves the best light client updates of up to count consecutive sync committee
+// periods, starting at the given period. The updates are ordered by period, and the retrieval stops at
+// the first period without an update so that the result never skips a period.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpb.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	var updates []*ethpb.LightClientUpdate
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		next := startPeriod
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startPeriod)); k != nil && uint64(len(updates)) < count; k, v = c.Next() {
+			if bytesutil.BytesToUint64BigEndian(k) != next {
+				break
+			}
+			update := &ethpb.LightClientUpdate{}
+			if err := decode(ctx, v, update); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+			next++
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return updates, err
+}
+
+// SaveLightClientBootstrap saves the light client bootstrap of the given block root, which is expected
+// to be the root of a finalized checkpoint. Once written, the bootstraps older than the
+// MIN_EPOCHS_FOR_BLOCK_REQUESTS window, relative to the slot of the saved bootstrap, are pruned.
+func (s *Store) SaveLightClientBootstrap(ctx context.Context, root [32]byte, bootstrap *ethpb.LightClientBootstrap) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientBootstrap")
+	defer span.End()
+
+	if bootstrap == nil || bootstrap.Header == nil || bootstrap.Header.Beacon == nil {
+		return errNilLightClientData
+	}
+	enc, err := encode(ctx, bootstrap)
+	if err != nil {
+		return err
+	}
+	slot := bootstrap.Header.Beacon.Slot
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(lightClientBootstrapsBucket).Put(root[:], enc); err != nil {
+			return err
+		}
+		if err := tx.Bucket(lightClientBootstrapSlotIndicesBucket).Put(lightClientBootstrapSlotKey(slot, root), nil); err != nil {
+			return err
+		}
+		return pruneLightClientBootstraps(tx, slot)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// LightClientBootstrap retrieves the light client bootstrap of the given block root.
+// It returns nil if no bootstrap was saved for that root.
+func (s *Store) LightClientBootstrap(ctx context.Context, root [32]byte) (*ethpb.LightClientBootstrap, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientBootstrap")
+	defer span.End()
+
+	var bootstrap *ethpb.LightClientBootstrap
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientBootstrapsBucket).Get(root[:])
+		if enc == nil {
+			return nil
+		}
+		bootstrap = &ethpb.LightClientBootstrap{}
+		return decode(ctx, enc, bootstrap)
+	})
+	tracing.AnnotateError(span, err)
+	return bootstrap, err
+}
+
+// pruneLightClientBootstraps deletes the bootstraps of slots older than the block retention window, measured
+// back from the given slot. The slot index is ordered by slot, so the cursor stops at the first bootstrap
+// within the window.
+func pruneLightClientBootstraps(tx *bolt.Tx, slot primitives.Slot) error {
+	cfg := params.BeaconConfig()
+	retention := primitives.Slot(params.BeaconNetworkConfig().MinEpochsForBlockRequests.Mul(uint64(cfg.SlotsPerEpoch)))
+	if slot <= retention {
+		return nil
+	}
+	cutoff := slot - retention
+	bkt := tx.Bucket(lightClientBootstrapsBucket)
+	idx := tx.Bucket(lightClientBootstrapSlotIndicesBucket)
+	var expired [][]byte
+	c := idx.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if bytesutil.BytesToSlotBigEndian(k[:8]) >= cutoff {
+			break
+		}
+		expired = append(expired, bytesutil.SafeCopyBytes(k))
+	}
+	for _, k := range expired {
+		if err := bkt.Delete(k[8:]); err != nil {
+			return err
+		}
+		if err := idx.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lightClientBootstrapSlotKey is the slot in big endian followed by the block root, so that the slot index
+// of bootstraps is ordered by slot.
+func lightClientBootstrapSlotKey(slot primitives.Slot, root [32]byte) []byte {
+	return append(bytesutil.SlotToBytesBigEndian(slot), root[:]...)
+}
@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	require.ErrorIs(t, db.SaveLightClientUpdate(ctx, 0, nil), errNilLightClientData)
+	update, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, (*ethpb.LightClientUpdate)(nil), update)
+
+	for _, period := range []uint64{1, 2, 3, 5} {
+		u := util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{SignatureSlot: primitives.Slot(period)})
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, u))
+	}
+	// The update of a period is replaced.
+	u := util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{SignatureSlot: 20})
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 2, u))
+	update, err = db.LightClientUpdate(ctx, 2)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, u, update)
+
+	updates, err := db.LightClientUpdates(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(updates), "retrieval must stop at the missing period")
+	assert.Equal(t, primitives.Slot(1), updates[0].SignatureSlot)
+	assert.Equal(t, primitives.Slot(20), updates[1].SignatureSlot)
+	assert.Equal(t, primitives.Slot(3), updates[2].SignatureSlot)
+
+	updates, err = db.LightClientUpdates(ctx, 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	updates, err = db.LightClientUpdates(ctx, 4, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(updates))
+}
+
+func TestStore_LightClientBootstraps(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	require.ErrorIs(t, db.SaveLightClientBootstrap(ctx, [32]byte{}, nil), errNilLightClientData)
+	bootstrap, err := db.LightClientBootstrap(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	require.Equal(t, (*ethpb.LightClientBootstrap)(nil), bootstrap)
+
+	b := util.HydrateLightClientBootstrap(&ethpb.LightClientBootstrap{
+		Header: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 32}},
+	})
+	require.NoError(t, db.SaveLightClientBootstrap(ctx, [32]byte{'a'}, b))
+	bootstrap, err = db.LightClientBootstrap(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, b, bootstrap)
+
+	// Bootstraps older than the block retention window are pruned.
+	retention := primitives.Slot(params.BeaconNetworkConfig().MinEpochsForBlockRequests) * params.BeaconConfig().SlotsPerEpoch
+	recent := util.HydrateLightClientBootstrap(&ethpb.LightClientBootstrap{
+		Header: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: retention + 64}},
+	})
+	require.NoError(t, db.SaveLightClientBootstrap(ctx, [32]byte{'b'}, recent))
+	bootstrap, err = db.LightClientBootstrap(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	require.Equal(t, (*ethpb.LightClientBootstrap)(nil), bootstrap)
+	bootstrap, err = db.LightClientBootstrap(ctx, [32]byte{'b'})
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, recent, bootstrap)
+}
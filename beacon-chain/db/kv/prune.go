@@ -0,0 +1,220 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// pruneBatchSize is the maximum number of blocks deleted in a single transaction, so that pruning a long
+// history does not hold the write lock of the database, nor grow a transaction, for too long.
+var pruneBatchSize = 256
+
+// PrunedSlot returns the lowest slot of the block history retained by the database after PruneHistory.
+// It is zero if the history was never pruned.
+func (s *Store) PrunedSlot(ctx context.Context) (primitives.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PrunedSlot")
+	defer span.End()
+
+	var slot primitives.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(blocksBucket).Get(prunedSlotKey)
+		if enc == nil {
+			return nil
+		}
+		slot = bytesutil.BytesToSlotBigEndian(enc)
+		return nil
+	})
+	return slot, err
+}
+
+// PruneHistory deletes the blocks with a slot lower than the cutoff, along with their entries in the slot, parent
+// root and finalized indices. The states of the pruned blocks are deleted as well, unless they lie on an archived
+// point, i.e. their slot is a multiple of slotsPerArchivedPoint. The genesis and origin checkpoint blocks and states
+// are never pruned, and the cutoff is capped to the finalized checkpoint slot. Blocks are deleted in bounded
+// transactions, the lowest retained slot is recorded after each of them so that an interrupted pruning can be
+// resumed. It returns the number of pruned blocks.
+func (s *Store) PruneHistory(ctx context.Context, cutoff, slotsPerArchivedPoint primitives.Slot) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	if slotsPerArchivedPoint == 0 {
+		return 0, errors.New("slots per archived point must be greater than zero")
+	}
+	cp, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	finalizedSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	if cutoff > finalizedSlot {
+		cutoff = finalizedSlot
+	}
+	protected := map[[32]byte]bool{bytesutil.ToBytes32(cp.Root): true}
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		for _, k := range [][]byte{genesisBlockRootKey, originCheckpointBlockRootKey} {
+			if r := bkt.Get(k); r != nil {
+				protected[bytesutil.ToBytes32(r)] = true
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	// The genesis block is never pruned, start right above it.
+	next := primitives.Slot(1)
+	pruned := 0
+	for next < cutoff {
+		if ctx.Err() != nil {
+			return pruned, ctx.Err()
+		}
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			var n int
+			next, n, err = s.pruneBatch(ctx, tx, next, cutoff, slotsPerArchivedPoint, protected)
+			if err != nil {
+				return err
+			}
+			pruned += n
+			return s.savePrunedSlot(tx, next)
+		}); err != nil {
+			return pruned, err
+		}
+	}
+	return pruned, nil
+}
+
+// pruneBatch deletes the blocks at or above the given slot, and lower than the cutoff, up to pruneBatchSize of
+// them. It returns the slot from which the next batch starts, and the number of deleted blocks.
+func (s *Store) pruneBatch(
+	ctx context.Context,
+	tx *bolt.Tx,
+	from, cutoff, slotsPerArchivedPoint primitives.Slot,
+	protected map[[32]byte]bool,
+) (primitives.Slot, int, error) {
+	type slotRoot struct {
+		slot primitives.Slot
+		root [32]byte
+	}
+	var batch []slotRoot
+	next := cutoff
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(from)); k != nil; k, v = c.Next() {
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if slot >= cutoff {
+			break
+		}
+		if len(batch) >= pruneBatchSize {
+			next = slot
+			break
+		}
+		roots, err := splitRoots(v)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "corrupt slot index at slot %d", slot)
+		}
+		for _, r := range roots {
+			batch = append(batch, slotRoot{slot: slot, root: r})
+		}
+	}
+
+	deleted := 0
+	for _, sr := range batch {
+		if protected[sr.root] {
+			continue
+		}
+		if err := s.pruneBlock(ctx, tx, sr.root); err != nil {
+			return 0, 0, errors.Wrapf(err, "could not prune block with root %#x", sr.root)
+		}
+		archived, err := s.isArchivedPoint(ctx, tx, sr.root, sr.slot, slotsPerArchivedPoint)
+		if err != nil {
+			return 0, 0, err
+		}
+		if !archived {
+			if err := s.deleteState(ctx, tx, sr.root); err != nil {
+				return 0, 0, errors.Wrapf(err, "could not prune state with root %#x", sr.root)
+			}
+			if err := tx.Bucket(stateSummaryBucket).Delete(sr.root[:]); err != nil {
+				return 0, 0, err
+			}
+			s.stateSummaryCache.delete(sr.root)
+		}
+		deleted++
+	}
+	if err := s.updateBackfillBlockRoot(tx, next); err != nil {
+		return 0, 0, err
+	}
+	return next, deleted, nil
+}
+
+// pruneBlock deletes the block of the given root from the blocks bucket and from every block index.
+func (s *Store) pruneBlock(ctx context.Context, tx *bolt.Tx, root [32]byte) error {
+	enc := tx.Bucket(blocksBucket).Get(root[:])
+	if enc == nil {
+		return nil
+	}
+	blk, err := unmarshalBlock(ctx, enc)
+	if err != nil {
+		return err
+	}
+	indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block())
+	if err := deleteValueForIndices(ctx, indicesByBucket, root[:], tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+	if err := tx.Bucket(blocksBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	s.blockCache.Del(string(root[:]))
+	return nil
+}
+
+// isArchivedPoint returns true if the state of the given block root lies on an archived point. The state summary
+// holds the slot of the state, which is higher than the slot of its block when the archived point is a skipped slot.
+func (s *Store) isArchivedPoint(ctx context.Context, tx *bolt.Tx, root [32]byte, blockSlot, slotsPerArchivedPoint primitives.Slot) (bool, error) {
+	slot := blockSlot
+	if enc := tx.Bucket(stateSummaryBucket).Get(root[:]); enc != nil {
+		summary := &ethpb.StateSummary{}
+		if err := decode(ctx, enc, summary); err != nil {
+			return false, err
+		}
+		slot = summary.Slot
+	}
+	return slot%slotsPerArchivedPoint == 0, nil
+}
+
+// updateBackfillBlockRoot moves the backfill block root to the lowest block at or above the given slot, if the block
+// it points at was pruned. The range below the lowest retained block then shows up as a gap in the backfill status.
+func (s *Store) updateBackfillBlockRoot(tx *bolt.Tx, from primitives.Slot) error {
+	bkt := tx.Bucket(blocksBucket)
+	bfRoot := bkt.Get(backfillBlockRootKey)
+	if bfRoot == nil || bkt.Get(bfRoot) != nil {
+		return nil
+	}
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(from)); k != nil; k, v = c.Next() {
+		if len(v) < 32 {
+			continue
+		}
+		return bkt.Put(backfillBlockRootKey, bytesutil.SafeCopyBytes(v[:32]))
+	}
+	return errors.New("no block left to point the backfill block root at")
+}
+
+func (s *Store) savePrunedSlot(tx *bolt.Tx, slot primitives.Slot) error {
+	bkt := tx.Bucket(blocksBucket)
+	if enc := bkt.Get(prunedSlotKey); enc != nil && bytesutil.BytesToSlotBigEndian(enc) >= slot {
+		return nil
+	}
+	return bkt.Put(prunedSlotKey, bytesutil.SlotToBytesBigEndian(slot))
+}
@@ -0,0 +1,89 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestStore_PruneHistory(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	defer func(n int) { pruneBatchSize = n }(pruneBatchSize)
+	pruneBatchSize = 4
+
+	slot, err := db.PrunedSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), slot)
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, 64, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	// blks[i] is at slot i+1: save the states of slot 5, 16 (an archived point) and 32 (finalized).
+	for _, i := range []int{4, 15, 31} {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(primitives.Slot(i+1)))
+		require.NoError(t, db.SaveState(ctx, st, roots[i]))
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: primitives.Slot(i + 1), Root: roots[i][:]}))
+	}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[31][:]}))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, roots[4]))
+
+	_, err = db.PruneHistory(ctx, 20, 0)
+	require.ErrorContains(t, "slots per archived point", err)
+
+	pruned, err := db.PruneHistory(ctx, 20, 8)
+	require.NoError(t, err)
+	assert.Equal(t, 19, pruned)
+	slot, err = db.PrunedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(20), slot)
+
+	for i := 0; i < 19; i++ {
+		assert.Equal(t, false, db.HasBlock(ctx, roots[i]), "block at slot %d was not pruned", i+1)
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, roots[i]), "block at slot %d is still in the finalized index", i+1)
+	}
+	for i := 19; i < len(roots); i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]), "block at slot %d was pruned", i+1)
+	}
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[19]))
+	found, _, err := db.BlockRootsBySlot(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+	children, err := db.BlockRoots(ctx, filters.NewFilter().SetParentRoot(roots[10][:]))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(children))
+
+	// Only the state lying on an archived point is kept.
+	assert.Equal(t, false, db.HasState(ctx, roots[4]))
+	assert.Equal(t, false, db.HasStateSummary(ctx, roots[4]))
+	assert.Equal(t, true, db.HasState(ctx, roots[15]))
+	assert.Equal(t, true, db.HasStateSummary(ctx, roots[15]))
+
+	// The backfill block root pointed at a pruned block, it moves up to the lowest retained block.
+	bfRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[19], bfRoot)
+
+	// The cutoff is capped to the finalized checkpoint.
+	pruned, err = db.PruneHistory(ctx, 1000, 8)
+	require.NoError(t, err)
+	assert.Equal(t, 12, pruned)
+	slot, err = db.PrunedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(32), slot)
+	assert.Equal(t, true, db.HasBlock(ctx, roots[31]))
+	assert.Equal(t, true, db.HasState(ctx, roots[31]))
+}
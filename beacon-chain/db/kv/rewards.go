@@ -0,0 +1,182 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var errInvalidEpochRange = errors.New("invalid end epoch and start epoch provided")
+
+// SaveValidatorEpochRewards replaces the rewards and penalties of validators during an epoch. Rewards are keyed
+// by epoch then validator index, so that the rewards of a range of epochs can be scanned in order. The rewards of
+// the epochs older than the rewards retention window, measured back from the saved epoch, are pruned.
+func (s *Store) SaveValidatorEpochRewards(ctx context.Context, epoch primitives.Epoch, rewards []*ethpb.ValidatorEpochRewards) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorEpochRewards")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorRewardsBucket)
+		// Rewards saved for the epoch on a previous head are removed.
+		prefix := bytesutil.Uint64ToBytesBigEndian(uint64(epoch))
+		var stale [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			stale = append(stale, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range stale {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		for _, r := range rewards {
+			if r == nil {
+				return errors.New("nil validator epoch rewards")
+			}
+			if r.Epoch != epoch {
+				return fmt.Errorf("validator epoch rewards of epoch %d saved for epoch %d", r.Epoch, epoch)
+			}
+			enc, err := encode(ctx, r)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(validatorRewardsKey(r.Epoch, r.ValidatorIndex), enc); err != nil {
+				return err
+			}
+		}
+		return pruneValidatorRewards(bkt, epoch)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// ValidatorEpochRewards retrieves at most limit validator rewards between the start and end epochs, inclusive,
+// ordered by epoch then validator index. Within the start epoch, the rewards of the validators with a lower
+// index than the start index are skipped, so that the rewards can be retrieved page by page. When indices are
+// given, only the rewards of these validators are retrieved.
+func (s *Store) ValidatorEpochRewards(
+	ctx context.Context,
+	startEpoch, endEpoch primitives.Epoch,
+	startIndex primitives.ValidatorIndex,
+	indices []primitives.ValidatorIndex,
+	limit int,
+) ([]*ethpb.ValidatorEpochRewards, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorEpochRewards")
+	defer span.End()
+
+	if startEpoch > endEpoch {
+		return nil, errInvalidEpochRange
+	}
+	rewards := make([]*ethpb.ValidatorEpochRewards, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorRewardsBucket)
+		c := bkt.Cursor()
+		end := bytesutil.Uint64ToBytesBigEndian(uint64(endEpoch))
+		if len(indices) == 0 {
+			for k, v := c.Seek(validatorRewardsKey(startEpoch, startIndex)); k != nil && len(rewards) < limit; k, v = c.Next() {
+				if bytes.Compare(k[:8], end) > 0 {
+					break
+				}
+				r := &ethpb.ValidatorEpochRewards{}
+				if err := decode(ctx, v, r); err != nil {
+					return err
+				}
+				rewards = append(rewards, r)
+			}
+			return nil
+		}
+		sorted := make([]primitives.ValidatorIndex, len(indices))
+		copy(sorted, indices)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		// The cursor only moves from one stored epoch to the next, the rewards of the requested validators are
+		// looked up within each epoch.
+		for k, _ := c.Seek(validatorRewardsKey(startEpoch, startIndex)); k != nil && len(rewards) < limit; {
+			if bytes.Compare(k[:8], end) > 0 {
+				break
+			}
+			epoch := primitives.Epoch(bytesutil.BytesToUint64BigEndian(k[:8]))
+			for i, idx := range sorted {
+				if len(rewards) == limit {
+					break
+				}
+				if epoch == startEpoch && idx < startIndex {
+					continue
+				}
+				// Duplicated indices are skipped.
+				if i > 0 && sorted[i-1] == idx {
+					continue
+				}
+				v := bkt.Get(validatorRewardsKey(epoch, idx))
+				if v == nil {
+					continue
+				}
+				r := &ethpb.ValidatorEpochRewards{}
+				if err := decode(ctx, v, r); err != nil {
+					return err
+				}
+				rewards = append(rewards, r)
+			}
+			k, _ = c.Seek(validatorRewardsKey(epoch+1, 0))
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return rewards, err
+}
+
+// LastValidatorRewardsEpoch returns the latest epoch for which validator rewards are stored.
+func (s *Store) LastValidatorRewardsEpoch(ctx context.Context) (primitives.Epoch, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LastValidatorRewardsEpoch")
+	defer span.End()
+
+	var epoch primitives.Epoch
+	err := s.db.View(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(validatorRewardsBucket).Cursor().Last()
+		if k == nil {
+			return ErrNotFoundValidatorRewards
+		}
+		epoch = primitives.Epoch(bytesutil.BytesToUint64BigEndian(k[:8]))
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return epoch, err
+}
+
+// pruneValidatorRewards deletes the rewards of the epochs older than the rewards retention window, measured back
+// from the given epoch. Keys are prefixed by their big endian epoch, so the cursor stops at the first key within
+// the window.
+func pruneValidatorRewards(bkt *bolt.Bucket, epoch primitives.Epoch) error {
+	retention := primitives.Epoch(features.Get().RewardsRetentionEpochs)
+	if retention == 0 || epoch < retention {
+		return nil
+	}
+	cutoff := bytesutil.Uint64ToBytesBigEndian(uint64(epoch - retention + 1))
+	var expired [][]byte
+	c := bkt.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if bytes.Compare(k[:8], cutoff) >= 0 {
+			break
+		}
+		expired = append(expired, bytesutil.SafeCopyBytes(k))
+	}
+	for _, k := range expired {
+		if err := bkt.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatorRewardsKey(epoch primitives.Epoch, id primitives.ValidatorIndex) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(epoch)), bytesutil.Uint64ToBytesBigEndian(uint64(id))...)
+}
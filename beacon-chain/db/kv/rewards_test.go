@@ -0,0 +1,99 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_ValidatorEpochRewards(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.ErrorContains(t, "nil validator epoch rewards", db.SaveValidatorEpochRewards(ctx, 1, []*ethpb.ValidatorEpochRewards{nil}))
+	require.ErrorContains(t, "saved for epoch 1", db.SaveValidatorEpochRewards(ctx, 1, []*ethpb.ValidatorEpochRewards{{Epoch: 2}}))
+	require.NoError(t, db.SaveValidatorEpochRewards(ctx, 1, []*ethpb.ValidatorEpochRewards{
+		{Epoch: 1, ValidatorIndex: 0, SourceReward: 10},
+		{Epoch: 1, ValidatorIndex: 300, SourcePenalty: 20},
+	}))
+	require.NoError(t, db.SaveValidatorEpochRewards(ctx, 2, []*ethpb.ValidatorEpochRewards{
+		{Epoch: 2, ValidatorIndex: 0, TargetReward: 30},
+		{Epoch: 2, ValidatorIndex: 1, InactivityPenalty: 40},
+	}))
+	// The rewards of an epoch are replaced when the epoch is indexed again.
+	require.NoError(t, db.SaveValidatorEpochRewards(ctx, 3, []*ethpb.ValidatorEpochRewards{
+		{Epoch: 3, ValidatorIndex: 0, HeadReward: 60},
+		{Epoch: 3, ValidatorIndex: 1, HeadReward: 60},
+		{Epoch: 3, ValidatorIndex: 3, HeadReward: 60},
+	}))
+	require.NoError(t, db.SaveValidatorEpochRewards(ctx, 3, []*ethpb.ValidatorEpochRewards{
+		{Epoch: 3, ValidatorIndex: 2, ProposerReward: 50},
+	}))
+
+	rewards, err := db.ValidatorEpochRewards(ctx, 0, 10, 0, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(rewards))
+	assert.Equal(t, primitives.ValidatorIndex(300), rewards[1].ValidatorIndex)
+	assert.Equal(t, uint64(20), rewards[1].SourcePenalty)
+	assert.Equal(t, primitives.Epoch(3), rewards[4].Epoch)
+	assert.Equal(t, uint64(50), rewards[4].ProposerReward)
+
+	rewards, err = db.ValidatorEpochRewards(ctx, 2, 2, 0, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rewards))
+	assert.Equal(t, uint64(30), rewards[0].TargetReward)
+	assert.Equal(t, uint64(40), rewards[1].InactivityPenalty)
+
+	// Pages continue from the epoch and index of the last returned rewards.
+	rewards, err = db.ValidatorEpochRewards(ctx, 1, 3, 1, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rewards))
+	assert.Equal(t, primitives.ValidatorIndex(300), rewards[0].ValidatorIndex)
+	assert.Equal(t, primitives.Epoch(2), rewards[1].Epoch)
+
+	// Only the rewards of the requested validators are retrieved.
+	rewards, err = db.ValidatorEpochRewards(ctx, 1, 10, 0, []primitives.ValidatorIndex{300, 0, 0}, 100)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(rewards))
+	assert.Equal(t, primitives.ValidatorIndex(0), rewards[0].ValidatorIndex)
+	assert.Equal(t, primitives.ValidatorIndex(300), rewards[1].ValidatorIndex)
+	assert.Equal(t, primitives.Epoch(2), rewards[2].Epoch)
+	rewards, err = db.ValidatorEpochRewards(ctx, 1, 10, 1, []primitives.ValidatorIndex{0, 300}, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rewards))
+	assert.Equal(t, primitives.ValidatorIndex(300), rewards[0].ValidatorIndex)
+
+	_, err = db.ValidatorEpochRewards(ctx, 2, 1, 0, nil, 100)
+	require.ErrorIs(t, err, errInvalidEpochRange)
+}
+
+func TestStore_ValidatorEpochRewards_Pruned(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{RewardsRetentionEpochs: 2})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.LastValidatorRewardsEpoch(ctx)
+	require.ErrorIs(t, err, ErrNotFoundValidatorRewards)
+	for epoch := primitives.Epoch(1); epoch <= 4; epoch++ {
+		require.NoError(t, db.SaveValidatorEpochRewards(ctx, epoch, []*ethpb.ValidatorEpochRewards{
+			{Epoch: epoch, ValidatorIndex: 0, HeadReward: 10},
+			{Epoch: epoch, ValidatorIndex: 1, HeadReward: 10},
+		}))
+	}
+	last, err := db.LastValidatorRewardsEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(4), last)
+
+	// Only the rewards of the last two saved epochs are kept.
+	rewards, err := db.ValidatorEpochRewards(ctx, 0, 10, 0, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(rewards))
+	assert.Equal(t, primitives.Epoch(3), rewards[0].Epoch)
+	assert.Equal(t, primitives.Epoch(4), rewards[3].Epoch)
+}
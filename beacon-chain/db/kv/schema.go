@@ -20,6 +20,15 @@ var (
 	stateValidatorsBucket   = []byte("state-validators")
 	feeRecipientBucket      = []byte("fee-recipient")
 	registrationBucket      = []byte("registration")
+	blobsBucket             = []byte("blobs")
+	builderDecisionsBucket  = []byte("builder-decisions")
+	forkChoiceBucket        = []byte("fork-choice")
+	validatorRewardsBucket  = []byte("validator-rewards")
+
+	// Light client buckets.
+	lightClientUpdatesBucket              = []byte("light-client-updates")
+	lightClientBootstrapsBucket           = []byte("light-client-bootstraps")
+	lightClientBootstrapSlotIndicesBucket = []byte("light-client-bootstrap-slot-indices")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -37,6 +46,7 @@ var (
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
 	blockRootValidatorHashesBucket      = []byte("block-root-validator-hashes")
+	blobRootIndicesBucket               = []byte("blob-root-indices")
 
 	// Specific item keys.
 	headBlockRootKey           = []byte("head-root")
@@ -46,6 +56,7 @@ var (
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	forkChoiceSnapshotKey      = []byte("fork-choice-snapshot")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -54,11 +65,15 @@ var (
 	bellatrixBlindKey          = []byte("blind-bellatrix")
 	capellaKey                 = []byte("capella")
 	capellaBlindKey            = []byte("blind-capella")
+	denebKey                   = []byte("deneb")
+	denebBlindKey              = []byte("blind-deneb")
 	saveBlindedBeaconBlocksKey = []byte("save-blinded-beacon-blocks")
 	// block root included in the beacon state used by weak subjectivity initial sync
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// block root tracking the progress of backfill, or pointing at genesis if backfill has not been initiated
 	backfillBlockRootKey = []byte("backfill-block-root")
+	// lowest slot of the block history retained after pruning
+	prunedSlotKey = []byte("pruned-slot")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
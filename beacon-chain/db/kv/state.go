@@ -315,6 +315,28 @@ func (s *Store) saveStatesEfficientInternal(ctx context.Context, tx *bolt.Tx, bl
 			if err := valIdxBkt.Put(rt[:], validatorKeys[i]); err != nil {
 				return err
 			}
+		case *ethpb.BeaconStateDeneb:
+			pbState, err := statenative.ProtobufBeaconStateDeneb(rawType)
+			if err != nil {
+				return err
+			}
+			if pbState == nil {
+				return errors.New("nil state")
+			}
+			valEntries := pbState.Validators
+			pbState.Validators = make([]*ethpb.Validator, 0)
+			rawObj, err := pbState.MarshalSSZ()
+			if err != nil {
+				return err
+			}
+			encodedState := snappy.Encode(nil, append(denebKey, rawObj...))
+			if err := bucket.Put(rt[:], encodedState); err != nil {
+				return err
+			}
+			pbState.Validators = valEntries
+			if err := valIdxBkt.Put(rt[:], validatorKeys[i]); err != nil {
+				return err
+			}
 		default:
 			return errors.New("invalid state type")
 		}
@@ -373,80 +395,85 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	defer span.End()
 
 	return s.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-		genesisBlockRoot := bkt.Get(genesisBlockRootKey)
+		return s.deleteState(ctx, tx, blockRoot)
+	})
+}
 
-		bkt = tx.Bucket(checkpointBucket)
-		enc := bkt.Get(finalizedCheckpointKey)
-		finalized := &ethpb.Checkpoint{}
-		if enc == nil {
-			finalized = &ethpb.Checkpoint{Root: genesisBlockRoot}
-		} else if err := decode(ctx, enc, finalized); err != nil {
-			return err
-		}
+// deleteState removes the state of the given block root, and its index entries, within the transaction.
+func (s *Store) deleteState(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) error {
+	bkt := tx.Bucket(blocksBucket)
+	genesisBlockRoot := bkt.Get(genesisBlockRootKey)
 
-		enc = bkt.Get(justifiedCheckpointKey)
-		justified := &ethpb.Checkpoint{}
-		if enc == nil {
-			justified = &ethpb.Checkpoint{Root: genesisBlockRoot}
-		} else if err := decode(ctx, enc, justified); err != nil {
-			return err
-		}
+	bkt = tx.Bucket(checkpointBucket)
+	enc := bkt.Get(finalizedCheckpointKey)
+	finalized := &ethpb.Checkpoint{}
+	if enc == nil {
+		finalized = &ethpb.Checkpoint{Root: genesisBlockRoot}
+	} else if err := decode(ctx, enc, finalized); err != nil {
+		return err
+	}
 
-		bkt = tx.Bucket(stateBucket)
-		// Safeguard against deleting genesis, finalized, head state.
-		if bytes.Equal(blockRoot[:], finalized.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], justified.Root) {
-			return ErrDeleteJustifiedAndFinalized
-		}
+	enc = bkt.Get(justifiedCheckpointKey)
+	justified := &ethpb.Checkpoint{}
+	if enc == nil {
+		justified = &ethpb.Checkpoint{Root: genesisBlockRoot}
+	} else if err := decode(ctx, enc, justified); err != nil {
+		return err
+	}
 
-		// Nothing to delete if state doesn't exist.
-		enc = bkt.Get(blockRoot[:])
-		if enc == nil {
-			return nil
-		}
+	bkt = tx.Bucket(stateBucket)
+	// Safeguard against deleting genesis, finalized, head state.
+	if bytes.Equal(blockRoot[:], finalized.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], justified.Root) {
+		return ErrDeleteJustifiedAndFinalized
+	}
 
-		slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
-		if err != nil {
-			return err
-		}
-		indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
-		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-			return errors.Wrap(err, "could not delete root for DB indices")
-		}
+	// Nothing to delete if state doesn't exist.
+	enc = bkt.Get(blockRoot[:])
+	if enc == nil {
+		return nil
+	}
 
-		ok, err := s.isStateValidatorMigrationOver()
+	slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
+	if err != nil {
+		return err
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+
+	ok, err := s.isStateValidatorMigrationOver()
+	if err != nil {
+		return err
+	}
+	if ok {
+		// remove the validator entry keys for the corresponding state.
+		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+		compressedValidatorHashes := idxBkt.Get(blockRoot[:])
+		err = idxBkt.Delete(blockRoot[:])
 		if err != nil {
 			return err
 		}
-		if ok {
-			// remove the validator entry keys for the corresponding state.
-			idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
-			compressedValidatorHashes := idxBkt.Get(blockRoot[:])
-			err = idxBkt.Delete(blockRoot[:])
-			if err != nil {
-				return err
-			}
 
-			// remove the respective validator entries from the cache.
-			if len(compressedValidatorHashes) == 0 {
-				return errors.Errorf("invalid compressed validator keys length")
-			}
-			validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
-			if sErr != nil {
-				return errors.Wrap(sErr, "failed to uncompress validator keys")
-			}
-			if len(validatorHashes)%hashLength != 0 {
-				return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
-			}
-			for i := 0; i < len(validatorHashes); i += hashLength {
-				key := validatorHashes[i : i+hashLength]
-				s.validatorEntryCache.Del(key)
-				validatorEntryCacheDelete.Inc()
-			}
+		// remove the respective validator entries from the cache.
+		if len(compressedValidatorHashes) == 0 {
+			return errors.Errorf("invalid compressed validator keys length")
+		}
+		validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
+		if sErr != nil {
+			return errors.Wrap(sErr, "failed to uncompress validator keys")
+		}
+		if len(validatorHashes)%hashLength != 0 {
+			return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
+		}
+		for i := 0; i < len(validatorHashes); i += hashLength {
+			key := validatorHashes[i : i+hashLength]
+			s.validatorEntryCache.Del(key)
+			validatorEntryCacheDelete.Inc()
 		}
+	}
 
-		return bkt.Delete(blockRoot[:])
-	})
+	return bkt.Delete(blockRoot[:])
 }
 
 // DeleteStates by block roots.
@@ -472,6 +499,20 @@ func (s *Store) unmarshalState(_ context.Context, enc []byte, validatorEntries [
 	}
 
 	switch {
+	case hasDenebKey(enc):
+		// Marshal state bytes to deneb beacon state.
+		protoState := &ethpb.BeaconStateDeneb{}
+		if err := protoState.UnmarshalSSZ(enc[len(denebKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for deneb")
+		}
+		ok, err := s.isStateValidatorMigrationOver()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			protoState.Validators = validatorEntries
+		}
+		return statenative.InitializeFromProtoUnsafeDeneb(protoState)
 	case hasCapellaKey(enc):
 		// Marshal state bytes to capella beacon state.
 		protoState := &ethpb.BeaconStateCapella{}
@@ -579,6 +620,19 @@ func marshalState(ctx context.Context, st state.ReadOnlyBeaconState) ([]byte, er
 			return nil, err
 		}
 		return snappy.Encode(nil, append(capellaKey, rawObj...)), nil
+	case *ethpb.BeaconStateDeneb:
+		rState, ok := st.ToProtoUnsafe().(*ethpb.BeaconStateDeneb)
+		if !ok {
+			return nil, errors.New("non valid inner state")
+		}
+		if rState == nil {
+			return nil, errors.New("nil state")
+		}
+		rawObj, err := rState.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		return snappy.Encode(nil, append(denebKey, rawObj...)), nil
 	default:
 		return nil, errors.New("invalid inner state")
 	}
@@ -0,0 +1,62 @@
+package pruner
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// ServiceOption is a functional option for the pruner service.
+type ServiceOption func(*Service) error
+
+// WithEnablePruning enables the pruner service, which otherwise exits as soon as it is started.
+func WithEnablePruning(enabled bool) ServiceOption {
+	return func(s *Service) error {
+		s.enabled = enabled
+		return nil
+	}
+}
+
+// WithRetentionEpochs sets the number of epochs of block history kept beyond MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+func WithRetentionEpochs(e primitives.Epoch) ServiceOption {
+	return func(s *Service) error {
+		s.retention = e
+		return nil
+	}
+}
+
+// WithSlotsPerArchivedPoint sets the interval of the archived points, whose states are not pruned.
+func WithSlotsPerArchivedPoint(n primitives.Slot) ServiceOption {
+	return func(s *Service) error {
+		if n == 0 {
+			return errors.New("slots per archived point must be greater than zero")
+		}
+		s.slotsPerArchivedPoint = n
+		return nil
+	}
+}
+
+// WithDatabase sets the database to prune.
+func WithDatabase(db Database) ServiceOption {
+	return func(s *Service) error {
+		s.db = db
+		return nil
+	}
+}
+
+// WithBackfillStatus sets the backfill status, which is reloaded after each pruning so that it reflects the
+// pruned history.
+func WithBackfillStatus(bs BackfillStatus) ServiceOption {
+	return func(s *Service) error {
+		s.backfillStatus = bs
+		return nil
+	}
+}
+
+// WithClockWaiter sets the waiter of the clock, which is available once the chain has started.
+func WithClockWaiter(cw startup.ClockWaiter) ServiceOption {
+	return func(s *Service) error {
+		s.clockWaiter = cw
+		return nil
+	}
+}
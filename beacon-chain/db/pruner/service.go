@@ -0,0 +1,142 @@
+package pruner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+// Database describes the set of DB methods that the pruner service needs to function.
+type Database interface {
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+	PrunedSlot(ctx context.Context) (primitives.Slot, error)
+	PruneHistory(ctx context.Context, cutoff, slotsPerArchivedPoint primitives.Slot) (int, error)
+}
+
+// BackfillStatus is reloaded from the database once the history was pruned.
+type BackfillStatus interface {
+	Reload(ctx context.Context) error
+}
+
+// Service prunes the block and state history of the database older than the retention period, once per epoch.
+type Service struct {
+	ctx                   context.Context
+	cancel                context.CancelFunc
+	enabled               bool
+	retention             primitives.Epoch
+	slotsPerArchivedPoint primitives.Slot
+	db                    Database
+	backfillStatus        BackfillStatus
+	clockWaiter           startup.ClockWaiter
+}
+
+// NewService initializes the pruner service.
+func NewService(ctx context.Context, opts ...ServiceOption) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:                   ctx,
+		cancel:                cancel,
+		slotsPerArchivedPoint: params.BeaconConfig().SlotsPerArchivedPoint,
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start prunes the history at the start of every epoch.
+func (s *Service) Start() {
+	if !s.enabled {
+		log.Debug("History pruning is disabled")
+		return
+	}
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Pruner failed to receive startup event")
+		return
+	}
+	log.WithField("retentionEpochs", s.retention).Info("Pruning the block and state history of the database")
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), secondsPerEpoch)
+	defer ticker.Done()
+	for {
+		if err := s.prune(s.ctx); err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			log.WithError(err).Error("Could not prune history")
+		}
+		select {
+		case <-ticker.C():
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// prune deletes the history below the cutoff computed from the finalized checkpoint, if it moved since the last run.
+func (s *Service) prune(ctx context.Context) error {
+	cp, err := s.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	cutoff := CutoffSlot(cp.Epoch, s.retention)
+	prunedSlot, err := s.db.PrunedSlot(ctx)
+	if err != nil {
+		return err
+	}
+	if cutoff <= prunedSlot {
+		return nil
+	}
+	n, err := s.db.PruneHistory(ctx, cutoff, s.slotsPerArchivedPoint)
+	if err != nil {
+		return err
+	}
+	if s.backfillStatus != nil {
+		if err := s.backfillStatus.Reload(ctx); err != nil {
+			return errors.Wrap(err, "could not reload backfill status")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"cutoffSlot": cutoff,
+		"blocks":     n,
+	}).Debug("Pruned history")
+	return nil
+}
+
+// CutoffSlot returns the lowest slot of the block history to retain, keeping the given number of epochs
+// beyond MIN_EPOCHS_FOR_BLOCK_REQUESTS below the finalized epoch.
+func CutoffSlot(finalized, retention primitives.Epoch) primitives.Slot {
+	keep := params.BeaconNetworkConfig().MinEpochsForBlockRequests + retention
+	if finalized <= keep {
+		return params.BeaconConfig().GenesisSlot
+	}
+	cutoff, err := slots.EpochStart(finalized - keep)
+	if err != nil {
+		return params.BeaconConfig().GenesisSlot
+	}
+	return cutoff
+}
@@ -0,0 +1,87 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type mockDB struct {
+	finalized  primitives.Epoch
+	prunedSlot primitives.Slot
+	cutoffs    []primitives.Slot
+}
+
+func (db *mockDB) FinalizedCheckpoint(_ context.Context) (*ethpb.Checkpoint, error) {
+	return &ethpb.Checkpoint{Epoch: db.finalized, Root: make([]byte, 32)}, nil
+}
+
+func (db *mockDB) PrunedSlot(_ context.Context) (primitives.Slot, error) {
+	return db.prunedSlot, nil
+}
+
+func (db *mockDB) PruneHistory(_ context.Context, cutoff, _ primitives.Slot) (int, error) {
+	db.cutoffs = append(db.cutoffs, cutoff)
+	db.prunedSlot = cutoff
+	return 1, nil
+}
+
+type mockStatus struct {
+	reloads int
+}
+
+func (s *mockStatus) Reload(_ context.Context) error {
+	s.reloads++
+	return nil
+}
+
+func TestCutoffSlot(t *testing.T) {
+	minEpochs := params.BeaconNetworkConfig().MinEpochsForBlockRequests
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	cases := []struct {
+		name      string
+		finalized primitives.Epoch
+		retention primitives.Epoch
+		cutoff    primitives.Slot
+	}{
+		{name: "within min epochs", finalized: minEpochs, cutoff: 0},
+		{name: "within retention", finalized: minEpochs + 10, retention: 10, cutoff: 0},
+		{name: "beyond min epochs", finalized: minEpochs + 5, cutoff: 5 * slotsPerEpoch},
+		{name: "beyond retention", finalized: minEpochs + 15, retention: 10, cutoff: 5 * slotsPerEpoch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.cutoff, CutoffSlot(c.finalized, c.retention))
+		})
+	}
+}
+
+func TestService_prune(t *testing.T) {
+	ctx := context.Background()
+	minEpochs := params.BeaconNetworkConfig().MinEpochsForBlockRequests
+	db := &mockDB{finalized: minEpochs}
+	bs := &mockStatus{}
+	s, err := NewService(ctx, WithDatabase(db), WithBackfillStatus(bs), WithSlotsPerArchivedPoint(64))
+	require.NoError(t, err)
+
+	// Nothing to prune within the retention period.
+	require.NoError(t, s.prune(ctx))
+	require.Equal(t, 0, len(db.cutoffs))
+	require.Equal(t, 0, bs.reloads)
+
+	db.finalized = minEpochs + 2
+	require.NoError(t, s.prune(ctx))
+	require.DeepEqual(t, []primitives.Slot{2 * params.BeaconConfig().SlotsPerEpoch}, db.cutoffs)
+	require.Equal(t, 1, bs.reloads)
+
+	// The history was already pruned up to the cutoff.
+	require.NoError(t, s.prune(ctx))
+	require.Equal(t, 1, len(db.cutoffs))
+
+	_, err = NewService(ctx, WithSlotsPerArchivedPoint(0))
+	require.ErrorContains(t, "slots per archived point", err)
+}
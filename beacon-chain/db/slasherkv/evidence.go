@@ -0,0 +1,162 @@
+package slasherkv
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const (
+	attesterSlashingEvidence byte = iota
+	proposerSlashingEvidence
+)
+
+// Detection time (8 bytes) and kind of the slashing (1 byte).
+const slashingEvidenceHeaderSize = 9
+
+// SaveSlashingEvidence saves the evidence of detected slashings. Each new evidence is assigned
+// the next sequential identifier. Slashings which were already saved are skipped.
+func (s *Store) SaveSlashingEvidence(ctx context.Context, evidence []*slashertypes.SlashingEvidence) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSlashingEvidence")
+	defer span.End()
+	if len(evidence) == 0 {
+		return nil
+	}
+	roots := make([][32]byte, len(evidence))
+	for i, ev := range evidence {
+		root, err := slashingEvidenceRoot(ev)
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingEvidenceBucket)
+		rootsBkt := tx.Bucket(slashingEvidenceRootsBucket)
+		for i, ev := range evidence {
+			if rootsBkt.Get(roots[i][:]) != nil {
+				continue
+			}
+			id, err := bkt.NextSequence()
+			if err != nil {
+				return err
+			}
+			ev.Id = id
+			enc, err := encodeSlashingEvidence(ev)
+			if err != nil {
+				return err
+			}
+			key := encodeSlashingEvidenceId(id)
+			if err := bkt.Put(key, enc); err != nil {
+				return err
+			}
+			if err := rootsBkt.Put(roots[i][:], key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SlashingEvidence retrieves at most limit evidences of detected slashings, in detection order,
+// starting from the evidence with identifier startId.
+func (s *Store) SlashingEvidence(
+	ctx context.Context, startId uint64, limit int,
+) ([]*slashertypes.SlashingEvidence, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlashingEvidence")
+	defer span.End()
+	evidence := make([]*slashertypes.SlashingEvidence, 0)
+	if limit <= 0 {
+		return evidence, nil
+	}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(slashingEvidenceBucket).Cursor()
+		for k, v := c.Seek(encodeSlashingEvidenceId(startId)); k != nil && len(evidence) < limit; k, v = c.Next() {
+			ev, err := decodeSlashingEvidence(v)
+			if err != nil {
+				return err
+			}
+			ev.Id = binary.BigEndian.Uint64(k)
+			evidence = append(evidence, ev)
+		}
+		return nil
+	})
+	return evidence, err
+}
+
+func slashingEvidenceRoot(ev *slashertypes.SlashingEvidence) ([32]byte, error) {
+	switch {
+	case ev.AttesterSlashing != nil:
+		return ev.AttesterSlashing.HashTreeRoot()
+	case ev.ProposerSlashing != nil:
+		return ev.ProposerSlashing.HashTreeRoot()
+	default:
+		return [32]byte{}, errors.New("slashing evidence has no slashing")
+	}
+}
+
+// Keys are big endian so that the evidence is iterated in the order of the identifiers.
+func encodeSlashingEvidenceId(id uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, id)
+	return enc
+}
+
+// Encode the evidence as the detection time in unix nanoseconds, the kind of the slashing
+// and the snappy compressed SSZ encoding of the slashing.
+func encodeSlashingEvidence(ev *slashertypes.SlashingEvidence) ([]byte, error) {
+	var kind byte
+	var encodedSlashing []byte
+	var err error
+	switch {
+	case ev.AttesterSlashing != nil:
+		kind = attesterSlashingEvidence
+		encodedSlashing, err = ev.AttesterSlashing.MarshalSSZ()
+	case ev.ProposerSlashing != nil:
+		kind = proposerSlashingEvidence
+		encodedSlashing, err = ev.ProposerSlashing.MarshalSSZ()
+	default:
+		return nil, errors.New("slashing evidence has no slashing")
+	}
+	if err != nil {
+		return nil, err
+	}
+	enc := make([]byte, slashingEvidenceHeaderSize)
+	binary.BigEndian.PutUint64(enc, uint64(ev.DetectedAt.UnixNano()))
+	enc[8] = kind
+	return append(enc, snappy.Encode(nil, encodedSlashing)...), nil
+}
+
+func decodeSlashingEvidence(enc []byte) (*slashertypes.SlashingEvidence, error) {
+	if len(enc) < slashingEvidenceHeaderSize {
+		return nil, errors.Errorf("wrong size: expected at least %d, got %d", slashingEvidenceHeaderSize, len(enc))
+	}
+	decodedSlashing, err := snappy.Decode(nil, enc[slashingEvidenceHeaderSize:])
+	if err != nil {
+		return nil, err
+	}
+	ev := &slashertypes.SlashingEvidence{
+		DetectedAt: time.Unix(0, int64(binary.BigEndian.Uint64(enc))),
+	}
+	switch enc[8] {
+	case attesterSlashingEvidence:
+		ev.AttesterSlashing = &ethpb.AttesterSlashing{}
+		err = ev.AttesterSlashing.UnmarshalSSZ(decodedSlashing)
+	case proposerSlashingEvidence:
+		ev.ProposerSlashing = &ethpb.ProposerSlashing{}
+		err = ev.ProposerSlashing.UnmarshalSSZ(decodedSlashing)
+	default:
+		return nil, errors.Errorf("unknown slashing evidence kind %d", enc[8])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,70 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_SlashingEvidence_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	evidence, err := beaconDB.SlashingEvidence(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(evidence))
+
+	detectedAt := time.Unix(1000, 5)
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: createAttestationWrapper(1, 2, []uint64{1}, []byte{1}).IndexedAttestation,
+		Attestation_2: createAttestationWrapper(0, 2, []uint64{1}, []byte{2}).IndexedAttestation,
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, 4, 1, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, 4, 1, []byte{2}).SignedBeaconBlockHeader,
+	}
+	require.NoError(t, beaconDB.SaveSlashingEvidence(ctx, []*slashertypes.SlashingEvidence{
+		{DetectedAt: detectedAt, AttesterSlashing: attesterSlashing},
+		{DetectedAt: detectedAt, ProposerSlashing: proposerSlashing},
+	}))
+	// Slashings which were already saved are skipped.
+	require.NoError(t, beaconDB.SaveSlashingEvidence(ctx, []*slashertypes.SlashingEvidence{
+		{DetectedAt: time.Now(), ProposerSlashing: proposerSlashing},
+	}))
+
+	evidence, err = beaconDB.SlashingEvidence(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(evidence))
+	assert.Equal(t, uint64(1), evidence[0].Id)
+	assert.Equal(t, true, detectedAt.Equal(evidence[0].DetectedAt))
+	assert.DeepEqual(t, attesterSlashing, evidence[0].AttesterSlashing)
+	assert.Equal(t, true, evidence[0].ProposerSlashing == nil)
+	assert.Equal(t, uint64(2), evidence[1].Id)
+	assert.DeepEqual(t, proposerSlashing, evidence[1].ProposerSlashing)
+	assert.Equal(t, true, evidence[1].AttesterSlashing == nil)
+
+	evidence, err = beaconDB.SlashingEvidence(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, uint64(2), evidence[0].Id)
+
+	evidence, err = beaconDB.SlashingEvidence(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, uint64(1), evidence[0].Id)
+
+	evidence, err = beaconDB.SlashingEvidence(ctx, 3, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(evidence))
+}
+
+func TestStore_SaveSlashingEvidence_NoSlashing(t *testing.T) {
+	beaconDB := setupDB(t)
+	err := beaconDB.SaveSlashingEvidence(context.Background(), []*slashertypes.SlashingEvidence{{DetectedAt: time.Now()}})
+	require.ErrorContains(t, "slashing evidence has no slashing", err)
+}
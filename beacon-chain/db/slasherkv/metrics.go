@@ -14,4 +14,8 @@ var (
 		Name: "slasher_proposals_pruned_total",
 		Help: "Total number of old proposals pruned by slasher",
 	})
+	slasherSlashingEvidencePrunedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_slashing_evidence_pruned_total",
+		Help: "Total number of old slashing evidences pruned by slasher",
+	})
 )
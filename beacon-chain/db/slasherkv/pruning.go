@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 
 	fssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	bolt "go.etcd.io/bbolt"
@@ -164,3 +165,61 @@ func uint64PrefixGreaterThan(key, lessThan []byte) bool {
 	enc := key[:8]
 	return bytes.Compare(enc, lessThan) > 0
 }
+
+// PruneSlashingEvidenceAtEpoch deletes the evidence of all detected slashings from the slasher DB
+// whose offense epoch is less than or equal to the specified epoch. The offense epoch is the
+// highest target epoch of the attestations of an attester slashing, and the epoch of the slot
+// of the headers of a proposer slashing.
+func (s *Store) PruneSlashingEvidenceAtEpoch(
+	ctx context.Context, maxEpoch primitives.Epoch,
+) (numPruned uint, err error) {
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingEvidenceBucket)
+		rootsBkt := tx.Bucket(slashingEvidenceRootsBucket)
+		// Evidence is keyed by detection order rather than by epoch, so the whole bucket is
+		// iterated. Slashings are rare, so the bucket stays small. The keys are deleted after the
+		// iteration, as deleting under a bolt cursor can make it skip the next key.
+		var prunedKeys [][]byte
+		c := bkt.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			ev, err := decodeSlashingEvidence(v)
+			if err != nil {
+				return err
+			}
+			if slashingEvidenceEpoch(ev) > maxEpoch {
+				continue
+			}
+			root, err := slashingEvidenceRoot(ev)
+			if err != nil {
+				return err
+			}
+			if err := rootsBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			prunedKeys = append(prunedKeys, k)
+		}
+		for _, k := range prunedKeys {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+			slasherSlashingEvidencePrunedTotal.Inc()
+			numPruned++
+		}
+		return nil
+	})
+	return
+}
+
+func slashingEvidenceEpoch(ev *slashertypes.SlashingEvidence) primitives.Epoch {
+	if ev.ProposerSlashing != nil {
+		return slots.ToEpoch(ev.ProposerSlashing.Header_1.Header.Slot)
+	}
+	epoch := ev.AttesterSlashing.Attestation_1.Data.Target.Epoch
+	if target := ev.AttesterSlashing.Attestation_2.Data.Target.Epoch; target > epoch {
+		epoch = target
+	}
+	return epoch
+}
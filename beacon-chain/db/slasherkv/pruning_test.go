@@ -8,6 +8,8 @@ import (
 	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -216,3 +218,40 @@ func TestStore_PruneAttestations_OK(t *testing.T) {
 		}
 	})
 }
+
+func TestStore_PruneSlashingEvidenceAtEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	evidence := []*slashertypes.SlashingEvidence{
+		{AttesterSlashing: &ethpb.AttesterSlashing{
+			Attestation_1: createAttestationWrapper(1, 2, []uint64{1}, []byte{1}).IndexedAttestation,
+			Attestation_2: createAttestationWrapper(0, 3, []uint64{1}, []byte{2}).IndexedAttestation,
+		}},
+		{ProposerSlashing: &ethpb.ProposerSlashing{
+			Header_1: createProposalWrapper(t, 2*slotsPerEpoch, 1, []byte{1}).SignedBeaconBlockHeader,
+			Header_2: createProposalWrapper(t, 2*slotsPerEpoch, 1, []byte{2}).SignedBeaconBlockHeader,
+		}},
+		{AttesterSlashing: &ethpb.AttesterSlashing{
+			Attestation_1: createAttestationWrapper(0, 1, []uint64{2}, []byte{1}).IndexedAttestation,
+			Attestation_2: createAttestationWrapper(0, 1, []uint64{2}, []byte{2}).IndexedAttestation,
+		}},
+	}
+	require.NoError(t, beaconDB.SaveSlashingEvidence(ctx, evidence))
+
+	// The first attester slashing is at the highest target epoch of its attestations, 3.
+	numPruned, err := beaconDB.PruneSlashingEvidenceAtEpoch(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), numPruned)
+	remaining, err := beaconDB.SlashingEvidence(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(remaining))
+	assert.Equal(t, uint64(1), remaining[0].Id)
+
+	// The roots of the pruned slashings are deleted, so they can be saved again.
+	require.NoError(t, beaconDB.SaveSlashingEvidence(ctx, evidence[1:2]))
+	remaining, err = beaconDB.SlashingEvidence(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(remaining))
+	assert.Equal(t, uint64(4), remaining[1].Id)
+}
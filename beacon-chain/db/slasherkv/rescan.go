@@ -0,0 +1,49 @@
+package slasherkv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Start epoch (8 bytes) and last epoch (8 bytes).
+const rescanCheckpointSize = 16
+
+// RescanCheckpoint retrieves the progress of the last historical re-scan of the beacon DB.
+// A nil checkpoint is returned if no re-scan was performed.
+func (s *Store) RescanCheckpoint(ctx context.Context) (*slashertypes.RescanCheckpoint, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.RescanCheckpoint")
+	defer span.End()
+	var checkpoint *slashertypes.RescanCheckpoint
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(rescanCheckpointBucket).Get(rescanCheckpointKey)
+		if enc == nil {
+			return nil
+		}
+		if len(enc) != rescanCheckpointSize {
+			return errors.Errorf("wrong size: expected %d, got %d", rescanCheckpointSize, len(enc))
+		}
+		checkpoint = &slashertypes.RescanCheckpoint{
+			StartEpoch: primitives.Epoch(ssz.UnmarshallUint64(enc[:8])),
+			LastEpoch:  primitives.Epoch(ssz.UnmarshallUint64(enc[8:])),
+		}
+		return nil
+	})
+	return checkpoint, err
+}
+
+// SaveRescanCheckpoint saves the progress of a historical re-scan of the beacon DB.
+func (s *Store) SaveRescanCheckpoint(ctx context.Context, checkpoint *slashertypes.RescanCheckpoint) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveRescanCheckpoint")
+	defer span.End()
+	enc := ssz.MarshalUint64(make([]byte, 0, rescanCheckpointSize), uint64(checkpoint.StartEpoch))
+	enc = ssz.MarshalUint64(enc, uint64(checkpoint.LastEpoch))
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(rescanCheckpointBucket).Put(rescanCheckpointKey, enc)
+	})
+}
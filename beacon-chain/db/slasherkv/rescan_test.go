@@ -0,0 +1,31 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_RescanCheckpoint_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	checkpoint, err := beaconDB.RescanCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, checkpoint == nil)
+
+	want := &slashertypes.RescanCheckpoint{StartEpoch: 10, LastEpoch: 20}
+	require.NoError(t, beaconDB.SaveRescanCheckpoint(ctx, want))
+	checkpoint, err = beaconDB.RescanCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, checkpoint)
+
+	want = &slashertypes.RescanCheckpoint{StartEpoch: 10, LastEpoch: 21}
+	require.NoError(t, beaconDB.SaveRescanCheckpoint(ctx, want))
+	checkpoint, err = beaconDB.RescanCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, checkpoint)
+}
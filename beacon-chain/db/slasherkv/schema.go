@@ -8,9 +8,14 @@ package slasherkv
 // corresponding attestations.
 var (
 	// Slasher buckets.
-	attestedEpochsByValidator  = []byte("attested-epochs-by-validator")
-	attestationRecordsBucket   = []byte("attestation-records")
-	attestationDataRootsBucket = []byte("attestation-data-roots")
-	proposalRecordsBucket      = []byte("proposal-records")
-	slasherChunksBucket        = []byte("slasher-chunks")
+	attestedEpochsByValidator   = []byte("attested-epochs-by-validator")
+	attestationRecordsBucket    = []byte("attestation-records")
+	attestationDataRootsBucket  = []byte("attestation-data-roots")
+	proposalRecordsBucket       = []byte("proposal-records")
+	slasherChunksBucket         = []byte("slasher-chunks")
+	slashingEvidenceBucket      = []byte("slashing-evidence")
+	slashingEvidenceRootsBucket = []byte("slashing-evidence-roots")
+	rescanCheckpointBucket      = []byte("rescan-checkpoint")
+
+	rescanCheckpointKey = []byte("rescan-checkpoint")
 )
@@ -9,6 +9,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
@@ -152,6 +153,11 @@ func (_ *Service) FinalizedDeposits(_ context.Context) *depositcache.FinalizedDe
 	return nil
 }
 
+// DepositSnapshot mocks out the deposit cache functionality for interop.
+func (_ *Service) DepositSnapshot(_ context.Context) (*ethpb.DepositSnapshot, error) {
+	return nil, errors.New("deposit snapshots are not supported in interop mode")
+}
+
 // NonFinalizedDeposits mocks out the deposit cache functionality for interop.
 func (_ *Service) NonFinalizedDeposits(_ context.Context, _ int64, _ *big.Int) []*ethpb.Deposit {
 	return []*ethpb.Deposit{}
@@ -35,13 +35,17 @@ var (
 	supportedEngineEndpoints = []string{
 		NewPayloadMethod,
 		NewPayloadMethodV2,
+		NewPayloadMethodV3,
 		ForkchoiceUpdatedMethod,
 		ForkchoiceUpdatedMethodV2,
+		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethod,
 		GetPayloadMethodV2,
+		GetPayloadMethodV3,
 		ExchangeTransitionConfigurationMethod,
 		GetPayloadBodiesByHashV1,
 		GetPayloadBodiesByRangeV1,
+		GetClientVersionV1,
 	}
 )
 
@@ -50,14 +54,20 @@ const (
 	NewPayloadMethod = "engine_newPayloadV1"
 	// NewPayloadMethodV2 v2 request string for JSON-RPC.
 	NewPayloadMethodV2 = "engine_newPayloadV2"
+	// NewPayloadMethodV3 v3 request string for JSON-RPC.
+	NewPayloadMethodV3 = "engine_newPayloadV3"
 	// ForkchoiceUpdatedMethod v1 request string for JSON-RPC.
 	ForkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV1"
 	// ForkchoiceUpdatedMethodV2 v2 request string for JSON-RPC.
 	ForkchoiceUpdatedMethodV2 = "engine_forkchoiceUpdatedV2"
+	// ForkchoiceUpdatedMethodV3 v3 request string for JSON-RPC.
+	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethod v1 request string for JSON-RPC.
 	GetPayloadMethod = "engine_getPayloadV1"
 	// GetPayloadMethodV2 v2 request string for JSON-RPC.
 	GetPayloadMethodV2 = "engine_getPayloadV2"
+	// GetPayloadMethodV3 v3 request string for JSON-RPC.
+	GetPayloadMethodV3 = "engine_getPayloadV3"
 	// ExchangeTransitionConfigurationMethod v1 request string for JSON-RPC.
 	ExchangeTransitionConfigurationMethod = "engine_exchangeTransitionConfigurationV1"
 	// ExecutionBlockByHashMethod request string for JSON-RPC.
@@ -70,10 +80,35 @@ const (
 	GetPayloadBodiesByRangeV1 = "engine_getPayloadBodiesByRangeV1"
 	// ExchangeCapabilities request string for JSON-RPC.
 	ExchangeCapabilities = "engine_exchangeCapabilities"
+	// GetClientVersionV1 v1 request string for JSON-RPC.
+	GetClientVersionV1 = "engine_getClientVersionV1"
+	// Client code of Prysm, as exchanged with engine_getClientVersionV1.
+	prysmClientCode = "PM"
+	// Duration during which the version of the execution client is cached, as it only changes on restarts.
+	clientVersionCacheDuration = 10 * time.Minute
 	// Defines the seconds before timing out engine endpoints with non-block execution semantics.
 	defaultEngineTimeout = time.Second
 )
 
+// ClientVersionV1 identifies an execution or consensus client, as exchanged with the
+// engine_getClientVersionV1 endpoint.
+type ClientVersionV1 struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
+// String returns the name and version of the client, e.g. Geth/v1.13.5.
+func (c *ClientVersionV1) String() string {
+	return fmt.Sprintf("%s/%s", c.Name, c.Version)
+}
+
+// ClientVersionFetcher retrieves the version of the execution client.
+type ClientVersionFetcher interface {
+	ExecutionClientVersion(ctx context.Context) (*ClientVersionV1, error)
+}
+
 // ForkchoiceUpdatedResponse is the response kind received by the
 // engine_forkchoiceUpdatedV1 endpoint.
 type ForkchoiceUpdatedResponse struct {
@@ -96,11 +131,11 @@ type ExecutionPayloadReconstructor interface {
 // EngineCaller defines a client that can interact with an Ethereum
 // execution node's engine service via JSON-RPC.
 type EngineCaller interface {
-	NewPayload(ctx context.Context, payload interfaces.ExecutionData) ([]byte, error)
+	NewPayload(ctx context.Context, payload interfaces.ExecutionData, versionedHashes []common.Hash, parentBlockRoot *common.Hash) ([]byte, error)
 	ForkchoiceUpdated(
 		ctx context.Context, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
 	) (*pb.PayloadIDBytes, []byte, error)
-	GetPayload(ctx context.Context, payloadId [8]byte, slot primitives.Slot) (interfaces.ExecutionData, error)
+	GetPayload(ctx context.Context, payloadId [8]byte, slot primitives.Slot) (interfaces.ExecutionData, *pb.BlobsBundle, error)
 	ExchangeTransitionConfiguration(
 		ctx context.Context, cfg *pb.TransitionConfiguration,
 	) error
@@ -111,7 +146,7 @@ type EngineCaller interface {
 var EmptyBlockHash = errors.New("Block hash is empty 0x0000...")
 
 // NewPayload calls the engine_newPayloadVX method via JSON-RPC.
-func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionData) ([]byte, error) {
+func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionData, versionedHashes []common.Hash, parentBlockRoot *common.Hash) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.NewPayload")
 	defer span.End()
 	start := time.Now()
@@ -143,6 +178,18 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		if err != nil {
 			return nil, handleRPCError(err)
 		}
+	case *pb.ExecutionPayloadDeneb:
+		payloadPb, ok := payload.Proto().(*pb.ExecutionPayloadDeneb)
+		if !ok {
+			return nil, errors.New("execution data must be a Deneb execution payload")
+		}
+		if parentBlockRoot == nil {
+			return nil, errors.New("parent beacon block root must be provided for a Deneb execution payload")
+		}
+		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV3, payloadPb, versionedHashes, *parentBlockRoot)
+		if err != nil {
+			return nil, handleRPCError(err)
+		}
 	default:
 		return nil, errors.New("unknown execution data type")
 	}
@@ -161,7 +208,7 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	}
 }
 
-// ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
+// ForkchoiceUpdated calls the engine_forkchoiceUpdatedVX method via JSON-RPC.
 func (s *Service) ForkchoiceUpdated(
 	ctx context.Context, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
 ) (*pb.PayloadIDBytes, []byte, error) {
@@ -199,6 +246,15 @@ func (s *Service) ForkchoiceUpdated(
 		if err != nil {
 			return nil, nil, handleRPCError(err)
 		}
+	case version.Deneb:
+		a, err := attrs.PbV3()
+		if err != nil {
+			return nil, nil, err
+		}
+		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
+		if err != nil {
+			return nil, nil, handleRPCError(err)
+		}
 	default:
 		return nil, nil, fmt.Errorf("unknown payload attribute version: %v", attrs.Version())
 	}
@@ -220,7 +276,7 @@ func (s *Service) ForkchoiceUpdated(
 }
 
 // GetPayload calls the engine_getPayloadVX method via JSON-RPC.
-func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primitives.Slot) (interfaces.ExecutionData, error) {
+func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primitives.Slot) (interfaces.ExecutionData, *pb.BlobsBundle, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetPayload")
 	defer span.End()
 	start := time.Now()
@@ -232,23 +288,46 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
 
+	if slots.ToEpoch(slot) >= params.BeaconConfig().DenebForkEpoch {
+		result := &pb.ExecutionPayloadDenebWithValueAndBlobsBundle{}
+		err := s.rpcClient.CallContext(ctx, result, GetPayloadMethodV3, pb.PayloadIDBytes(payloadId))
+		if err != nil {
+			return nil, nil, handleRPCError(err)
+		}
+
+		v := big.NewInt(0).SetBytes(bytesutil.ReverseByteOrder(result.Value))
+		ed, err := blocks.WrappedExecutionPayloadDeneb(result.Payload, math.WeiToGwei(v))
+		if err != nil {
+			return nil, nil, err
+		}
+		return ed, result.BlobsBundle, nil
+	}
+
 	if slots.ToEpoch(slot) >= params.BeaconConfig().CapellaForkEpoch {
 		result := &pb.ExecutionPayloadCapellaWithValue{}
 		err := s.rpcClient.CallContext(ctx, result, GetPayloadMethodV2, pb.PayloadIDBytes(payloadId))
 		if err != nil {
-			return nil, handleRPCError(err)
+			return nil, nil, handleRPCError(err)
 		}
 
 		v := big.NewInt(0).SetBytes(bytesutil.ReverseByteOrder(result.Value))
-		return blocks.WrappedExecutionPayloadCapella(result.Payload, math.WeiToGwei(v))
+		ed, err := blocks.WrappedExecutionPayloadCapella(result.Payload, math.WeiToGwei(v))
+		if err != nil {
+			return nil, nil, err
+		}
+		return ed, nil, nil
 	}
 
 	result := &pb.ExecutionPayload{}
 	err := s.rpcClient.CallContext(ctx, result, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
 	if err != nil {
-		return nil, handleRPCError(err)
+		return nil, nil, handleRPCError(err)
 	}
-	return blocks.WrappedExecutionPayload(result)
+	ed, err := blocks.WrappedExecutionPayload(result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ed, nil, nil
 }
 
 // ExchangeTransitionConfiguration calls the engine_exchangeTransitionConfigurationV1 method via JSON-RPC.
@@ -322,6 +401,58 @@ func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
 	return result.SupportedMethods, handleRPCError(err)
 }
 
+// ExecutionClientVersion calls the engine_getClientVersionV1 method via JSON-RPC, and returns the
+// version of the execution client. The result is cached for a few minutes, as the version only
+// changes when the execution client is restarted. Failures are cached as well, so that execution
+// clients which don't support the method are not queried for every proposal.
+func (s *Service) ExecutionClientVersion(ctx context.Context) (*ClientVersionV1, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExecutionClientVersion")
+	defer span.End()
+
+	s.clientVersionLock.Lock()
+	defer s.clientVersionLock.Unlock()
+	if !s.clientVersionTime.IsZero() && time.Since(s.clientVersionTime) < clientVersionCacheDuration {
+		return s.clientVersion, s.clientVersionErr
+	}
+	s.clientVersion, s.clientVersionErr = s.fetchClientVersion(ctx)
+	s.clientVersionTime = time.Now()
+	return s.clientVersion, s.clientVersionErr
+}
+
+func (s *Service) fetchClientVersion(ctx context.Context) (*ClientVersionV1, error) {
+	d := time.Now().Add(defaultEngineTimeout)
+	ctx, cancel := context.WithDeadline(ctx, d)
+	defer cancel()
+	var result []*ClientVersionV1
+	if err := s.rpcClient.CallContext(ctx, &result, GetClientVersionV1, prysmClientVersion()); err != nil {
+		return nil, handleRPCError(err)
+	}
+	// Execution clients running as a multiplexer may return several versions, the first one
+	// identifies the execution client itself.
+	if len(result) == 0 || result[0] == nil {
+		return nil, errors.New("execution client returned no client version")
+	}
+	return result[0], nil
+}
+
+// Version of this beacon node, sent to the execution client with engine_getClientVersionV1.
+func prysmClientVersion() *ClientVersionV1 {
+	commit := ""
+	// Build data has the form Prysm/<tag>/<commit>.
+	if parts := strings.Split(version.BuildData(), "/"); len(parts) == 3 {
+		commit = parts[2]
+	}
+	if len(commit) > 8 {
+		commit = commit[:8]
+	}
+	return &ClientVersionV1{
+		Code:    prysmClientCode,
+		Name:    "Prysm",
+		Version: version.SemanticVersion(),
+		Commit:  "0x" + commit,
+	}
+}
+
 // GetTerminalBlockHash returns the valid terminal block hash based on total difficulty.
 //
 // Spec code:
@@ -747,7 +878,34 @@ func fullPayloadFromExecutionBlock(
 			Transactions:  txs,
 		})
 	}
-	return blocks.WrappedExecutionPayloadCapella(&pb.ExecutionPayloadCapella{
+	if block.Version == version.Capella {
+		return blocks.WrappedExecutionPayloadCapella(&pb.ExecutionPayloadCapella{
+			ParentHash:    header.ParentHash(),
+			FeeRecipient:  header.FeeRecipient(),
+			StateRoot:     header.StateRoot(),
+			ReceiptsRoot:  header.ReceiptsRoot(),
+			LogsBloom:     header.LogsBloom(),
+			PrevRandao:    header.PrevRandao(),
+			BlockNumber:   header.BlockNumber(),
+			GasLimit:      header.GasLimit(),
+			GasUsed:       header.GasUsed(),
+			Timestamp:     header.Timestamp(),
+			ExtraData:     header.ExtraData(),
+			BaseFeePerGas: header.BaseFeePerGas(),
+			BlockHash:     blockHash[:],
+			Transactions:  txs,
+			Withdrawals:   block.Withdrawals,
+		}, 0) // We can't get the block value and don't care about the block value for this instance
+	}
+	bgu, err := header.BlobGasUsed()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to extract BlobGasUsed attribute from execution payload header")
+	}
+	ebg, err := header.ExcessBlobGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to extract ExcessBlobGas attribute from execution payload header")
+	}
+	return blocks.WrappedExecutionPayloadDeneb(&pb.ExecutionPayloadDeneb{
 		ParentHash:    header.ParentHash(),
 		FeeRecipient:  header.FeeRecipient(),
 		StateRoot:     header.StateRoot(),
@@ -763,6 +921,8 @@ func fullPayloadFromExecutionBlock(
 		BlockHash:     blockHash[:],
 		Transactions:  txs,
 		Withdrawals:   block.Withdrawals,
+		BlobGasUsed:   bgu,
+		ExcessBlobGas: ebg,
 	}, 0) // We can't get the block value and don't care about the block value for this instance
 }
 
@@ -791,7 +951,34 @@ func fullPayloadFromPayloadBody(
 			Transactions:  body.Transactions,
 		})
 	}
-	return blocks.WrappedExecutionPayloadCapella(&pb.ExecutionPayloadCapella{
+	if bVersion == version.Capella {
+		return blocks.WrappedExecutionPayloadCapella(&pb.ExecutionPayloadCapella{
+			ParentHash:    header.ParentHash(),
+			FeeRecipient:  header.FeeRecipient(),
+			StateRoot:     header.StateRoot(),
+			ReceiptsRoot:  header.ReceiptsRoot(),
+			LogsBloom:     header.LogsBloom(),
+			PrevRandao:    header.PrevRandao(),
+			BlockNumber:   header.BlockNumber(),
+			GasLimit:      header.GasLimit(),
+			GasUsed:       header.GasUsed(),
+			Timestamp:     header.Timestamp(),
+			ExtraData:     header.ExtraData(),
+			BaseFeePerGas: header.BaseFeePerGas(),
+			BlockHash:     header.BlockHash(),
+			Transactions:  body.Transactions,
+			Withdrawals:   body.Withdrawals,
+		}, 0) // We can't get the block value and don't care about the block value for this instance
+	}
+	bgu, err := header.BlobGasUsed()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to extract BlobGasUsed attribute from execution payload header")
+	}
+	ebg, err := header.ExcessBlobGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to extract ExcessBlobGas attribute from execution payload header")
+	}
+	return blocks.WrappedExecutionPayloadDeneb(&pb.ExecutionPayloadDeneb{
 		ParentHash:    header.ParentHash(),
 		FeeRecipient:  header.FeeRecipient(),
 		StateRoot:     header.StateRoot(),
@@ -807,6 +994,8 @@ func fullPayloadFromPayloadBody(
 		BlockHash:     header.BlockHash(),
 		Transactions:  body.Transactions,
 		Withdrawals:   body.Withdrawals,
+		BlobGasUsed:   bgu,
+		ExcessBlobGas: ebg,
 	}, 0) // We can't get the block value and don't care about the block value for this instance
 }
 
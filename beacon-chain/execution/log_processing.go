@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
@@ -555,12 +556,32 @@ func (s *Service) savePowchainData(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	snapshot, err := s.depositSnapshot(ctx)
+	if err != nil {
+		return err
+	}
 	eth1Data := &ethpb.ETH1ChainData{
 		CurrentEth1Data:   s.latestEth1Data,
 		ChainstartData:    s.chainStartData,
 		BeaconState:       pbState, // I promise not to mutate it!
-		Trie:              s.depositTrie.ToProto(),
 		DepositContainers: s.cfg.depositCache.AllDepositContainers(ctx),
+		DepositSnapshot:   snapshot,
 	}
 	return s.cfg.beaconDB.SaveExecutionChainData(ctx, eth1Data)
 }
+
+// depositSnapshot returns the snapshot of the finalized deposit tree, or nil if no deposits have
+// been finalized yet. The deposit tree of the service is pruned up to the snapshot as well.
+func (s *Service) depositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	snapshot, err := s.cfg.depositCache.DepositSnapshot(ctx)
+	if errors.Is(err, depositsnapshot.ErrEmptyExecutionBlock) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get deposit snapshot")
+	}
+	if err := s.depositTrie.Finalize(snapshot.DepositCount, bytesutil.ToBytes32(snapshot.ExecutionHash), snapshot.ExecutionDepth); err != nil {
+		return nil, errors.Wrap(err, "could not finalize deposit tree")
+	}
+	return snapshot, nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
@@ -30,7 +31,6 @@ import (
 	native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
-	"github.com/prysmaticlabs/prysm/v4/container/trie"
 	contracts "github.com/prysmaticlabs/prysm/v4/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/clientstats"
@@ -149,22 +149,21 @@ type Service struct {
 	headerCache             *headerCache // cache to store block hash/block height.
 	latestEth1Data          *ethpb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
-	depositTrie             *trie.SparseMerkleTrie
+	depositTrie             *depositsnapshot.DepositTree
 	chainStartData          *ethpb.ChainStartData
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	clientVersionLock       sync.Mutex
+	clientVersion           *ClientVersionV1
+	clientVersionErr        error
+	clientVersionTime       time.Time
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	_ = cancel // govet fix for lost cancel. Cancel is handled in service.Stop()
-	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "could not set up deposit trie")
-	}
 	genState, err := transition.EmptyGenesisState()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not set up genesis state")
@@ -185,7 +184,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			LastRequestedBlock: 0,
 		},
 		headerCache: newHeaderCache(),
-		depositTrie: depositTrie,
+		depositTrie: depositsnapshot.New(),
 		chainStartData: &ethpb.ChainStartData{
 			Eth1Data:           &ethpb.Eth1Data{},
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
@@ -332,7 +331,14 @@ func (s *Service) followedBlockHeight(ctx context.Context) (uint64, error) {
 	return blk.Number.Uint64(), nil
 }
 
-func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositContainer) error {
+func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositContainer, snapshot *ethpb.DepositSnapshot) error {
+	// A node bootstrapped from a deposit snapshot does not hold the deposits
+	// finalized in the snapshot, so the cache is bootstrapped from it as well.
+	if snapshot != nil && (len(ctrs) == 0 || ctrs[0].Index != 0) {
+		if err := s.cfg.depositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+			return errors.Wrap(err, "could not insert deposit snapshot")
+		}
+	}
 	if len(ctrs) == 0 {
 		return nil
 	}
@@ -378,12 +384,18 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 		if err = s.cfg.depositCache.PruneProofs(ctx, actualIndex); err != nil {
 			return errors.Wrap(err, "could not prune deposit proofs")
 		}
+
+		// Restore the finalization of the deposit tree persisted with the deposit snapshot.
+		if snapshot != nil {
+			if err = s.cfg.depositCache.FinalizeDepositTree(ctx, snapshot.DepositCount, bytesutil.ToBytes32(snapshot.ExecutionHash), snapshot.ExecutionDepth); err != nil {
+				log.WithError(err).Warn("Could not finalize deposit tree from deposit snapshot")
+			}
+		}
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add pending deposits which are not yet included in the state.
+	for _, c := range ctrs {
+		if c.Index >= int64(currIndex) { // lint:ignore uintcast -- deposit index will not exceed int64 in your lifetime.
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -742,9 +754,12 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 		return nil
 	}
 	var err error
-	s.depositTrie, err = trie.CreateTrieFromProto(eth1DataInDB.Trie)
+	sort.SliceStable(eth1DataInDB.DepositContainers, func(i, j int) bool {
+		return eth1DataInDB.DepositContainers[i].Index < eth1DataInDB.DepositContainers[j].Index
+	})
+	s.depositTrie, err = depositTreeFromChainData(eth1DataInDB)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "could not build deposit tree")
 	}
 	s.chainStartData = eth1DataInDB.ChainstartData
 	if !reflect.ValueOf(eth1DataInDB.BeaconState).IsZero() {
@@ -756,15 +771,42 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
-	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
+	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers, eth1DataInDB.DepositSnapshot); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
 }
 
+// depositTreeFromChainData builds the deposit tree from the persisted deposit snapshot, if any,
+// and the sorted deposit containers which are not finalized in the snapshot.
+func depositTreeFromChainData(eth1Data *ethpb.ETH1ChainData) (*depositsnapshot.DepositTree, error) {
+	tree := depositsnapshot.New()
+	if eth1Data.DepositSnapshot != nil {
+		var err error
+		tree, err = depositsnapshot.NewFromSnapshot(eth1Data.DepositSnapshot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create deposit tree from snapshot")
+		}
+	}
+	for _, c := range eth1Data.DepositContainers {
+		if c.Index < int64(tree.NumOfItems()) {
+			continue
+		}
+		depositHash, err := c.Deposit.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash deposit data")
+		}
+		if err := tree.Insert(depositHash[:], int(c.Index)); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
+// in order. The containers start either from the first deposit, or from the first
+// deposit not finalized in the deposit snapshot the node was bootstrapped from.
+func validateDepositContainers(ctrs []*ethpb.DepositContainer, snapshot *ethpb.DepositSnapshot) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -775,6 +817,9 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if snapshot != nil && ctrs[0].Index == int64(snapshot.DepositCount) { // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -800,7 +845,7 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.GetChainstartData().GetChainstarted() || !validateDepositContainers(eth1Data.DepositContainers, eth1Data.DepositSnapshot) {
 		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return err
@@ -812,12 +857,18 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 			Eth1Data:           genState.Eth1Data(),
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
 		}
+		// Deposits finalized in a persisted deposit snapshot, such as the one retrieved during
+		// checkpoint sync, don't need to be requested again from the deposit contract logs.
+		snapshot := eth1Data.GetDepositSnapshot()
+		if snapshot != nil {
+			s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionDepth
+		}
 		eth1Data = &ethpb.ETH1ChainData{
 			CurrentEth1Data:   s.latestEth1Data,
 			ChainstartData:    s.chainStartData,
 			BeaconState:       pbState,
-			Trie:              s.depositTrie.ToProto(),
 			DepositContainers: s.cfg.depositCache.AllDepositContainers(ctx),
+			DepositSnapshot:   snapshot,
 		}
 		return s.cfg.beaconDB.SaveExecutionChainData(ctx, eth1Data)
 	}
@@ -349,7 +349,7 @@ func TestInitDepositCache_OK(t *testing.T) {
 	var err error
 	s.cfg.depositCache, err = depositcache.New()
 	require.NoError(t, err)
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 
 	require.Equal(t, 0, len(s.cfg.depositCache.PendingContainers(context.Background(), nil)))
 
@@ -360,7 +360,7 @@ func TestInitDepositCache_OK(t *testing.T) {
 	require.NoError(t, s.cfg.beaconDB.SaveGenesisBlockRoot(context.Background(), blockRootA))
 	require.NoError(t, s.cfg.beaconDB.SaveState(context.Background(), emptyState, blockRootA))
 	s.chainStartData.Chainstarted = true
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 	require.Equal(t, 3, len(s.cfg.depositCache.PendingContainers(context.Background(), nil)))
 }
 
@@ -410,7 +410,7 @@ func TestInitDepositCacheWithFinalization_OK(t *testing.T) {
 	var err error
 	s.cfg.depositCache, err = depositcache.New()
 	require.NoError(t, err)
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 
 	require.Equal(t, 0, len(s.cfg.depositCache.PendingContainers(context.Background(), nil)))
 
@@ -432,12 +432,63 @@ func TestInitDepositCacheWithFinalization_OK(t *testing.T) {
 	s.cfg.finalizedStateAtStartup = emptyState
 
 	s.chainStartData.Chainstarted = true
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 	fDeposits := s.cfg.depositCache.FinalizedDeposits(ctx)
 	deps := s.cfg.depositCache.NonFinalizedDeposits(context.Background(), fDeposits.MerkleTrieIndex, nil)
 	assert.Equal(t, 0, len(deps))
 }
 
+func TestDepositTreeFromChainData_DepositSnapshot(t *testing.T) {
+	ctrs := make([]*ethpb.DepositContainer, 6)
+	for i := range ctrs {
+		ctrs[i] = &ethpb.DepositContainer{
+			Index:           int64(i),
+			Eth1BlockHeight: uint64(i + 10),
+			Deposit: &ethpb.Deposit{
+				Data: &ethpb.Deposit_Data{
+					PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+					WithdrawalCredentials: make([]byte, 32),
+					Signature:             make([]byte, 96),
+				},
+			},
+		}
+	}
+	full, err := depositTreeFromChainData(&ethpb.ETH1ChainData{DepositContainers: ctrs})
+	require.NoError(t, err)
+	require.Equal(t, len(ctrs), full.NumOfItems())
+	wantRoot, err := full.HashTreeRoot()
+	require.NoError(t, err)
+
+	finalized := full.Copy()
+	require.NoError(t, finalized.Finalize(3, [32]byte{'a'}, 12))
+	snapshot, err := finalized.GetSnapshot()
+	require.NoError(t, err)
+	eth1Data := &ethpb.ETH1ChainData{DepositContainers: ctrs[3:], DepositSnapshot: snapshot.ToProto()}
+	require.Equal(t, true, validateDepositContainers(eth1Data.DepositContainers, eth1Data.DepositSnapshot))
+
+	restored, err := depositTreeFromChainData(eth1Data)
+	require.NoError(t, err)
+	require.Equal(t, len(ctrs), restored.NumOfItems())
+	gotRoot, err := restored.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+
+	gs, _ := util.DeterministicGenesisState(t, 1)
+	s := &Service{
+		chainStartData:  &ethpb.ChainStartData{Chainstarted: false},
+		preGenesisState: gs,
+		cfg:             &config{beaconDB: dbutil.SetupDB(t)},
+	}
+	s.cfg.depositCache, err = depositcache.New()
+	require.NoError(t, err)
+	require.NoError(t, s.initDepositCaches(context.Background(), eth1Data.DepositContainers, eth1Data.DepositSnapshot))
+	cachedSnapshot, err := s.cfg.depositCache.DepositSnapshot(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, eth1Data.DepositSnapshot, cachedSnapshot)
+	count, _ := s.cfg.depositCache.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(20))
+	assert.Equal(t, uint64(len(ctrs)), count)
+}
+
 func TestNewService_EarliestVotingBlock(t *testing.T) {
 	testAcc, err := mock.Setup()
 	require.NoError(t, err, "Unable to set up simulated backend")
@@ -647,6 +698,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
 		name        string
 		ctrsFunc    func() []*ethpb.DepositContainer
+		snapshot    *ethpb.DepositSnapshot
 		expectedRes bool
 	}{
 		{
@@ -692,10 +744,34 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers after deposit snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 5},
+			expectedRes: true,
+		},
+		{
+			name: "containers missing after deposit snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 6; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 5},
+			expectedRes: false,
+		},
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), test.snapshot))
 	}
 }
 
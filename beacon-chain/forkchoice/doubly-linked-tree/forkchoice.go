@@ -35,6 +35,7 @@ func New() *ForkChoice {
 		nodeByPayload:                 make(map[[fieldparams.RootLength]byte]*Node),
 		slashedIndices:                make(map[primitives.ValidatorIndex]bool),
 		receivedBlocksLastEpoch:       [fieldparams.SlotsPerEpoch]primitives.Slot{},
+		now:                           time.Now,
 	}
 
 	b := make([]uint64, 0)
@@ -71,7 +72,7 @@ func (f *ForkChoice) Head(
 
 	jc := f.JustifiedCheckpoint()
 	fc := f.FinalizedCheckpoint()
-	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(f.store.genesisTime), 0))
+	currentEpoch := slots.ToEpoch(f.store.currentSlot())
 	if err := f.store.treeRootNode.updateBestDescendant(ctx, jc.Epoch, fc.Epoch, currentEpoch); err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not update best descendant")
 	}
@@ -144,6 +145,28 @@ func (f *ForkChoice) InsertNode(ctx context.Context, state state.BeaconState, ro
 	return f.updateCheckpoints(ctx, jc, fc)
 }
 
+// InsertNodeWithCheckpoints inserts a block node given the checkpoints of the block's post state
+// instead of the state itself. The unrealized checkpoints are the ones that the post state would
+// justify and finalize, they are not pulled into the node when nil. It is used to replay recorded
+// fork choice inputs.
+func (f *ForkChoice) InsertNodeWithCheckpoints(ctx context.Context, slot primitives.Slot, root, parentRoot, payloadHash [32]byte,
+	jc, fc, ujc, ufc *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.InsertNodeWithCheckpoints")
+	defer span.End()
+
+	if jc == nil || fc == nil {
+		return errInvalidNilCheckpoint
+	}
+	node, err := f.store.insert(ctx, slot, root, parentRoot, payloadHash, jc.Epoch, fc.Epoch)
+	if err != nil {
+		return err
+	}
+	if ujc != nil && ufc != nil && !f.store.inheritUnrealizedCheckpoints(node, slot) {
+		jc, fc = f.store.setUnrealizedCheckpoints(node, slot, jc, fc, ujc, ufc)
+	}
+	return f.updateCheckpoints(ctx, jc, fc)
+}
+
 // updateCheckpoints update the checkpoints when inserting a new node.
 func (f *ForkChoice) updateCheckpoints(ctx context.Context, jc, fc *ethpb.Checkpoint) error {
 	if jc.Epoch > f.store.justifiedCheckpoint.Epoch {
@@ -506,6 +529,11 @@ func (f *ForkChoice) SetGenesisTime(genesisTime uint64) {
 	f.store.genesisTime = genesisTime
 }
 
+// SetClock sets the function used by forkchoice to get the current time. It defaults to time.Now.
+func (f *ForkChoice) SetClock(now func() time.Time) {
+	f.store.now = now
+}
+
 // SetOriginRoot sets the genesis block root
 func (f *ForkChoice) SetOriginRoot(root [32]byte) {
 	f.store.originRoot = root
@@ -616,6 +644,12 @@ func (f *ForkChoice) updateJustifiedBalances(ctx context.Context, root [32]byte)
 	if err != nil {
 		return errors.Wrap(err, "could not get justified balances")
 	}
+	f.setJustifiedBalances(balances)
+	return nil
+}
+
+// setJustifiedBalances sets the justified balances and the committee weight derived from them.
+func (f *ForkChoice) setJustifiedBalances(balances []uint64) {
 	f.justifiedBalances = balances
 	f.store.committeeWeight = 0
 	f.numActiveValidators = 0
@@ -626,7 +660,6 @@ func (f *ForkChoice) updateJustifiedBalances(ctx context.Context, root [32]byte)
 		}
 	}
 	f.store.committeeWeight /= uint64(params.BeaconConfig().SlotsPerEpoch)
-	return nil
 }
 
 // Slot returns the slot of the given root if it's known to forkchoice
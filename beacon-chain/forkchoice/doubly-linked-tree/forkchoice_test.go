@@ -861,3 +861,25 @@ func TestForkChoiceSlot(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, primitives.Slot(3), slot)
 }
+
+func TestForkChoice_InsertNodeWithCheckpoints(t *testing.T) {
+	f := setup(0, 0)
+	ctx := context.Background()
+	zero := params.BeaconConfig().ZeroHash
+	cp := &ethpb.Checkpoint{Root: zero[:]}
+	require.ErrorIs(t, f.InsertNodeWithCheckpoints(ctx, 1, [32]byte{'a'}, zero, [32]byte{'A'}, nil, cp, nil, nil), errInvalidNilCheckpoint)
+
+	// Without unrealized checkpoints the node keeps the ones of its post state.
+	require.NoError(t, f.InsertNodeWithCheckpoints(ctx, 1, [32]byte{'a'}, zero, [32]byte{'A'}, cp, cp, nil, nil))
+	node := f.store.nodeByRoot[[32]byte{'a'}]
+	require.Equal(t, primitives.Epoch(0), node.unrealizedJustifiedEpoch)
+
+	// The unrealized checkpoints of a block from a past epoch are pulled into the node and the store.
+	uj := &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}}
+	require.NoError(t, f.InsertNodeWithCheckpoints(ctx, 2, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'B'}, cp, cp, uj, cp))
+	node = f.store.nodeByRoot[[32]byte{'b'}]
+	require.Equal(t, primitives.Epoch(1), node.unrealizedJustifiedEpoch)
+	require.Equal(t, primitives.Epoch(1), node.justifiedEpoch)
+	require.Equal(t, primitives.Epoch(1), f.JustifiedCheckpoint().Epoch)
+	require.Equal(t, primitives.Epoch(1), f.store.unrealizedJustifiedCheckpoint.Epoch)
+}
@@ -51,4 +51,18 @@ var (
 			Help: "The number of times pruning happened.",
 		},
 	)
+	lateBlockReorgAttemptedCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "doublylinkedtree_late_block_reorg_attempted_count",
+			Help: "The number of times the late block reorg heuristic decided to orphan the head, by stage of the decision.",
+		},
+		[]string{"stage"},
+	)
+	lateBlockReorgSkippedCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "doublylinkedtree_late_block_reorg_skipped_count",
+			Help: "The number of times the late block reorg heuristic decided not to orphan the head, by stage of the decision and reason.",
+		},
+		[]string{"stage", "reason"},
+	)
 )
@@ -1,17 +1,12 @@
 package doublylinkedtree
 
 import (
-	"time"
-
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 )
 
-// orphanLateBlockProposingEarly determines the maximum threshold that we
-// consider the node is proposing early and sure to receive proposer boost
-const orphanLateBlockProposingEarly = 2
-
 // ShouldOverrideFCU returns whether the current forkchoice head is weak
 // and thus may be reorged when proposing the next block.
 // This function should only be called if the following two conditions are
@@ -32,45 +27,116 @@ const orphanLateBlockProposingEarly = 2
 // the engine's view of head with the parent block or the incoming block. It
 // does not guarantee an attempted reorg. This will only be decided later at
 // proposal time by calling GetProposerHead.
-func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
-	override = false
+func (f *ForkChoice) ShouldOverrideFCU() bool {
+	return f.OverrideFCUDecision().Reorg
+}
 
+// OverrideFCUDecision is the same as ShouldOverrideFCU, returning the full
+// decision along with the reason for which the head is not reorged.
+func (f *ForkChoice) OverrideFCUDecision() *forkchoicetypes.ReorgDecision {
 	// We only need to override FCU if our current head is from the current
 	// slot. This differs from the spec implementation in that we assume
 	// that we will call this function in the previous slot to proposing.
+	d := &forkchoicetypes.ReorgDecision{
+		Stage:        forkchoicetypes.ReorgStageFCU,
+		ProposalSlot: f.store.currentSlot() + 1,
+	}
+	f.evaluateLateBlockReorg(d)
+	reportReorgDecision(d)
+	return d
+}
+
+// GetProposerHead returns the block root that has to be used as ParentRoot by a
+// proposer. It may not be the actual head of the canonical chain, in certain
+// cases it may be its parent, when the last head block has arrived early and is
+// considered safe to be orphaned.
+//
+// This function needs to be called only when proposing a block and all
+// attestation processing has already happened.
+func (f *ForkChoice) GetProposerHead() [32]byte {
+	return f.ProposerHeadDecision().ProposerHead()
+}
+
+// ProposerHeadDecision is the same as GetProposerHead, returning the full
+// decision along with the reason for which the head is not reorged.
+func (f *ForkChoice) ProposerHeadDecision() *forkchoicetypes.ReorgDecision {
+	d := &forkchoicetypes.ReorgDecision{
+		Stage:        forkchoicetypes.ReorgStageProposal,
+		ProposalSlot: f.store.currentSlot(),
+	}
+	defer reportReorgDecision(d)
+	if features.Get().DisableReorgLateBlocks {
+		d.HeadRoot = f.CachedHeadRoot()
+		d.Reason = forkchoicetypes.ReorgSkipDisabled
+		return d
+	}
+	f.evaluateLateBlockReorg(d)
+	if !d.Reorg {
+		return d
+	}
+	// Only reorg if we are proposing early
+	secs, err := slots.SecondsSinceSlotStart(d.ProposalSlot, f.store.genesisTime, f.store.currentTime())
+	if err != nil {
+		log.WithError(err).Error("could not check if proposing early")
+		d.Reorg, d.Reason = false, forkchoicetypes.ReorgSkipError
+		return d
+	}
+	if secs >= params.BeaconConfig().ReorgMaxProposalOffset {
+		d.Reorg, d.Reason = false, forkchoicetypes.ReorgSkipProposalTooLate
+	}
+	return d
+}
+
+// evaluateLateBlockReorg checks whether the head of fork choice is a late and
+// weak block that may be orphaned by the proposer of the decision's slot.
+func (f *ForkChoice) evaluateLateBlockReorg(d *forkchoicetypes.ReorgDecision) {
 	head := f.store.headNode
 	if head == nil {
+		d.Reason = forkchoicetypes.ReorgSkipNoHead
 		return
 	}
+	d.HeadRoot = head.root
+	d.HeadSlot = head.slot
+	d.HeadWeight = head.weight
+	d.CommitteeWeight = f.store.committeeWeight
 
-	if head.slot != slots.CurrentSlot(f.store.genesisTime) {
+	// Only reorg blocks from the slot preceding the proposal.
+	if head.slot+1 != d.ProposalSlot {
+		d.Reason = forkchoicetypes.ReorgSkipHeadNotLatestSlot
 		return
 	}
-
 	// Do not reorg on epoch boundaries
 	if (head.slot+1)%params.BeaconConfig().SlotsPerEpoch == 0 {
+		d.Reason = forkchoicetypes.ReorgSkipEpochBoundary
 		return
 	}
 	// Only reorg blocks that arrive late
 	early, err := head.arrivedEarly(f.store.genesisTime)
 	if err != nil {
 		log.WithError(err).Error("could not check if block arrived early")
+		d.Reason = forkchoicetypes.ReorgSkipError
 		return
 	}
 	if early {
+		d.Reason = forkchoicetypes.ReorgSkipHeadTimely
 		return
 	}
 	// Only reorg if we have been finalizing
 	finalizedEpoch := f.store.finalizedCheckpoint.Epoch
 	if slots.ToEpoch(head.slot+1) > finalizedEpoch+params.BeaconConfig().ReorgMaxEpochsSinceFinalization {
+		d.Reason = forkchoicetypes.ReorgSkipNotFinalizing
 		return
 	}
 	// Only orphan a single block
 	parent := head.parent
 	if parent == nil {
+		d.Reason = forkchoicetypes.ReorgSkipParentNotPreviousSlot
 		return
 	}
+	d.ParentRoot = parent.root
+	d.ParentWeight = parent.weight
 	if head.slot > parent.slot+1 {
+		d.Reason = forkchoicetypes.ReorgSkipParentNotPreviousSlot
 		return
 	}
 	// Do not orphan a block that has higher justification than the parent
@@ -80,81 +146,22 @@ func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
 
 	// Only orphan a block if the head LMD vote is weak
 	if head.weight*100 > f.store.committeeWeight*params.BeaconConfig().ReorgWeightThreshold {
+		d.Reason = forkchoicetypes.ReorgSkipHeadStrong
 		return
 	}
-
 	// Only orphan a block if the parent LMD vote is strong
 	if parent.weight*100 < f.store.committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold {
+		d.Reason = forkchoicetypes.ReorgSkipParentWeak
 		return
 	}
-	return true
+	d.Reorg = true
 }
 
-// GetProposerHead returns the block root that has to be used as ParentRoot by a
-// proposer. It may not be the actual head of the canonical chain, in certain
-// cases it may be its parent, when the last head block has arrived early and is
-// considered safe to be orphaned.
-//
-// This function needs to be called only when proposing a block and all
-// attestation processing has already happened.
-func (f *ForkChoice) GetProposerHead() [32]byte {
-	if features.Get().DisableReorgLateBlocks {
-		return f.CachedHeadRoot()
-	}
-	head := f.store.headNode
-	if head == nil {
-		return [32]byte{}
-	}
-
-	// Only reorg blocks from the previous slot.
-	if head.slot+1 != slots.CurrentSlot(f.store.genesisTime) {
-		return head.root
-	}
-	// Do not reorg on epoch boundaries
-	if (head.slot+1)%params.BeaconConfig().SlotsPerEpoch == 0 {
-		return head.root
-	}
-	// Only reorg blocks that arrive late
-	early, err := head.arrivedEarly(f.store.genesisTime)
-	if err != nil {
-		log.WithError(err).Error("could not check if block arrived early")
-		return head.root
-	}
-	if early {
-		return head.root
-	}
-	// Only reorg if we have been finalizing
-	finalizedEpoch := f.store.finalizedCheckpoint.Epoch
-	if slots.ToEpoch(head.slot+1) > finalizedEpoch+params.BeaconConfig().ReorgMaxEpochsSinceFinalization {
-		return head.root
-	}
-	// Only orphan a single block
-	parent := head.parent
-	if parent == nil {
-		return head.root
-	}
-	if head.slot > parent.slot+1 {
-		return head.root
-	}
-
-	// Only orphan a block if the head LMD vote is weak
-	if head.weight*100 > f.store.committeeWeight*params.BeaconConfig().ReorgWeightThreshold {
-		return head.root
-	}
-
-	// Only orphan a block if the parent LMD vote is strong
-	if parent.weight*100 < f.store.committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold {
-		return head.root
-	}
-
-	// Only reorg if we are proposing early
-	secs, err := slots.SecondsSinceSlotStart(head.slot+1, f.store.genesisTime, uint64(time.Now().Unix()))
-	if err != nil {
-		log.WithError(err).Error("could not check if proposing early")
-		return head.root
-	}
-	if secs >= orphanLateBlockProposingEarly {
-		return head.root
+// reportReorgDecision updates the late block reorg metrics with the decision.
+func reportReorgDecision(d *forkchoicetypes.ReorgDecision) {
+	if d.Reorg {
+		lateBlockReorgAttemptedCount.WithLabelValues(d.Stage).Inc()
+		return
 	}
-	return parent.root
+	lateBlockReorgSkippedCount.WithLabelValues(d.Stage, d.Reason).Inc()
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 )
 
@@ -186,3 +188,90 @@ func TestForkChoice_GetProposerHead(t *testing.T) {
 		require.Equal(t, childRoot, f.GetProposerHead())
 	})
 }
+
+func TestForkChoice_ProposerHeadDecision(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	f := setup(0, 0)
+	f.numActiveValidators = 640
+	f.justifiedBalances = make([]uint64, f.numActiveValidators)
+	for i := range f.justifiedBalances {
+		f.justifiedBalances[i] = uint64(10)
+		f.store.committeeWeight += uint64(10)
+	}
+	f.store.committeeWeight /= uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	driftGenesisTime(f, 1, 0)
+	parentRoot := [32]byte{'a'}
+	st, root, err := prepareForkchoiceState(ctx, 1, parentRoot, [32]byte{}, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	attesters := make([]uint64, f.numActiveValidators-64)
+	for i := range attesters {
+		attesters[i] = uint64(i + 64)
+	}
+	f.ProcessAttestation(ctx, attesters, root, 0)
+
+	driftGenesisTime(f, 3, 1)
+	childRoot := [32]byte{'b'}
+	st, root, err = prepareForkchoiceState(ctx, 2, childRoot, [32]byte{'a'}, [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	_, err = f.Head(ctx)
+	require.NoError(t, err)
+	f.store.headNode.timestamp -= params.BeaconConfig().SecondsPerSlot - orphanLateBlockFirstThreshold
+
+	d := f.ProposerHeadDecision()
+	require.Equal(t, true, d.Reorg)
+	require.Equal(t, "", d.Reason)
+	require.Equal(t, forkchoicetypes.ReorgStageProposal, d.Stage)
+	require.Equal(t, primitives.Slot(3), d.ProposalSlot)
+	require.Equal(t, childRoot, d.HeadRoot)
+	require.Equal(t, parentRoot, d.ParentRoot)
+	require.Equal(t, f.store.committeeWeight, d.CommitteeWeight)
+	require.Equal(t, parentRoot, d.ProposerHead())
+
+	t.Run("proposal offset exceeded", func(t *testing.T) {
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgMaxProposalOffset = 1
+		params.OverrideBeaconConfig(cfg)
+		d := f.ProposerHeadDecision()
+		require.Equal(t, false, d.Reorg)
+		require.Equal(t, forkchoicetypes.ReorgSkipProposalTooLate, d.Reason)
+		require.Equal(t, childRoot, d.ProposerHead())
+		cfg.ReorgMaxProposalOffset = 2
+		params.OverrideBeaconConfig(cfg)
+	})
+	t.Run("head weight threshold", func(t *testing.T) {
+		f.store.headNode.weight = f.store.committeeWeight / 10
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgWeightThreshold = 5
+		params.OverrideBeaconConfig(cfg)
+		require.Equal(t, forkchoicetypes.ReorgSkipHeadStrong, f.ProposerHeadDecision().Reason)
+		cfg.ReorgWeightThreshold = 20
+		params.OverrideBeaconConfig(cfg)
+		require.Equal(t, true, f.ProposerHeadDecision().Reorg)
+		f.store.headNode.weight = 0
+	})
+	t.Run("parent weight threshold", func(t *testing.T) {
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgParentWeightThreshold = 10000
+		params.OverrideBeaconConfig(cfg)
+		require.Equal(t, forkchoicetypes.ReorgSkipParentWeak, f.ProposerHeadDecision().Reason)
+		cfg.ReorgParentWeightThreshold = 160
+		params.OverrideBeaconConfig(cfg)
+	})
+	t.Run("head is early", func(t *testing.T) {
+		saved := f.store.headNode.timestamp
+		f.store.headNode.timestamp = saved - 2
+		require.Equal(t, forkchoicetypes.ReorgSkipHeadTimely, f.ProposerHeadDecision().Reason)
+		f.store.headNode.timestamp = saved
+	})
+	t.Run("fcu stage", func(t *testing.T) {
+		d := f.OverrideFCUDecision()
+		require.Equal(t, forkchoicetypes.ReorgStageFCU, d.Stage)
+		require.Equal(t, primitives.Slot(4), d.ProposalSlot)
+		require.Equal(t, false, d.Reorg)
+		require.Equal(t, forkchoicetypes.ReorgSkipHeadNotLatestSlot, d.Reason)
+		require.Equal(t, childRoot, d.ProposerHead())
+	})
+}
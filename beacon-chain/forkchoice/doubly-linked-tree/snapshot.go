@@ -0,0 +1,289 @@
+package doublylinkedtree
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stateutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+var errInvalidSnapshot = errors.New("invalid fork choice snapshot")
+
+// Snapshot returns a compact snapshot of the fork choice store, which can be persisted
+// and restored with RestoreSnapshot after a restart.
+func (f *ForkChoice) Snapshot(ctx context.Context) (*ethpb.ForkChoiceSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.Snapshot")
+	defer span.End()
+
+	if f.store.treeRootNode == nil {
+		return nil, errors.Wrap(ErrNilNode, "could not snapshot fork choice without a tree root")
+	}
+	nodes, err := f.store.treeRootNode.snapshotNodes(ctx, make([]*ethpb.ForkChoiceSnapshotNode, 0, f.NodeCount()))
+	if err != nil {
+		return nil, err
+	}
+	balancesRoot, err := stateutil.Uint64ListRootWithRegistryLimit(f.justifiedBalances)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute justified balances root")
+	}
+	var headRoot [32]byte
+	if f.store.headNode != nil {
+		headRoot = f.store.headNode.root
+	}
+
+	// Votes are stored as indices into the table of the distinct roots voted for, as most
+	// validators vote for the same few blocks.
+	voteRoots := make([][]byte, 0)
+	rootIndices := make(map[[32]byte]uint32)
+	rootIndex := func(root [32]byte) uint32 {
+		idx, ok := rootIndices[root]
+		if !ok {
+			idx = uint32(len(voteRoots))
+			rootIndices[root] = idx
+			voteRoots = append(voteRoots, bytesutil.SafeCopyBytes(root[:]))
+		}
+		return idx
+	}
+	currentRoots := make([]uint32, len(f.votes))
+	nextRoots := make([]uint32, len(f.votes))
+	nextEpochs := make([]uint64, len(f.votes))
+	for i, v := range f.votes {
+		currentRoots[i] = rootIndex(v.currentRoot)
+		nextRoots[i] = rootIndex(v.nextRoot)
+		nextEpochs[i] = uint64(v.nextEpoch)
+	}
+
+	slashed := make([]uint64, 0, len(f.store.slashedIndices))
+	for idx := range f.store.slashedIndices {
+		slashed = append(slashed, uint64(idx))
+	}
+	sort.Slice(slashed, func(i, j int) bool { return slashed[i] < slashed[j] })
+
+	return &ethpb.ForkChoiceSnapshot{
+		JustifiedCheckpoint:           checkpointToProto(f.store.justifiedCheckpoint),
+		UnrealizedJustifiedCheckpoint: checkpointToProto(f.store.unrealizedJustifiedCheckpoint),
+		UnrealizedFinalizedCheckpoint: checkpointToProto(f.store.unrealizedFinalizedCheckpoint),
+		PreviousJustifiedCheckpoint:   checkpointToProto(f.store.prevJustifiedCheckpoint),
+		FinalizedCheckpoint:           checkpointToProto(f.store.finalizedCheckpoint),
+		ProposerBoostRoot:             bytesutil.SafeCopyBytes(f.store.proposerBoostRoot[:]),
+		PreviousProposerBoostRoot:     bytesutil.SafeCopyBytes(f.store.previousProposerBoostRoot[:]),
+		PreviousProposerBoostScore:    f.store.previousProposerBoostScore,
+		OriginRoot:                    bytesutil.SafeCopyBytes(f.store.originRoot[:]),
+		GenesisTime:                   f.store.genesisTime,
+		HeadRoot:                      headRoot[:],
+		Nodes:                         nodes,
+		VoteRoots:                     voteRoots,
+		VoteCurrentRoots:              currentRoots,
+		VoteNextRoots:                 nextRoots,
+		VoteNextEpochs:                nextEpochs,
+		Balances:                      append([]uint64{}, f.balances...),
+		JustifiedBalancesRoot:         balancesRoot[:],
+		SlashedIndices:                slashed,
+	}, nil
+}
+
+// RestoreSnapshot replaces the fork choice store with the one of the given snapshot. The snapshot
+// is validated against the justified balances obtained from the balances handler, and the store is
+// left untouched if the snapshot is invalid.
+func (f *ForkChoice) RestoreSnapshot(ctx context.Context, snapshot *ethpb.ForkChoiceSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.RestoreSnapshot")
+	defer span.End()
+
+	if snapshot == nil || len(snapshot.Nodes) == 0 {
+		return errors.Wrap(errInvalidSnapshot, "no nodes")
+	}
+	s := &Store{
+		proposerBoostRoot:          bytesutil.ToBytes32(snapshot.ProposerBoostRoot),
+		previousProposerBoostRoot:  bytesutil.ToBytes32(snapshot.PreviousProposerBoostRoot),
+		previousProposerBoostScore: snapshot.PreviousProposerBoostScore,
+		originRoot:                 bytesutil.ToBytes32(snapshot.OriginRoot),
+		genesisTime:                snapshot.GenesisTime,
+		nodeByRoot:                 make(map[[fieldparams.RootLength]byte]*Node, len(snapshot.Nodes)),
+		nodeByPayload:              make(map[[fieldparams.RootLength]byte]*Node, len(snapshot.Nodes)),
+		slashedIndices:             make(map[primitives.ValidatorIndex]bool, len(snapshot.SlashedIndices)),
+		receivedBlocksLastEpoch:    [fieldparams.SlotsPerEpoch]primitives.Slot{},
+		now:                        f.store.now,
+	}
+	var err error
+	if s.justifiedCheckpoint, err = checkpointFromProto(snapshot.JustifiedCheckpoint); err != nil {
+		return err
+	}
+	if s.unrealizedJustifiedCheckpoint, err = checkpointFromProto(snapshot.UnrealizedJustifiedCheckpoint); err != nil {
+		return err
+	}
+	if s.unrealizedFinalizedCheckpoint, err = checkpointFromProto(snapshot.UnrealizedFinalizedCheckpoint); err != nil {
+		return err
+	}
+	if s.prevJustifiedCheckpoint, err = checkpointFromProto(snapshot.PreviousJustifiedCheckpoint); err != nil {
+		return err
+	}
+	if s.finalizedCheckpoint, err = checkpointFromProto(snapshot.FinalizedCheckpoint); err != nil {
+		return err
+	}
+
+	for i, sn := range snapshot.Nodes {
+		if len(sn.Root) != fieldparams.RootLength || len(sn.PayloadHash) != fieldparams.RootLength {
+			return errors.Wrapf(errInvalidSnapshot, "invalid roots of node %d", i)
+		}
+		n := &Node{
+			slot:                     primitives.Slot(sn.Slot),
+			root:                     bytesutil.ToBytes32(sn.Root),
+			payloadHash:              bytesutil.ToBytes32(sn.PayloadHash),
+			justifiedEpoch:           primitives.Epoch(sn.JustifiedEpoch),
+			unrealizedJustifiedEpoch: primitives.Epoch(sn.UnrealizedJustifiedEpoch),
+			finalizedEpoch:           primitives.Epoch(sn.FinalizedEpoch),
+			unrealizedFinalizedEpoch: primitives.Epoch(sn.UnrealizedFinalizedEpoch),
+			balance:                  sn.Balance,
+			optimistic:               sn.Optimistic,
+			timestamp:                sn.Timestamp,
+		}
+		if _, ok := s.nodeByRoot[n.root]; ok {
+			return errors.Wrapf(errInvalidSnapshot, "duplicated node %#x", n.root)
+		}
+		if i == 0 {
+			s.treeRootNode = n
+			s.highestReceivedNode = n
+		} else {
+			parent, ok := s.nodeByRoot[bytesutil.ToBytes32(sn.ParentRoot)]
+			if !ok {
+				return errors.WithMessage(errInvalidParentRoot, fmt.Sprintf("%#x", sn.ParentRoot))
+			}
+			n.parent = parent
+			parent.children = append(parent.children, n)
+		}
+		if n.slot > s.highestReceivedNode.slot {
+			s.highestReceivedNode = n
+		}
+		s.nodeByRoot[n.root] = n
+		s.nodeByPayload[n.payloadHash] = n
+	}
+	if _, ok := s.nodeByRoot[s.finalizedCheckpoint.Root]; !ok && s.finalizedCheckpoint.Epoch != params.BeaconConfig().GenesisEpoch {
+		return errors.WithMessage(errUnknownFinalizedRoot, fmt.Sprintf("%#x", s.finalizedCheckpoint.Root))
+	}
+	if _, ok := s.nodeByRoot[s.justifiedCheckpoint.Root]; !ok && s.justifiedCheckpoint.Epoch != params.BeaconConfig().GenesisEpoch {
+		return errors.WithMessage(errUnknownJustifiedRoot, fmt.Sprintf("%#x", s.justifiedCheckpoint.Root))
+	}
+	s.headNode = s.nodeByRoot[bytesutil.ToBytes32(snapshot.HeadRoot)]
+	if s.headNode == nil {
+		s.headNode = s.treeRootNode
+	}
+	for _, idx := range snapshot.SlashedIndices {
+		s.slashedIndices[primitives.ValidatorIndex(idx)] = true
+	}
+
+	votes, err := votesFromSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+	if f.balancesByRoot == nil {
+		return errors.New("no balances handler to validate the fork choice snapshot")
+	}
+	justifiedBalances, err := f.balancesByRoot(ctx, s.justifiedCheckpoint.Root)
+	if err != nil {
+		return errors.Wrap(err, "could not get justified balances")
+	}
+	balancesRoot, err := stateutil.Uint64ListRootWithRegistryLimit(justifiedBalances)
+	if err != nil {
+		return errors.Wrap(err, "could not compute justified balances root")
+	}
+	if !bytes.Equal(balancesRoot[:], snapshot.JustifiedBalancesRoot) {
+		return errors.Wrap(errInvalidSnapshot, "justified balances root mismatch")
+	}
+
+	if err := s.treeRootNode.applyWeightChanges(ctx); err != nil {
+		return errors.Wrap(err, "could not apply weight changes")
+	}
+	currentEpoch := slots.ToEpoch(s.currentSlot())
+	if err := s.treeRootNode.updateBestDescendant(ctx, s.justifiedCheckpoint.Epoch, s.finalizedCheckpoint.Epoch, currentEpoch); err != nil {
+		return errors.Wrap(err, "could not update best descendant")
+	}
+
+	f.store = s
+	f.votes = votes
+	f.balances = append([]uint64{}, snapshot.Balances...)
+	f.setJustifiedBalances(justifiedBalances)
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+	return nil
+}
+
+// snapshotNodes appends the snapshot of this node and of all its descendants to the given list,
+// listing every node after its parent.
+func (n *Node) snapshotNodes(ctx context.Context, nodes []*ethpb.ForkChoiceSnapshotNode) ([]*ethpb.ForkChoiceSnapshotNode, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	var parentRoot [32]byte
+	if n.parent != nil {
+		parentRoot = n.parent.root
+	}
+	nodes = append(nodes, &ethpb.ForkChoiceSnapshotNode{
+		Slot:                     uint64(n.slot),
+		Root:                     bytesutil.SafeCopyBytes(n.root[:]),
+		ParentRoot:               parentRoot[:],
+		PayloadHash:              bytesutil.SafeCopyBytes(n.payloadHash[:]),
+		JustifiedEpoch:           uint64(n.justifiedEpoch),
+		UnrealizedJustifiedEpoch: uint64(n.unrealizedJustifiedEpoch),
+		FinalizedEpoch:           uint64(n.finalizedEpoch),
+		UnrealizedFinalizedEpoch: uint64(n.unrealizedFinalizedEpoch),
+		Balance:                  n.balance,
+		Optimistic:               n.optimistic,
+		Timestamp:                n.timestamp,
+	})
+	var err error
+	for _, child := range n.children {
+		nodes, err = child.snapshotNodes(ctx, nodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func votesFromSnapshot(snapshot *ethpb.ForkChoiceSnapshot) ([]Vote, error) {
+	count := len(snapshot.VoteCurrentRoots)
+	if len(snapshot.VoteNextRoots) != count || len(snapshot.VoteNextEpochs) != count {
+		return nil, errors.Wrap(errInvalidSnapshot, "mismatched vote lengths")
+	}
+	roots := make([][32]byte, len(snapshot.VoteRoots))
+	for i, r := range snapshot.VoteRoots {
+		if len(r) != fieldparams.RootLength {
+			return nil, errors.Wrapf(errInvalidSnapshot, "invalid vote root %d", i)
+		}
+		roots[i] = bytesutil.ToBytes32(r)
+	}
+	votes := make([]Vote, count)
+	for i := range votes {
+		current, next := snapshot.VoteCurrentRoots[i], snapshot.VoteNextRoots[i]
+		if int(current) >= len(roots) || int(next) >= len(roots) {
+			return nil, errors.Wrapf(errInvalidSnapshot, "invalid vote root index of validator %d", i)
+		}
+		votes[i] = Vote{
+			currentRoot: roots[current],
+			nextRoot:    roots[next],
+			nextEpoch:   primitives.Epoch(snapshot.VoteNextEpochs[i]),
+		}
+	}
+	return votes, nil
+}
+
+func checkpointToProto(cp *forkchoicetypes.Checkpoint) *ethpb.Checkpoint {
+	return &ethpb.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root[:])}
+}
+
+func checkpointFromProto(cp *ethpb.Checkpoint) (*forkchoicetypes.Checkpoint, error) {
+	if cp == nil {
+		return nil, errInvalidNilCheckpoint
+	}
+	return &forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)}, nil
+}
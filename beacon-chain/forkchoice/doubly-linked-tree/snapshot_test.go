@@ -0,0 +1,105 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func snapshotTestForkChoice(t *testing.T) *ForkChoice {
+	ctx := context.Background()
+	f := setup(1, 1)
+	f.setJustifiedBalances([]uint64{10, 20, 30})
+	//            0
+	//           / \
+	//          1   2
+	//          |
+	//          3
+	st, blkRoot, err := prepareForkchoiceState(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'A'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, blkRoot))
+	st, blkRoot, err = prepareForkchoiceState(ctx, 2, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{'B'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, blkRoot))
+	st, blkRoot, err = prepareForkchoiceState(ctx, 3, indexToHash(3), indexToHash(1), [32]byte{'C'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, blkRoot))
+
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(3), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(2), 2)
+	f.store.slashedIndices[1] = true
+	_, err = f.Head(ctx)
+	require.NoError(t, err)
+	return f
+}
+
+func TestForkChoice_SnapshotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	f := snapshotTestForkChoice(t)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	snapshot, err := f.Snapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(snapshot.Nodes))
+	require.Equal(t, 3, len(snapshot.VoteRoots))
+	require.DeepEqual(t, []uint64{1}, snapshot.SlashedIndices)
+
+	restored := New()
+	restored.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) { return f.justifiedBalances, nil })
+	require.NoError(t, restored.RestoreSnapshot(ctx, snapshot))
+	require.Equal(t, f.NodeCount(), restored.NodeCount())
+	require.DeepEqual(t, f.votes, restored.votes)
+	require.DeepEqual(t, f.balances, restored.balances)
+	require.Equal(t, f.store.committeeWeight, restored.store.committeeWeight)
+	require.Equal(t, f.HighestReceivedBlockSlot(), restored.HighestReceivedBlockSlot())
+	require.Equal(t, head, restored.CachedHeadRoot())
+	for i := uint64(1); i <= 3; i++ {
+		want, err := f.Weight(indexToHash(i))
+		require.NoError(t, err)
+		got, err := restored.Weight(indexToHash(i))
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	restoredHead, err := restored.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, head, restoredHead)
+
+	again, err := restored.Snapshot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, snapshot, again)
+}
+
+func TestForkChoice_RestoreSnapshotInvalid(t *testing.T) {
+	ctx := context.Background()
+	f := snapshotTestForkChoice(t)
+
+	t.Run("nil snapshot", func(t *testing.T) {
+		require.ErrorIs(t, New().RestoreSnapshot(ctx, nil), errInvalidSnapshot)
+	})
+	t.Run("unknown parent", func(t *testing.T) {
+		snapshot, err := f.Snapshot(ctx)
+		require.NoError(t, err)
+		snapshot.Nodes = append(snapshot.Nodes[:1], snapshot.Nodes[2:]...)
+		restored := New()
+		restored.SetBalancesByRooter(f.balancesByRoot)
+		require.ErrorIs(t, restored.RestoreSnapshot(ctx, snapshot), errInvalidParentRoot)
+	})
+	t.Run("invalid vote index", func(t *testing.T) {
+		snapshot, err := f.Snapshot(ctx)
+		require.NoError(t, err)
+		snapshot.VoteNextRoots[0] = uint32(len(snapshot.VoteRoots))
+		restored := New()
+		restored.SetBalancesByRooter(f.balancesByRoot)
+		require.ErrorIs(t, restored.RestoreSnapshot(ctx, snapshot), errInvalidSnapshot)
+	})
+	t.Run("justified balances mismatch", func(t *testing.T) {
+		snapshot, err := f.Snapshot(ctx)
+		require.NoError(t, err)
+		restored := New()
+		restored.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) { return []uint64{1}, nil })
+		require.ErrorContains(t, "justified balances root mismatch", restored.RestoreSnapshot(ctx, snapshot))
+		require.Equal(t, 0, restored.NodeCount())
+	})
+}
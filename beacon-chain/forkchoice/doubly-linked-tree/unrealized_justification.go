@@ -59,11 +59,24 @@ func (f *ForkChoice) updateUnrealizedCheckpoints(ctx context.Context) error {
 }
 
 func (s *Store) pullTips(state state.BeaconState, node *Node, jc, fc *ethpb.Checkpoint) (*ethpb.Checkpoint, *ethpb.Checkpoint) {
-	if node.parent == nil { // Nothing to do if the parent is nil.
+	if s.inheritUnrealizedCheckpoints(node, state.Slot()) {
 		return jc, fc
 	}
-	currentEpoch := slots.ToEpoch(slots.CurrentSlot(s.genesisTime))
-	stateSlot := state.Slot()
+	uj, uf, err := precompute.UnrealizedCheckpoints(state)
+	if err != nil {
+		log.WithError(err).Debug("could not compute unrealized checkpoints")
+		uj, uf = jc, fc
+	}
+	return s.setUnrealizedCheckpoints(node, state.Slot(), jc, fc, uj, uf)
+}
+
+// inheritUnrealizedCheckpoints returns true if the unrealized checkpoints of the node's post state
+// do not need to be computed, setting the node's unrealized epochs to its parent's when it has one.
+func (s *Store) inheritUnrealizedCheckpoints(node *Node, stateSlot primitives.Slot) bool {
+	if node.parent == nil { // Nothing to do if the parent is nil.
+		return true
+	}
+	currentEpoch := slots.ToEpoch(s.currentSlot())
 	stateEpoch := slots.ToEpoch(stateSlot)
 	currJustified := node.parent.unrealizedJustifiedEpoch == currentEpoch
 	prevJustified := node.parent.unrealizedJustifiedEpoch+1 == currentEpoch
@@ -72,15 +85,14 @@ func (s *Store) pullTips(state state.BeaconState, node *Node, jc, fc *ethpb.Chec
 	if currJustified || (stateEpoch == currentEpoch && prevJustified && tooEarlyForCurr) {
 		node.unrealizedJustifiedEpoch = node.parent.unrealizedJustifiedEpoch
 		node.unrealizedFinalizedEpoch = node.parent.unrealizedFinalizedEpoch
-		return jc, fc
-	}
-
-	uj, uf, err := precompute.UnrealizedCheckpoints(state)
-	if err != nil {
-		log.WithError(err).Debug("could not compute unrealized checkpoints")
-		uj, uf = jc, fc
+		return true
 	}
+	return false
+}
 
+// setUnrealizedCheckpoints updates the store and the given node with the unrealized checkpoints
+// of the node's post state, returning the checkpoints to be used for the node.
+func (s *Store) setUnrealizedCheckpoints(node *Node, stateSlot primitives.Slot, jc, fc, uj, uf *ethpb.Checkpoint) (*ethpb.Checkpoint, *ethpb.Checkpoint) {
 	// Update store's unrealized checkpoints.
 	if uj.Epoch > s.unrealizedJustifiedCheckpoint.Epoch {
 		s.unrealizedJustifiedCheckpoint = &forkchoicetypes.Checkpoint{
@@ -98,7 +110,7 @@ func (s *Store) pullTips(state state.BeaconState, node *Node, jc, fc *ethpb.Chec
 
 	// Update node's checkpoints.
 	node.unrealizedJustifiedEpoch, node.unrealizedFinalizedEpoch = uj.Epoch, uf.Epoch
-	if stateEpoch < currentEpoch {
+	if slots.ToEpoch(stateSlot) < slots.ToEpoch(s.currentSlot()) {
 		jc, fc = uj, uf
 		node.justifiedEpoch = uj.Epoch
 		node.finalizedEpoch = uf.Epoch
@@ -8,6 +8,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	v1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
 // BalancesByRooter is a handler to obtain the effective balances of the state
@@ -31,6 +32,7 @@ type ForkChoicer interface {
 type HeadRetriever interface {
 	Head(context.Context) ([32]byte, error)
 	GetProposerHead() [32]byte
+	ProposerHeadDecision() *forkchoicetypes.ReorgDecision
 	CachedHeadRoot() [32]byte
 }
 
@@ -67,7 +69,9 @@ type Getter interface {
 	Tips() ([][32]byte, []primitives.Slot)
 	IsOptimistic(root [32]byte) (bool, error)
 	ShouldOverrideFCU() bool
+	OverrideFCUDecision() *forkchoicetypes.ReorgDecision
 	Slot([32]byte) (primitives.Slot, error)
+	Snapshot(context.Context) (*ethpb.ForkChoiceSnapshot, error)
 }
 
 // Setter allows to set forkchoice information
@@ -78,6 +82,7 @@ type Setter interface {
 	UpdateFinalizedCheckpoint(*forkchoicetypes.Checkpoint) error
 	SetGenesisTime(uint64)
 	SetOriginRoot([32]byte)
+	RestoreSnapshot(context.Context, *ethpb.ForkChoiceSnapshot) error
 	NewSlot(context.Context, primitives.Slot) error
 	SetBalancesByRooter(BalancesByRooter)
 	InsertSlashedIndex(context.Context, primitives.ValidatorIndex)
@@ -0,0 +1,44 @@
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// maxStepSize bounds the size of a single line of a trace, balances steps hold an entry per validator.
+const maxStepSize = 1 << 28
+
+// ReadSteps reads all the steps of a trace written by a Recorder.
+func ReadSteps(r io.Reader) ([]*Step, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 1<<16), maxStepSize)
+	steps := make([]*Step, 0)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		step := &Step{}
+		if err := json.Unmarshal(scanner.Bytes(), step); err != nil {
+			return nil, errors.Wrapf(err, "could not decode step at line %d", line)
+		}
+		steps = append(steps, step)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read trace")
+	}
+	return steps, nil
+}
+
+// WriteSteps writes the steps in the trace format read by ReadSteps.
+func WriteSteps(w io.Writer, steps []*Step) error {
+	enc := json.NewEncoder(w)
+	for _, step := range steps {
+		if err := enc.Encode(step); err != nil {
+			return errors.Wrap(err, "could not encode step")
+		}
+	}
+	return nil
+}
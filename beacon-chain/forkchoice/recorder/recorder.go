@@ -0,0 +1,232 @@
+// Package recorder records the inputs of fork choice to a trace, so that the behavior of fork choice
+// can be replayed deterministically after the fact.
+package recorder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "forkchoice-recorder")
+
+// Recorder wraps a fork choice store and writes every input it receives to a trace, one JSON encoded
+// step per line. Checks of the head and checkpoints are written whenever the head is computed.
+type Recorder struct {
+	forkchoice.ForkChoicer
+	lock   sync.Mutex
+	w      *bufio.Writer
+	enc    *json.Encoder
+	closer io.Closer
+	failed bool
+}
+
+// New returns a recorder writing the inputs of the given fork choice store to w.
+func New(f forkchoice.ForkChoicer, w io.WriteCloser) *Recorder {
+	bw := bufio.NewWriter(w)
+	return &Recorder{
+		ForkChoicer: f,
+		w:           bw,
+		enc:         json.NewEncoder(bw),
+		closer:      w,
+	}
+}
+
+// Close flushes the trace and closes the underlying writer.
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.w.Flush(); err != nil {
+		return errors.Wrap(err, "could not flush fork choice trace")
+	}
+	return r.closer.Close()
+}
+
+func (r *Recorder) record(step *Step, flush bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	step.Time = uint64(prysmTime.Now().UnixMilli())
+	err := r.enc.Encode(step)
+	if err == nil && flush {
+		err = r.w.Flush()
+	}
+	if err != nil && !r.failed {
+		// Only log the first failure, the trace is unusable from there on.
+		r.failed = true
+		log.WithError(err).Error("Could not record fork choice step")
+	}
+}
+
+// InsertNode records the block and the checkpoints of its post state before inserting it.
+func (r *Recorder) InsertNode(ctx context.Context, st state.BeaconState, root [32]byte) error {
+	if b, err := BlockFromState(st, root); err != nil {
+		log.WithError(err).Debug("Could not record inserted block")
+	} else {
+		r.record(&Step{Block: b}, false)
+	}
+	return r.ForkChoicer.InsertNode(ctx, st, root)
+}
+
+// InsertChain records the blocks of the chain, parents first, before inserting them.
+func (r *Recorder) InsertChain(ctx context.Context, chain []*forkchoicetypes.BlockAndCheckpoints) error {
+	for i := len(chain) - 1; i > 0; i-- {
+		b := chain[i].Block
+		root := chain[i-1].Block.ParentRoot()
+		parentRoot := b.ParentRoot()
+		payloadHash, err := blocks.GetBlockPayloadHash(b)
+		if err != nil {
+			log.WithError(err).Debug("Could not record inserted chain")
+			break
+		}
+		r.record(&Step{Block: &Block{
+			Slot:                b.Slot(),
+			Root:                root[:],
+			ParentRoot:          parentRoot[:],
+			PayloadHash:         payloadHash[:],
+			JustifiedCheckpoint: CheckpointFromProto(chain[i].JustifiedCheckpoint),
+			FinalizedCheckpoint: CheckpointFromProto(chain[i].FinalizedCheckpoint),
+		}}, false)
+	}
+	return r.ForkChoicer.InsertChain(ctx, chain)
+}
+
+// ProcessAttestation records the votes before processing them.
+func (r *Recorder) ProcessAttestation(ctx context.Context, indices []uint64, root [32]byte, targetEpoch primitives.Epoch) {
+	r.record(&Step{Attestation: &Attestation{
+		ValidatorIndices: indices,
+		BlockRoot:        bytesutil.SafeCopyBytes(root[:]),
+		TargetEpoch:      targetEpoch,
+	}}, false)
+	r.ForkChoicer.ProcessAttestation(ctx, indices, root, targetEpoch)
+}
+
+// Head computes the head and records it, along with the checkpoints and proposer boost root used.
+func (r *Recorder) Head(ctx context.Context) ([32]byte, error) {
+	head, err := r.ForkChoicer.Head(ctx)
+	if err != nil {
+		return head, err
+	}
+	jc := r.ForkChoicer.JustifiedCheckpoint()
+	fc := r.ForkChoicer.FinalizedCheckpoint()
+	boost := r.ForkChoicer.ProposerBoost()
+	r.record(&Step{Check: &Check{
+		Head:                bytesutil.SafeCopyBytes(head[:]),
+		JustifiedCheckpoint: &Checkpoint{Epoch: jc.Epoch, Root: bytesutil.SafeCopyBytes(jc.Root[:])},
+		FinalizedCheckpoint: &Checkpoint{Epoch: fc.Epoch, Root: bytesutil.SafeCopyBytes(fc.Root[:])},
+		ProposerBoostRoot:   boost[:],
+	}}, true)
+	return head, nil
+}
+
+// UpdateJustifiedCheckpoint records the checkpoint before updating it.
+func (r *Recorder) UpdateJustifiedCheckpoint(ctx context.Context, cp *forkchoicetypes.Checkpoint) error {
+	if cp != nil {
+		r.record(&Step{JustifiedCheckpoint: &Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root[:])}}, false)
+	}
+	return r.ForkChoicer.UpdateJustifiedCheckpoint(ctx, cp)
+}
+
+// UpdateFinalizedCheckpoint records the checkpoint before updating it.
+func (r *Recorder) UpdateFinalizedCheckpoint(cp *forkchoicetypes.Checkpoint) error {
+	if cp != nil {
+		r.record(&Step{FinalizedCheckpoint: &Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root[:])}}, false)
+	}
+	return r.ForkChoicer.UpdateFinalizedCheckpoint(cp)
+}
+
+// SetGenesisTime records the genesis time before setting it.
+func (r *Recorder) SetGenesisTime(genesisTime uint64) {
+	r.record(&Step{GenesisTime: &genesisTime}, false)
+	r.ForkChoicer.SetGenesisTime(genesisTime)
+}
+
+// NewSlot records the slot start before processing it.
+func (r *Recorder) NewSlot(ctx context.Context, slot primitives.Slot) error {
+	r.record(&Step{NewSlot: &slot}, true)
+	return r.ForkChoicer.NewSlot(ctx, slot)
+}
+
+// InsertSlashedIndex records the slashed index before inserting it.
+func (r *Recorder) InsertSlashedIndex(ctx context.Context, index primitives.ValidatorIndex) {
+	r.record(&Step{SlashedIndex: &index}, false)
+	r.ForkChoicer.InsertSlashedIndex(ctx, index)
+}
+
+// SetOptimisticToValid records the validated block before updating it.
+func (r *Recorder) SetOptimisticToValid(ctx context.Context, root [32]byte) error {
+	r.record(&Step{ValidPayload: bytesutil.SafeCopyBytes(root[:])}, false)
+	return r.ForkChoicer.SetOptimisticToValid(ctx, root)
+}
+
+// SetOptimisticToInvalid records the invalidated block before updating it.
+func (r *Recorder) SetOptimisticToInvalid(ctx context.Context, root, parentRoot, payloadHash [32]byte) ([][32]byte, error) {
+	r.record(&Step{InvalidPayload: &InvalidPayload{
+		Root:            bytesutil.SafeCopyBytes(root[:]),
+		ParentRoot:      bytesutil.SafeCopyBytes(parentRoot[:]),
+		LatestValidHash: bytesutil.SafeCopyBytes(payloadHash[:]),
+	}}, false)
+	return r.ForkChoicer.SetOptimisticToInvalid(ctx, root, parentRoot, payloadHash)
+}
+
+// SetBalancesByRooter wraps the handler so that the balances it returns are recorded.
+func (r *Recorder) SetBalancesByRooter(handler forkchoice.BalancesByRooter) {
+	r.ForkChoicer.SetBalancesByRooter(func(ctx context.Context, root [32]byte) ([]uint64, error) {
+		balances, err := handler(ctx, root)
+		if err == nil {
+			r.record(&Step{Balances: &Balances{Root: bytesutil.SafeCopyBytes(root[:]), Balances: balances}}, false)
+		}
+		return balances, err
+	})
+}
+
+// BlockFromState returns the fork choice inputs of the block with the given root and post state.
+func BlockFromState(st state.BeaconState, root [32]byte) (*Block, error) {
+	bh := st.LatestBlockHeader()
+	if bh == nil {
+		return nil, errors.New("nil block header")
+	}
+	var payloadHash [32]byte
+	if st.Version() >= version.Bellatrix {
+		ph, err := st.LatestExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		if ph != nil {
+			copy(payloadHash[:], ph.BlockHash())
+		}
+	}
+	jc := st.CurrentJustifiedCheckpoint()
+	fc := st.FinalizedCheckpoint()
+	if jc == nil || fc == nil {
+		return nil, errors.New("nil checkpoint")
+	}
+	uj, uf, err := precompute.UnrealizedCheckpoints(st)
+	if err != nil {
+		// Fork choice falls back to the state checkpoints as well.
+		uj, uf = jc, fc
+	}
+	return &Block{
+		Slot:                          st.Slot(),
+		Root:                          bytesutil.SafeCopyBytes(root[:]),
+		ParentRoot:                    bytesutil.SafeCopyBytes(bh.ParentRoot),
+		PayloadHash:                   payloadHash[:],
+		JustifiedCheckpoint:           CheckpointFromProto(jc),
+		FinalizedCheckpoint:           CheckpointFromProto(fc),
+		UnrealizedJustifiedCheckpoint: CheckpointFromProto(uj),
+		UnrealizedFinalizedCheckpoint: CheckpointFromProto(uf),
+	}, nil
+}
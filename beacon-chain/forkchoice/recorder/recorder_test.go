@@ -0,0 +1,161 @@
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func prepareState(t *testing.T, slot primitives.Slot, root, parentRoot [32]byte) state.BeaconState {
+	cp := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	base := &ethpb.BeaconStateBellatrix{
+		Slot:                         slot,
+		RandaoMixes:                  make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		BlockRoots:                   [][]byte{root[:]},
+		CurrentJustifiedCheckpoint:   cp,
+		FinalizedCheckpoint:          cp,
+		LatestExecutionPayloadHeader: &enginev1.ExecutionPayloadHeader{BlockHash: append([]byte{'p'}, root[1:]...)},
+		LatestBlockHeader:            &ethpb.BeaconBlockHeader{ParentRoot: parentRoot[:]},
+	}
+	st, err := state_native.InitializeFromProtoBellatrix(base)
+	require.NoError(t, err)
+	return st
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+	r := New(doublylinkedtree.New(), nopCloser{buf})
+	// The proposer boost outweighs the single vote of validator 0.
+	balances := make([]uint64, 32)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	balances[0] = params.BeaconConfig().EffectiveBalanceIncrement
+	r.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) { return balances, nil })
+
+	// The last block is received at the start of its slot, so that it is boosted.
+	genesis := uint64(time.Now().Unix()) - 3*params.BeaconConfig().SecondsPerSlot
+	r.SetGenesisTime(genesis)
+	zero := params.BeaconConfig().ZeroHash
+	require.NoError(t, r.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Root: zero}))
+	require.NoError(t, r.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Root: zero}))
+	//     0
+	//    / \
+	//   1   2
+	//   |
+	//   3
+	require.NoError(t, r.InsertNode(ctx, prepareState(t, 0, zero, [32]byte{}), zero))
+	require.NoError(t, r.InsertNode(ctx, prepareState(t, 1, [32]byte{1}, zero), [32]byte{1}))
+	require.NoError(t, r.InsertNode(ctx, prepareState(t, 2, [32]byte{2}, zero), [32]byte{2}))
+	r.ProcessAttestation(ctx, []uint64{0}, [32]byte{2}, 0)
+	head, err := r.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{2}, head)
+	require.NoError(t, r.InsertNode(ctx, prepareState(t, 3, [32]byte{3}, [32]byte{1}), [32]byte{3}))
+	head, err = r.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{3}, head)
+	require.NoError(t, r.Close())
+
+	steps, err := ReadSteps(buf)
+	require.NoError(t, err)
+	require.Equal(t, true, len(steps) > 8)
+
+	var results []*Result
+	replayer := NewReplayer(steps)
+	require.NoError(t, replayer.Run(ctx, func(res *Result) {
+		require.NoError(t, res.Err)
+		require.Equal(t, 0, len(res.Mismatches), strings.Join(res.Mismatches, ", "))
+		if res.Step.Check != nil {
+			results = append(results, res)
+		}
+	}))
+	require.Equal(t, 2, len(results))
+	require.Equal(t, [32]byte{2}, results[0].Head)
+	require.Equal(t, true, results[0].HeadChanged)
+	require.Equal(t, [32]byte{3}, results[1].Head)
+	require.Equal(t, primitives.Slot(3), results[1].HeadSlot)
+	require.Equal(t, 2, len(results[1].Tips))
+	require.Equal(t, 4, replayer.ForkChoice().NodeCount())
+}
+
+func TestReplayer_Mismatch(t *testing.T) {
+	genesis := uint64(time.Now().Unix())
+	zero := params.BeaconConfig().ZeroHash
+	cp := &Checkpoint{Root: zero[:]}
+	steps := []*Step{
+		{Time: genesis * 1000, GenesisTime: &genesis},
+		{Time: genesis * 1000, Balances: &Balances{Root: zero[:], Balances: []uint64{10}}},
+		{Time: genesis * 1000, JustifiedCheckpoint: cp},
+		{Time: genesis * 1000, Block: &Block{Root: zero[:], ParentRoot: make([]byte, 32), PayloadHash: make([]byte, 32), JustifiedCheckpoint: cp, FinalizedCheckpoint: cp}},
+		{Time: genesis * 1000, Check: &Check{Head: []byte{'a'}, FinalizedCheckpoint: &Checkpoint{Epoch: 1, Root: zero[:]}}},
+	}
+	var mismatches []string
+	require.NoError(t, NewReplayer(steps).Run(context.Background(), func(res *Result) {
+		require.NoError(t, res.Err)
+		mismatches = append(mismatches, res.Mismatches...)
+	}))
+	require.Equal(t, 2, len(mismatches))
+	require.Equal(t, true, strings.HasPrefix(mismatches[0], "head"))
+	require.Equal(t, true, strings.HasPrefix(mismatches[1], "finalized checkpoint"))
+}
+
+func TestReplayer_Clock(t *testing.T) {
+	// The replay does not depend on the time at which it runs, nor on the second boundaries.
+	genesis := uint64(1600000000)
+	sps := params.BeaconConfig().SecondsPerSlot
+	zero := params.BeaconConfig().ZeroHash
+	cp := &Checkpoint{Root: zero[:]}
+	slot := primitives.Slot(2)
+	steps := []*Step{
+		{Time: genesis * 1000, GenesisTime: &genesis},
+		{Time: genesis * 1000, Balances: &Balances{Root: zero[:], Balances: []uint64{10}}},
+		{Time: genesis * 1000, JustifiedCheckpoint: cp},
+		{Time: genesis * 1000, Block: &Block{Root: zero[:], ParentRoot: make([]byte, 32), PayloadHash: make([]byte, 32), JustifiedCheckpoint: cp, FinalizedCheckpoint: cp}},
+		// Received just before the boost deadline of slot 1.
+		{Time: (genesis+sps+sps/params.BeaconConfig().IntervalsPerSlot)*1000 - 1, Block: &Block{Slot: 1, Root: []byte{1: 1, 31: 0}, ParentRoot: zero[:], PayloadHash: []byte{1: 1, 31: 0}, JustifiedCheckpoint: cp, FinalizedCheckpoint: cp}},
+		{Time: (genesis+sps+sps/params.BeaconConfig().IntervalsPerSlot)*1000 - 1, Check: &Check{ProposerBoostRoot: []byte{1: 1, 31: 0}}},
+		{Time: (genesis + 2*sps) * 1000, NewSlot: &slot},
+		// Received at the boost deadline of slot 2.
+		{Time: (genesis + 2*sps + sps/params.BeaconConfig().IntervalsPerSlot) * 1000, Block: &Block{Slot: 2, Root: []byte{1: 2, 31: 0}, ParentRoot: []byte{1: 1, 31: 0}, PayloadHash: []byte{1: 2, 31: 0}, JustifiedCheckpoint: cp, FinalizedCheckpoint: cp}},
+		{Time: (genesis + 2*sps + sps/params.BeaconConfig().IntervalsPerSlot) * 1000, Check: &Check{ProposerBoostRoot: zero[:]}},
+	}
+	require.NoError(t, NewReplayer(steps).Run(context.Background(), func(res *Result) {
+		require.NoError(t, res.Err)
+		require.Equal(t, 0, len(res.Mismatches), strings.Join(res.Mismatches, ", "))
+	}))
+}
+
+func TestReadSteps(t *testing.T) {
+	slot := primitives.Slot(5)
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteSteps(buf, []*Step{{Time: 1, NewSlot: &slot}, {Time: 2, SlashedIndex: new(primitives.ValidatorIndex)}}))
+	steps, err := ReadSteps(buf)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(steps))
+	require.Equal(t, slot, *steps[0].NewSlot)
+	require.Equal(t, uint64(2), steps[1].Time)
+
+	_, err = ReadSteps(strings.NewReader("{\"time\":1}\nnot json\n"))
+	require.ErrorContains(t, "could not decode step at line 2", err)
+}
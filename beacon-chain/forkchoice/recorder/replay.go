@@ -0,0 +1,181 @@
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+)
+
+// Result is the outcome of replaying a step of a trace.
+type Result struct {
+	Index int
+	Step  *Step
+	// Err is the error returned by fork choice when applying the step.
+	Err error
+	// The head and the weights of the tips are only set for check steps.
+	Head        [32]byte
+	HeadSlot    primitives.Slot
+	HeadWeight  uint64
+	HeadChanged bool
+	Tips        []*Tip
+	// Mismatches lists the checks of the step that fork choice does not satisfy.
+	Mismatches []string
+}
+
+// Tip is a leaf of the fork choice store and its weight.
+type Tip struct {
+	Root   [32]byte
+	Slot   primitives.Slot
+	Weight uint64
+}
+
+// Replayer replays a trace against a new fork choice store. The clock of the store is set to the
+// time of every step, so that fork choice computes the same slots and proposer boosts as when the
+// trace was recorded, regardless of when it is replayed.
+type Replayer struct {
+	f        *doublylinkedtree.ForkChoice
+	steps    []*Step
+	balances map[[32]byte][]uint64
+	now      time.Time
+	head     [32]byte
+}
+
+// NewReplayer returns a replayer of the given trace.
+func NewReplayer(steps []*Step) *Replayer {
+	r := &Replayer{
+		f:        doublylinkedtree.New(),
+		steps:    steps,
+		balances: make(map[[32]byte][]uint64),
+	}
+	// Balances are recorded once fork choice obtained them, which happens while applying an earlier
+	// step. Balances only depend on the block root, so they are all loaded upfront.
+	for _, step := range steps {
+		if step.Balances != nil {
+			r.balances[bytesutil.ToBytes32(step.Balances.Root)] = step.Balances.Balances
+		}
+	}
+	r.f.SetClock(func() time.Time { return r.now })
+	r.f.SetBalancesByRooter(func(_ context.Context, root [32]byte) ([]uint64, error) {
+		balances, ok := r.balances[root]
+		if !ok {
+			return nil, fmt.Errorf("no balances recorded for root %#x", root)
+		}
+		return balances, nil
+	})
+	return r
+}
+
+// ForkChoice returns the fork choice store the trace is replayed against.
+func (r *Replayer) ForkChoice() *doublylinkedtree.ForkChoice {
+	return r.f
+}
+
+// Run replays every step of the trace in order, calling the handler with the result of each step.
+func (r *Replayer) Run(ctx context.Context, handler func(*Result)) error {
+	for i, step := range r.steps {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		res := r.apply(ctx, step)
+		res.Index = i
+		handler(res)
+	}
+	return nil
+}
+
+func (r *Replayer) apply(ctx context.Context, step *Step) *Result {
+	res := &Result{Step: step}
+	if step.GenesisTime != nil {
+		r.f.SetGenesisTime(*step.GenesisTime)
+	}
+	r.now = time.UnixMilli(int64(step.Time)) // lint:ignore uintcast -- Step times will not exceed int64 in your lifetime.
+
+	r.f.Lock()
+	defer r.f.Unlock()
+	switch {
+	case step.Block != nil:
+		b := step.Block
+		if b.JustifiedCheckpoint == nil || b.FinalizedCheckpoint == nil {
+			res.Err = errors.New("block without checkpoints")
+			break
+		}
+		res.Err = r.f.InsertNodeWithCheckpoints(ctx, b.Slot, bytesutil.ToBytes32(b.Root), bytesutil.ToBytes32(b.ParentRoot),
+			bytesutil.ToBytes32(b.PayloadHash), b.JustifiedCheckpoint.ToProto(), b.FinalizedCheckpoint.ToProto(),
+			b.UnrealizedJustifiedCheckpoint.ToProto(), b.UnrealizedFinalizedCheckpoint.ToProto())
+	case step.Attestation != nil:
+		a := step.Attestation
+		r.f.ProcessAttestation(ctx, a.ValidatorIndices, bytesutil.ToBytes32(a.BlockRoot), a.TargetEpoch)
+	case step.JustifiedCheckpoint != nil:
+		cp := step.JustifiedCheckpoint
+		res.Err = r.f.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)})
+	case step.FinalizedCheckpoint != nil:
+		cp := step.FinalizedCheckpoint
+		res.Err = r.f.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)})
+	case step.NewSlot != nil:
+		res.Err = r.f.NewSlot(ctx, *step.NewSlot)
+	case step.SlashedIndex != nil:
+		r.f.InsertSlashedIndex(ctx, *step.SlashedIndex)
+	case step.ValidPayload != nil:
+		res.Err = r.f.SetOptimisticToValid(ctx, bytesutil.ToBytes32(step.ValidPayload))
+	case step.InvalidPayload != nil:
+		p := step.InvalidPayload
+		_, res.Err = r.f.SetOptimisticToInvalid(ctx, bytesutil.ToBytes32(p.Root), bytesutil.ToBytes32(p.ParentRoot), bytesutil.ToBytes32(p.LatestValidHash))
+	case step.Check != nil:
+		r.check(ctx, step.Check, res)
+	}
+	return res
+}
+
+func (r *Replayer) check(ctx context.Context, c *Check, res *Result) {
+	head, err := r.f.Head(ctx)
+	if err != nil {
+		res.Err = err
+		return
+	}
+	res.Head = head
+	res.HeadChanged = head != r.head
+	r.head = head
+	if slot, err := r.f.Slot(head); err == nil {
+		res.HeadSlot = slot
+	}
+	if weight, err := r.f.Weight(head); err == nil {
+		res.HeadWeight = weight
+	}
+	roots, slots := r.f.Tips()
+	for i, root := range roots {
+		weight, err := r.f.Weight(root)
+		if err != nil {
+			continue
+		}
+		res.Tips = append(res.Tips, &Tip{Root: root, Slot: slots[i], Weight: weight})
+	}
+
+	if len(c.Head) > 0 && !bytes.Equal(c.Head, head[:]) {
+		res.Mismatches = append(res.Mismatches, fmt.Sprintf("head: got %#x, want %#x", head, []byte(c.Head)))
+	}
+	if cp := c.JustifiedCheckpoint; cp != nil {
+		got := r.f.JustifiedCheckpoint()
+		if got.Epoch != cp.Epoch || !bytes.Equal(got.Root[:], cp.Root) {
+			res.Mismatches = append(res.Mismatches, fmt.Sprintf("justified checkpoint: got %d %#x, want %d %#x", got.Epoch, got.Root, cp.Epoch, []byte(cp.Root)))
+		}
+	}
+	if cp := c.FinalizedCheckpoint; cp != nil {
+		got := r.f.FinalizedCheckpoint()
+		if got.Epoch != cp.Epoch || !bytes.Equal(got.Root[:], cp.Root) {
+			res.Mismatches = append(res.Mismatches, fmt.Sprintf("finalized checkpoint: got %d %#x, want %d %#x", got.Epoch, got.Root, cp.Epoch, []byte(cp.Root)))
+		}
+	}
+	if len(c.ProposerBoostRoot) > 0 {
+		got := r.f.ProposerBoost()
+		if !bytes.Equal(got[:], c.ProposerBoostRoot) {
+			res.Mismatches = append(res.Mismatches, fmt.Sprintf("proposer boost root: got %#x, want %#x", got, []byte(c.ProposerBoostRoot)))
+		}
+	}
+}
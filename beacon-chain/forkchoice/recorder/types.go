@@ -0,0 +1,91 @@
+package recorder
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// Step is a single entry of a fork choice trace. It holds either one input of fork choice or a check
+// of the fork choice outputs at that point of the trace.
+type Step struct {
+	// Time is the unix time in milliseconds at which the step happened.
+	Time                uint64                     `json:"time_ms"`
+	GenesisTime         *uint64                    `json:"genesis_time,omitempty"`
+	Block               *Block                     `json:"block,omitempty"`
+	Attestation         *Attestation               `json:"attestation,omitempty"`
+	JustifiedCheckpoint *Checkpoint                `json:"justified_checkpoint,omitempty"`
+	FinalizedCheckpoint *Checkpoint                `json:"finalized_checkpoint,omitempty"`
+	NewSlot             *primitives.Slot           `json:"new_slot,omitempty"`
+	SlashedIndex        *primitives.ValidatorIndex `json:"slashed_index,omitempty"`
+	Balances            *Balances                  `json:"balances,omitempty"`
+	ValidPayload        hexutil.Bytes              `json:"valid_payload,omitempty"`
+	InvalidPayload      *InvalidPayload            `json:"invalid_payload,omitempty"`
+	Check               *Check                     `json:"check,omitempty"`
+}
+
+// Block holds the fork choice relevant data of a block and its post state.
+type Block struct {
+	Slot                primitives.Slot `json:"slot"`
+	Root                hexutil.Bytes   `json:"root"`
+	ParentRoot          hexutil.Bytes   `json:"parent_root"`
+	PayloadHash         hexutil.Bytes   `json:"payload_hash"`
+	JustifiedCheckpoint *Checkpoint     `json:"justified_checkpoint"`
+	FinalizedCheckpoint *Checkpoint     `json:"finalized_checkpoint"`
+	// The unrealized checkpoints are the ones the post state justifies and finalizes by processing the
+	// attestations included so far in its epoch. They are not set for blocks inserted as part of a chain.
+	UnrealizedJustifiedCheckpoint *Checkpoint `json:"unrealized_justified_checkpoint,omitempty"`
+	UnrealizedFinalizedCheckpoint *Checkpoint `json:"unrealized_finalized_checkpoint,omitempty"`
+}
+
+// Attestation holds the votes of an attestation processed by fork choice.
+type Attestation struct {
+	ValidatorIndices []uint64         `json:"validator_indices"`
+	BlockRoot        hexutil.Bytes    `json:"block_root"`
+	TargetEpoch      primitives.Epoch `json:"target_epoch"`
+}
+
+// Balances holds the active balances of the state of the given block root, as used by fork choice
+// for the justified checkpoint.
+type Balances struct {
+	Root     hexutil.Bytes `json:"root"`
+	Balances []uint64      `json:"balances"`
+}
+
+// InvalidPayload marks the payload of a block as invalid.
+type InvalidPayload struct {
+	Root            hexutil.Bytes `json:"root"`
+	ParentRoot      hexutil.Bytes `json:"parent_root"`
+	LatestValidHash hexutil.Bytes `json:"latest_valid_hash"`
+}
+
+// Check holds the fork choice outputs expected at a point of the trace. Unset fields are not checked.
+type Check struct {
+	Head                hexutil.Bytes `json:"head,omitempty"`
+	JustifiedCheckpoint *Checkpoint   `json:"justified_checkpoint,omitempty"`
+	FinalizedCheckpoint *Checkpoint   `json:"finalized_checkpoint,omitempty"`
+	ProposerBoostRoot   hexutil.Bytes `json:"proposer_boost_root,omitempty"`
+}
+
+// Checkpoint is the JSON representation of a checkpoint.
+type Checkpoint struct {
+	Epoch primitives.Epoch `json:"epoch"`
+	Root  hexutil.Bytes    `json:"root"`
+}
+
+// CheckpointFromProto converts a checkpoint to its trace representation.
+func CheckpointFromProto(cp *ethpb.Checkpoint) *Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root)}
+}
+
+// ToProto converts the checkpoint to its protobuf representation.
+func (c *Checkpoint) ToProto() *ethpb.Checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &ethpb.Checkpoint{Epoch: c.Epoch, Root: bytesutil.SafeCopyBytes(c.Root)}
+}
@@ -21,3 +21,51 @@ type BlockAndCheckpoints struct {
 	JustifiedCheckpoint *ethpb.Checkpoint
 	FinalizedCheckpoint *ethpb.Checkpoint
 }
+
+// Stages at which the late block reorg heuristic is evaluated.
+const (
+	// ReorgStageFCU is the evaluation, in the slot before proposing, of whether to withhold the forkchoice
+	// update of a late head block.
+	ReorgStageFCU = "fcu"
+	// ReorgStageProposal is the evaluation, at proposal time, of whether to build on the parent of the head.
+	ReorgStageProposal = "proposal"
+)
+
+// Reasons for which a late block reorg is not attempted.
+const (
+	ReorgSkipDisabled              = "disabled"
+	ReorgSkipNoHead                = "no_head"
+	ReorgSkipHeadNotLatestSlot     = "head_not_latest_slot"
+	ReorgSkipEpochBoundary         = "epoch_boundary"
+	ReorgSkipHeadTimely            = "head_timely"
+	ReorgSkipNotFinalizing         = "not_finalizing"
+	ReorgSkipParentNotPreviousSlot = "parent_not_previous_slot"
+	ReorgSkipHeadStrong            = "head_weight_above_threshold"
+	ReorgSkipParentWeak            = "parent_weight_below_threshold"
+	ReorgSkipProposalTooLate       = "proposal_offset_exceeded"
+	ReorgSkipError                 = "error"
+)
+
+// ReorgDecision is the outcome of evaluating the late block reorg heuristic on the head of fork choice.
+type ReorgDecision struct {
+	Stage           string
+	ProposalSlot    primitives.Slot
+	HeadRoot        [32]byte
+	HeadSlot        primitives.Slot
+	ParentRoot      [32]byte
+	HeadWeight      uint64
+	ParentWeight    uint64
+	CommitteeWeight uint64
+	// Reorg is true if the head is to be reorged.
+	Reorg bool
+	// Reason is the reason the reorg is not attempted, it is empty when Reorg is true.
+	Reason string
+}
+
+// ProposerHead returns the block root to be used as parent by the proposer.
+func (d *ReorgDecision) ProposerHead() [32]byte {
+	if d.Reorg {
+		return d.ParentRoot
+	}
+	return d.HeadRoot
+}
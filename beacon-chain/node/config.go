@@ -9,7 +9,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/math"
 	tracing2 "github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
@@ -74,9 +76,51 @@ func configureBuilderCircuitBreaker(cliCtx *cli.Context) error {
 			return err
 		}
 	}
+	if cliCtx.IsSet(flags.MinBuilderBid.Name) {
+		minBid := cliCtx.String(flags.MinBuilderBid.Name)
+		if _, err := math.ParseWei(minBid); err != nil {
+			return fmt.Errorf("invalid %s value %q: %w", flags.MinBuilderBid.Name, minBid, err)
+		}
+		c := params.BeaconConfig().Copy()
+		c.MinBuilderBid = minBid
+		if err := params.SetActive(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func configureReorgLateBlocks(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(flags.ReorgHeadWeightThreshold.Name) && !cliCtx.IsSet(flags.ReorgParentWeightThreshold.Name) &&
+		!cliCtx.IsSet(flags.ReorgMaxEpochsSinceFinalization.Name) && !cliCtx.IsSet(flags.ReorgMaxProposalOffset.Name) {
+		return nil
+	}
+	c := params.BeaconConfig().Copy()
+	if cliCtx.IsSet(flags.ReorgHeadWeightThreshold.Name) {
+		c.ReorgWeightThreshold = cliCtx.Uint64(flags.ReorgHeadWeightThreshold.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgParentWeightThreshold.Name) {
+		c.ReorgParentWeightThreshold = cliCtx.Uint64(flags.ReorgParentWeightThreshold.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgMaxEpochsSinceFinalization.Name) {
+		c.ReorgMaxEpochsSinceFinalization = primitives.Epoch(cliCtx.Uint64(flags.ReorgMaxEpochsSinceFinalization.Name))
+	}
+	if cliCtx.IsSet(flags.ReorgMaxProposalOffset.Name) {
+		offset := cliCtx.Uint64(flags.ReorgMaxProposalOffset.Name)
+		if offset >= c.SecondsPerSlot {
+			return fmt.Errorf("--%s must be lower than the slot duration of %d seconds", flags.ReorgMaxProposalOffset.Name, c.SecondsPerSlot)
+		}
+		c.ReorgMaxProposalOffset = offset
+	}
+	log.WithFields(logrus.Fields{
+		"headWeightThreshold":        c.ReorgWeightThreshold,
+		"parentWeightThreshold":      c.ReorgParentWeightThreshold,
+		"maxEpochsSinceFinalization": c.ReorgMaxEpochsSinceFinalization,
+		"maxProposalOffsetSeconds":   c.ReorgMaxProposalOffset,
+	}).Info("Late block reorg settings overridden")
+	return params.SetActive(c)
+}
+
 func configureSlotsPerArchivedPoint(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
 		c := params.BeaconConfig().Copy()
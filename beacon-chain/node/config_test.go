@@ -53,6 +53,45 @@ func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	assert.Equal(t, primitives.Slot(100), params.BeaconConfig().SlotsPerArchivedPoint)
 }
 
+func TestConfigureReorgLateBlocks(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.ReorgHeadWeightThreshold.Name, 0, "")
+	set.Uint64(flags.ReorgMaxEpochsSinceFinalization.Name, 0, "")
+	set.Uint64(flags.ReorgMaxProposalOffset.Name, 0, "")
+	require.NoError(t, set.Set(flags.ReorgHeadWeightThreshold.Name, "30"))
+	require.NoError(t, set.Set(flags.ReorgMaxEpochsSinceFinalization.Name, "4"))
+	require.NoError(t, set.Set(flags.ReorgMaxProposalOffset.Name, "1"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	parentThreshold := params.BeaconConfig().ReorgParentWeightThreshold
+	require.NoError(t, configureReorgLateBlocks(cliCtx))
+	assert.Equal(t, uint64(30), params.BeaconConfig().ReorgWeightThreshold)
+	assert.Equal(t, parentThreshold, params.BeaconConfig().ReorgParentWeightThreshold)
+	assert.Equal(t, primitives.Epoch(4), params.BeaconConfig().ReorgMaxEpochsSinceFinalization)
+	assert.Equal(t, uint64(1), params.BeaconConfig().ReorgMaxProposalOffset)
+
+	require.NoError(t, set.Set(flags.ReorgMaxProposalOffset.Name, strconv.FormatUint(params.BeaconConfig().SecondsPerSlot, 10)))
+	require.ErrorContains(t, "must be lower than the slot duration", configureReorgLateBlocks(cliCtx))
+}
+
+func TestConfigureBuilderCircuitBreaker_MinBuilderBid(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.MinBuilderBid.Name, "", "")
+	require.NoError(t, set.Set(flags.MinBuilderBid.Name, "20000000000000000000"))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, configureBuilderCircuitBreaker(cliCtx))
+	assert.Equal(t, "20000000000000000000", params.BeaconConfig().MinBuilderBid)
+
+	require.NoError(t, set.Set(flags.MinBuilderBid.Name, "-1"))
+	require.ErrorContains(t, "invalid min-builder-bid value", configureBuilderCircuitBreaker(cliCtx))
+}
+
 func TestConfigureProofOfWork(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
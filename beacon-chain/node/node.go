@@ -25,11 +25,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v4/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/recorder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/gateway"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/node/registration"
@@ -56,6 +58,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/container/slice"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/prometheus"
 	"github.com/prysmaticlabs/prysm/v4/runtime"
@@ -75,6 +78,8 @@ type serviceFlagOpts struct {
 	blockchainFlagOpts     []blockchain.Option
 	executionChainFlagOpts []execution.Option
 	builderOpts            []builder.Option
+	backfillOpts           []backfill.ServiceOption
+	prunerOpts             []pruner.ServiceOption
 }
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -108,6 +113,7 @@ type BeaconNode struct {
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
 	forkChoicer             forkchoice.ForkChoicer
+	forkChoiceRecorder      *recorder.Recorder
 	clockWaiter             startup.ClockWaiter
 	initialSyncComplete     chan struct{}
 }
@@ -139,6 +145,9 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := configureReorgLateBlocks(cliCtx); err != nil {
+		return nil, err
+	}
 	if err := configureSlotsPerArchivedPoint(cliCtx); err != nil {
 		return nil, err
 	}
@@ -191,6 +200,15 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	beacon.clockWaiter = synchronizer
 
 	beacon.forkChoicer = doublylinkedtree.New()
+	if traceFile := cliCtx.String(flags.ForkChoiceTraceFileFlag.Name); traceFile != "" {
+		f, err := os.OpenFile(traceFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open fork choice trace file")
+		}
+		log.WithField("path", traceFile).Warn("Recording fork choice inputs, this slows down block processing")
+		beacon.forkChoiceRecorder = recorder.New(beacon.forkChoicer, f)
+		beacon.forkChoicer = beacon.forkChoiceRecorder
+	}
 	depositAddress, err := execution.DepositContractAddress()
 	if err != nil {
 		return nil, err
@@ -250,6 +268,16 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(bfs); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(bfs); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
@@ -354,6 +382,11 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.WithError(err).Error("Failed to close database")
 	}
+	if b.forkChoiceRecorder != nil {
+		if err := b.forkChoiceRecorder.Close(); err != nil {
+			log.WithError(err).Error("Failed to close fork choice trace")
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
@@ -679,6 +712,11 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}) erro
 		return err
 	}
 
+	kzgVerifier, err := kzg.Default()
+	if err != nil {
+		return errors.Wrap(err, "could not load KZG trusted setup")
+	}
+
 	rs := regularsync.NewService(
 		b.ctx,
 		regularsync.WithDatabase(b.db),
@@ -699,16 +737,49 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}) erro
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
 		regularsync.WithClockWaiter(b.clockWaiter),
 		regularsync.WithInitialSyncComplete(initialSyncComplete),
+		regularsync.WithKZGVerifier(kzgVerifier),
 	)
 	return b.services.RegisterService(rs)
 }
 
+func (b *BeaconNode) registerBackfillService(bfs *backfill.Status) error {
+	opts := append([]backfill.ServiceOption{
+		backfill.WithDatabase(b.db),
+		backfill.WithP2P(b.fetchP2P()),
+		backfill.WithClockWaiter(b.clockWaiter),
+		backfill.WithInitialSyncComplete(b.initialSyncComplete),
+	}, b.serviceFlagOpts.backfillOpts...)
+	bf, err := backfill.NewService(b.ctx, bfs, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize backfill service")
+	}
+	return b.services.RegisterService(bf)
+}
+
+func (b *BeaconNode) registerPrunerService(bfs *backfill.Status) error {
+	opts := append([]pruner.ServiceOption{
+		pruner.WithDatabase(b.db),
+		pruner.WithBackfillStatus(bfs),
+		pruner.WithClockWaiter(b.clockWaiter),
+	}, b.serviceFlagOpts.prunerOpts...)
+	p, err := pruner.NewService(b.ctx, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize pruner service")
+	}
+	return b.services.RegisterService(p)
+}
+
 func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
 		return err
 	}
 
+	kzgVerifier, err := kzg.Default()
+	if err != nil {
+		return errors.Wrap(err, "could not load KZG trusted setup")
+	}
+
 	is := initialsync.NewService(b.ctx, &initialsync.Config{
 		DB:                  b.db,
 		Chain:               chainService,
@@ -717,6 +788,7 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 		BlockNotifier:       b,
 		ClockWaiter:         b.clockWaiter,
 		InitialSyncComplete: complete,
+		KZGVerifier:         kzgVerifier,
 	})
 	return b.services.RegisterService(is)
 }
@@ -734,6 +806,19 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
+	var rescan *slasher.RescanConfig
+	if b.cliCtx.Bool(flags.SlasherRescanFlag.Name) {
+		rescan = &slasher.RescanConfig{}
+		if b.cliCtx.IsSet(flags.SlasherRescanStartEpochFlag.Name) {
+			epoch := primitives.Epoch(b.cliCtx.Uint64(flags.SlasherRescanStartEpochFlag.Name))
+			rescan.StartEpoch = &epoch
+		}
+		if b.cliCtx.IsSet(flags.SlasherRescanEndEpochFlag.Name) {
+			epoch := primitives.Epoch(b.cliCtx.Uint64(flags.SlasherRescanEndEpochFlag.Name))
+			rescan.EndEpoch = &epoch
+		}
+	}
+
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
@@ -745,6 +830,8 @@ func (b *BeaconNode) registerSlasherService() error {
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
 		ClockWaiter:             b.clockWaiter,
+		BeaconDatabase:          b.db,
+		Rescan:                  rescan,
 	})
 	if err != nil {
 		return err
@@ -801,6 +888,11 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
 
+	kzgVerifier, err := kzg.Default()
+	if err != nil {
+		return errors.Wrap(err, "could not load KZG trusted setup")
+	}
+
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		ExecutionEngineCaller:         web3Service,
@@ -822,6 +914,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		ForkFetcher:                   chainService,
 		ForkchoiceFetcher:             chainService,
 		FinalizationFetcher:           chainService,
+		LightClientFetcher:            chainService,
 		BlockReceiver:                 chainService,
 		AttestationReceiver:           chainService,
 		GenesisTimeFetcher:            chainService,
@@ -835,6 +928,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
 		ExecutionChainInfoFetcher:     web3Service,
+		ExecutionClientVersionFetcher: web3Service,
 		ChainStartFetcher:             chainStartFetcher,
 		MockEth1Votes:                 mockEth1DataVotes,
 		SyncService:                   syncService,
@@ -850,6 +944,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		BlockBuilder:                  b.fetchBuilderService(),
 		Router:                        router,
 		ClockWaiter:                   b.clockWaiter,
+		KZGVerifier:                   kzgVerifier,
 	})
 
 	return b.services.RegisterService(rpcService)
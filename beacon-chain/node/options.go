@@ -3,7 +3,9 @@ package node
 import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
 )
 
 // Option for beacon node configuration.
@@ -32,3 +34,19 @@ func WithBuilderFlagOptions(opts []builder.Option) Option {
 		return nil
 	}
 }
+
+// WithPrunerFlagOptions includes functional options for the history pruner service related to CLI flags.
+func WithPrunerFlagOptions(opts []pruner.ServiceOption) Option {
+	return func(bn *BeaconNode) error {
+		bn.serviceFlagOpts.prunerOpts = opts
+		return nil
+	}
+}
+
+// WithBackfillFlagOptions includes functional options for the backfill service related to CLI flags.
+func WithBackfillFlagOptions(opts []backfill.ServiceOption) Option {
+	return func(bn *BeaconNode) error {
+		bn.serviceFlagOpts.backfillOpts = opts
+		return nil
+	}
+}
@@ -12,6 +12,7 @@ type MockBroadcaster struct {
 	BroadcastCalled       bool
 	BroadcastMessages     []proto.Message
 	BroadcastAttestations []*ethpb.Attestation
+	BroadcastBlobs        []*ethpb.BlobSidecar
 }
 
 // Broadcast records a broadcast occurred.
@@ -33,3 +34,10 @@ func (m *MockBroadcaster) BroadcastSyncCommitteeMessage(_ context.Context, _ uin
 	m.BroadcastCalled = true
 	return nil
 }
+
+// BroadcastBlob records a broadcast occurred.
+func (m *MockBroadcaster) BroadcastBlob(_ context.Context, _ uint64, b *ethpb.BlobSidecar) error {
+	m.BroadcastCalled = true
+	m.BroadcastBlobs = append(m.BroadcastBlobs, b)
+	return nil
+}
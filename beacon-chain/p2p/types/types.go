@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
 const rootLength = 32
@@ -80,6 +81,98 @@ func (r *BeaconBlockByRootsReq) UnmarshalSSZ(buf []byte) error {
 	return nil
 }
 
+// BlobSidecarsByRootReq is used to specify a list of blob targets (root+index) in a BlobSidecarsByRoot RPC request.
+type BlobSidecarsByRootReq []*eth.BlobIdentifier
+
+// BlobIdentifier is a fixed size value, so we can compute its fixed size at start time (see init below)
+var blobIdSize int
+
+// MarshalSSZTo appends the serialized BlobSidecarsByRootReq value to the provided byte slice.
+func (b *BlobSidecarsByRootReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	marshalledObj, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, marshalledObj...), nil
+}
+
+// MarshalSSZ serializes the BlobSidecarsByRootReq value to a byte slice.
+func (b *BlobSidecarsByRootReq) MarshalSSZ() ([]byte, error) {
+	if len(*b) > int(params.BeaconConfig().MaxRequestBlobSidecars) {
+		return nil, errors.Errorf("blob sidecars by root request exceeds max size: %d > %d", len(*b), params.BeaconConfig().MaxRequestBlobSidecars)
+	}
+	buf := make([]byte, 0, b.SizeSSZ())
+	for _, id := range *b {
+		by, err := id.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, by...)
+	}
+	return buf, nil
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (b *BlobSidecarsByRootReq) SizeSSZ() int {
+	return len(*b) * blobIdSize
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// BlobSidecarsByRootReq value.
+func (b *BlobSidecarsByRootReq) UnmarshalSSZ(buf []byte) error {
+	bufLen := len(buf)
+	maxLength := int(params.BeaconConfig().MaxRequestBlobSidecars) * blobIdSize
+	if bufLen > maxLength {
+		return errors.Errorf("expected buffer with length of up to %d but received length %d", maxLength, bufLen)
+	}
+	if bufLen%blobIdSize != 0 {
+		return ssz.ErrIncorrectByteSize
+	}
+	count := bufLen / blobIdSize
+	*b = make([]*eth.BlobIdentifier, count)
+	for i := 0; i < count; i++ {
+		id := &eth.BlobIdentifier{}
+		if err := id.UnmarshalSSZ(buf[i*blobIdSize : (i+1)*blobIdSize]); err != nil {
+			return err
+		}
+		(*b)[i] = id
+	}
+	return nil
+}
+
+func init() {
+	sizer := &eth.BlobIdentifier{}
+	blobIdSize = sizer.SizeSSZ()
+}
+
+// LightClientBootstrapReq specifies the block root of a light client bootstrap request.
+type LightClientBootstrapReq [rootLength]byte
+
+// MarshalSSZTo marshals the light client bootstrap request with the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ marshals the light client bootstrap request into the serialized object.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, rootLength))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (r *LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client bootstrap request object.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return ssz.ErrSize
+	}
+	copy(r[:], buf)
+	return nil
+}
+
 // ErrorMessage describes the error message type.
 type ErrorMessage []byte
 
@@ -4,8 +4,11 @@ import (
 	"encoding/hex"
 	"testing"
 
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 )
@@ -28,6 +31,27 @@ func TestBeaconBlockByRootsReq_Limit(t *testing.T) {
 	require.ErrorContains(t, "expected buffer with length of upto", req2.UnmarshalSSZ(buf))
 }
 
+func TestBlobSidecarsByRootReq_Limit(t *testing.T) {
+	ids := make([]*eth.BlobIdentifier, 0)
+	for i := uint64(0); i < params.BeaconConfig().MaxRequestBlobSidecars+1; i++ {
+		ids = append(ids, &eth.BlobIdentifier{BlockRoot: bytesutil.PadTo([]byte{byte(i)}, 32), Index: i % 6})
+	}
+	req := BlobSidecarsByRootReq(ids)
+
+	_, err := req.MarshalSSZ()
+	require.ErrorContains(t, "blob sidecars by root request exceeds max size", err)
+
+	buf := make([]byte, 0)
+	for _, id := range ids {
+		b, err := id.MarshalSSZ()
+		require.NoError(t, err)
+		buf = append(buf, b...)
+	}
+	req2 := BlobSidecarsByRootReq(nil)
+	require.ErrorContains(t, "expected buffer with length of up to", req2.UnmarshalSSZ(buf))
+	require.ErrorIs(t, req2.UnmarshalSSZ(buf[:blobIdSize+1]), ssz.ErrIncorrectByteSize)
+}
+
 func TestErrorResponse_Limit(t *testing.T) {
 	errorMessage := make([]byte, 0)
 	// Provide a message of size 6400 bytes.
@@ -42,6 +66,39 @@ func TestErrorResponse_Limit(t *testing.T) {
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestBlocksByRootReq(t)
 	roundTripTestErrorMessage(t)
+	roundTripTestBlobSidecarsByRootReq(t)
+	roundTripTestLightClientBootstrapReq(t)
+}
+
+func roundTripTestLightClientBootstrapReq(t *testing.T) {
+	req := LightClientBootstrapReq{'a', 'b', 'c'}
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, rootLength, len(marshalledObj))
+	newVal := LightClientBootstrapReq{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.Equal(t, req, newVal)
+	require.ErrorIs(t, newVal.UnmarshalSSZ(marshalledObj[1:]), ssz.ErrSize)
+}
+
+func roundTripTestBlobSidecarsByRootReq(t *testing.T) {
+	ids := make([]*eth.BlobIdentifier, 0)
+	for i := 0; i < 20; i++ {
+		ids = append(ids, &eth.BlobIdentifier{BlockRoot: bytesutil.PadTo([]byte{byte(i)}, 32), Index: uint64(i % 6)})
+	}
+	req := BlobSidecarsByRootReq(ids)
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	newVal := BlobSidecarsByRootReq(nil)
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	require.Equal(t, len(ids), len(newVal))
+	for i := range ids {
+		assert.DeepEqual(t, ids[i], newVal[i])
+	}
 }
 
 func roundTripTestBlocksByRootReq(t *testing.T) {
@@ -418,6 +418,8 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &SignedContributionAndProofJson{}
 			case events.BLSToExecutionChangeTopic:
 				data = &SignedBLSToExecutionChangeJson{}
+			case events.LateBlockReorgTopic:
+				data = &EventLateBlockReorgJson{}
 			case events.PayloadAttributesTopic:
 				dataSubset := &dataSubset{}
 				if err := json.Unmarshal(msg.Data, dataSubset); err != nil {
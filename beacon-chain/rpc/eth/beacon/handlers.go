@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
@@ -19,6 +21,7 @@ import (
 	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v4/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
 )
 
 const (
@@ -562,6 +565,36 @@ func publishBlockV2(bs *Server, w http.ResponseWriter, r *http.Request) {
 	http2.WriteError(w, errJson)
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 deposit tree snapshot, which can be used
+// to bootstrap the deposit tree of a node syncing from a checkpoint.
+func (bs *Server) GetDepositSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetDepositSnapshot")
+	defer span.End()
+
+	if bs.DepositFetcher == nil {
+		http2.HandleError(w, "Deposit fetcher is not available", http.StatusInternalServerError)
+		return
+	}
+	snapshot, err := bs.DepositFetcher.DepositSnapshot(ctx)
+	if err != nil {
+		http2.HandleError(w, "No finalized snapshot available: "+err.Error(), http.StatusNotFound)
+		return
+	}
+	finalized := make([]string, len(snapshot.Finalized))
+	for i, f := range snapshot.Finalized {
+		finalized[i] = hexutil.Encode(f)
+	}
+	http2.WriteJson(w, &GetDepositSnapshotResponse{
+		Data: &DepositSnapshot{
+			Finalized:            finalized,
+			DepositRoot:          hexutil.Encode(snapshot.DepositRoot),
+			DepositCount:         strconv.FormatUint(snapshot.DepositCount, 10),
+			ExecutionBlockHash:   hexutil.Encode(snapshot.ExecutionHash),
+			ExecutionBlockHeight: strconv.FormatUint(snapshot.ExecutionDepth, 10),
+		},
+	})
+}
+
 func (bs *Server) proposeBlock(ctx context.Context, w http.ResponseWriter, blk *eth.GenericSignedBeaconBlock) {
 	_, err := bs.V1Alpha1ValidatorServer.ProposeBeaconBlock(ctx, blk)
 	if err != nil {
@@ -9,8 +9,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	testing2 "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/testutil"
@@ -418,6 +420,54 @@ func TestValidateEquivocation(t *testing.T) {
 	})
 }
 
+func TestGetDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dc, err := depositcache.New()
+	require.NoError(t, err)
+	server := &Server{DepositFetcher: dc}
+
+	t.Run("no finalized snapshot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		assert.StringContains(t, "No finalized snapshot available", writer.Body.String())
+	})
+	t.Run("ok", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			d := &eth.Deposit{Data: &eth.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			}}
+			require.NoError(t, dc.InsertDeposit(ctx, d, uint64(10+i), int64(i), [32]byte{}))
+		}
+		require.NoError(t, dc.InsertFinalizedDeposits(ctx, 3))
+		executionHash := bytesutil.ToBytes32([]byte("execution block"))
+		require.NoError(t, dc.FinalizeDepositTree(ctx, 4, executionHash, 13))
+		snapshot, err := dc.DepositSnapshot(ctx)
+		require.NoError(t, err)
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetDepositSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		require.Equal(t, len(snapshot.Finalized), len(resp.Data.Finalized))
+		for i, f := range snapshot.Finalized {
+			assert.Equal(t, hexutil.Encode(f), resp.Data.Finalized[i])
+		}
+		assert.Equal(t, hexutil.Encode(snapshot.DepositRoot), resp.Data.DepositRoot)
+		assert.Equal(t, "4", resp.Data.DepositCount)
+		assert.Equal(t, hexutil.Encode(executionHash[:]), resp.Data.ExecutionBlockHash)
+		assert.Equal(t, "13", resp.Data.ExecutionBlockHeight)
+	})
+}
+
 const (
 	phase0Block = `{
   "message": {
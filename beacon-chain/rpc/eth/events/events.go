@@ -15,6 +15,7 @@ import (
 	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/v4/proto/migration"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	log "github.com/sirupsen/logrus"
@@ -43,6 +44,8 @@ const (
 	BLSToExecutionChangeTopic = "bls_to_execution_change"
 	// PayloadAttributesTopic represents a new payload attributes for execution payload building event topic.
 	PayloadAttributesTopic = "payload_attributes"
+	// LateBlockReorgTopic represents a late block reorg decision of a proposer event topic.
+	LateBlockReorgTopic = "late_block_reorg"
 )
 
 var casesHandled = map[string]bool{
@@ -55,6 +58,7 @@ var casesHandled = map[string]bool{
 	SyncCommitteeContributionTopic: true,
 	BLSToExecutionChangeTopic:      true,
 	PayloadAttributesTopic:         true,
+	LateBlockReorgTopic:            true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -234,6 +238,15 @@ func (s *Server) handleStateEvents(
 			ExecutionOptimistic: blkData.Optimistic,
 		}
 		return streamData(stream, BlockTopic, eventBlock)
+	case statefeed.LateBlockReorg:
+		if _, ok := requestedTopics[LateBlockReorgTopic]; !ok {
+			return nil
+		}
+		decision, ok := event.Data.(*eth.LateBlockReorgEvent)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, LateBlockReorgTopic, decision)
 	default:
 		return nil
 	}
@@ -19,6 +19,7 @@ import (
 	prysmtime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/v4/proto/migration"
@@ -538,6 +539,42 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(LateBlockReorgTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedDecision := &eth.LateBlockReorgEvent{
+			Stage:           "proposal",
+			ProposalSlot:    9,
+			HeadRoot:        bytesutil.PadTo([]byte{'b'}, 32),
+			HeadSlot:        8,
+			ParentRoot:      bytesutil.PadTo([]byte{'a'}, 32),
+			HeadWeight:      10,
+			ParentWeight:    100,
+			CommitteeWeight: 50,
+			Reason:          "proposal_offset_exceeded",
+		}
+		genericResponse, err := anypb.New(wantedDecision)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LateBlockReorgTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LateBlockReorgTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LateBlockReorg,
+				Data: wantedDecision,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 	t.Run(BlockTopic, func(t *testing.T) {
 		ctx := context.Background()
 		srv, ctrl, mockStream := setupServer(ctx, t)
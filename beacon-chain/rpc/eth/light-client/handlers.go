@@ -0,0 +1,206 @@
+package lightclient
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+// GetLightClientBootstrap is an HTTP handler for Beacon API getLightClientBootstrap.
+// Bootstraps are only available for finalized checkpoint block roots.
+func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, r *http.Request) {
+	rawRoot := mux.Vars(r)["block_root"]
+	if !shared.ValidateHex(w, "block_root", rawRoot) {
+		return
+	}
+	root, err := hexutil.Decode(rawRoot)
+	if err != nil || len(root) != fieldparams.RootLength {
+		http2.WriteError(w, &http2.DefaultErrorJson{
+			Message: "block_root is invalid",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	bootstrap, err := s.BeaconDB.LightClientBootstrap(r.Context(), bytesutil.ToBytes32(root))
+	if err != nil {
+		http2.WriteError(w, &http2.DefaultErrorJson{
+			Message: "Could not get light client bootstrap: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	if bootstrap == nil {
+		http2.WriteError(w, &http2.DefaultErrorJson{
+			Message: "Light client bootstrap not found",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	http2.WriteJson(w, &LightClientBootstrapResponse{
+		Version: versionAtSlot(bootstrap.Header.Beacon.Slot),
+		Data: &LightClientBootstrap{
+			Header:                     headerFromConsensus(bootstrap.Header),
+			CurrentSyncCommittee:       syncCommitteeFromConsensus(bootstrap.CurrentSyncCommittee),
+			CurrentSyncCommitteeBranch: branchFromConsensus(bootstrap.CurrentSyncCommitteeBranch),
+		},
+	})
+}
+
+// GetLightClientUpdatesByRange is an HTTP handler for Beacon API getLightClientUpdatesByRange.
+// It returns the best updates of consecutive sync committee periods, stopping at the first period without
+// an update. At most MAX_REQUEST_LIGHT_CLIENT_UPDATES updates are returned.
+func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, r *http.Request) {
+	startPeriod, ok := shared.ValidateUint(w, "start_period", r.URL.Query().Get("start_period"))
+	if !ok {
+		return
+	}
+	count, ok := shared.ValidateUint(w, "count", r.URL.Query().Get("count"))
+	if !ok {
+		return
+	}
+	if count == 0 {
+		http2.WriteError(w, &http2.DefaultErrorJson{
+			Message: "count must be greater than zero",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if count > lightclient.MaxRequestLightClientUpdates {
+		count = lightclient.MaxRequestLightClientUpdates
+	}
+	updates, err := s.BeaconDB.LightClientUpdates(r.Context(), startPeriod, count)
+	if err != nil {
+		http2.WriteError(w, &http2.DefaultErrorJson{
+			Message: "Could not get light client updates: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	resp := make([]*LightClientUpdateWithVersion, len(updates))
+	for i, u := range updates {
+		resp[i] = &LightClientUpdateWithVersion{
+			Version: versionAtSlot(u.AttestedHeader.Beacon.Slot),
+			Data:    updateFromConsensus(u),
+		}
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetLightClientFinalityUpdate is an HTTP handler for Beacon API getLightClientFinalityUpdate.
+func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, _ *http.Request) {
+	update := s.LightClientFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		http2.WriteError(w, &http2.DefaultErrorJson{
+			Message: "No light client finality update available",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	http2.WriteJson(w, &LightClientFinalityUpdateResponse{
+		Version: versionAtSlot(update.AttestedHeader.Beacon.Slot),
+		Data: &LightClientFinalityUpdate{
+			AttestedHeader:  headerFromConsensus(update.AttestedHeader),
+			FinalizedHeader: headerFromConsensus(update.FinalizedHeader),
+			FinalityBranch:  branchFromConsensus(update.FinalityBranch),
+			SyncAggregate:   syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:   strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// GetLightClientOptimisticUpdate is an HTTP handler for Beacon API getLightClientOptimisticUpdate.
+func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, _ *http.Request) {
+	update := s.LightClientFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		http2.WriteError(w, &http2.DefaultErrorJson{
+			Message: "No light client optimistic update available",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	http2.WriteJson(w, &LightClientOptimisticUpdateResponse{
+		Version: versionAtSlot(update.AttestedHeader.Beacon.Slot),
+		Data: &LightClientOptimisticUpdate{
+			AttestedHeader: headerFromConsensus(update.AttestedHeader),
+			SyncAggregate:  syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:  strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// versionAtSlot returns the name of the fork active at the given slot, light client data only exists from altair.
+func versionAtSlot(slot primitives.Slot) string {
+	epoch := slots.ToEpoch(slot)
+	switch {
+	case epoch >= params.BeaconConfig().DenebForkEpoch:
+		return version.String(version.Deneb)
+	case epoch >= params.BeaconConfig().CapellaForkEpoch:
+		return version.String(version.Capella)
+	case epoch >= params.BeaconConfig().BellatrixForkEpoch:
+		return version.String(version.Bellatrix)
+	default:
+		return version.String(version.Altair)
+	}
+}
+
+func updateFromConsensus(u *ethpb.LightClientUpdate) *LightClientUpdate {
+	return &LightClientUpdate{
+		AttestedHeader:          headerFromConsensus(u.AttestedHeader),
+		NextSyncCommittee:       syncCommitteeFromConsensus(u.NextSyncCommittee),
+		NextSyncCommitteeBranch: branchFromConsensus(u.NextSyncCommitteeBranch),
+		FinalizedHeader:         headerFromConsensus(u.FinalizedHeader),
+		FinalityBranch:          branchFromConsensus(u.FinalityBranch),
+		SyncAggregate:           syncAggregateFromConsensus(u.SyncAggregate),
+		SignatureSlot:           strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+}
+
+func headerFromConsensus(h *ethpb.LightClientHeader) *LightClientHeader {
+	return &LightClientHeader{
+		Beacon: &BeaconBlockHeader{
+			Slot:          strconv.FormatUint(uint64(h.Beacon.Slot), 10),
+			ProposerIndex: strconv.FormatUint(uint64(h.Beacon.ProposerIndex), 10),
+			ParentRoot:    hexutil.Encode(h.Beacon.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Beacon.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Beacon.BodyRoot),
+		},
+	}
+}
+
+func syncCommitteeFromConsensus(c *ethpb.SyncCommittee) *SyncCommittee {
+	pubkeys := make([]string, len(c.Pubkeys))
+	for i, pk := range c.Pubkeys {
+		pubkeys[i] = hexutil.Encode(pk)
+	}
+	return &SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: hexutil.Encode(c.AggregatePubkey),
+	}
+}
+
+func syncAggregateFromConsensus(a *ethpb.SyncAggregate) *SyncAggregate {
+	return &SyncAggregate{
+		SyncCommitteeBits:      hexutil.Encode(a.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(a.SyncCommitteeSignature),
+	}
+}
+
+func branchFromConsensus(branch [][]byte) []string {
+	b := make([]string, len(branch))
+	for i, r := range branch {
+		b[i] = hexutil.Encode(r)
+	}
+	return b
+}
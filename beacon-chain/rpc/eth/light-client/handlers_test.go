@@ -0,0 +1,169 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestGetLightClientBootstrap(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	root := [32]byte{'a'}
+	bootstrap := util.HydrateLightClientBootstrap(&ethpb.LightClientBootstrap{
+		Header: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 64, ProposerIndex: 3}},
+	})
+	require.NoError(t, db.SaveLightClientBootstrap(context.Background(), root, bootstrap))
+	s := &Server{BeaconDB: db}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(root[:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &LightClientBootstrapResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.String(version.Altair), resp.Version)
+		assert.Equal(t, "64", resp.Data.Header.Beacon.Slot)
+		assert.Equal(t, "3", resp.Data.Header.Beacon.ProposerIndex)
+		assert.Equal(t, len(bootstrap.CurrentSyncCommittee.Pubkeys), len(resp.Data.CurrentSyncCommittee.Pubkeys))
+		assert.Equal(t, 5, len(resp.Data.CurrentSyncCommitteeBranch))
+	})
+	t.Run("not found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(make([]byte, 32))})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": "0x1234"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "block_root is invalid", e.Message)
+	})
+}
+
+func TestGetLightClientUpdatesByRange(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	slotsPerPeriod := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod))
+	for period := uint64(1); period <= 3; period++ {
+		u := util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+			AttestedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: slotsPerPeriod.Mul(period)}},
+			SignatureSlot:  slotsPerPeriod.Mul(period) + 1,
+		})
+		require.NoError(t, db.SaveLightClientUpdate(context.Background(), period, u))
+	}
+	s := &Server{BeaconDB: db}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/updates?start_period=2&count=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var resp []*LightClientUpdateWithVersion
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, "16385", resp[0].Data.SignatureSlot)
+		assert.Equal(t, "24577", resp[1].Data.SignatureSlot)
+	})
+	t.Run("zero count", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/updates?start_period=2&count=0", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("missing start period", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/updates?count=1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "start_period is required", e.Message)
+	})
+}
+
+func TestGetLightClientFinalityUpdate(t *testing.T) {
+	chain := &mock.ChainService{}
+	s := &Server{LightClientFetcher: chain}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/finality_update", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientFinalityUpdate(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	chain.LCFinalityUpdate = lightclient.FinalityUpdateFromUpdate(util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+		AttestedHeader:  &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 70}},
+		FinalizedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 32}},
+		SignatureSlot:   71,
+	}))
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientFinalityUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &LightClientFinalityUpdateResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "70", resp.Data.AttestedHeader.Beacon.Slot)
+	assert.Equal(t, "32", resp.Data.FinalizedHeader.Beacon.Slot)
+	assert.Equal(t, "71", resp.Data.SignatureSlot)
+	assert.Equal(t, 6, len(resp.Data.FinalityBranch))
+}
+
+func TestGetLightClientOptimisticUpdate(t *testing.T) {
+	chain := &mock.ChainService{}
+	s := &Server{LightClientFetcher: chain}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/optimistic_update", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientOptimisticUpdate(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	chain.LCOptimisticUpdate = lightclient.OptimisticUpdateFromUpdate(util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+		AttestedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 70}},
+		SignatureSlot:  71,
+	}))
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientOptimisticUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &LightClientOptimisticUpdateResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, version.String(version.Altair), resp.Version)
+	assert.Equal(t, "70", resp.Data.AttestedHeader.Beacon.Slot)
+	assert.Equal(t, "71", resp.Data.SignatureSlot)
+}
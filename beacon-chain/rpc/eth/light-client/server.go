@@ -0,0 +1,11 @@
+package lightclient
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+)
+
+type Server struct {
+	LightClientFetcher blockchain.LightClientFetcher
+	BeaconDB           db.ReadOnlyDatabase
+}
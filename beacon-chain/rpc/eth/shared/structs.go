@@ -73,6 +73,19 @@ type BeaconCommitteeSubscription struct {
 	IsAggregator     bool   `json:"is_aggregator"`
 }
 
+type BeaconCommitteeSelection struct {
+	ValidatorIndex string `json:"validator_index" validate:"required,number,gte=0"`
+	Slot           string `json:"slot" validate:"required,number,gte=0"`
+	SelectionProof string `json:"selection_proof" validate:"required,hexadecimal"`
+}
+
+type SyncCommitteeSelection struct {
+	ValidatorIndex    string `json:"validator_index" validate:"required,number,gte=0"`
+	Slot              string `json:"slot" validate:"required,number,gte=0"`
+	SubcommitteeIndex string `json:"subcommittee_index" validate:"required,number,gte=0"`
+	SelectionProof    string `json:"selection_proof" validate:"required,hexadecimal"`
+}
+
 func (s *SignedContributionAndProof) ToConsensus() (*eth.SignedContributionAndProof, error) {
 	msg, err := s.Message.ToConsensus()
 	if err != nil {
@@ -398,3 +398,61 @@ func (s *Server) SubmitBeaconCommitteeSubscription(w http.ResponseWriter, r *htt
 		core.AssignValidatorToSubnet(pubkey[:], valStatus)
 	}
 }
+
+// BeaconCommitteeSelections exchanges the partial beacon committee selection proofs of a distributed validator for
+// the combined ones. The endpoint is served by the distributed validator middleware sitting between the validator
+// client and the beacon node, a beacon node only validates the request and responds with 501 Not Implemented.
+func (s *Server) BeaconCommitteeSelections(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.BeaconCommitteeSelections")
+	defer span.End()
+
+	if r.Body == http.NoBody {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	var req BeaconCommitteeSelectionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req.Data); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.Data) == 0 {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		http2.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	http2.HandleError(w, "Beacon committee selections are only supported by distributed validator middleware", http.StatusNotImplemented)
+}
+
+// SyncCommitteeSelections exchanges the partial sync committee selection proofs of a distributed validator for
+// the combined ones. The endpoint is served by the distributed validator middleware sitting between the validator
+// client and the beacon node, a beacon node only validates the request and responds with 501 Not Implemented.
+func (s *Server) SyncCommitteeSelections(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.SyncCommitteeSelections")
+	defer span.End()
+
+	if r.Body == http.NoBody {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	var req SyncCommitteeSelectionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req.Data); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.Data) == 0 {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	validate := validator.New()
+	if err := validate.Struct(req); err != nil {
+		http2.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	http2.HandleError(w, "Sync committee selections are only supported by distributed validator middleware", http.StatusNotImplemented)
+}
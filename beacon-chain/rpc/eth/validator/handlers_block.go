@@ -0,0 +1,241 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api"
+	"github.com/prysmaticlabs/prysm/v4/api/gateway/apimiddleware"
+	rpcmiddleware "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	mathutil "github.com/prysmaticlabs/prysm/v4/math"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/proto/migration"
+	ethpbalpha "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ProduceBlockV3 requests the beacon node to produce a valid unsigned beacon block, which can then be signed by a proposer and submitted.
+// The block contains either the local execution payload or the payload header of a builder, whichever is more valuable, so that the
+// validator does not have to guess which of `/eth/v2/validator/blocks/{slot}` and `/eth/v1/validator/blinded_blocks/{slot}` to call.
+// The kind and the values of the block are returned in the Eth-Execution-Payload-Blinded, Eth-Execution-Payload-Value and
+// Eth-Consensus-Block-Value headers, the block is encoded in SSZ when requested by the Accept header.
+func (s *Server) ProduceBlockV3(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.ProduceBlockV3")
+	defer span.End()
+
+	s.produceBlockV3(ctx, w, r, false)
+}
+
+// ProduceBlockWithBuilderSettings serves the Prysm specific `/prysm/v1/validator/blocks/{slot}` endpoint. It behaves like
+// ProduceBlockV3, but also accepts the builder_min_bid, local_block_value_boost and builder_relays query parameters, with
+// which the builder settings of the validator override those of the beacon node.
+func (s *Server) ProduceBlockWithBuilderSettings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.ProduceBlockWithBuilderSettings")
+	defer span.End()
+
+	s.produceBlockV3(ctx, w, r, true)
+}
+
+func (s *Server) produceBlockV3(ctx context.Context, w http.ResponseWriter, r *http.Request, withBuilderSettings bool) {
+	if shared.IsSyncing(ctx, w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
+		return
+	}
+	rawSlot := mux.Vars(r)["slot"]
+	slot, valid := shared.ValidateUint(w, "Slot", rawSlot)
+	if !valid {
+		return
+	}
+	rawRandaoReveal := r.URL.Query().Get("randao_reveal")
+	if !shared.ValidateHex(w, "Randao reveal", rawRandaoReveal) {
+		return
+	}
+	randaoReveal, err := hexutil.Decode(rawRandaoReveal)
+	if err != nil {
+		http2.HandleError(w, "Could not decode randao reveal: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	var graffiti []byte
+	if rawGraffiti := r.URL.Query().Get("graffiti"); rawGraffiti != "" {
+		graffiti, err = hexutil.Decode(rawGraffiti)
+		if err != nil {
+			http2.HandleError(w, "Could not decode graffiti: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	req := &ethpbalpha.BlockRequest{
+		Slot:                       primitives.Slot(slot),
+		RandaoReveal:               randaoReveal,
+		Graffiti:                   graffiti,
+		IncludeConsensusBlockValue: true,
+	}
+	if withBuilderSettings {
+		if rawMinBid := r.URL.Query().Get("builder_min_bid"); rawMinBid != "" {
+			if _, err := mathutil.ParseWei(rawMinBid); err != nil {
+				http2.HandleError(w, "Builder min bid is invalid: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			req.BuilderMinBid = rawMinBid
+		}
+		if rawBoost := r.URL.Query().Get("local_block_value_boost"); rawBoost != "" {
+			boost, valid := shared.ValidateUint(w, "Local block value boost", rawBoost)
+			if !valid {
+				return
+			}
+			req.LocalBlockValueBoost = &boost
+		}
+		req.BuilderRelays = r.URL.Query()["builder_relays"]
+	}
+
+	blk, err := s.V1Alpha1Server.GetBeaconBlock(ctx, req)
+	if err != nil {
+		http2.HandleError(w, "Could not produce block: "+status.Convert(err).Message(), gwruntime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	ver, err := genericBlockVersion(blk)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	payloadValue := gweiToWei(blk.PayloadValue)
+	consensusBlockValue := gweiToWei(blk.ConsensusBlockValue)
+	w.Header().Set(api.VersionHeader, version.String(ver))
+	w.Header().Set(api.ExecutionPayloadBlindedHeader, strconv.FormatBool(blk.IsBlinded))
+	w.Header().Set(api.ExecutionPayloadValueHeader, payloadValue)
+	w.Header().Set(api.ConsensusBlockValueHeader, consensusBlockValue)
+
+	isSSZ, err := http2.SszRequested(r)
+	if isSSZ && err == nil {
+		sszBlock, err := genericBlockSSZ(blk)
+		if err != nil {
+			http2.HandleError(w, "Could not marshal block into SSZ format: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszBlock, "block.ssz")
+		return
+	}
+	data, err := genericBlockJson(blk)
+	if err != nil {
+		http2.HandleError(w, "Could not prepare beacon block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &ProduceBlockV3Response{
+		Version:                 version.String(ver),
+		ExecutionPayloadBlinded: blk.IsBlinded,
+		ExecutionPayloadValue:   payloadValue,
+		ConsensusBlockValue:     consensusBlockValue,
+		Data:                    data,
+	})
+}
+
+func gweiToWei(gwei uint64) string {
+	return new(big.Int).Mul(new(big.Int).SetUint64(gwei), big.NewInt(1e9)).String()
+}
+
+func genericBlockVersion(blk *ethpbalpha.GenericBeaconBlock) (int, error) {
+	switch blk.Block.(type) {
+	case *ethpbalpha.GenericBeaconBlock_Phase0:
+		return version.Phase0, nil
+	case *ethpbalpha.GenericBeaconBlock_Altair:
+		return version.Altair, nil
+	case *ethpbalpha.GenericBeaconBlock_Bellatrix, *ethpbalpha.GenericBeaconBlock_BlindedBellatrix:
+		return version.Bellatrix, nil
+	case *ethpbalpha.GenericBeaconBlock_Capella, *ethpbalpha.GenericBeaconBlock_BlindedCapella:
+		return version.Capella, nil
+	case *ethpbalpha.GenericBeaconBlock_Deneb, *ethpbalpha.GenericBeaconBlock_BlindedDeneb:
+		return version.Deneb, nil
+	default:
+		return 0, fmt.Errorf("unsupported block type %T", blk.Block)
+	}
+}
+
+// genericBlockSSZ encodes the block in SSZ. The v1alpha1 and the Beacon API blocks have the same SSZ encoding.
+func genericBlockSSZ(blk *ethpbalpha.GenericBeaconBlock) ([]byte, error) {
+	switch b := blk.Block.(type) {
+	case *ethpbalpha.GenericBeaconBlock_Phase0:
+		return b.Phase0.MarshalSSZ()
+	case *ethpbalpha.GenericBeaconBlock_Altair:
+		return b.Altair.MarshalSSZ()
+	case *ethpbalpha.GenericBeaconBlock_Bellatrix:
+		return b.Bellatrix.MarshalSSZ()
+	case *ethpbalpha.GenericBeaconBlock_BlindedBellatrix:
+		return b.BlindedBellatrix.MarshalSSZ()
+	case *ethpbalpha.GenericBeaconBlock_Capella:
+		return b.Capella.MarshalSSZ()
+	case *ethpbalpha.GenericBeaconBlock_BlindedCapella:
+		return b.BlindedCapella.MarshalSSZ()
+	case *ethpbalpha.GenericBeaconBlock_Deneb:
+		return b.Deneb.MarshalSSZ()
+	case *ethpbalpha.GenericBeaconBlock_BlindedDeneb:
+		return b.BlindedDeneb.MarshalSSZ()
+	default:
+		return nil, fmt.Errorf("unsupported block type %T", blk.Block)
+	}
+}
+
+// genericBlockJson encodes the block the same way as the gateway encodes the blocks of
+// `/eth/v2/validator/blocks/{slot}` and `/eth/v1/validator/blinded_blocks/{slot}`.
+func genericBlockJson(blk *ethpbalpha.GenericBeaconBlock) (json.RawMessage, error) {
+	var (
+		block     proto.Message
+		container interface{}
+		err       error
+	)
+	switch b := blk.Block.(type) {
+	case *ethpbalpha.GenericBeaconBlock_Phase0:
+		block, err = migration.V1Alpha1ToV1Block(b.Phase0)
+		container = &rpcmiddleware.BeaconBlockJson{}
+	case *ethpbalpha.GenericBeaconBlock_Altair:
+		block, err = migration.V1Alpha1BeaconBlockAltairToV2(b.Altair)
+		container = &rpcmiddleware.BeaconBlockAltairJson{}
+	case *ethpbalpha.GenericBeaconBlock_Bellatrix:
+		block, err = migration.V1Alpha1BeaconBlockBellatrixToV2(b.Bellatrix)
+		container = &rpcmiddleware.BeaconBlockBellatrixJson{}
+	case *ethpbalpha.GenericBeaconBlock_BlindedBellatrix:
+		block, err = migration.V1Alpha1BeaconBlockBlindedBellatrixToV2Blinded(b.BlindedBellatrix)
+		container = &rpcmiddleware.BlindedBeaconBlockBellatrixJson{}
+	case *ethpbalpha.GenericBeaconBlock_Capella:
+		block, err = migration.V1Alpha1BeaconBlockCapellaToV2(b.Capella)
+		container = &rpcmiddleware.BeaconBlockCapellaJson{}
+	case *ethpbalpha.GenericBeaconBlock_BlindedCapella:
+		block, err = migration.V1Alpha1BeaconBlockBlindedCapellaToV2Blinded(b.BlindedCapella)
+		container = &rpcmiddleware.BlindedBeaconBlockCapellaJson{}
+	// There is no Beacon API protobuf for Deneb blocks. The v1alpha1 field names are the same as the Beacon API ones,
+	// so the v1alpha1 blocks are encoded directly.
+	case *ethpbalpha.GenericBeaconBlock_Deneb:
+		block = b.Deneb
+		container = &rpcmiddleware.BeaconBlockContentsDenebJson{}
+	case *ethpbalpha.GenericBeaconBlock_BlindedDeneb:
+		block = b.BlindedDeneb
+		container = &rpcmiddleware.BlindedBeaconBlockDenebJson{}
+	default:
+		return nil, fmt.Errorf("unsupported block type %T for JSON encoding", blk.Block)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	if errJson := apimiddleware.DeserializeGrpcResponseBodyIntoContainer(b, container); errJson != nil {
+		return nil, errors.New(errJson.Msg())
+	}
+	if errJson := apimiddleware.ProcessMiddlewareResponseFields(container); errJson != nil {
+		return nil, errors.New(errJson.Msg())
+	}
+	return json.Marshal(container)
+}
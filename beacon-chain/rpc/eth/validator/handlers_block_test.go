@@ -0,0 +1,288 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v4/api"
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	rpcmiddleware "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpbalpha "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/mock"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestProduceBlockV3(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	randaoReveal := "0x" + string(bytes.Repeat([]byte("ab"), 96))
+
+	newRequest := func(t *testing.T, url string) *http.Request {
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		return mux.SetURLVars(request, map[string]string{"slot": "123"})
+	}
+
+	t.Run("Capella", func(t *testing.T) {
+		blk := util.NewBeaconBlockCapella().Block
+		blk.Slot = 123
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, req *ethpbalpha.BlockRequest) (*ethpbalpha.GenericBeaconBlock, error) {
+				assert.Equal(t, primitives.Slot(123), req.Slot)
+				assert.Equal(t, false, req.SkipMevBoost)
+				assert.Equal(t, true, req.IncludeConsensusBlockValue)
+				assert.Equal(t, "", req.BuilderMinBid)
+				assert.Equal(t, (*uint64)(nil), req.LocalBlockValueBoost)
+				return &ethpbalpha.GenericBeaconBlock{
+					Block:               &ethpbalpha.GenericBeaconBlock_Capella{Capella: blk},
+					PayloadValue:        2,
+					ConsensusBlockValue: 3,
+				}, nil
+			})
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123?randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		assert.Equal(t, "capella", writer.Header().Get(api.VersionHeader))
+		assert.Equal(t, "false", writer.Header().Get(api.ExecutionPayloadBlindedHeader))
+		assert.Equal(t, "2000000000", writer.Header().Get(api.ExecutionPayloadValueHeader))
+		assert.Equal(t, "3000000000", writer.Header().Get(api.ConsensusBlockValueHeader))
+		resp := &ProduceBlockV3Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "capella", resp.Version)
+		assert.Equal(t, false, resp.ExecutionPayloadBlinded)
+		assert.Equal(t, "2000000000", resp.ExecutionPayloadValue)
+		assert.Equal(t, "3000000000", resp.ConsensusBlockValue)
+		data := &rpcmiddleware.BeaconBlockCapellaJson{}
+		require.NoError(t, json.Unmarshal(resp.Data, data))
+		assert.Equal(t, "123", data.Slot)
+		require.NotNil(t, data.Body.ExecutionPayload)
+	})
+	t.Run("Blinded Capella", func(t *testing.T) {
+		blk := util.NewBlindedBeaconBlockCapella().Block
+		blk.Slot = 123
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(&ethpbalpha.GenericBeaconBlock{
+			Block:        &ethpbalpha.GenericBeaconBlock_BlindedCapella{BlindedCapella: blk},
+			IsBlinded:    true,
+			PayloadValue: 5,
+		}, nil)
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123?randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		assert.Equal(t, "true", writer.Header().Get(api.ExecutionPayloadBlindedHeader))
+		assert.Equal(t, "5000000000", writer.Header().Get(api.ExecutionPayloadValueHeader))
+		resp := &ProduceBlockV3Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.ExecutionPayloadBlinded)
+		data := &rpcmiddleware.BlindedBeaconBlockCapellaJson{}
+		require.NoError(t, json.Unmarshal(resp.Data, data))
+		assert.Equal(t, "123", data.Slot)
+		require.NotNil(t, data.Body.ExecutionPayloadHeader)
+	})
+	t.Run("Deneb", func(t *testing.T) {
+		blk := util.NewBeaconBlockDeneb().Block
+		blk.Slot = 123
+		blk.Body.ExecutionPayload.BlobGasUsed = 4
+		blk.Body.BlobKzgCommitments = [][]byte{bytes.Repeat([]byte{1}, 48)}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(&ethpbalpha.GenericBeaconBlock{
+			Block: &ethpbalpha.GenericBeaconBlock_Deneb{Deneb: &ethpbalpha.BeaconBlockContentsDeneb{
+				Block:     blk,
+				KzgProofs: [][]byte{bytes.Repeat([]byte{2}, 48)},
+				Blobs:     [][]byte{bytes.Repeat([]byte{3}, 131072)},
+			}},
+		}, nil)
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123?randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		assert.Equal(t, "deneb", writer.Header().Get(api.VersionHeader))
+		resp := &ProduceBlockV3Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "deneb", resp.Version)
+		data := &rpcmiddleware.BeaconBlockContentsDenebJson{}
+		require.NoError(t, json.Unmarshal(resp.Data, data))
+		require.NotNil(t, data.Block)
+		assert.Equal(t, "123", data.Block.Slot)
+		require.NotNil(t, data.Block.Body.ExecutionPayload)
+		assert.Equal(t, "4", data.Block.Body.ExecutionPayload.BlobGasUsed)
+		assert.DeepEqual(t, []string{"0x" + string(bytes.Repeat([]byte("01"), 48))}, data.Block.Body.BlobKzgCommitments)
+		assert.DeepEqual(t, []string{"0x" + string(bytes.Repeat([]byte("02"), 48))}, data.KzgProofs)
+		require.Equal(t, 1, len(data.Blobs))
+	})
+	t.Run("Blinded Deneb", func(t *testing.T) {
+		blk := util.NewBlindedBeaconBlockDeneb().Block
+		blk.Slot = 123
+		blk.Body.ExecutionPayloadHeader.ExcessBlobGas = 5
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(&ethpbalpha.GenericBeaconBlock{
+			Block:     &ethpbalpha.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: blk},
+			IsBlinded: true,
+		}, nil)
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123?randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		assert.Equal(t, "deneb", writer.Header().Get(api.VersionHeader))
+		assert.Equal(t, "true", writer.Header().Get(api.ExecutionPayloadBlindedHeader))
+		resp := &ProduceBlockV3Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		data := &rpcmiddleware.BlindedBeaconBlockDenebJson{}
+		require.NoError(t, json.Unmarshal(resp.Data, data))
+		assert.Equal(t, "123", data.Slot)
+		require.NotNil(t, data.Body.ExecutionPayloadHeader)
+		assert.Equal(t, "5", data.Body.ExecutionPayloadHeader.ExcessBlobGas)
+	})
+	t.Run("SSZ", func(t *testing.T) {
+		blk := util.NewBlindedBeaconBlockCapella().Block
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(&ethpbalpha.GenericBeaconBlock{
+			Block:     &ethpbalpha.GenericBeaconBlock_BlindedCapella{BlindedCapella: blk},
+			IsBlinded: true,
+		}, nil)
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123?randao_reveal="+randaoReveal)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		assert.Equal(t, "true", writer.Header().Get(api.ExecutionPayloadBlindedHeader))
+		expected, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
+	t.Run("missing randao reveal", func(t *testing.T) {
+		server := &Server{SyncChecker: &mockSync.Sync{IsSyncing: false}}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Randao reveal is required", e.Message)
+	})
+	t.Run("builder settings", func(t *testing.T) {
+		blk := util.NewBlindedBeaconBlockCapella().Block
+		blk.Slot = 123
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, req *ethpbalpha.BlockRequest) (*ethpbalpha.GenericBeaconBlock, error) {
+				require.NotNil(t, req.LocalBlockValueBoost)
+				assert.Equal(t, "20000000000000000000", req.BuilderMinBid)
+				assert.Equal(t, uint64(10), *req.LocalBlockValueBoost)
+				assert.DeepEqual(t, []string{"relay1.example.com", "relay2.example.com"}, req.BuilderRelays)
+				return &ethpbalpha.GenericBeaconBlock{
+					Block:     &ethpbalpha.GenericBeaconBlock_BlindedCapella{BlindedCapella: blk},
+					IsBlinded: true,
+				}, nil
+			})
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := newRequest(t, "http://foo.example/prysm/v1/validator/blocks/123?builder_min_bid=20000000000000000000&local_block_value_boost=10&builder_relays=relay1.example.com&builder_relays=relay2.example.com&randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockWithBuilderSettings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+	})
+	t.Run("builder settings ignored by standard endpoint", func(t *testing.T) {
+		blk := util.NewBlindedBeaconBlockCapella().Block
+		blk.Slot = 123
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, req *ethpbalpha.BlockRequest) (*ethpbalpha.GenericBeaconBlock, error) {
+				assert.Equal(t, "", req.BuilderMinBid)
+				assert.Equal(t, (*uint64)(nil), req.LocalBlockValueBoost)
+				assert.Equal(t, 0, len(req.BuilderRelays))
+				return &ethpbalpha.GenericBeaconBlock{
+					Block:     &ethpbalpha.GenericBeaconBlock_BlindedCapella{BlindedCapella: blk},
+					IsBlinded: true,
+				}, nil
+			})
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123?builder_min_bid=1000&local_block_value_boost=10&builder_relays=relay.example.com&randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+	})
+	t.Run("invalid builder min bid", func(t *testing.T) {
+		server := &Server{SyncChecker: &mockSync.Sync{IsSyncing: false}}
+
+		request := newRequest(t, "http://foo.example/prysm/v1/validator/blocks/123?builder_min_bid=foo&randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockWithBuilderSettings(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Builder min bid is invalid", e.Message)
+	})
+	t.Run("syncing", func(t *testing.T) {
+		server := &Server{
+			SyncChecker:           &mockSync.Sync{IsSyncing: true},
+			HeadFetcher:           &mockChain.ChainService{},
+			TimeFetcher:           &mockChain.ChainService{},
+			OptimisticModeFetcher: &mockChain.ChainService{},
+		}
+
+		request := newRequest(t, "http://foo.example/eth/v3/validator/blocks/123?randao_reveal="+randaoReveal)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
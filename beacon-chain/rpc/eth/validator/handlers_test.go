@@ -1115,3 +1115,80 @@ var (
   }
 ]`
 )
+
+func TestBeaconCommitteeSelections(t *testing.T) {
+	s := &Server{}
+
+	t.Run("not implemented", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err := body.WriteString(`[{"validator_index":"1","slot":"2","selection_proof":"0xab"}]`)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BeaconCommitteeSelections(writer, request)
+		assert.Equal(t, http.StatusNotImplemented, writer.Code)
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BeaconCommitteeSelections(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err := body.WriteString(`[{"validator_index":"foo","slot":"2","selection_proof":"0xab"}]`)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BeaconCommitteeSelections(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestSyncCommitteeSelections(t *testing.T) {
+	s := &Server{}
+
+	t.Run("not implemented", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err := body.WriteString(`[{"validator_index":"1","slot":"2","subcommittee_index":"3","selection_proof":"0xab"}]`)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SyncCommitteeSelections(writer, request)
+		assert.Equal(t, http.StatusNotImplemented, writer.Code)
+	})
+	t.Run("empty", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err := body.WriteString("[]")
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SyncCommitteeSelections(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err := body.WriteString(`[{"validator_index":"1","slot":"2","selection_proof":"0xab"}]`)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SyncCommitteeSelections(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
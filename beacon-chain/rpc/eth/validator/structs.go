@@ -1,6 +1,10 @@
 package validator
 
-import "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+import (
+	"encoding/json"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+)
 
 type AggregateAttestationResponse struct {
 	Data *shared.Attestation `json:"data" validate:"required"`
@@ -21,3 +25,27 @@ type SubmitSyncCommitteeSubscriptionsRequest struct {
 type SubmitBeaconCommitteeSubscriptionsRequest struct {
 	Data []*shared.BeaconCommitteeSubscription `json:"data" validate:"required,dive"`
 }
+
+type BeaconCommitteeSelectionsRequest struct {
+	Data []*shared.BeaconCommitteeSelection `json:"data" validate:"required,dive"`
+}
+
+type BeaconCommitteeSelectionsResponse struct {
+	Data []*shared.BeaconCommitteeSelection `json:"data"`
+}
+
+type SyncCommitteeSelectionsRequest struct {
+	Data []*shared.SyncCommitteeSelection `json:"data" validate:"required,dive"`
+}
+
+type SyncCommitteeSelectionsResponse struct {
+	Data []*shared.SyncCommitteeSelection `json:"data"`
+}
+
+type ProduceBlockV3Response struct {
+	Version                 string          `json:"version"`
+	ExecutionPayloadBlinded bool            `json:"execution_payload_blinded"`
+	ExecutionPayloadValue   string          `json:"execution_payload_value"`
+	ConsensusBlockValue     string          `json:"consensus_block_value"`
+	Data                    json.RawMessage `json:"data"`
+}
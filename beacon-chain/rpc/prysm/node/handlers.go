@@ -118,6 +118,28 @@ func (s *Server) RemoveTrustedPeer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetExecutionClientVersion retrieves the name and version of the execution client of the node, as returned by
+// the engine_getClientVersionV1 method of the engine API.
+func (s *Server) GetExecutionClientVersion(w http.ResponseWriter, r *http.Request) {
+	if s.ExecutionClientVersionFetcher == nil {
+		http2.HandleError(w, "Execution client version is not available", http.StatusServiceUnavailable)
+		return
+	}
+	clientVersion, err := s.ExecutionClientVersionFetcher.ExecutionClientVersion(r.Context())
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "Could not get execution client version").Error(), http.StatusServiceUnavailable)
+		return
+	}
+	http2.WriteJson(w, &ExecutionClientVersionResponse{
+		Data: &ExecutionClientVersion{
+			Code:    clientVersion.Code,
+			Name:    clientVersion.Name,
+			Version: clientVersion.Version,
+			Commit:  clientVersion.Commit,
+		},
+	})
+}
+
 // httpPeerInfo does the same thing as peerInfo function in node.go but returns the
 // http peer response.
 func httpPeerInfo(peerStatus *peers.Status, id peer.ID) (*Peer, error) {
@@ -2,6 +2,7 @@ package node
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -14,6 +15,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
 	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
 	mockp2p "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
@@ -248,3 +250,45 @@ func TestRemoveTrustedPeer_EmptyParameter(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
 	assert.Equal(t, "Could not decode peer id: failed to parse peer ID: invalid cid: cid too short", e.Message)
 }
+
+type mockClientVersionFetcher struct {
+	clientVersion *execution.ClientVersionV1
+	err           error
+}
+
+func (m *mockClientVersionFetcher) ExecutionClientVersion(_ context.Context) (*execution.ClientVersionV1, error) {
+	return m.clientVersion, m.err
+}
+
+func TestGetExecutionClientVersion(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := Server{ExecutionClientVersionFetcher: &mockClientVersionFetcher{
+			clientVersion: &execution.ClientVersionV1{Code: "GE", Name: "Geth", Version: "v1.13.4", Commit: "0x3f907d6a"},
+		}}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/node/execution_client_version", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetExecutionClientVersion(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ExecutionClientVersionResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "GE", resp.Data.Code)
+		assert.Equal(t, "Geth", resp.Data.Name)
+		assert.Equal(t, "v1.13.4", resp.Data.Version)
+		assert.Equal(t, "0x3f907d6a", resp.Data.Commit)
+	})
+	t.Run("unsupported", func(t *testing.T) {
+		s := Server{ExecutionClientVersionFetcher: &mockClientVersionFetcher{err: execution.ErrMethodNotFound}}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/node/execution_client_version", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetExecutionClientVersion(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Could not get execution client version", e.Message)
+	})
+}
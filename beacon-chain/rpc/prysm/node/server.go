@@ -9,13 +9,14 @@ import (
 )
 
 type Server struct {
-	SyncChecker               sync.Checker
-	OptimisticModeFetcher     blockchain.OptimisticModeFetcher
-	BeaconDB                  db.ReadOnlyDatabase
-	PeersFetcher              p2p.PeersProvider
-	PeerManager               p2p.PeerManager
-	MetadataProvider          p2p.MetadataProvider
-	GenesisTimeFetcher        blockchain.TimeFetcher
-	HeadFetcher               blockchain.HeadFetcher
-	ExecutionChainInfoFetcher execution.ChainInfoFetcher
+	SyncChecker                   sync.Checker
+	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
+	BeaconDB                      db.ReadOnlyDatabase
+	PeersFetcher                  p2p.PeersProvider
+	PeerManager                   p2p.PeerManager
+	MetadataProvider              p2p.MetadataProvider
+	GenesisTimeFetcher            blockchain.TimeFetcher
+	HeadFetcher                   blockchain.HeadFetcher
+	ExecutionChainInfoFetcher     execution.ChainInfoFetcher
+	ExecutionClientVersionFetcher execution.ClientVersionFetcher
 }
@@ -15,3 +15,14 @@ type Peer struct {
 	State              string `json:"state"`
 	Direction          string `json:"direction"`
 }
+
+type ExecutionClientVersionResponse struct {
+	Data *ExecutionClientVersion `json:"data"`
+}
+
+type ExecutionClientVersion struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
@@ -0,0 +1,186 @@
+package slasher
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"go.opencensus.io/trace"
+)
+
+const (
+	defaultSlashingsPageSize = 100
+	maxSlashingsPageSize     = 1000
+)
+
+// HighestAttestations returns the highest source and target epochs attested for the validator
+// indices submitted in the request body, for the validators that have been observed by the slasher.
+func (s *Server) HighestAttestations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.HighestAttestations")
+	defer span.End()
+
+	if r.Body == http.NoBody {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	var rawIndices []string
+	if err := json.NewDecoder(r.Body).Decode(&rawIndices); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	indices := make([]primitives.ValidatorIndex, len(rawIndices))
+	for i, raw := range rawIndices {
+		idx, ok := shared.ValidateUint(w, "validator index", raw)
+		if !ok {
+			return
+		}
+		indices[i] = primitives.ValidatorIndex(idx)
+	}
+
+	atts, err := s.SlashingChecker.HighestAttestations(ctx, indices)
+	if err != nil {
+		http2.HandleError(w, "Could not get highest attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &HighestAttestationsResponse{Data: make([]*HighestAttestation, len(atts))}
+	for i, att := range atts {
+		resp.Data[i] = &HighestAttestation{
+			ValidatorIndex:     strconv.FormatUint(att.ValidatorIndex, 10),
+			HighestSourceEpoch: strconv.FormatUint(uint64(att.HighestSourceEpoch), 10),
+			HighestTargetEpoch: strconv.FormatUint(uint64(att.HighestTargetEpoch), 10),
+		}
+	}
+	http2.WriteJson(w, resp)
+}
+
+// IsSlashableAttestation returns the attester slashings caused by the indexed attestation
+// submitted in the request body.
+func (s *Server) IsSlashableAttestation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if r.Body == http.NoBody {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	var req IndexedAttestation
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := validator.New().Struct(req); err != nil {
+		http2.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	att, err := req.ToConsensus()
+	if err != nil {
+		http2.HandleError(w, "Could not convert request attestation to consensus attestation: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	slashings, err := s.SlashingChecker.IsSlashableAttestation(ctx, att)
+	if err != nil {
+		http2.HandleError(w, "Could not determine if attestation is slashable: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &IsSlashableAttestationResponse{Data: make([]*AttesterSlashing, len(slashings))}
+	for i, sl := range slashings {
+		resp.Data[i] = attesterSlashingFromConsensus(sl)
+	}
+	http2.WriteJson(w, resp)
+}
+
+// IsSlashableBlock returns the proposer slashing caused by the signed beacon block header
+// submitted in the request body.
+func (s *Server) IsSlashableBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if r.Body == http.NoBody {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	var req SignedBeaconBlockHeader
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := validator.New().Struct(req); err != nil {
+		http2.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	header, err := req.ToConsensus()
+	if err != nil {
+		http2.HandleError(w, "Could not convert request header to consensus header: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	slashing, err := s.SlashingChecker.IsSlashableBlock(ctx, header)
+	if err != nil {
+		http2.HandleError(w, "Could not determine if block is slashable: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &IsSlashableBlockResponse{Data: []*ProposerSlashing{}}
+	if slashing != nil {
+		resp.Data = append(resp.Data, proposerSlashingFromConsensus(slashing))
+	}
+	http2.WriteJson(w, resp)
+}
+
+// ListSlashings returns the evidence of the slashings detected by the slasher, in detection order.
+// The optional page_size query parameter limits the number of returned slashings, and the page_token
+// query parameter is the next_page_token of the previous page. The next_page_token of the response
+// is empty when there are no more slashings.
+func (s *Server) ListSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.ListSlashings")
+	defer span.End()
+
+	pageSize := uint64(defaultSlashingsPageSize)
+	var ok bool
+	if raw := r.URL.Query().Get("page_size"); raw != "" {
+		if pageSize, ok = shared.ValidateUint(w, "page_size", raw); !ok {
+			return
+		}
+		if pageSize == 0 || pageSize > maxSlashingsPageSize {
+			http2.HandleError(w, "page_size must be between 1 and "+strconv.Itoa(maxSlashingsPageSize), http.StatusBadRequest)
+			return
+		}
+	}
+	var startId uint64
+	if raw := r.URL.Query().Get("page_token"); raw != "" {
+		if startId, ok = shared.ValidateUint(w, "page_token", raw); !ok {
+			return
+		}
+	}
+
+	// One more slashing than the page size is requested to know if there is a next page.
+	evidence, err := s.SlashingChecker.SlashingEvidence(ctx, startId, int(pageSize)+1)
+	if err != nil {
+		http2.HandleError(w, "Could not get slashing evidence: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &SlashingsResponse{Data: make([]*SlashingEvidence, 0, len(evidence))}
+	if uint64(len(evidence)) > pageSize {
+		resp.NextPageToken = strconv.FormatUint(evidence[pageSize].Id, 10)
+		evidence = evidence[:pageSize]
+	}
+	for _, ev := range evidence {
+		item := &SlashingEvidence{
+			Id:         strconv.FormatUint(ev.Id, 10),
+			DetectedAt: ev.DetectedAt.UTC().Format(time.RFC3339Nano),
+		}
+		if ev.AttesterSlashing != nil {
+			item.AttesterSlashing = attesterSlashingFromConsensus(ev.AttesterSlashing)
+		}
+		if ev.ProposerSlashing != nil {
+			item.ProposerSlashing = proposerSlashingFromConsensus(ev.ProposerSlashing)
+		}
+		resp.Data = append(resp.Data, item)
+	}
+	http2.WriteJson(w, resp)
+}
@@ -0,0 +1,223 @@
+package slasher
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/mock"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestServer_HighestAttestations(t *testing.T) {
+	s := &Server{
+		SlashingChecker: &mock.MockSlashingChecker{
+			HighestAtts: map[primitives.ValidatorIndex]*ethpb.HighestAttestation{
+				1: {ValidatorIndex: 1, HighestSourceEpoch: 2, HighestTargetEpoch: 3},
+			},
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/highest_attestations", bytes.NewBufferString(`["1","2"]`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.HighestAttestations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &HighestAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].ValidatorIndex)
+		assert.Equal(t, "2", resp.Data[0].HighestSourceEpoch)
+		assert.Equal(t, "3", resp.Data[0].HighestTargetEpoch)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/highest_attestations", bytes.NewBufferString(`["foo"]`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.HighestAttestations(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "validator index is invalid", e.Message)
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/highest_attestations", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.HighestAttestations(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestServer_IsSlashableAttestation(t *testing.T) {
+	att := indexedAttestationFromConsensus(util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}))
+	body, err := json.Marshal(att)
+	require.NoError(t, err)
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{AttesterSlashingFound: true}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/is_slashable/attestation", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 1, len(resp.Data))
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/is_slashable/attestation", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("invalid attestation", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{}}
+		invalid := &IndexedAttestation{AttestingIndices: []string{"1"}, Data: &shared.AttestationData{}, Signature: "0x"}
+		invalidBody, err := json.Marshal(invalid)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/is_slashable/attestation", bytes.NewReader(invalidBody))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableAttestation(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestServer_IsSlashableBlock(t *testing.T) {
+	header := signedHeaderFromConsensus(util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}))
+	body, err := json.Marshal(header)
+	require.NoError(t, err)
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{ProposerSlashingFound: true}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/is_slashable/block", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &IsSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "0", resp.Data[0].SignedHeader1.Message.Slot)
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mock.MockSlashingChecker{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/is_slashable/block", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.IsSlashableBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &IsSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 0, len(resp.Data))
+	})
+}
+
+func TestServer_ListSlashings(t *testing.T) {
+	detectedAt := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	evidence := make([]*slashertypes.SlashingEvidence, 0)
+	for i := uint64(1); i <= 3; i++ {
+		ev := &slashertypes.SlashingEvidence{Id: i, DetectedAt: detectedAt}
+		if i%2 == 0 {
+			ev.ProposerSlashing = &ethpb.ProposerSlashing{
+				Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+				Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+			}
+		} else {
+			ev.AttesterSlashing = &ethpb.AttesterSlashing{
+				Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{i}}),
+				Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{i}}),
+			}
+		}
+		evidence = append(evidence, ev)
+	}
+	s := &Server{SlashingChecker: &mock.MockSlashingChecker{Evidence: evidence}}
+
+	t.Run("all", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &SlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, "", resp.NextPageToken)
+		assert.Equal(t, "1", resp.Data[0].Id)
+		assert.Equal(t, "2023-07-01T12:00:00Z", resp.Data[0].DetectedAt)
+		require.NotNil(t, resp.Data[0].AttesterSlashing)
+		assert.DeepEqual(t, []string{"1"}, resp.Data[0].AttesterSlashing.Attestation1.AttestingIndices)
+		assert.Equal(t, true, resp.Data[0].ProposerSlashing == nil)
+		require.NotNil(t, resp.Data[1].ProposerSlashing)
+		assert.Equal(t, true, resp.Data[1].AttesterSlashing == nil)
+	})
+	t.Run("pages", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings?page_size=2", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &SlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "3", resp.NextPageToken)
+
+		request = httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings?page_size=2&page_token="+resp.NextPageToken, nil)
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp = &SlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "3", resp.Data[0].Id)
+		assert.Equal(t, "", resp.NextPageToken)
+	})
+	t.Run("invalid page size", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings?page_size=0", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListSlashings(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "page_size must be between 1 and 1000", e.Message)
+	})
+	t.Run("invalid page token", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings?page_token=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListSlashings(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
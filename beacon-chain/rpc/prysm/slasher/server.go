@@ -0,0 +1,11 @@
+// Package slasher defines the Prysm REST API of the slasher running in the beacon node.
+package slasher
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
+)
+
+// Server defines a server implementation of the HTTP endpoints of the slasher.
+type Server struct {
+	SlashingChecker slasher.SlashingChecker
+}
@@ -0,0 +1,195 @@
+package slasher
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+type HighestAttestationsResponse struct {
+	Data []*HighestAttestation `json:"data"`
+}
+
+type HighestAttestation struct {
+	ValidatorIndex     string `json:"validator_index"`
+	HighestSourceEpoch string `json:"highest_source_epoch"`
+	HighestTargetEpoch string `json:"highest_target_epoch"`
+}
+
+type IsSlashableAttestationResponse struct {
+	Data []*AttesterSlashing `json:"data"`
+}
+
+type IsSlashableBlockResponse struct {
+	Data []*ProposerSlashing `json:"data"`
+}
+
+type SlashingsResponse struct {
+	Data          []*SlashingEvidence `json:"data"`
+	NextPageToken string              `json:"next_page_token"`
+}
+
+type SlashingEvidence struct {
+	Id               string            `json:"id"`
+	DetectedAt       string            `json:"detected_at"`
+	AttesterSlashing *AttesterSlashing `json:"attester_slashing,omitempty"`
+	ProposerSlashing *ProposerSlashing `json:"proposer_slashing,omitempty"`
+}
+
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+type ProposerSlashing struct {
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+type IndexedAttestation struct {
+	AttestingIndices []string                `json:"attesting_indices" validate:"required"`
+	Data             *shared.AttestationData `json:"data" validate:"required"`
+	Signature        string                  `json:"signature" validate:"required,hexadecimal"`
+}
+
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message" validate:"required"`
+	Signature string             `json:"signature" validate:"required,hexadecimal"`
+}
+
+type BeaconBlockHeader struct {
+	Slot          string `json:"slot" validate:"required,number,gte=0"`
+	ProposerIndex string `json:"proposer_index" validate:"required,number,gte=0"`
+	ParentRoot    string `json:"parent_root" validate:"required,hexadecimal"`
+	StateRoot     string `json:"state_root" validate:"required,hexadecimal"`
+	BodyRoot      string `json:"body_root" validate:"required,hexadecimal"`
+}
+
+func (a *IndexedAttestation) ToConsensus() (*eth.IndexedAttestation, error) {
+	indices := make([]uint64, len(a.AttestingIndices))
+	var err error
+	for i, ix := range a.AttestingIndices {
+		indices[i], err = strconv.ParseUint(ix, 10, 64)
+		if err != nil {
+			return nil, shared.NewDecodeError(err, fmt.Sprintf("AttestingIndices[%d]", i))
+		}
+	}
+	data, err := a.Data.ToConsensus()
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Data")
+	}
+	sig, err := hexutil.Decode(a.Signature)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Signature")
+	}
+
+	return &eth.IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             data,
+		Signature:        sig,
+	}, nil
+}
+
+func (s *SignedBeaconBlockHeader) ToConsensus() (*eth.SignedBeaconBlockHeader, error) {
+	msg, err := s.Message.ToConsensus()
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Message")
+	}
+	sig, err := hexutil.Decode(s.Signature)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Signature")
+	}
+
+	return &eth.SignedBeaconBlockHeader{
+		Header:    msg,
+		Signature: sig,
+	}, nil
+}
+
+func (h *BeaconBlockHeader) ToConsensus() (*eth.BeaconBlockHeader, error) {
+	slot, err := strconv.ParseUint(h.Slot, 10, 64)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Slot")
+	}
+	proposerIndex, err := strconv.ParseUint(h.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "ProposerIndex")
+	}
+	parentRoot, err := hexutil.Decode(h.ParentRoot)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "ParentRoot")
+	}
+	stateRoot, err := hexutil.Decode(h.StateRoot)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "StateRoot")
+	}
+	bodyRoot, err := hexutil.Decode(h.BodyRoot)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "BodyRoot")
+	}
+
+	return &eth.BeaconBlockHeader{
+		Slot:          primitives.Slot(slot),
+		ProposerIndex: primitives.ValidatorIndex(proposerIndex),
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		BodyRoot:      bodyRoot,
+	}, nil
+}
+
+func attesterSlashingFromConsensus(s *eth.AttesterSlashing) *AttesterSlashing {
+	return &AttesterSlashing{
+		Attestation1: indexedAttestationFromConsensus(s.Attestation_1),
+		Attestation2: indexedAttestationFromConsensus(s.Attestation_2),
+	}
+}
+
+func proposerSlashingFromConsensus(s *eth.ProposerSlashing) *ProposerSlashing {
+	return &ProposerSlashing{
+		SignedHeader1: signedHeaderFromConsensus(s.Header_1),
+		SignedHeader2: signedHeaderFromConsensus(s.Header_2),
+	}
+}
+
+func indexedAttestationFromConsensus(a *eth.IndexedAttestation) *IndexedAttestation {
+	indices := make([]string, len(a.AttestingIndices))
+	for i, ix := range a.AttestingIndices {
+		indices[i] = strconv.FormatUint(ix, 10)
+	}
+	data := a.GetData()
+	return &IndexedAttestation{
+		AttestingIndices: indices,
+		Data: &shared.AttestationData{
+			Slot:            strconv.FormatUint(uint64(data.GetSlot()), 10),
+			CommitteeIndex:  strconv.FormatUint(uint64(data.GetCommitteeIndex()), 10),
+			BeaconBlockRoot: hexutil.Encode(data.GetBeaconBlockRoot()),
+			Source: &shared.Checkpoint{
+				Epoch: strconv.FormatUint(uint64(data.GetSource().GetEpoch()), 10),
+				Root:  hexutil.Encode(data.GetSource().GetRoot()),
+			},
+			Target: &shared.Checkpoint{
+				Epoch: strconv.FormatUint(uint64(data.GetTarget().GetEpoch()), 10),
+				Root:  hexutil.Encode(data.GetTarget().GetRoot()),
+			},
+		},
+		Signature: hexutil.Encode(a.Signature),
+	}
+}
+
+func signedHeaderFromConsensus(h *eth.SignedBeaconBlockHeader) *SignedBeaconBlockHeader {
+	header := h.GetHeader()
+	return &SignedBeaconBlockHeader{
+		Message: &BeaconBlockHeader{
+			Slot:          strconv.FormatUint(uint64(header.GetSlot()), 10),
+			ProposerIndex: strconv.FormatUint(uint64(header.GetProposerIndex()), 10),
+			ParentRoot:    hexutil.Encode(header.GetParentRoot()),
+			StateRoot:     hexutil.Encode(header.GetStateRoot()),
+			BodyRoot:      hexutil.Encode(header.GetBodyRoot()),
+		},
+		Signature: hexutil.Encode(h.Signature),
+	}
+}
@@ -41,6 +41,7 @@ type Server struct {
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
 	POWChainInfoFetcher  execution.ChainInfoFetcher
+	ClientVersionFetcher execution.ClientVersionFetcher
 	BeaconMonitoringHost string
 	BeaconMonitoringPort int
 }
@@ -76,11 +77,19 @@ func (ns *Server) GetGenesis(ctx context.Context, _ *empty.Empty) (*ethpb.Genesi
 	}, nil
 }
 
-// GetVersion checks the version information of the beacon node.
-func (_ *Server) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.Version, error) {
-	return &ethpb.Version{
+// GetVersion checks the version information of the beacon node, along with the name and version
+// of its execution client when it supports engine_getClientVersionV1.
+func (ns *Server) GetVersion(ctx context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+	v := &ethpb.Version{
 		Version: version.Version(),
-	}, nil
+	}
+	if ns.ClientVersionFetcher != nil {
+		// The execution client version is left out if the execution client does not support it.
+		if clientVersion, err := ns.ClientVersionFetcher.ExecutionClientVersion(ctx); err == nil {
+			v.ExecutionClientVersion = clientVersion.String()
+		}
+	}
+	return v, nil
 }
 
 // ListImplementedServices lists the services implemented and enabled by this node.
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/testutil"
@@ -71,12 +72,35 @@ func TestNodeServer_GetGenesis(t *testing.T) {
 	assert.Equal(t, res.GenesisTime.Seconds, pUnix.Seconds)
 }
 
+type mockClientVersionFetcher struct {
+	clientVersion *execution.ClientVersionV1
+	err           error
+}
+
+func (m *mockClientVersionFetcher) ExecutionClientVersion(_ context.Context) (*execution.ClientVersionV1, error) {
+	return m.clientVersion, m.err
+}
+
 func TestNodeServer_GetVersion(t *testing.T) {
 	v := version.Version()
 	ns := &Server{}
 	res, err := ns.GetVersion(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "", res.ExecutionClientVersion)
+
+	ns.ClientVersionFetcher = &mockClientVersionFetcher{
+		clientVersion: &execution.ClientVersionV1{Code: "GE", Name: "Geth", Version: "v1.13.4", Commit: "0x3f907d6a"},
+	}
+	res, err = ns.GetVersion(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "Geth/v1.13.4", res.ExecutionClientVersion)
+
+	ns.ClientVersionFetcher = &mockClientVersionFetcher{err: errors.New("method not found")}
+	res, err = ns.GetVersion(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.ExecutionClientVersion)
 }
 
 func TestNodeServer_GetImplementedServices(t *testing.T) {
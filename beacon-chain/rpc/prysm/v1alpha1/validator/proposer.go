@@ -25,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
@@ -63,6 +64,11 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
 	}
 
+	policy, err := builderPolicyFromRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// process attestations and update head in forkchoice
 	vs.ForkchoiceFetcher.UpdateHead(ctx, vs.TimeFetcher.CurrentSlot())
 	headRoot := vs.ForkchoiceFetcher.CachedHeadRoot()
@@ -104,8 +110,11 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 	}
 	sBlk.SetProposerIndex(idx)
 
+	var blobsBundle *enginev1.BlobsBundle
+	var decision *ethpb.BuilderDecision
 	if features.Get().BuildBlockParallel {
-		if err := vs.BuildBlockParallel(ctx, sBlk, head); err != nil {
+		blobsBundle, decision, err = vs.BuildBlockParallel(ctx, sBlk, head, policy)
+		if err != nil {
 			return nil, errors.Wrap(err, "could not build block in parallel")
 		}
 	} else {
@@ -140,18 +149,26 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 		vs.setSyncAggregate(ctx, sBlk)
 
 		// Get local and builder (if enabled) payloads. Set execution data. New in Bellatrix.
-		localPayload, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
+		localPayload, bundle, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get local payload: %v", err)
 		}
-		builderPayload, err := vs.getBuilderPayload(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
+		builderPayload, builderValue, err := vs.getBuilderPayload(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex(), policy.relays)
 		if err != nil {
 			builderGetPayloadMissCount.Inc()
 			log.WithError(err).Error("Could not get builder payload")
 		}
-		if err := setExecutionData(ctx, sBlk, localPayload, builderPayload); err != nil {
+		decision, err = setExecutionData(ctx, sBlk, localPayload, builderPayload, builderValue, policy)
+		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
 		}
+		vs.saveBuilderDecision(ctx, decision, builderPayload)
+
+		// Set blob kzg commitments. New in Deneb.
+		blobsBundle, err = setBlobKzgCommitments(sBlk, bundle, vs.KZGVerifier)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set blob kzg commitments: %v", err)
+		}
 
 		// Set bls to execution change. New in Capella.
 		vs.setBlsToExecData(sBlk, head)
@@ -169,29 +186,63 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 		"validator":          sBlk.Block().ProposerIndex(),
 	}).Info("Finished building block")
 
-	pb, err := sBlk.Block().Proto()
+	blk, err := genericBeaconBlock(sBlk, blobsBundle)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not convert block to proto: %v", err)
 	}
-	if slots.ToEpoch(req.Slot) >= params.BeaconConfig().CapellaForkEpoch {
+	blk.IsBlinded = sBlk.IsBlinded()
+	blk.PayloadValue = payloadValue(decision)
+	// Computing the consensus block value processes the operations of the block again, so it is only done when asked.
+	if req.IncludeConsensusBlockValue {
+		blk.ConsensusBlockValue, err = consensusBlockValue(ctx, head, sBlk)
+		if err != nil {
+			log.WithError(err).Error("Could not compute consensus block value")
+		}
+	}
+	return blk, nil
+}
+
+// genericBeaconBlock wraps the block of the fork of its slot.
+func genericBeaconBlock(sBlk interfaces.ReadOnlySignedBeaconBlock, blobsBundle *enginev1.BlobsBundle) (*ethpb.GenericBeaconBlock, error) {
+	pb, err := sBlk.Block().Proto()
+	if err != nil {
+		return nil, err
+	}
+	slot := sBlk.Block().Slot()
+	if slots.ToEpoch(slot) >= params.BeaconConfig().DenebForkEpoch {
+		if sBlk.IsBlinded() {
+			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: pb.(*ethpb.BlindedBeaconBlockDeneb)}}, nil
+		}
+		contents := &ethpb.BeaconBlockContentsDeneb{Block: pb.(*ethpb.BeaconBlockDeneb)}
+		if blobsBundle != nil {
+			contents.KzgProofs = blobsBundle.Proofs
+			contents.Blobs = blobsBundle.Blobs
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Deneb{Deneb: contents}}, nil
+	}
+	if slots.ToEpoch(slot) >= params.BeaconConfig().CapellaForkEpoch {
 		if sBlk.IsBlinded() {
 			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedCapella{BlindedCapella: pb.(*ethpb.BlindedBeaconBlockCapella)}}, nil
 		}
 		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Capella{Capella: pb.(*ethpb.BeaconBlockCapella)}}, nil
 	}
-	if slots.ToEpoch(req.Slot) >= params.BeaconConfig().BellatrixForkEpoch {
+	if slots.ToEpoch(slot) >= params.BeaconConfig().BellatrixForkEpoch {
 		if sBlk.IsBlinded() {
 			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: pb.(*ethpb.BlindedBeaconBlockBellatrix)}}, nil
 		}
 		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: pb.(*ethpb.BeaconBlockBellatrix)}}, nil
 	}
-	if slots.ToEpoch(req.Slot) >= params.BeaconConfig().AltairForkEpoch {
+	if slots.ToEpoch(slot) >= params.BeaconConfig().AltairForkEpoch {
 		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Altair{Altair: pb.(*ethpb.BeaconBlockAltair)}}, nil
 	}
 	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: pb.(*ethpb.BeaconBlock)}}, nil
 }
 
-func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState) error {
+// BuildBlockParallel fills in the consensus and execution fields of the block concurrently. From Deneb onwards,
+// the blobs bundle returned by the execution client is returned alongside so that it can be served to the proposer.
+// The builder policy defines how a builder bid is weighed against the local payload, the resulting decision is
+// returned as well.
+func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState, policy builderPolicy) (*enginev1.BlobsBundle, *ethpb.BuilderDecision, error) {
 	// Build consensus fields in background
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -232,24 +283,31 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 		vs.setBlsToExecData(sBlk, head)
 	}()
 
-	localPayload, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
+	localPayload, bundle, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Could not get local payload: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "Could not get local payload: %v", err)
 	}
 
-	builderPayload, err := vs.getBuilderPayload(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
+	builderPayload, builderValue, err := vs.getBuilderPayload(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex(), policy.relays)
 	if err != nil {
 		builderGetPayloadMissCount.Inc()
 		log.WithError(err).Error("Could not get builder payload")
 	}
 
-	if err := setExecutionData(ctx, sBlk, localPayload, builderPayload); err != nil {
-		return status.Errorf(codes.Internal, "Could not set execution data: %v", err)
+	decision, err := setExecutionData(ctx, sBlk, localPayload, builderPayload, builderValue, policy)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
+	}
+	vs.saveBuilderDecision(ctx, decision, builderPayload)
+
+	blobsBundle, err := setBlobKzgCommitments(sBlk, bundle, vs.KZGVerifier)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Could not set blob kzg commitments: %v", err)
 	}
 
 	wg.Wait() // Wait until block is built via consensus and execution fields.
 
-	return nil
+	return blobsBundle, decision, nil
 }
 
 // ProposeBeaconBlock is called by a proposer during its assigned slot to create a block in an attempt
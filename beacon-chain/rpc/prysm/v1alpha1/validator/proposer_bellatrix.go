@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
@@ -16,10 +17,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
+	"github.com/prysmaticlabs/prysm/v4/math"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
@@ -40,23 +45,91 @@ var emptyTransactionsRoot = [32]byte{127, 254, 36, 30, 166, 1, 135, 253, 176, 24
 // block request. This value is known as `BUILDER_PROPOSAL_DELAY_TOLERANCE` in builder spec.
 const blockBuilderTimeout = 1 * time.Second
 
+// Reasons of the decision between the builder and the local payload of a proposal.
+const (
+	decisionNoBuilderBid          = "no_builder_bid"
+	decisionBuilderHigherValue    = "builder_higher_value"
+	decisionLocalHigherValue      = "local_higher_value"
+	decisionBidBelowMinimum       = "bid_below_minimum"
+	decisionWithdrawalsMismatch   = "withdrawals_mismatch"
+	decisionInvalidBuilderPayload = "invalid_builder_payload"
+	decisionBuilderOnly           = "builder_without_local_value"
+)
+
+// builderPolicy defines how the builder bid of a proposal is weighed against the local payload.
+type builderPolicy struct {
+	minBid *big.Int // minBid is the minimum value in wei of a builder bid.
+	boost  uint64   // boost is the percentage boost of the local payload value.
+	relays []string // relays are the hosts of the relays asked for a bid, all the relays of the builder service when empty.
+}
+
+// builderPolicyFromRequest returns the builder policy of a block request, the values which are not set by the
+// validator default to the beacon node configuration.
+func builderPolicyFromRequest(req *ethpb.BlockRequest) (builderPolicy, error) {
+	minBid := params.BeaconConfig().MinBuilderBid
+	if req.BuilderMinBid != "" {
+		minBid = req.BuilderMinBid
+	}
+	v, err := math.ParseWei(minBid)
+	if err != nil {
+		return builderPolicy{}, errors.Wrapf(err, "invalid builder min bid %q", minBid)
+	}
+	p := builderPolicy{
+		minBid: v,
+		boost:  params.BeaconConfig().LocalBlockValueBoost,
+		relays: req.BuilderRelays,
+	}
+	if req.LocalBlockValueBoost != nil {
+		p.boost = *req.LocalBlockValueBoost
+	}
+	return p, nil
+}
+
 // Sets the execution data for the block. Execution data can come from local EL client or remote builder depends on validator registration and circuit breaker conditions.
-func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, localPayload, builderPayload interfaces.ExecutionData) error {
+// The builder value is the value in wei of the bid which offered the builder payload.
+// The returned decision records which payload was used and why, it is nil before bellatrix.
+func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, localPayload, builderPayload interfaces.ExecutionData, builderValue *big.Int, policy builderPolicy) (*ethpb.BuilderDecision, error) {
 	_, span := trace.StartSpan(ctx, "ProposerServer.setExecutionData")
 	defer span.End()
 
 	slot := blk.Block().Slot()
 	if slots.ToEpoch(slot) < params.BeaconConfig().BellatrixForkEpoch {
-		return nil
+		return nil, nil
 	}
 
 	if localPayload == nil {
-		return errors.New("local payload is nil")
+		return nil, errors.New("local payload is nil")
+	}
+
+	decision := &ethpb.BuilderDecision{
+		Slot:                 slot,
+		ProposerIndex:        blk.Block().ProposerIndex(),
+		MinBid:               policy.minBid.String(),
+		LocalBlockValueBoost: policy.boost,
+	}
+	useLocal := func(reason string) (*ethpb.BuilderDecision, error) {
+		decision.UseBuilder = false
+		decision.Reason = reason
+		return decision, blk.SetExecution(localPayload)
+	}
+	useBuilder := func(reason string) (*ethpb.BuilderDecision, error) {
+		blk.SetBlinded(true)
+		if err := blk.SetExecution(builderPayload); err != nil {
+			log.WithError(err).Warn("Proposer: failed to set builder payload")
+			blk.SetBlinded(false)
+			return useLocal(decisionInvalidBuilderPayload)
+		}
+		decision.UseBuilder = true
+		decision.Reason = reason
+		return decision, nil
 	}
 
 	// Use local payload if builder payload is nil.
 	if builderPayload == nil {
-		return blk.SetExecution(localPayload)
+		if localValueGwei, err := localPayload.ValueInGwei(); err == nil {
+			decision.LocalValueGwei = localValueGwei
+		}
+		return useLocal(decisionNoBuilderBid)
 	}
 
 	switch {
@@ -64,36 +137,46 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		// Compare payload values between local and builder. Default to the local value if it is higher.
 		localValueGwei, err := localPayload.ValueInGwei()
 		if err != nil {
-			return errors.Wrap(err, "failed to get local payload value")
+			return nil, errors.Wrap(err, "failed to get local payload value")
 		}
+		decision.LocalValueGwei = localValueGwei
 		builderValueGwei, err := builderPayload.ValueInGwei()
 		if err != nil {
 			log.WithError(err).Warn("Proposer: failed to get builder payload value") // Default to local if can't get builder value.
-			return blk.SetExecution(localPayload)
+			return useLocal(decisionInvalidBuilderPayload)
+		}
+		decision.BuilderValueGwei = builderValueGwei
+
+		// Drop the bid if its value is below the minimum bid.
+		if builderValue == nil || builderValue.Cmp(policy.minBid) < 0 {
+			log.WithFields(logrus.Fields{
+				"builderWeiValue": builderValue,
+				"minBidWei":       policy.minBid,
+			}).Warn("Proposer: using local execution payload because builder bid is below the minimum bid")
+			return useLocal(decisionBidBelowMinimum)
 		}
 
 		withdrawalsMatched, err := matchingWithdrawalsRoot(localPayload, builderPayload)
 		if err != nil {
 			tracing.AnnotateError(span, err)
 			log.WithError(err).Warn("Proposer: failed to match withdrawals root")
-			return blk.SetExecution(localPayload)
+			return useLocal(decisionWithdrawalsMismatch)
 		}
 
 		// Use builder payload if the following in true:
 		// builder_bid_value * 100 > local_block_value * (local-block-value-boost + 100)
-		boost := params.BeaconConfig().LocalBlockValueBoost
+		boost := policy.boost
 		higherValueBuilder := builderValueGwei*100 > localValueGwei*(100+boost)
 
+		span.AddAttributes(
+			trace.BoolAttribute("higherValueBuilder", higherValueBuilder),
+			trace.Int64Attribute("localGweiValue", int64(localValueGwei)),     // lint:ignore uintcast -- This is OK for tracing.
+			trace.Int64Attribute("localBoostPercentage", int64(boost)),        // lint:ignore uintcast -- This is OK for tracing.
+			trace.Int64Attribute("builderGweiValue", int64(builderValueGwei)), // lint:ignore uintcast -- This is OK for tracing.
+		)
 		// If we can't get the builder value, just use local block.
 		if higherValueBuilder && withdrawalsMatched { // Builder value is higher and withdrawals match.
-			blk.SetBlinded(true)
-			if err := blk.SetExecution(builderPayload); err != nil {
-				log.WithError(err).Warn("Proposer: failed to set builder payload")
-				blk.SetBlinded(false)
-				return blk.SetExecution(localPayload)
-			} else {
-				return nil
-			}
+			return useBuilder(decisionBuilderHigherValue)
 		}
 		if !higherValueBuilder {
 			log.WithFields(logrus.Fields{
@@ -101,111 +184,136 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 				"localBoostPercentage": boost,
 				"builderGweiValue":     builderValueGwei,
 			}).Warn("Proposer: using local execution payload because higher value")
+			return useLocal(decisionLocalHigherValue)
 		}
-		span.AddAttributes(
-			trace.BoolAttribute("higherValueBuilder", higherValueBuilder),
-			trace.Int64Attribute("localGweiValue", int64(localValueGwei)),     // lint:ignore uintcast -- This is OK for tracing.
-			trace.Int64Attribute("localBoostPercentage", int64(boost)),        // lint:ignore uintcast -- This is OK for tracing.
-			trace.Int64Attribute("builderGweiValue", int64(builderValueGwei)), // lint:ignore uintcast -- This is OK for tracing.
-		)
-		return blk.SetExecution(localPayload)
-	default: // Bellatrix case.
-		blk.SetBlinded(true)
-		if err := blk.SetExecution(builderPayload); err != nil {
-			log.WithError(err).Warn("Proposer: failed to set builder payload")
-			blk.SetBlinded(false)
-			return blk.SetExecution(localPayload)
-		} else {
-			return nil
+		return useLocal(decisionWithdrawalsMismatch)
+	default: // Bellatrix case, payloads carry no value so the builder payload is used.
+		return useBuilder(decisionBuilderOnly)
+	}
+}
+
+// saveBuilderDecision persists the builder decision of a proposal. Failing to save the decision does not fail
+// the block production, the error is only logged. Decisions are only saved when a builder is configured.
+func (vs *Server) saveBuilderDecision(ctx context.Context, decision *ethpb.BuilderDecision, builderPayload interfaces.ExecutionData) {
+	if decision == nil || vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
+		return
+	}
+	if builderPayload != nil {
+		decision.Relay = vs.BlockBuilder.BidRelay(bytesutil.ToBytes32(builderPayload.BlockHash()))
+	}
+	if err := vs.BeaconDB.SaveBuilderDecision(ctx, decision); err != nil {
+		log.WithError(err).Error("Could not save builder decision")
+	}
+}
+
+// setBlobKzgCommitments sets the blob kzg commitments of a locally built Deneb block from the blobs bundle
+// returned by the execution client. The bundle is returned if it was used, so its blobs and proofs can be
+// handed to the proposer alongside the block. If a verifier is given, the blobs of the bundle are checked
+// against their commitments first, as a block with invalid blobs would not be available to the network.
+func setBlobKzgCommitments(blk interfaces.SignedBeaconBlock, bundle *enginev1.BlobsBundle, v kzg.Verifier) (*enginev1.BlobsBundle, error) {
+	if blk.Version() < version.Deneb {
+		return nil, nil
+	}
+	if blk.IsBlinded() || bundle == nil {
+		return nil, blk.SetBlobKzgCommitments([][]byte{})
+	}
+	if v != nil {
+		if err := v.VerifyBlobKZGProofBatch(bundle.Blobs, bundle.KzgCommitments, bundle.Proofs); err != nil {
+			return nil, errors.Wrap(err, "invalid blobs bundle from execution client")
 		}
 	}
+	if err := blk.SetBlobKzgCommitments(bundle.KzgCommitments); err != nil {
+		return nil, err
+	}
+	return bundle, nil
 }
 
 // This function retrieves the payload header given the slot number and the validator index.
 // It's a no-op if the latest head block is not versioned bellatrix.
-func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitives.Slot, idx primitives.ValidatorIndex) (interfaces.ExecutionData, error) {
+// The value in wei of the bid is returned alongside the header. When relays are given, only these relays are asked for a bid.
+func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitives.Slot, idx primitives.ValidatorIndex, relays []string) (interfaces.ExecutionData, *big.Int, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.getPayloadHeaderFromBuilder")
 	defer span.End()
 
 	if slots.ToEpoch(slot) < params.BeaconConfig().BellatrixForkEpoch {
-		return nil, errors.New("can't get payload header from builder before bellatrix epoch")
+		return nil, nil, errors.New("can't get payload header from builder before bellatrix epoch")
 	}
 
 	b, err := vs.HeadFetcher.HeadBlock(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	h, err := b.Block().Body().Execution()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get execution header")
+		return nil, nil, errors.Wrap(err, "failed to get execution header")
 	}
 	pk, err := vs.HeadFetcher.HeadValidatorIndexToPublicKey(ctx, idx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
 	defer cancel()
 
-	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk)
+	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(h.BlockHash()), pk, relays)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if signedBid.IsNil() {
-		return nil, errors.New("builder returned nil bid")
+		return nil, nil, errors.New("builder returned nil bid")
 	}
 	fork, err := forks.Fork(slots.ToEpoch(slot))
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to get fork information")
+		return nil, nil, errors.Wrap(err, "unable to get fork information")
 	}
 	forkName, ok := params.BeaconConfig().ForkVersionNames[bytesutil.ToBytes4(fork.CurrentVersion)]
 	if !ok {
-		return nil, errors.New("unable to find current fork in schedule")
+		return nil, nil, errors.New("unable to find current fork in schedule")
 	}
 	if !strings.EqualFold(version.String(signedBid.Version()), forkName) {
-		return nil, fmt.Errorf("builder bid response version: %d is different from head block version: %d for epoch %d", signedBid.Version(), b.Version(), slots.ToEpoch(slot))
+		return nil, nil, fmt.Errorf("builder bid response version: %d is different from head block version: %d for epoch %d", signedBid.Version(), b.Version(), slots.ToEpoch(slot))
 	}
 
 	bid, err := signedBid.Message()
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get bid")
+		return nil, nil, errors.Wrap(err, "could not get bid")
 	}
 	if bid.IsNil() {
-		return nil, errors.New("builder returned nil bid")
+		return nil, nil, errors.New("builder returned nil bid")
 	}
 
 	v := bytesutil.LittleEndianBytesToBigInt(bid.Value())
 	if v.String() == "0" {
-		return nil, errors.New("builder returned header with 0 bid amount")
+		return nil, nil, errors.New("builder returned header with 0 bid amount")
 	}
 
 	header, err := bid.Header()
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get bid header")
+		return nil, nil, errors.Wrap(err, "could not get bid header")
 	}
 	txRoot, err := header.TransactionsRoot()
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get transaction root")
+		return nil, nil, errors.Wrap(err, "could not get transaction root")
 	}
 	if bytesutil.ToBytes32(txRoot) == emptyTransactionsRoot {
-		return nil, errors.New("builder returned header with an empty tx root")
+		return nil, nil, errors.New("builder returned header with an empty tx root")
 	}
 
 	if !bytes.Equal(header.ParentHash(), h.BlockHash()) {
-		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), h.BlockHash())
+		return nil, nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), h.BlockHash())
 	}
 
 	t, err := slots.ToTime(uint64(vs.TimeFetcher.GenesisTime().Unix()), slot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if header.Timestamp() != uint64(t.Unix()) {
-		return nil, fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
+		return nil, nil, fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
 	}
 
 	if err := validateBuilderSignature(signedBid); err != nil {
-		return nil, errors.Wrap(err, "could not validate builder signature")
+		return nil, nil, errors.Wrap(err, "could not validate builder signature")
 	}
 
 	log.WithFields(logrus.Fields{
@@ -223,7 +331,7 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 		trace.StringAttribute("blockHash", fmt.Sprintf("%#x", header.BlockHash())),
 	)
 
-	return header, nil
+	return header, v, nil
 }
 
 // Validates builder signature and returns an error if the signature is invalid.
@@ -42,6 +42,11 @@ func TestServer_setExecutionData(t *testing.T) {
 	cfg.CapellaForkEpoch = 0
 	params.OverrideBeaconConfig(cfg)
 	params.SetupTestConfigCleanup(t)
+	defaultPolicy := func() builderPolicy {
+		p, err := builderPolicyFromRequest(&ethpb.BlockRequest{})
+		require.NoError(t, err)
+		return p
+	}
 
 	beaconDB := dbTest.SetupDB(t)
 	capellaTransitionState, _ := util.DeterministicGenesisStateCapella(t, 1)
@@ -78,11 +83,13 @@ func TestServer_setExecutionData(t *testing.T) {
 		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
 		require.NoError(t, err)
 		b := blk.Block()
-		localPayload, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		localPayload, _, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		require.NoError(t, err)
+		builderPayload, bidValue, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex(), nil)
 		require.NoError(t, err)
-		builderPayload, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex())
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload, bidValue, defaultPolicy())
 		require.NoError(t, err)
-		require.NoError(t, setExecutionData(context.Background(), blk, localPayload, builderPayload))
+		require.Equal(t, decisionNoBuilderBid, decision.Reason)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(1), e.BlockNumber()) // Local block
@@ -96,6 +103,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		require.NoError(t, err)
 		sk, err := bls.RandKey()
 		require.NoError(t, err)
+		builderValue := bytesutil.ReverseByteOrder(big.NewInt(1e9).Bytes())
 		bid := &ethpb.BuilderBidCapella{
 			Header: &v1.ExecutionPayloadHeaderCapella{
 				FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
@@ -112,7 +120,7 @@ func TestServer_setExecutionData(t *testing.T) {
 				WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
 			},
 			Pubkey: sk.PublicKey().Marshal(),
-			Value:  bytesutil.PadTo([]byte{1}, 32),
+			Value:  bytesutil.PadTo(builderValue, 32),
 		}
 		d := params.BeaconConfig().DomainApplicationBuilder
 		domain, err := signing.ComputeDomain(d, nil, nil)
@@ -137,11 +145,13 @@ func TestServer_setExecutionData(t *testing.T) {
 		vs.HeadFetcher = chain
 		b := blk.Block()
 
-		localPayload, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		localPayload, _, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		require.NoError(t, err)
+		builderPayload, bidValue, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex(), nil)
 		require.NoError(t, err)
-		builderPayload, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex())
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload, bidValue, defaultPolicy())
 		require.NoError(t, err)
-		require.NoError(t, setExecutionData(context.Background(), blk, localPayload, builderPayload))
+		require.Equal(t, decisionWithdrawalsMismatch, decision.Reason)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(1), e.BlockNumber()) // Local block because incorrect withdrawals
@@ -199,11 +209,13 @@ func TestServer_setExecutionData(t *testing.T) {
 		vs.HeadFetcher = chain
 
 		b := blk.Block()
-		localPayload, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		localPayload, _, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
 		require.NoError(t, err)
-		builderPayload, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex())
+		builderPayload, bidValue, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex(), nil)
 		require.NoError(t, err)
-		require.NoError(t, setExecutionData(context.Background(), blk, localPayload, builderPayload))
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload, bidValue, defaultPolicy())
+		require.NoError(t, err)
+		require.Equal(t, decisionBuilderHigherValue, decision.Reason)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(2), e.BlockNumber()) // Builder block
@@ -213,11 +225,13 @@ func TestServer_setExecutionData(t *testing.T) {
 		require.NoError(t, err)
 		vs.ExecutionEngineCaller = &powtesting.EngineClient{PayloadIDBytes: id, ExecutionPayloadCapella: &v1.ExecutionPayloadCapella{BlockNumber: 3}, BlockValue: 2}
 		b := blk.Block()
-		localPayload, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		localPayload, _, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		require.NoError(t, err)
+		builderPayload, bidValue, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex(), nil)
 		require.NoError(t, err)
-		builderPayload, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex())
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload, bidValue, defaultPolicy())
 		require.NoError(t, err)
-		require.NoError(t, setExecutionData(context.Background(), blk, localPayload, builderPayload))
+		require.Equal(t, decisionLocalHigherValue, decision.Reason)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(3), e.BlockNumber()) // Local block
@@ -233,11 +247,13 @@ func TestServer_setExecutionData(t *testing.T) {
 		require.NoError(t, err)
 		vs.ExecutionEngineCaller = &powtesting.EngineClient{PayloadIDBytes: id, ExecutionPayloadCapella: &v1.ExecutionPayloadCapella{BlockNumber: 3}, BlockValue: 1}
 		b := blk.Block()
-		localPayload, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		localPayload, _, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		require.NoError(t, err)
+		builderPayload, bidValue, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex(), nil)
 		require.NoError(t, err)
-		builderPayload, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex())
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload, bidValue, defaultPolicy())
 		require.NoError(t, err)
-		require.NoError(t, setExecutionData(context.Background(), blk, localPayload, builderPayload))
+		require.Equal(t, decisionLocalHigherValue, decision.Reason)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(3), e.BlockNumber()) // Local block
@@ -254,16 +270,128 @@ func TestServer_setExecutionData(t *testing.T) {
 		}
 		vs.ExecutionEngineCaller = &powtesting.EngineClient{PayloadIDBytes: id, ExecutionPayloadCapella: &v1.ExecutionPayloadCapella{BlockNumber: 4}, BlockValue: 0}
 		b := blk.Block()
-		localPayload, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		localPayload, _, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
 		require.NoError(t, err)
-		builderPayload, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex())
+		builderPayload, bidValue, err := vs.getBuilderPayload(ctx, b.Slot(), b.ProposerIndex(), nil)
 		require.ErrorIs(t, consensus_types.ErrNilObjectWrapped, err) // Builder returns fault. Use local block
-		require.NoError(t, setExecutionData(context.Background(), blk, localPayload, builderPayload))
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload, bidValue, defaultPolicy())
+		require.NoError(t, err)
+		require.Equal(t, decisionNoBuilderBid, decision.Reason)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
 		require.Equal(t, uint64(4), e.BlockNumber()) // Local block
 	})
 }
+func TestServer_setExecutionData_BuilderPolicy(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.MinBuilderBid = "1000000000000000"
+	cfg.LocalBlockValueBoost = 10
+	params.OverrideBeaconConfig(cfg)
+
+	wr, err := ssz.WithdrawalSliceRoot([]*v1.Withdrawal{}, fieldparams.MaxWithdrawalsPerPayload)
+	require.NoError(t, err)
+	localPayload, err := blocks.WrappedExecutionPayloadCapella(&v1.ExecutionPayloadCapella{BlockNumber: 1, Withdrawals: []*v1.Withdrawal{}}, 100)
+	require.NoError(t, err)
+	builderPayload := func(value uint64) interfaces.ExecutionData {
+		h, err := blocks.WrappedExecutionPayloadHeaderCapella(&v1.ExecutionPayloadHeaderCapella{BlockNumber: 2, WithdrawalsRoot: wr[:]}, value)
+		require.NoError(t, err)
+		return h
+	}
+	wei := func(gwei uint64) *big.Int {
+		return new(big.Int).Mul(new(big.Int).SetUint64(gwei), big.NewInt(1e9))
+	}
+
+	t.Run("Policy defaults to the beacon node configuration", func(t *testing.T) {
+		p, err := builderPolicyFromRequest(&ethpb.BlockRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "1000000000000000", p.minBid.String())
+		require.Equal(t, uint64(10), p.boost)
+		require.Equal(t, 0, len(p.relays))
+		boost := uint64(50)
+		p, err = builderPolicyFromRequest(&ethpb.BlockRequest{BuilderMinBid: "0", LocalBlockValueBoost: &boost, BuilderRelays: []string{"relay.example.com"}})
+		require.NoError(t, err)
+		require.Equal(t, "0", p.minBid.String())
+		require.Equal(t, uint64(50), p.boost)
+		require.DeepEqual(t, []string{"relay.example.com"}, p.relays)
+		_, err = builderPolicyFromRequest(&ethpb.BlockRequest{BuilderMinBid: "-1"})
+		require.ErrorContains(t, "invalid builder min bid", err)
+	})
+	t.Run("Builder bid below minimum bid", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		// The bid is compared in wei, a single wei below the minimum bid drops it.
+		minBid := new(big.Int).Add(wei(200), big.NewInt(1))
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload(200), wei(200), builderPolicy{minBid: minBid})
+		require.NoError(t, err)
+		require.Equal(t, false, decision.UseBuilder)
+		require.Equal(t, decisionBidBelowMinimum, decision.Reason)
+		require.Equal(t, uint64(200), decision.BuilderValueGwei)
+		require.Equal(t, uint64(100), decision.LocalValueGwei)
+		require.Equal(t, "200000000001", decision.MinBid)
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), e.BlockNumber())
+	})
+	t.Run("Builder bid above minimum bid", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+		require.NoError(t, err)
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload(200), wei(200), builderPolicy{minBid: wei(200), boost: 99})
+		require.NoError(t, err)
+		require.Equal(t, true, decision.UseBuilder)
+		require.Equal(t, decisionBuilderHigherValue, decision.Reason)
+		require.Equal(t, "200000000000", decision.MinBid)
+		require.Equal(t, uint64(99), decision.LocalBlockValueBoost)
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), e.BlockNumber())
+	})
+	t.Run("Minimum bid beyond the uint64 range", func(t *testing.T) {
+		p, err := builderPolicyFromRequest(&ethpb.BlockRequest{BuilderMinBid: "20000000000000000000"})
+		require.NoError(t, err)
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload(19e9), wei(19e9), p)
+		require.NoError(t, err)
+		require.Equal(t, decisionBidBelowMinimum, decision.Reason)
+		require.Equal(t, "20000000000000000000", decision.MinBid)
+
+		blk, err = blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+		require.NoError(t, err)
+		decision, err = setExecutionData(context.Background(), blk, localPayload, builderPayload(21e9), wei(21e9), p)
+		require.NoError(t, err)
+		require.Equal(t, true, decision.UseBuilder)
+	})
+	t.Run("Local block value boost of the validator", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		decision, err := setExecutionData(context.Background(), blk, localPayload, builderPayload(200), wei(200), builderPolicy{minBid: big.NewInt(0), boost: 100})
+		require.NoError(t, err)
+		require.Equal(t, false, decision.UseBuilder)
+		require.Equal(t, decisionLocalHigherValue, decision.Reason)
+	})
+}
+
+func TestServer_saveBuilderDecision(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	vs := &Server{
+		BeaconDB:     beaconDB,
+		BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true, Relay: "relay.example.com"},
+	}
+	h, err := blocks.WrappedExecutionPayloadHeaderCapella(&v1.ExecutionPayloadHeaderCapella{BlockNumber: 2}, 200)
+	require.NoError(t, err)
+	ctx := context.Background()
+	vs.saveBuilderDecision(ctx, &ethpb.BuilderDecision{Slot: 5, ProposerIndex: 3, UseBuilder: true, Reason: decisionBuilderHigherValue}, h)
+
+	decisions, err := beaconDB.BuilderDecisions(ctx, 3, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decisions))
+	require.Equal(t, "relay.example.com", decisions[0].Relay)
+	require.Equal(t, primitives.Slot(5), decisions[0].Slot)
+}
+
 func TestServer_getPayloadHeader(t *testing.T) {
 	genesis := time.Now().Add(-time.Duration(params.BeaconConfig().SlotsPerEpoch) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
 	params.SetupTestConfigCleanup(t)
@@ -479,11 +607,12 @@ func TestServer_getPayloadHeader(t *testing.T) {
 			}}
 			hb, err := vs.HeadFetcher.HeadBlock(context.Background())
 			require.NoError(t, err)
-			h, err := vs.getPayloadHeaderFromBuilder(context.Background(), hb.Block().Slot(), 0)
+			h, v, err := vs.getPayloadHeaderFromBuilder(context.Background(), hb.Block().Slot(), 0, nil)
 			if tc.err != "" {
 				require.ErrorContains(t, tc.err, err)
 			} else {
 				require.NoError(t, err)
+				require.NotNil(t, v)
 				if tc.returnedHeader != nil {
 					want, err := blocks.WrappedExecutionPayloadHeader(tc.returnedHeader)
 					require.NoError(t, err)
@@ -593,3 +722,56 @@ func TestEmptyTransactionsRoot(t *testing.T) {
 	require.NoError(t, err)
 	require.DeepEqual(t, r, emptyTransactionsRoot)
 }
+
+type mockKZGVerifier struct {
+	err error
+}
+
+func (m *mockKZGVerifier) VerifyBlobKZGProof(_, _, _ []byte) error {
+	return m.err
+}
+
+func (m *mockKZGVerifier) VerifyBlobKZGProofBatch(_, _, _ [][]byte) error {
+	return m.err
+}
+
+func Test_setBlobKzgCommitments(t *testing.T) {
+	bundle := &v1.BlobsBundle{
+		KzgCommitments: [][]byte{bytesutil.PadTo([]byte{'c'}, 48)},
+		Proofs:         [][]byte{bytesutil.PadTo([]byte{'p'}, 48)},
+		Blobs:          [][]byte{make([]byte, fieldparams.BlobLength)},
+	}
+	t.Run("pre-deneb block", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		got, err := setBlobKzgCommitments(blk, bundle, &mockKZGVerifier{})
+		require.NoError(t, err)
+		require.Equal(t, (*v1.BlobsBundle)(nil), got)
+	})
+	t.Run("no bundle", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+		require.NoError(t, err)
+		got, err := setBlobKzgCommitments(blk, nil, &mockKZGVerifier{})
+		require.NoError(t, err)
+		require.Equal(t, (*v1.BlobsBundle)(nil), got)
+		commitments, err := blk.Block().Body().BlobKzgCommitments()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commitments))
+	})
+	t.Run("valid bundle", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+		require.NoError(t, err)
+		got, err := setBlobKzgCommitments(blk, bundle, &mockKZGVerifier{})
+		require.NoError(t, err)
+		require.Equal(t, bundle, got)
+		commitments, err := blk.Block().Body().BlobKzgCommitments()
+		require.NoError(t, err)
+		require.DeepEqual(t, bundle.KzgCommitments, commitments)
+	})
+	t.Run("invalid bundle", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+		require.NoError(t, err)
+		_, err = setBlobKzgCommitments(blk, bundle, &mockKZGVerifier{err: errors.New("bad proof")})
+		require.ErrorContains(t, "invalid blobs bundle from execution client", err)
+	})
+}
@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	coreblocks "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// payloadValue returns the value in gwei of the execution payload which was selected for the block.
+func payloadValue(decision *ethpb.BuilderDecision) uint64 {
+	if decision == nil {
+		return 0
+	}
+	if decision.UseBuilder {
+		return decision.BuilderValueGwei
+	}
+	return decision.LocalValueGwei
+}
+
+// consensusBlockValue returns the rewards in gwei the proposer receives for the attestations, slashings and
+// sync aggregate of the block. The state must be at the slot of the block, it is not mutated.
+// Phase 0 proposers are rewarded at the epoch transition, the value of a Phase 0 block is therefore zero.
+func consensusBlockValue(ctx context.Context, st state.BeaconState, blk interfaces.ReadOnlySignedBeaconBlock) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.consensusBlockValue")
+	defer span.End()
+
+	if blk.Version() == version.Phase0 {
+		return 0, nil
+	}
+	st = st.Copy()
+	idx := blk.Block().ProposerIndex()
+	initBalance, err := st.BalanceAtIndex(idx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get proposer's balance")
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not process attestations")
+	}
+	st, err = coreblocks.ProcessAttesterSlashings(ctx, st, blk.Block().Body().AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not process attester slashings")
+	}
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, blk.Block().Body().ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not process proposer slashings")
+	}
+	balance, err := st.BalanceAtIndex(idx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get proposer's balance")
+	}
+	sa, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get sync aggregate")
+	}
+	_, syncReward, err := altair.ProcessSyncAggregate(ctx, st, sa)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not process sync aggregate")
+	}
+	// The proposer may be slashed by its own block.
+	if balance < initBalance {
+		return syncReward, nil
+	}
+	return balance - initBalance + syncReward, nil
+}
@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestPayloadValue(t *testing.T) {
+	require.Equal(t, uint64(0), payloadValue(nil))
+	require.Equal(t, uint64(2), payloadValue(&ethpb.BuilderDecision{UseBuilder: true, BuilderValueGwei: 2, LocalValueGwei: 1}))
+	require.Equal(t, uint64(1), payloadValue(&ethpb.BuilderDecision{UseBuilder: false, BuilderValueGwei: 2, LocalValueGwei: 1}))
+}
+
+func TestConsensusBlockValue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("phase 0", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisState(t, 64)
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+		require.NoError(t, err)
+		v, err := consensusBlockValue(ctx, st, blk)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), v)
+	})
+	t.Run("empty altair block", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateAltair(t, 64)
+		require.NoError(t, st.SetSlot(1))
+		b := util.NewBeaconBlockAltair()
+		b.Block.Slot = 1
+		// An empty sync aggregate carries the point at infinity.
+		b.Block.Body.SyncAggregate.SyncCommitteeSignature = append([]byte{0xC0}, make([]byte, 95)...)
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		balance, err := st.BalanceAtIndex(blk.Block().ProposerIndex())
+		require.NoError(t, err)
+
+		v, err := consensusBlockValue(ctx, st, blk)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), v)
+		// The state is not mutated.
+		after, err := st.BalanceAtIndex(blk.Block().ProposerIndex())
+		require.NoError(t, err)
+		require.Equal(t, balance, after)
+	})
+}
@@ -6,9 +6,9 @@ import (
 	"math/big"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
-	"github.com/prysmaticlabs/prysm/v4/container/trie"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -129,11 +129,11 @@ func (vs *Server) deposits(
 	return pendingDeposits, nil
 }
 
-func (vs *Server) depositTrie(ctx context.Context, canonicalEth1Data *ethpb.Eth1Data, canonicalEth1DataHeight *big.Int) (*trie.SparseMerkleTrie, error) {
+func (vs *Server) depositTrie(ctx context.Context, canonicalEth1Data *ethpb.Eth1Data, canonicalEth1DataHeight *big.Int) (*depositsnapshot.DepositTree, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.depositTrie")
 	defer span.End()
 
-	var depositTrie *trie.SparseMerkleTrie
+	var depositTrie *depositsnapshot.DepositTree
 
 	finalizedDeposits := vs.DepositFetcher.FinalizedDeposits(ctx)
 	depositTrie = finalizedDeposits.Deposits
@@ -167,24 +167,29 @@ func (vs *Server) depositTrie(ctx context.Context, canonicalEth1Data *ethpb.Eth1
 	return depositTrie, nil
 }
 
-// rebuilds our deposit trie by recreating it from all processed deposits till
-// specified eth1 block height.
-func (vs *Server) rebuildDepositTrie(ctx context.Context, canonicalEth1Data *ethpb.Eth1Data, canonicalEth1DataHeight *big.Int) (*trie.SparseMerkleTrie, error) {
+// rebuilds our deposit trie by recreating it from the stored deposit snapshot and all
+// processed deposits after it till specified eth1 block height. The deposits finalized
+// in the snapshot are not held in the deposit cache when it was bootstrapped from a
+// snapshot, so the trie cannot be rebuilt from the processed deposits alone.
+func (vs *Server) rebuildDepositTrie(ctx context.Context, canonicalEth1Data *ethpb.Eth1Data, canonicalEth1DataHeight *big.Int) (*depositsnapshot.DepositTree, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.rebuildDepositTrie")
 	defer span.End()
 
-	deposits := vs.DepositFetcher.AllDeposits(ctx, canonicalEth1DataHeight)
-	trieItems := make([][]byte, 0, len(deposits))
+	depositTrie, lastSnapshotIndex, err := vs.snapshotDepositTrie(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deposits := vs.DepositFetcher.NonFinalizedDeposits(ctx, lastSnapshotIndex, canonicalEth1DataHeight)
+	insertIndex := lastSnapshotIndex + 1
 	for _, dep := range deposits {
 		depHash, err := dep.Data.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "could not hash deposit data")
 		}
-		trieItems = append(trieItems, depHash[:])
-	}
-	depositTrie, err := trie.GenerateTrieFromItems(trieItems, params.BeaconConfig().DepositContractTreeDepth)
-	if err != nil {
-		return nil, err
+		if err = depositTrie.Insert(depHash[:], int(insertIndex)); err != nil {
+			return nil, err
+		}
+		insertIndex++
 	}
 
 	valid, err := validateDepositTrie(depositTrie, canonicalEth1Data)
@@ -195,8 +200,25 @@ func (vs *Server) rebuildDepositTrie(ctx context.Context, canonicalEth1Data *eth
 	return depositTrie, nil
 }
 
+// snapshotDepositTrie returns the deposit trie of the stored deposit snapshot and the index of
+// its last deposit, or an empty trie and -1 if no deposits were finalized in a snapshot yet.
+func (vs *Server) snapshotDepositTrie(ctx context.Context) (*depositsnapshot.DepositTree, int64, error) {
+	snapshot, err := vs.DepositFetcher.DepositSnapshot(ctx)
+	if errors.Is(err, depositsnapshot.ErrEmptyExecutionBlock) {
+		return depositsnapshot.New(), -1, nil
+	}
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get deposit snapshot")
+	}
+	depositTrie, err := depositsnapshot.NewFromSnapshot(snapshot)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	return depositTrie, int64(snapshot.DepositCount) - 1, nil
+}
+
 // validate that the provided deposit trie matches up with the canonical eth1 data provided.
-func validateDepositTrie(trie *trie.SparseMerkleTrie, canonicalEth1Data *ethpb.Eth1Data) (bool, error) {
+func validateDepositTrie(trie *depositsnapshot.DepositTree, canonicalEth1Data *ethpb.Eth1Data) (bool, error) {
 	if trie == nil || canonicalEth1Data == nil {
 		return false, errors.New("nil trie or eth1data provided")
 	}
@@ -213,7 +235,7 @@ func validateDepositTrie(trie *trie.SparseMerkleTrie, canonicalEth1Data *ethpb.E
 	return true, nil
 }
 
-func constructMerkleProof(trie *trie.SparseMerkleTrie, index int, deposit *ethpb.Deposit) (*ethpb.Deposit, error) {
+func constructMerkleProof(trie *depositsnapshot.DepositTree, index int, deposit *ethpb.Deposit) (*ethpb.Deposit, error) {
 	proof, err := trie.MerkleProof(index)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not generate merkle proof for deposit at index %d", index)
@@ -15,6 +15,7 @@ import (
 	builderTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/builder/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	b "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
@@ -1492,11 +1493,54 @@ func TestProposer_DepositTrie_RebuildTrie(t *testing.T) {
 
 }
 
+func TestProposer_DepositTrie_RebuildTrieFromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	var mockSig [96]byte
+	var mockCreds [32]byte
+	deposits := make([]*ethpb.Deposit, 4)
+	fullTrie := depositsnapshot.New()
+	snapshotTrie := depositsnapshot.New()
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			Signature:             mockSig[:],
+			WithdrawalCredentials: mockCreds[:],
+		}}
+		depositHash, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, fullTrie.Insert(depositHash[:], i))
+		if i < 2 {
+			require.NoError(t, snapshotTrie.Insert(depositHash[:], i))
+		}
+	}
+	require.NoError(t, snapshotTrie.Finalize(2, [32]byte{'a'}, 10))
+	snapshot, err := snapshotTrie.GetSnapshot()
+	require.NoError(t, err)
+
+	// The cache is bootstrapped from the snapshot, so it does not hold the first two deposits.
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	require.NoError(t, depositCache.InsertDepositSnapshot(ctx, snapshot.ToProto()))
+	for i := 2; i < len(deposits); i++ {
+		assert.NoError(t, depositCache.InsertDeposit(ctx, deposits[i], 11, int64(i), [32]byte{}))
+	}
+	bs := &Server{DepositFetcher: depositCache}
+
+	expectedRoot, err := fullTrie.HashTreeRoot()
+	require.NoError(t, err)
+	dt, err := bs.rebuildDepositTrie(ctx, &ethpb.Eth1Data{DepositRoot: expectedRoot[:], DepositCount: 4}, big.NewInt(11))
+	require.NoError(t, err)
+	actualRoot, err := dt.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, expectedRoot, actualRoot, "Incorrect deposit trie root")
+	assert.Equal(t, 4, dt.NumOfItems())
+}
+
 func TestProposer_ValidateDepositTrie(t *testing.T) {
 	tt := []struct {
 		name            string
 		eth1dataCreator func() *ethpb.Eth1Data
-		trieCreator     func() *trie.SparseMerkleTrie
+		trieCreator     func() *depositsnapshot.DepositTree
 		success         bool
 	}{
 		{
@@ -1504,10 +1548,8 @@ func TestProposer_ValidateDepositTrie(t *testing.T) {
 			eth1dataCreator: func() *ethpb.Eth1Data {
 				return &ethpb.Eth1Data{DepositRoot: []byte{}, DepositCount: 10, BlockHash: []byte{}}
 			},
-			trieCreator: func() *trie.SparseMerkleTrie {
-				newTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-				assert.NoError(t, err)
-				return newTrie
+			trieCreator: func() *depositsnapshot.DepositTree {
+				return depositsnapshot.New()
 			},
 			success: false,
 		},
@@ -1516,17 +1558,16 @@ func TestProposer_ValidateDepositTrie(t *testing.T) {
 			eth1dataCreator: func() *ethpb.Eth1Data {
 				newTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
 				assert.NoError(t, err)
-				assert.NoError(t, newTrie.Insert([]byte{'a'}, 0))
-				assert.NoError(t, newTrie.Insert([]byte{'b'}, 1))
-				assert.NoError(t, newTrie.Insert([]byte{'c'}, 2))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'a'}, 32), 0))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'b'}, 32), 1))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'c'}, 32), 2))
 				return &ethpb.Eth1Data{DepositRoot: []byte{'B'}, DepositCount: 3, BlockHash: []byte{}}
 			},
-			trieCreator: func() *trie.SparseMerkleTrie {
-				newTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-				assert.NoError(t, err)
-				assert.NoError(t, newTrie.Insert([]byte{'a'}, 0))
-				assert.NoError(t, newTrie.Insert([]byte{'b'}, 1))
-				assert.NoError(t, newTrie.Insert([]byte{'c'}, 2))
+			trieCreator: func() *depositsnapshot.DepositTree {
+				newTrie := depositsnapshot.New()
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'a'}, 32), 0))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'b'}, 32), 1))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'c'}, 32), 2))
 				return newTrie
 			},
 			success: false,
@@ -1536,19 +1577,18 @@ func TestProposer_ValidateDepositTrie(t *testing.T) {
 			eth1dataCreator: func() *ethpb.Eth1Data {
 				newTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
 				assert.NoError(t, err)
-				assert.NoError(t, newTrie.Insert([]byte{'a'}, 0))
-				assert.NoError(t, newTrie.Insert([]byte{'b'}, 1))
-				assert.NoError(t, newTrie.Insert([]byte{'c'}, 2))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'a'}, 32), 0))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'b'}, 32), 1))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'c'}, 32), 2))
 				rt, err := newTrie.HashTreeRoot()
 				require.NoError(t, err)
 				return &ethpb.Eth1Data{DepositRoot: rt[:], DepositCount: 3, BlockHash: []byte{}}
 			},
-			trieCreator: func() *trie.SparseMerkleTrie {
-				newTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-				assert.NoError(t, err)
-				assert.NoError(t, newTrie.Insert([]byte{'a'}, 0))
-				assert.NoError(t, newTrie.Insert([]byte{'b'}, 1))
-				assert.NoError(t, newTrie.Insert([]byte{'c'}, 2))
+			trieCreator: func() *depositsnapshot.DepositTree {
+				newTrie := depositsnapshot.New()
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'a'}, 32), 0))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'b'}, 32), 1))
+				assert.NoError(t, newTrie.Insert(bytesutil.PadTo([]byte{'c'}, 32), 2))
 				return newTrie
 			},
 			success: true,
@@ -4,6 +4,7 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -76,6 +78,7 @@ type Server struct {
 	BLSChangesPool         blstoexec.PoolManager
 	ClockWaiter            startup.ClockWaiter
 	CoreService            *core.Service
+	KZGVerifier            kzg.Verifier
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -145,6 +148,15 @@ func (vs *Server) DomainData(_ context.Context, request *ethpb.DomainRequest) (*
 		return nil, err
 	}
 	headGenesisValidatorsRoot := vs.HeadFetcher.HeadGenesisValidatorsRoot()
+	isExitDomain := bytes.Equal(request.Domain, params.BeaconConfig().DomainVoluntaryExit[:])
+	if isExitDomain && request.Epoch >= params.BeaconConfig().DenebForkEpoch {
+		// EIP-7044: voluntary exits are locked to the Capella fork domain from Deneb onwards.
+		dv, err := signing.ComputeDomain(params.BeaconConfig().DomainVoluntaryExit, params.BeaconConfig().CapellaForkVersion, headGenesisValidatorsRoot[:])
+		if err != nil {
+			return nil, err
+		}
+		return &ethpb.DomainResponse{SignatureDomain: dv}, nil
+	}
 	dv, err := signing.Domain(fork, request.Epoch, bytesutil.ToBytes4(request.Domain), headGenesisValidatorsRoot[:])
 	if err != nil {
 		return nil, err
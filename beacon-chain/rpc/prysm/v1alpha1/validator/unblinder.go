@@ -159,6 +159,12 @@ func (u *unblinder) blindedProtoBlock() (proto.Message, error) {
 				Body: &ethpb.BlindedBeaconBlockBodyCapella{},
 			},
 		}, nil
+	case version.Deneb:
+		return &ethpb.SignedBlindedBeaconBlockDeneb{
+			Block: &ethpb.BlindedBeaconBlockDeneb{
+				Body: &ethpb.BlindedBeaconBlockBodyDeneb{},
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("invalid version %s", version.String(u.b.Version()))
 	}
@@ -178,6 +184,12 @@ func (u *unblinder) protoBlock() (proto.Message, error) {
 				Body: &ethpb.BeaconBlockBodyCapella{},
 			},
 		}, nil
+	case version.Deneb:
+		return &ethpb.SignedBeaconBlockDeneb{
+			Block: &ethpb.BeaconBlockDeneb{
+				Body: &ethpb.BeaconBlockBodyDeneb{},
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("invalid version %s", version.String(u.b.Version()))
 	}
@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+)
+
+type BuilderDecisionsResponse struct {
+	Data []*BuilderDecision `json:"data"`
+}
+
+type BuilderDecision struct {
+	Slot                 string `json:"slot"`
+	ProposerIndex        string `json:"proposer_index"`
+	UseBuilder           bool   `json:"use_builder"`
+	BuilderValueGwei     string `json:"builder_value_gwei"`
+	LocalValueGwei       string `json:"local_value_gwei"`
+	Relay                string `json:"relay"`
+	Reason               string `json:"reason"`
+	MinBid               string `json:"min_bid"`
+	LocalBlockValueBoost string `json:"local_block_value_boost"`
+}
+
+// GetBuilderDecisions is an HTTP handler returning the decisions between the builder and the local payload
+// of the proposals of a validator, between the optional start_slot and end_slot query parameters.
+// The end slot defaults to the current slot.
+func (vs *Server) GetBuilderDecisions(w http.ResponseWriter, r *http.Request) {
+	idx, ok := shared.ValidateUint(w, "validator_index", mux.Vars(r)["validator_index"])
+	if !ok {
+		return
+	}
+	var startSlot uint64
+	if raw := r.URL.Query().Get("start_slot"); raw != "" {
+		if startSlot, ok = shared.ValidateUint(w, "start_slot", raw); !ok {
+			return
+		}
+	}
+	endSlot := uint64(vs.GenesisTimeFetcher.CurrentSlot())
+	if raw := r.URL.Query().Get("end_slot"); raw != "" {
+		if endSlot, ok = shared.ValidateUint(w, "end_slot", raw); !ok {
+			return
+		}
+	}
+	if startSlot > endSlot {
+		handleHTTPError(w, "start_slot must not be greater than end_slot", http.StatusBadRequest)
+		return
+	}
+	decisions, err := vs.BeaconDB.BuilderDecisions(r.Context(), primitives.ValidatorIndex(idx), primitives.Slot(startSlot), primitives.Slot(endSlot))
+	if err != nil {
+		handleHTTPError(w, "Could not get builder decisions: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &BuilderDecisionsResponse{Data: make([]*BuilderDecision, len(decisions))}
+	for i, d := range decisions {
+		resp.Data[i] = &BuilderDecision{
+			Slot:                 strconv.FormatUint(uint64(d.Slot), 10),
+			ProposerIndex:        strconv.FormatUint(uint64(d.ProposerIndex), 10),
+			UseBuilder:           d.UseBuilder,
+			BuilderValueGwei:     strconv.FormatUint(d.BuilderValueGwei, 10),
+			LocalValueGwei:       strconv.FormatUint(d.LocalValueGwei, 10),
+			Relay:                d.Relay,
+			Reason:               d.Reason,
+			MinBid:               d.MinBid,
+			LocalBlockValueBoost: strconv.FormatUint(d.LocalBlockValueBoost, 10),
+		}
+	}
+	http2.WriteJson(w, resp)
+}
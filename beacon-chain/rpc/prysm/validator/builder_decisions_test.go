@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestServer_GetBuilderDecisions(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	for _, d := range []*ethpb.BuilderDecision{
+		{Slot: 10, ProposerIndex: 1, UseBuilder: true, BuilderValueGwei: 20, LocalValueGwei: 10, Relay: "relay.example.com", Reason: "builder_higher_value", MinBid: "20000000000000000000"},
+		{Slot: 20, ProposerIndex: 1, LocalValueGwei: 30, BuilderValueGwei: 20, Reason: "local_higher_value", LocalBlockValueBoost: 10},
+		{Slot: 30, ProposerIndex: 1, LocalValueGwei: 30, Reason: "no_builder_bid"},
+	} {
+		require.NoError(t, db.SaveBuilderDecision(context.Background(), d))
+	}
+	slot := primitives.Slot(25)
+	vs := &Server{BeaconDB: db, GenesisTimeFetcher: &mock.ChainService{Slot: &slot}}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/1/builder_decisions?start_slot=5", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		vs.GetBuilderDecisions(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &BuilderDecisionsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		// The end slot defaults to the current slot.
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "10", resp.Data[0].Slot)
+		assert.Equal(t, true, resp.Data[0].UseBuilder)
+		assert.Equal(t, "20", resp.Data[0].BuilderValueGwei)
+		assert.Equal(t, "relay.example.com", resp.Data[0].Relay)
+		assert.Equal(t, "20000000000000000000", resp.Data[0].MinBid)
+		assert.Equal(t, "local_higher_value", resp.Data[1].Reason)
+		assert.Equal(t, "10", resp.Data[1].LocalBlockValueBoost)
+	})
+	t.Run("slot range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/1/builder_decisions?start_slot=15&end_slot=100", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		vs.GetBuilderDecisions(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &BuilderDecisionsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "20", resp.Data[0].Slot)
+		assert.Equal(t, "30", resp.Data[1].Slot)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/1/builder_decisions?start_slot=15&end_slot=10", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		vs.GetBuilderDecisions(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "start_slot must not be greater than end_slot", e.Message)
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/foo/builder_decisions", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		vs.GetBuilderDecisions(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
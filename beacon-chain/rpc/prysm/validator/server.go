@@ -2,6 +2,7 @@ package validator
 
 import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
 )
@@ -13,4 +14,5 @@ type Server struct {
 	SyncChecker        sync.Checker
 	HeadFetcher        blockchain.HeadFetcher
 	CoreService        *core.Service
+	BeaconDB           db.ReadOnlyDatabase
 }
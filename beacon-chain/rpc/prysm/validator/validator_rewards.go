@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+const (
+	defaultRewardsPageSize = 100
+	maxRewardsPageSize     = 1000
+	maxRewardsValidators   = 1000
+)
+
+type ValidatorRewardsResponse struct {
+	Data          []*ValidatorEpochRewards `json:"data"`
+	NextPageToken string                   `json:"next_page_token"`
+}
+
+type ValidatorEpochRewards struct {
+	Epoch                string `json:"epoch"`
+	ValidatorIndex       string `json:"validator_index"`
+	SourceReward         string `json:"source_reward"`
+	SourcePenalty        string `json:"source_penalty"`
+	TargetReward         string `json:"target_reward"`
+	TargetPenalty        string `json:"target_penalty"`
+	HeadReward           string `json:"head_reward"`
+	InactivityPenalty    string `json:"inactivity_penalty"`
+	ProposerReward       string `json:"proposer_reward"`
+	SyncCommitteeReward  string `json:"sync_committee_reward"`
+	SyncCommitteePenalty string `json:"sync_committee_penalty"`
+}
+
+// GetValidatorRewards is an HTTP handler returning the rewards and penalties of the validators stored by the
+// rewards indexer, between the optional from_epoch and to_epoch query parameters, ordered by epoch then validator
+// index. The to epoch defaults to the current epoch. The optional validator_index query parameter, which can be
+// repeated, restricts the rewards to the given validators. The optional page_size query parameter limits the
+// number of returned rewards, and the page_token query parameter is the next_page_token of the previous page. The
+// next_page_token of the response is empty when there are no more rewards.
+func (vs *Server) GetValidatorRewards(w http.ResponseWriter, r *http.Request) {
+	var fromEpoch uint64
+	var ok bool
+	if raw := r.URL.Query().Get("from_epoch"); raw != "" {
+		if fromEpoch, ok = shared.ValidateUint(w, "from_epoch", raw); !ok {
+			return
+		}
+	}
+	toEpoch := uint64(slots.ToEpoch(vs.GenesisTimeFetcher.CurrentSlot()))
+	if raw := r.URL.Query().Get("to_epoch"); raw != "" {
+		if toEpoch, ok = shared.ValidateUint(w, "to_epoch", raw); !ok {
+			return
+		}
+	}
+	if fromEpoch > toEpoch {
+		handleHTTPError(w, "from_epoch must not be greater than to_epoch", http.StatusBadRequest)
+		return
+	}
+	rawIndices := r.URL.Query()["validator_index"]
+	if len(rawIndices) > maxRewardsValidators {
+		handleHTTPError(w, "Too many validator_index values, the maximum is "+strconv.Itoa(maxRewardsValidators), http.StatusBadRequest)
+		return
+	}
+	indices := make([]primitives.ValidatorIndex, 0, len(rawIndices))
+	for _, raw := range rawIndices {
+		index, ok := shared.ValidateUint(w, "validator_index", raw)
+		if !ok {
+			return
+		}
+		indices = append(indices, primitives.ValidatorIndex(index))
+	}
+	pageSize := uint64(defaultRewardsPageSize)
+	if raw := r.URL.Query().Get("page_size"); raw != "" {
+		if pageSize, ok = shared.ValidateUint(w, "page_size", raw); !ok {
+			return
+		}
+		if pageSize == 0 || pageSize > maxRewardsPageSize {
+			handleHTTPError(w, "page_size must be between 1 and "+strconv.Itoa(maxRewardsPageSize), http.StatusBadRequest)
+			return
+		}
+	}
+	var startIndex uint64
+	if raw := r.URL.Query().Get("page_token"); raw != "" {
+		var err error
+		var epoch uint64
+		if epoch, startIndex, err = parseRewardsPageToken(raw); err != nil {
+			handleHTTPError(w, "Invalid page_token: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if epoch < fromEpoch || epoch > toEpoch {
+			handleHTTPError(w, "page_token is outside of the requested epochs", http.StatusBadRequest)
+			return
+		}
+		fromEpoch = epoch
+	}
+
+	// One more reward than the page size is requested to know if there is a next page.
+	rewards, err := vs.BeaconDB.ValidatorEpochRewards(
+		r.Context(),
+		primitives.Epoch(fromEpoch),
+		primitives.Epoch(toEpoch),
+		primitives.ValidatorIndex(startIndex),
+		indices,
+		int(pageSize)+1,
+	)
+	if err != nil {
+		handleHTTPError(w, "Could not get validator rewards: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &ValidatorRewardsResponse{Data: make([]*ValidatorEpochRewards, 0, len(rewards))}
+	if uint64(len(rewards)) > pageSize {
+		resp.NextPageToken = fmt.Sprintf("%d:%d", rewards[pageSize].Epoch, rewards[pageSize].ValidatorIndex)
+		rewards = rewards[:pageSize]
+	}
+	for _, r := range rewards {
+		resp.Data = append(resp.Data, &ValidatorEpochRewards{
+			Epoch:                strconv.FormatUint(uint64(r.Epoch), 10),
+			ValidatorIndex:       strconv.FormatUint(uint64(r.ValidatorIndex), 10),
+			SourceReward:         strconv.FormatUint(r.SourceReward, 10),
+			SourcePenalty:        strconv.FormatUint(r.SourcePenalty, 10),
+			TargetReward:         strconv.FormatUint(r.TargetReward, 10),
+			TargetPenalty:        strconv.FormatUint(r.TargetPenalty, 10),
+			HeadReward:           strconv.FormatUint(r.HeadReward, 10),
+			InactivityPenalty:    strconv.FormatUint(r.InactivityPenalty, 10),
+			ProposerReward:       strconv.FormatUint(r.ProposerReward, 10),
+			SyncCommitteeReward:  strconv.FormatUint(r.SyncCommitteeReward, 10),
+			SyncCommitteePenalty: strconv.FormatUint(r.SyncCommitteePenalty, 10),
+		})
+	}
+	http2.WriteJson(w, resp)
+}
+
+// parseRewardsPageToken parses a page token made of the epoch and the index of the first validator of the page.
+func parseRewardsPageToken(token string) (uint64, uint64, error) {
+	rawEpoch, rawIndex, found := strings.Cut(token, ":")
+	if !found {
+		return 0, 0, fmt.Errorf("expected <epoch>:<validator_index>, got %q", token)
+	}
+	epoch, err := strconv.ParseUint(rawEpoch, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	index, err := strconv.ParseUint(rawIndex, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return epoch, index, nil
+}
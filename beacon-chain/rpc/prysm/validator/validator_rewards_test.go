@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestServer_GetValidatorRewards(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	ctx := context.Background()
+	require.NoError(t, db.SaveValidatorEpochRewards(ctx, 1, []*ethpb.ValidatorEpochRewards{
+		{Epoch: 1, ValidatorIndex: 0, SourceReward: 10, TargetReward: 20, HeadReward: 30},
+		{Epoch: 1, ValidatorIndex: 1, SourcePenalty: 10, TargetPenalty: 20, InactivityPenalty: 5},
+	}))
+	require.NoError(t, db.SaveValidatorEpochRewards(ctx, 2, []*ethpb.ValidatorEpochRewards{
+		{Epoch: 2, ValidatorIndex: 0, ProposerReward: 100, SyncCommitteeReward: 7},
+		{Epoch: 2, ValidatorIndex: 1, SyncCommitteePenalty: 7},
+	}))
+	require.NoError(t, db.SaveValidatorEpochRewards(ctx, 5, []*ethpb.ValidatorEpochRewards{
+		{Epoch: 5, ValidatorIndex: 0, SourceReward: 10},
+	}))
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(3)
+	vs := &Server{BeaconDB: db, GenesisTimeFetcher: &mock.ChainService{Slot: &slot}}
+
+	get := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/rewards"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		vs.GetValidatorRewards(writer, request)
+		return writer
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		writer := get(t, "?from_epoch=1")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ValidatorRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		// The to epoch defaults to the current epoch.
+		require.Equal(t, 4, len(resp.Data))
+		assert.Equal(t, "", resp.NextPageToken)
+		assert.DeepEqual(t, &ValidatorEpochRewards{
+			Epoch:                "1",
+			ValidatorIndex:       "1",
+			SourceReward:         "0",
+			SourcePenalty:        "10",
+			TargetReward:         "0",
+			TargetPenalty:        "20",
+			HeadReward:           "0",
+			InactivityPenalty:    "5",
+			ProposerReward:       "0",
+			SyncCommitteeReward:  "0",
+			SyncCommitteePenalty: "0",
+		}, resp.Data[1])
+		assert.Equal(t, "2", resp.Data[2].Epoch)
+		assert.Equal(t, "100", resp.Data[2].ProposerReward)
+		assert.Equal(t, "7", resp.Data[3].SyncCommitteePenalty)
+	})
+	t.Run("epoch range", func(t *testing.T) {
+		writer := get(t, "?from_epoch=2&to_epoch=10")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ValidatorRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, "5", resp.Data[2].Epoch)
+	})
+	t.Run("pagination", func(t *testing.T) {
+		writer := get(t, "?to_epoch=10&page_size=3")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ValidatorRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, "2:1", resp.NextPageToken)
+
+		writer = get(t, "?to_epoch=10&page_size=3&page_token="+resp.NextPageToken)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp = &ValidatorRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "2", resp.Data[0].Epoch)
+		assert.Equal(t, "1", resp.Data[0].ValidatorIndex)
+		assert.Equal(t, "5", resp.Data[1].Epoch)
+		assert.Equal(t, "", resp.NextPageToken)
+	})
+	t.Run("validator indices", func(t *testing.T) {
+		writer := get(t, "?to_epoch=10&validator_index=0&page_size=2")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ValidatorRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "0", resp.Data[1].ValidatorIndex)
+		assert.Equal(t, "2", resp.Data[1].Epoch)
+		assert.Equal(t, "5:0", resp.NextPageToken)
+
+		writer = get(t, "?to_epoch=10&validator_index=1&validator_index=0&page_token=2:1")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp = &ValidatorRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].ValidatorIndex)
+		assert.Equal(t, "5", resp.Data[1].Epoch)
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		writer := get(t, "?validator_index=foo")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "validator_index is invalid", e.Message)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		writer := get(t, "?from_epoch=3&to_epoch=2")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "from_epoch must not be greater than to_epoch", e.Message)
+	})
+	t.Run("invalid page size", func(t *testing.T) {
+		writer := get(t, "?page_size=0")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "page_size must be between 1 and 1000", e.Message)
+	})
+	t.Run("invalid page token", func(t *testing.T) {
+		writer := get(t, "?page_token=foo")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		writer = get(t, "?from_epoch=2&page_token=1:0")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "page_token is outside of the requested epochs", e.Message)
+	})
+}
+
+func TestParseRewardsPageToken(t *testing.T) {
+	epoch, index, err := parseRewardsPageToken("12:345")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), epoch)
+	assert.Equal(t, uint64(345), index)
+	_, _, err = parseRewardsPageToken("12")
+	require.ErrorContains(t, "expected <epoch>:<validator_index>", err)
+	_, _, err = parseRewardsPageToken("12:x")
+	require.NotNil(t, err)
+}
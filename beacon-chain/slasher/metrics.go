@@ -37,6 +37,10 @@ var (
 		Name: "slasher_blocks_processed_total",
 		Help: "Total number of blocks successfully processed by slasher",
 	})
+	rescanLastEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_rescan_last_epoch",
+		Help: "The last epoch of the beacon DB re-scanned by slasher",
+	})
 	doubleProposalsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "slasher_double_proposals_total",
 		Help: "Total slashable proposals successfully detected by slasher",
@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -12,6 +13,22 @@ type MockSlashingChecker struct {
 	AttesterSlashingFound bool
 	ProposerSlashingFound bool
 	HighestAtts           map[primitives.ValidatorIndex]*ethpb.HighestAttestation
+	Evidence              []*slashertypes.SlashingEvidence
+}
+
+func (s *MockSlashingChecker) SlashingEvidence(
+	_ context.Context, startId uint64, limit int,
+) ([]*slashertypes.SlashingEvidence, error) {
+	evidence := make([]*slashertypes.SlashingEvidence, 0)
+	for _, ev := range s.Evidence {
+		if len(evidence) == limit {
+			break
+		}
+		if ev.Id >= startId {
+			evidence = append(evidence, ev)
+		}
+	}
+	return evidence, nil
 }
 
 func (s *MockSlashingChecker) HighestAttestations(
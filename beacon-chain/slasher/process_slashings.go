@@ -2,15 +2,17 @@ package slasher
 
 import (
 	"context"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
-// Verifies attester slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
+// Verifies attester slashings, logs them, saves them as slashing evidence and submits them to
+// the slashing operations pool in the beacon node if they pass validation.
 func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
 	var beaconState state.BeaconState
 	var err error
@@ -20,6 +22,7 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*eth
 			return err
 		}
 	}
+	evidence := make([]*slashertypes.SlashingEvidence, 0, len(slashings))
 	for _, sl := range slashings {
 		if err := s.verifyAttSignature(ctx, sl.Attestation_1); err != nil {
 			log.WithError(err).WithField("a", sl.Attestation_1).Warn(
@@ -36,17 +39,24 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*eth
 
 		// Log the slashing event and insert into the beacon node's operations pool.
 		logAttesterSlashing(sl)
+		evidence = append(evidence, &slashertypes.SlashingEvidence{
+			DetectedAt:       time.Now(),
+			AttesterSlashing: sl,
+		})
 		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(
 			ctx, beaconState, sl,
 		); err != nil {
 			log.WithError(err).Error("Could not insert attester slashing into operations pool")
 		}
 	}
+	if err := s.serviceCfg.Database.SaveSlashingEvidence(ctx, evidence); err != nil {
+		log.WithError(err).Error("Could not save attester slashing evidence")
+	}
 	return nil
 }
 
-// Verifies proposer slashings, logs them, and submits them to the slashing operations pool
-// in the beacon node if they pass validation.
+// Verifies proposer slashings, logs them, saves them as slashing evidence and submits them to
+// the slashing operations pool in the beacon node if they pass validation.
 func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
 	var beaconState state.BeaconState
 	var err error
@@ -56,6 +66,7 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 			return err
 		}
 	}
+	evidence := make([]*slashertypes.SlashingEvidence, 0, len(slashings))
 	for _, sl := range slashings {
 		if err := s.verifyBlockSignature(ctx, sl.Header_1); err != nil {
 			log.WithError(err).WithField("a", sl.Header_1).Warn(
@@ -71,10 +82,17 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		}
 		// Log the slashing event and insert into the beacon node's operations pool.
 		logProposerSlashing(sl)
+		evidence = append(evidence, &slashertypes.SlashingEvidence{
+			DetectedAt:       time.Now(),
+			ProposerSlashing: sl,
+		})
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, sl); err != nil {
 			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
 		}
 	}
+	if err := s.serviceCfg.Database.SaveSlashingEvidence(ctx, evidence); err != nil {
+		log.WithError(err).Error("Could not save proposer slashing evidence")
+	}
 	return nil
 }
 
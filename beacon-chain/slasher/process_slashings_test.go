@@ -123,6 +123,11 @@ func TestService_processAttesterSlashings(t *testing.T) {
 		err = s.processAttesterSlashings(ctx, slashings)
 		require.NoError(tt, err)
 		require.LogsDoNotContain(tt, hook, "Invalid signature")
+
+		evidence, err := slasherDB.SlashingEvidence(ctx, 0, 10)
+		require.NoError(tt, err)
+		require.Equal(tt, 1, len(evidence))
+		require.DeepEqual(tt, slashings[0], evidence[0].AttesterSlashing)
 	})
 }
 
@@ -249,5 +254,10 @@ func TestService_processProposerSlashings(t *testing.T) {
 		err = s.processProposerSlashings(ctx, slashings)
 		require.NoError(tt, err)
 		require.LogsDoNotContain(tt, hook, "Invalid signature")
+
+		evidence, err := slasherDB.SlashingEvidence(ctx, 0, 10)
+		require.NoError(tt, err)
+		require.Equal(tt, 1, len(evidence))
+		require.DeepEqual(tt, slashings[0], evidence[0].ProposerSlashing)
 	})
 }
@@ -80,94 +80,124 @@ func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-ch
 	for {
 		select {
 		case currentSlot := <-slotTicker:
-			attestations := s.attsQueue.dequeue()
-			currentEpoch := slots.ToEpoch(currentSlot)
-			// We take all the attestations in the queue and filter out
-			// those which are valid now and valid in the future.
-			validAtts, validInFuture, numDropped := s.filterAttestations(attestations, currentEpoch)
-
-			deferredAttestationsTotal.Add(float64(len(validInFuture)))
-			droppedAttestationsTotal.Add(float64(numDropped))
-
-			// We add back those attestations that are valid in the future to the queue.
-			s.attsQueue.extend(validInFuture)
-
-			log.WithFields(logrus.Fields{
-				"currentSlot":     currentSlot,
-				"currentEpoch":    currentEpoch,
-				"numValidAtts":    len(validAtts),
-				"numDeferredAtts": len(validInFuture),
-				"numDroppedAtts":  numDropped,
-			}).Info("Processing queued attestations for slashing detection")
-
-			// Save the attestation records to our database.
-			if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(
-				ctx, validAtts,
-			); err != nil {
-				log.WithError(err).Error("Could not save attestation records to DB")
-				continue
-			}
-
-			// Check for slashings.
-			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
-			if err != nil {
-				log.WithError(err).Error("Could not check slashable attestations")
-				continue
-			}
-
-			// Process attester slashings by verifying their signatures, submitting
-			// to the beacon node's operations pool, and logging them.
-			if err := s.processAttesterSlashings(ctx, slashings); err != nil {
-				log.WithError(err).Error("Could not process attester slashings")
-				continue
+			if err := s.processAttestationsInQueue(ctx, currentSlot); err != nil {
+				log.WithError(err).Error("Could not process queued attestations")
 			}
-
-			processedAttestationsTotal.Add(float64(len(validAtts)))
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// Performs slashing detection on the attestations in the queue which are valid at the current slot.
+// Those valid in the future are added back to the queue.
+func (s *Service) processAttestationsInQueue(ctx context.Context, currentSlot primitives.Slot) error {
+	s.detectionLock.Lock()
+	defer s.detectionLock.Unlock()
+	attestations := s.attsQueue.dequeue()
+	currentEpoch := slots.ToEpoch(currentSlot)
+	// We take all the attestations in the queue and filter out
+	// those which are valid now and valid in the future.
+	validAtts, validInFuture, numDropped := s.filterAttestations(attestations, currentEpoch)
+
+	deferredAttestationsTotal.Add(float64(len(validInFuture)))
+	droppedAttestationsTotal.Add(float64(numDropped))
+
+	// We add back those attestations that are valid in the future to the queue.
+	s.attsQueue.extend(validInFuture)
+
+	log.WithFields(logrus.Fields{
+		"currentSlot":     currentSlot,
+		"currentEpoch":    currentEpoch,
+		"numValidAtts":    len(validAtts),
+		"numDeferredAtts": len(validInFuture),
+		"numDroppedAtts":  numDropped,
+	}).Info("Processing queued attestations for slashing detection")
+
+	return s.detectAttestations(ctx, currentEpoch, validAtts)
+}
+
+// Saves the records of the given attestations, which must be valid at the current epoch, and
+// processes the attester slashings they reveal. The caller must hold the detection lock.
+func (s *Service) detectAttestations(
+	ctx context.Context, currentEpoch primitives.Epoch, validAtts []*slashertypes.IndexedAttestationWrapper,
+) error {
+	// Save the attestation records to our database.
+	if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(
+		ctx, validAtts,
+	); err != nil {
+		return errors.Wrap(err, "could not save attestation records to DB")
+	}
+
+	// Check for slashings.
+	slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+	if err != nil {
+		return errors.Wrap(err, "could not check slashable attestations")
+	}
+
+	// Process attester slashings by verifying their signatures, submitting
+	// to the beacon node's operations pool, and logging them.
+	if err := s.processAttesterSlashings(ctx, slashings); err != nil {
+		return errors.Wrap(err, "could not process attester slashings")
+	}
+
+	processedAttestationsTotal.Add(float64(len(validAtts)))
+	return nil
+}
+
 // Process queued blocks every time an epoch ticker fires. We retrieve
 // these blocks from a queue, then perform double proposal detection.
 func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan primitives.Slot) {
 	for {
 		select {
 		case currentSlot := <-slotTicker:
-			blocks := s.blksQueue.dequeue()
-			currentEpoch := slots.ToEpoch(currentSlot)
+			if err := s.processBlocksInQueue(ctx, currentSlot); err != nil {
+				log.WithError(err).Error("Could not process queued blocks")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
 
-			receivedBlocksTotal.Add(float64(len(blocks)))
+// Performs double proposal detection on the blocks in the queue.
+func (s *Service) processBlocksInQueue(ctx context.Context, currentSlot primitives.Slot) error {
+	return s.processBlocks(ctx, s.blksQueue.dequeue(), currentSlot)
+}
 
-			log.WithFields(logrus.Fields{
-				"currentSlot":  currentSlot,
-				"currentEpoch": currentEpoch,
-				"numBlocks":    len(blocks),
-			}).Info("Processing queued blocks for slashing detection")
+// Performs double proposal detection on the given blocks.
+func (s *Service) processBlocks(
+	ctx context.Context, blocks []*slashertypes.SignedBlockHeaderWrapper, currentSlot primitives.Slot,
+) error {
+	s.detectionLock.Lock()
+	defer s.detectionLock.Unlock()
+	currentEpoch := slots.ToEpoch(currentSlot)
 
-			start := time.Now()
-			// Check for slashings.
-			slashings, err := s.detectProposerSlashings(ctx, blocks)
-			if err != nil {
-				log.WithError(err).Error("Could not detect proposer slashings")
-				continue
-			}
+	receivedBlocksTotal.Add(float64(len(blocks)))
 
-			// Process proposer slashings by verifying their signatures, submitting
-			// to the beacon node's operations pool, and logging them.
-			if err := s.processProposerSlashings(ctx, slashings); err != nil {
-				log.WithError(err).Error("Could not process proposer slashings")
-				continue
-			}
+	log.WithFields(logrus.Fields{
+		"currentSlot":  currentSlot,
+		"currentEpoch": currentEpoch,
+		"numBlocks":    len(blocks),
+	}).Info("Processing queued blocks for slashing detection")
 
-			log.WithField("elapsed", time.Since(start)).Debug("Done checking slashable blocks")
+	start := time.Now()
+	// Check for slashings.
+	slashings, err := s.detectProposerSlashings(ctx, blocks)
+	if err != nil {
+		return errors.Wrap(err, "could not detect proposer slashings")
+	}
 
-			processedBlocksTotal.Add(float64(len(blocks)))
-		case <-ctx.Done():
-			return
-		}
+	// Process proposer slashings by verifying their signatures, submitting
+	// to the beacon node's operations pool, and logging them.
+	if err := s.processProposerSlashings(ctx, slashings); err != nil {
+		return errors.Wrap(err, "could not process proposer slashings")
 	}
+
+	log.WithField("elapsed", time.Since(start)).Debug("Done checking slashable blocks")
+
+	processedBlocksTotal.Add(float64(len(blocks)))
+	return nil
 }
 
 // Prunes slasher data on each slot tick to prevent unnecessary build-up of disk space usage.
@@ -203,7 +233,7 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	log.WithFields(logrus.Fields{
 		"currentEpoch":          currentEpoch,
 		"pruningAllBeforeEpoch": maxPruningEpoch,
-	}).Info("Pruning old attestations and proposals for slasher")
+	}).Info("Pruning old attestations, proposals and slashing evidence for slasher")
 	numPrunedAtts, err := s.serviceCfg.Database.PruneAttestationsAtEpoch(
 		ctx, maxPruningEpoch,
 	)
@@ -216,6 +246,12 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if err != nil {
 		return errors.Wrap(err, "Could not prune proposals")
 	}
+	numPrunedEvidence, err := s.serviceCfg.Database.PruneSlashingEvidenceAtEpoch(
+		ctx, maxPruningEpoch,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Could not prune slashing evidence")
+	}
 	fields := logrus.Fields{}
 	if numPrunedAtts > 0 {
 		fields["numPrunedAtts"] = numPrunedAtts
@@ -223,7 +259,10 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if numPrunedProposals > 0 {
 		fields["numPrunedProposals"] = numPrunedProposals
 	}
+	if numPrunedEvidence > 0 {
+		fields["numPrunedEvidence"] = numPrunedEvidence
+	}
 	fields["elapsed"] = time.Since(start)
-	log.WithFields(fields).Info("Done pruning old attestations and proposals for slasher")
+	log.WithFields(fields).Info("Done pruning old attestations, proposals and slashing evidence for slasher")
 	return nil
 }
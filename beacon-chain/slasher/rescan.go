@@ -0,0 +1,187 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Replays the blocks stored in the beacon DB over the configured range of epochs, and the
+// attestations they include, into the slasher. Slashing detection is performed on each epoch in
+// turn, interleaved with the detection on the live queues, and the progress is checkpointed in
+// the slasher DB so that an interrupted re-scan resumes after the last epoch it completed.
+func (s *Service) rescanHistory(ctx context.Context) error {
+	currentEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
+	startEpoch, endEpoch := s.rescanRange(currentEpoch)
+	if startEpoch > endEpoch {
+		return errors.Errorf("start epoch %d of the re-scan is after its end epoch %d", startEpoch, endEpoch)
+	}
+
+	// The epochs at the beginning of the range which were already re-scanned are skipped.
+	checkpoint, err := s.serviceCfg.Database.RescanCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get re-scan checkpoint")
+	}
+	checkpointStart := startEpoch
+	if checkpoint != nil && checkpoint.StartEpoch <= startEpoch && checkpoint.LastEpoch >= startEpoch {
+		checkpointStart = checkpoint.StartEpoch
+		startEpoch = checkpoint.LastEpoch + 1
+	}
+	if startEpoch > endEpoch {
+		log.WithField("lastEpoch", checkpoint.LastEpoch).Info("Beacon DB was already re-scanned by slasher")
+		return nil
+	}
+
+	numEpochs := uint64(endEpoch-startEpoch) + 1
+	log.WithFields(logrus.Fields{
+		"startEpoch": startEpoch,
+		"endEpoch":   endEpoch,
+	}).Info("Re-scanning beacon DB for slashable offenses")
+	start := time.Now()
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		blocks, atts, err := s.storedEpoch(ctx, epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not get blocks of epoch %d", epoch)
+		}
+		currentSlot := slots.CurrentSlot(uint64(s.genesisTime.Unix()))
+		if err := s.processBlocks(ctx, blocks, currentSlot); err != nil {
+			return err
+		}
+		if err := s.rescanAttestations(ctx, atts, currentSlot); err != nil {
+			return err
+		}
+		if err := s.serviceCfg.Database.SaveRescanCheckpoint(ctx, &slashertypes.RescanCheckpoint{
+			StartEpoch: checkpointStart,
+			LastEpoch:  epoch,
+		}); err != nil {
+			return errors.Wrap(err, "could not save re-scan checkpoint")
+		}
+		rescanLastEpoch.Set(float64(epoch))
+
+		done := uint64(epoch-startEpoch) + 1
+		log.WithFields(logrus.Fields{
+			"epoch":     epoch,
+			"numBlocks": len(blocks),
+			"numAtts":   len(atts),
+			"progress":  float64(done*100) / float64(numEpochs),
+			"elapsed":   time.Since(start),
+		}).Info("Re-scanned epoch of beacon DB")
+	}
+	log.WithField("elapsed", time.Since(start)).Info("Completed re-scan of beacon DB")
+	return nil
+}
+
+// Performs slashing detection on attestations stored in the beacon DB. Unlike the queued
+// attestations, those which are only valid in the future are dropped instead of being
+// deferred, so that the re-scan never adds to the live queue.
+func (s *Service) rescanAttestations(
+	ctx context.Context, atts []*slashertypes.IndexedAttestationWrapper, currentSlot primitives.Slot,
+) error {
+	s.detectionLock.Lock()
+	defer s.detectionLock.Unlock()
+	currentEpoch := slots.ToEpoch(currentSlot)
+	validAtts, validInFuture, numDropped := s.filterAttestations(atts, currentEpoch)
+	droppedAttestationsTotal.Add(float64(numDropped + len(validInFuture)))
+	return s.detectAttestations(ctx, currentEpoch, validAtts)
+}
+
+// Returns the range of epochs to re-scan. By default, the range covers the slasher history
+// up to the current epoch. The range never starts before the slasher history, as the
+// attestations of older epochs are dropped, nor ends after the current epoch.
+func (s *Service) rescanRange(currentEpoch primitives.Epoch) (primitives.Epoch, primitives.Epoch) {
+	var historyStart primitives.Epoch
+	if currentEpoch >= s.params.historyLength {
+		historyStart = currentEpoch - s.params.historyLength + 1
+	}
+	startEpoch := historyStart
+	endEpoch := currentEpoch
+	if s.serviceCfg.Rescan.StartEpoch != nil {
+		startEpoch = *s.serviceCfg.Rescan.StartEpoch
+		if startEpoch < historyStart {
+			log.WithFields(logrus.Fields{
+				"startEpoch":   startEpoch,
+				"historyStart": historyStart,
+			}).Warn("Re-scan start epoch is before the slasher history, starting the re-scan at the oldest epoch of the history")
+			startEpoch = historyStart
+		}
+	}
+	if s.serviceCfg.Rescan.EndEpoch != nil && *s.serviceCfg.Rescan.EndEpoch < currentEpoch {
+		endEpoch = *s.serviceCfg.Rescan.EndEpoch
+	}
+	return startEpoch, endEpoch
+}
+
+// Returns the headers of the blocks stored in the beacon DB for an epoch, and the indexed
+// attestations they include. They are not pushed to the slasher queues, so that the re-scan
+// does not hold up the processing of the live attestations and blocks.
+func (s *Service) storedEpoch(
+	ctx context.Context, epoch primitives.Epoch,
+) ([]*slashertypes.SignedBlockHeaderWrapper, []*slashertypes.IndexedAttestationWrapper, error) {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch - 1
+	blks, _, err := s.serviceCfg.BeaconDatabase.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get blocks from beacon DB")
+	}
+	blocks := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(blks))
+	var atts []*slashertypes.IndexedAttestationWrapper
+	for _, blk := range blks {
+		header, err := blk.Header()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get block header")
+		}
+		signingRoot, err := header.Header.HashTreeRoot()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get hash tree root of block header")
+		}
+		blocks = append(blocks, &slashertypes.SignedBlockHeaderWrapper{
+			SignedBeaconBlockHeader: header,
+			SigningRoot:             signingRoot,
+		})
+
+		for _, att := range blk.Block().Body().Attestations() {
+			indexedAtt, err := s.indexedAttestation(ctx, att)
+			if err != nil {
+				log.WithError(err).WithField("slot", att.Data.Slot).Warn("Could not convert stored attestation to indexed attestation")
+				continue
+			}
+			signingRoot, err := indexedAtt.Data.HashTreeRoot()
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "could not get hash tree root of attestation")
+			}
+			atts = append(atts, &slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: indexedAtt,
+				SigningRoot:        signingRoot,
+			})
+		}
+	}
+	return blocks, atts, nil
+}
+
+func (s *Service) indexedAttestation(ctx context.Context, att *ethpb.Attestation) (*ethpb.IndexedAttestation, error) {
+	targetState, err := s.serviceCfg.AttestationStateFetcher.AttestationTargetState(ctx, att.Data.Target)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation target state")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, targetState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation committee")
+	}
+	return attestation.ConvertToIndexed(ctx, att, committee)
+}
@@ -0,0 +1,191 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	slashingsmock "github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings/mock"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_rescanRange(t *testing.T) {
+	epoch := func(e primitives.Epoch) *primitives.Epoch { return &e }
+	tests := []struct {
+		name         string
+		rescan       *RescanConfig
+		currentEpoch primitives.Epoch
+		wantStart    primitives.Epoch
+		wantEnd      primitives.Epoch
+		wantWarning  bool
+	}{
+		{
+			name:         "defaults to the history",
+			rescan:       &RescanConfig{},
+			currentEpoch: 10,
+			wantStart:    7,
+			wantEnd:      10,
+		},
+		{
+			name:         "history longer than the chain",
+			rescan:       &RescanConfig{},
+			currentEpoch: 2,
+			wantStart:    0,
+			wantEnd:      2,
+		},
+		{
+			name:         "configured range",
+			rescan:       &RescanConfig{StartEpoch: epoch(3), EndEpoch: epoch(5)},
+			currentEpoch: 6,
+			wantStart:    3,
+			wantEnd:      5,
+		},
+		{
+			name:         "start before the history",
+			rescan:       &RescanConfig{StartEpoch: epoch(2), EndEpoch: epoch(8)},
+			currentEpoch: 10,
+			wantStart:    7,
+			wantEnd:      8,
+			wantWarning:  true,
+		},
+		{
+			name:         "end after the current epoch",
+			rescan:       &RescanConfig{EndEpoch: epoch(20)},
+			currentEpoch: 10,
+			wantStart:    7,
+			wantEnd:      10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			s := &Service{
+				params:     &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4},
+				serviceCfg: &ServiceConfig{Rescan: tt.rescan},
+			}
+			start, end := s.rescanRange(tt.currentEpoch)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+			if tt.wantWarning {
+				require.LogsContain(t, hook, "Re-scan start epoch is before the slasher history")
+			} else {
+				require.LogsDoNotContain(t, hook, "Re-scan start epoch is before the slasher history")
+			}
+		})
+	}
+}
+
+func TestService_rescanHistory(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconDB := dbtest.SetupDB(t)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	for _, slot := range []primitives.Slot{1, slotsPerEpoch + 1, 2*slotsPerEpoch + 1} {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ProposerIndex = 1
+		util.SaveBlock(t, ctx, beaconDB, blk)
+	}
+	// A second block of the same proposer at the same slot is a double proposal.
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = slotsPerEpoch + 1
+	blk.Block.ProposerIndex = 1
+	blk.Block.Body.Graffiti = []byte("double proposal graffiti padded!")
+	util.SaveBlock(t, ctx, beaconDB, blk)
+
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	mockChain := &mock.ChainService{State: beaconState}
+	// The current epoch is 3.
+	genesisTime := time.Now().Add(-time.Duration(uint64(3*slotsPerEpoch+1)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	end := primitives.Epoch(2)
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			BeaconDatabase:       beaconDB,
+			StateGen:             stategen.New(beaconDB, doublylinkedtree.New()),
+			SlashingPoolInserter: &slashingsmock.PoolMock{},
+			HeadStateFetcher:     mockChain,
+			Rescan:               &RescanConfig{EndEpoch: &end},
+		},
+		attsQueue:                      newAttestationsQueue(),
+		blksQueue:                      newBlocksQueue(),
+		genesisTime:                    genesisTime,
+		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+	}
+
+	// A block received live is left in the queue for the slot ticker.
+	s.blksQueue.push(&slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 3 * slotsPerEpoch}},
+	})
+
+	require.NoError(t, s.rescanHistory(ctx))
+	assert.Equal(t, 1, s.blksQueue.size())
+	for _, slot := range []primitives.Slot{1, slotsPerEpoch + 1, 2*slotsPerEpoch + 1} {
+		proposal, err := slasherDB.BlockProposalForValidator(ctx, 1, slot)
+		require.NoError(t, err)
+		require.NotNil(t, proposal)
+	}
+	// The signatures of the detected double proposal cannot be verified against the test states.
+	require.LogsContain(t, hook, "Invalid signature for block header in detected slashing offense")
+	checkpoint, err := slasherDB.RescanCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &slashertypes.RescanCheckpoint{StartEpoch: 0, LastEpoch: 2}, checkpoint)
+
+	// The re-scan resumes after the last re-scanned epoch.
+	hook.Reset()
+	require.NoError(t, s.rescanHistory(ctx))
+	require.LogsContain(t, hook, "Beacon DB was already re-scanned by slasher")
+
+	s.serviceCfg.Rescan.EndEpoch = nil
+	require.NoError(t, s.rescanHistory(ctx))
+	checkpoint, err = slasherDB.RescanCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &slashertypes.RescanCheckpoint{StartEpoch: 0, LastEpoch: 3}, checkpoint)
+}
+
+func TestService_rescanAttestations(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:             slasherDB,
+			SlashingPoolInserter: &slashingsmock.PoolMock{},
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+		},
+		attsQueue:                      newAttestationsQueue(),
+		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
+	}
+	currentSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+
+	// The attestation targeting a future epoch is dropped instead of being added to the live queue.
+	atts := []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(t, 1, 2, []uint64{1}, []byte{1}),
+		createAttestationWrapper(t, 2, 4, []uint64{2}, []byte{2}),
+	}
+	require.NoError(t, s.rescanAttestations(ctx, atts, currentSlot))
+	assert.Equal(t, 0, s.attsQueue.size())
+	records, err := slasherDB.AttestationRecordForValidator(ctx, 1, 2)
+	require.NoError(t, err)
+	require.NotNil(t, records)
+	records, err = slasherDB.AttestationRecordForValidator(ctx, 2, 4)
+	require.NoError(t, err)
+	assert.Equal(t, true, records == nil)
+}
@@ -23,6 +23,18 @@ func (s *Service) HighestAttestations(
 	return atts, nil
 }
 
+// SlashingEvidence returns at most limit evidences of the slashings detected by the slasher,
+// starting from the evidence with identifier startId.
+func (s *Service) SlashingEvidence(
+	ctx context.Context, startId uint64, limit int,
+) ([]*slashertypes.SlashingEvidence, error) {
+	evidence, err := s.serviceCfg.Database.SlashingEvidence(ctx, startId, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get slashing evidence from database")
+	}
+	return evidence, nil
+}
+
 // IsSlashableBlock checks if an input block header is slashable
 // with respect to historical block proposal data.
 func (s *Service) IsSlashableBlock(
@@ -6,6 +6,7 @@ package slasher
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v4/async/event"
@@ -13,9 +14,10 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
+	beaconsync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -38,8 +40,19 @@ type ServiceConfig struct {
 	StateGen                stategen.StateManager
 	SlashingPoolInserter    slashings.PoolInserter
 	HeadStateFetcher        blockchain.HeadFetcher
-	SyncChecker             sync.Checker
+	SyncChecker             beaconsync.Checker
 	ClockWaiter             startup.ClockWaiter
+	BeaconDatabase          db.ReadOnlyDatabase
+	Rescan                  *RescanConfig
+}
+
+// RescanConfig defines the range of epochs of the blocks stored in the beacon DB which are
+// replayed into the slasher when it starts. A nil start epoch defaults to the oldest epoch of
+// the slasher history, and a nil end epoch to the current epoch. The range is clamped to the
+// slasher history.
+type RescanConfig struct {
+	StartEpoch *primitives.Epoch
+	EndEpoch   *primitives.Epoch
 }
 
 // SlashingChecker is an interface for defining services that the beacon node may interact with to provide slashing data.
@@ -49,6 +62,7 @@ type SlashingChecker interface {
 	HighestAttestations(
 		ctx context.Context, indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	SlashingEvidence(ctx context.Context, startId uint64, limit int) ([]*slashertypes.SlashingEvidence, error)
 }
 
 // Service defining a slasher implementation as part of
@@ -67,6 +81,9 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochWrittenForValidator map[primitives.ValidatorIndex]primitives.Epoch
+	// detectionLock serializes the slashing detection of the queued blocks and attestations
+	// with the one of the re-scanned epochs, as both update the same slasher DB records.
+	detectionLock sync.Mutex
 }
 
 // New instantiates a new slasher from configuration values.
@@ -136,6 +153,16 @@ func (s *Service) run() {
 	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
 	go s.processQueuedBlocks(s.ctx, s.blocksSlotTicker.C())
 	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
+
+	// The re-scan runs in the background, one epoch at a time, so that the live attestations
+	// and blocks keep being processed on each slot while it is in progress.
+	if s.serviceCfg.Rescan != nil {
+		go func() {
+			if err := s.rescanHistory(s.ctx); err != nil {
+				log.WithError(err).Error("Could not re-scan the history of the beacon DB")
+			}
+		}()
+	}
 }
 
 // Stop the slasher service.
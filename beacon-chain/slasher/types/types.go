@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
@@ -51,3 +53,19 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex primitives.ValidatorIndex
 	Epoch          primitives.Epoch
 }
+
+// SlashingEvidence is a slashing detected by the slasher, kept as evidence of the offense.
+// Exactly one of AttesterSlashing and ProposerSlashing is set.
+type SlashingEvidence struct {
+	Id               uint64
+	DetectedAt       time.Time
+	AttesterSlashing *ethpb.AttesterSlashing
+	ProposerSlashing *ethpb.ProposerSlashing
+}
+
+// RescanCheckpoint records the progress of a historical re-scan of the beacon DB by the slasher.
+// The epochs from StartEpoch to LastEpoch, included, were re-scanned.
+type RescanCheckpoint struct {
+	StartEpoch primitives.Epoch
+	LastEpoch  primitives.Epoch
+}
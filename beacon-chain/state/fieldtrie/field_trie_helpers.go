@@ -14,6 +14,22 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
+// itemsAccessor gives access to the elements of a field without copying them,
+// e.g. to the items of a single state stored in a multi-value slice.
+type itemsAccessor[V any] interface {
+	Len() int
+	At(index uint64) (V, error)
+	Value() []V
+}
+
+// elementsLen returns the number of elements of a field.
+func elementsLen(elements interface{}) int {
+	if l, ok := elements.(interface{ Len() int }); ok {
+		return l.Len()
+	}
+	return reflect.Indirect(reflect.ValueOf(elements)).Len()
+}
+
 // ProofFromMerkleLayers creates a proof starting at the leaf index of the state Merkle layers.
 func ProofFromMerkleLayers(layers [][][]byte, startingLeafIndex int) [][]byte {
 	// The merkle tree structure looks as follows:
@@ -54,9 +70,9 @@ func validateElements(field types.FieldIndex, dataType types.DataType, elements
 		}
 		length *= comLength
 	}
-	val := reflect.Indirect(reflect.ValueOf(elements))
-	if uint64(val.Len()) > length {
-		return errors.Errorf("elements length is larger than expected for field %s: %d > %d", field.String(), val.Len(), length)
+	l := elementsLen(elements)
+	if uint64(l) > length {
+		return errors.Errorf("elements length is larger than expected for field %s: %d > %d", field.String(), l, length)
 	}
 	return nil
 }
@@ -111,6 +127,11 @@ func convertRandaoMixes(indices []uint64, elements interface{}, convertAll bool)
 		return handleByteArrays(val, indices, convertAll)
 	case *customtypes.RandaoMixes:
 		return handle32ByteArrays(val[:], indices, convertAll)
+	case itemsAccessor[[32]byte]:
+		if convertAll {
+			return handle32ByteArrays(val.Value(), indices, convertAll)
+		}
+		return handle32ByteArrayItems(val, indices)
 	default:
 		return nil, errors.Errorf("Incorrect type used for randao mixes")
 	}
@@ -125,11 +146,17 @@ func convertEth1DataVotes(indices []uint64, elements interface{}, convertAll boo
 }
 
 func convertValidators(indices []uint64, elements interface{}, convertAll bool) ([][32]byte, error) {
-	val, ok := elements.([]*ethpb.Validator)
-	if !ok {
+	switch val := elements.(type) {
+	case []*ethpb.Validator:
+		return handleValidatorSlice(val, indices, convertAll)
+	case itemsAccessor[*ethpb.Validator]:
+		if convertAll {
+			return handleValidatorSlice(val.Value(), indices, convertAll)
+		}
+		return handleValidatorItems(val, indices)
+	default:
 		return nil, errors.Errorf("Wanted type of %T but got %T", []*ethpb.Validator{}, elements)
 	}
-	return handleValidatorSlice(val, indices, convertAll)
 }
 
 func convertAttestations(indices []uint64, elements interface{}, convertAll bool) ([][32]byte, error) {
@@ -141,11 +168,17 @@ func convertAttestations(indices []uint64, elements interface{}, convertAll bool
 }
 
 func convertBalances(indices []uint64, elements interface{}, convertAll bool) ([][32]byte, error) {
-	val, ok := elements.([]uint64)
-	if !ok {
+	switch val := elements.(type) {
+	case []uint64:
+		return handleBalanceSlice(val, indices, convertAll)
+	case itemsAccessor[uint64]:
+		if convertAll {
+			return handleBalanceSlice(val.Value(), indices, convertAll)
+		}
+		return handleBalanceItems(val, indices)
+	default:
 		return nil, errors.Errorf("Wanted type of %T but got %T", []uint64{}, elements)
 	}
-	return handleBalanceSlice(val, indices, convertAll)
 }
 
 // handleByteArrays computes and returns byte arrays in a slice of root format.
@@ -347,3 +380,61 @@ func handleBalanceSlice(val, indices []uint64, convertAll bool) ([][32]byte, err
 	}
 	return [][32]byte{}, nil
 }
+
+// handle32ByteArrayItems returns the 32 byte arrays at the given indices in a slice of root format.
+func handle32ByteArrayItems(val itemsAccessor[[32]byte], indices []uint64) ([][32]byte, error) {
+	roots := make([][32]byte, 0, len(indices))
+	for _, idx := range indices {
+		root, err := val.At(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get byte array at index %d", idx)
+		}
+		roots = append(roots, root)
+	}
+	return roots, nil
+}
+
+// handleValidatorItems returns the roots of the validators at the given indices.
+func handleValidatorItems(val itemsAccessor[*ethpb.Validator], indices []uint64) ([][32]byte, error) {
+	roots := make([][32]byte, 0, len(indices))
+	for _, idx := range indices {
+		v, err := val.At(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator at index %d", idx)
+		}
+		root, err := stateutil.ValidatorRootWithHasher(v)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+	return roots, nil
+}
+
+// handleBalanceItems returns the chunks containing the balances at the given indices.
+func handleBalanceItems(val itemsAccessor[uint64], indices []uint64) ([][32]byte, error) {
+	numOfElems, err := types.Balances.ElemsInChunk()
+	if err != nil {
+		return nil, err
+	}
+	length := uint64(val.Len())
+	roots := make([][32]byte, 0, len(indices))
+	for _, idx := range indices {
+		startGroup := idx / numOfElems * numOfElems
+		var chunk [32]byte
+		sizeOfElem := uint64(len(chunk)) / numOfElems
+		for i, j := uint64(0), startGroup; j < startGroup+numOfElems; i, j = i+sizeOfElem, j+1 {
+			// Indices beyond the end of the list are zeroed out, as in handleBalanceSlice.
+			wantedVal := uint64(0)
+			if j < length {
+				wantedVal, err = val.At(j)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not get balance at index %d", j)
+				}
+			}
+			binary.LittleEndian.PutUint64(chunk[i:i+sizeOfElem], wantedVal)
+		}
+		roots = append(roots, chunk)
+	}
+	return roots, nil
+}
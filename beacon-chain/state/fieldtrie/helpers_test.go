@@ -71,6 +71,66 @@ func TestBalancesSlice_CorrectRoots_Some(t *testing.T) {
 	assert.DeepEqual(t, roots, [][32]byte{root1, root1})
 }
 
+type testItems[V any] []V
+
+func (i testItems[V]) Len() int { return len(i) }
+
+func (i testItems[V]) At(index uint64) (V, error) {
+	if index >= uint64(len(i)) {
+		var def V
+		return def, fmt.Errorf("index %d out of bounds", index)
+	}
+	return i[index], nil
+}
+
+func (i testItems[V]) Value() []V { return i }
+
+func TestFieldConverters_ItemsAccessor(t *testing.T) {
+	balances := []uint64{5, 2929, 34, 1291, 354305}
+	validators := []*ethpb.Validator{
+		{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 1},
+		{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 2},
+		{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 3},
+	}
+	mixes := make([][32]byte, fieldparams.RandaoMixesLength)
+	for i := range mixes {
+		mixes[i] = [32]byte{byte(i)}
+	}
+	var mixesArr customtypes.RandaoMixes
+	copy(mixesArr[:], mixes)
+
+	tests := []struct {
+		field    types.FieldIndex
+		elements interface{}
+		items    interface{}
+		indices  []uint64
+	}{
+		{field: types.Balances, elements: balances, items: testItems[uint64](balances), indices: []uint64{2, 4}},
+		{field: types.Validators, elements: validators, items: testItems[*ethpb.Validator](validators), indices: []uint64{0, 2}},
+		{field: types.RandaoMixes, elements: &mixesArr, items: testItems[[32]byte](mixes), indices: []uint64{1, 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field.String(), func(t *testing.T) {
+			want, err := fieldConverters(tt.field, []uint64{}, tt.elements, true)
+			require.NoError(t, err)
+			got, err := fieldConverters(tt.field, []uint64{}, tt.items, true)
+			require.NoError(t, err)
+			assert.DeepEqual(t, want, got)
+
+			want, err = fieldConverters(tt.field, tt.indices, tt.elements, false)
+			require.NoError(t, err)
+			got, err = fieldConverters(tt.field, tt.indices, tt.items, false)
+			require.NoError(t, err)
+			assert.DeepEqual(t, want, got)
+		})
+	}
+
+	_, err := fieldConverters(types.Balances, []uint64{10}, testItems[uint64](balances), false)
+	require.NoError(t, err, "Balances beyond the end of the list are zero")
+	_, err = fieldConverters(types.Validators, []uint64{10}, testItems[*ethpb.Validator](validators), false)
+	assert.ErrorContains(t, "could not get validator at index 10", err)
+}
+
 func TestValidateIndices_CompressedField(t *testing.T) {
 	fakeTrie := &FieldTrie{
 		RWMutex:     new(sync.RWMutex),
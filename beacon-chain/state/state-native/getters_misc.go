@@ -82,6 +82,9 @@ func (b *BeaconState) HistoricalRoots() ([][]byte, error) {
 // balancesLength returns the length of the balances slice.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) balancesLength() int {
+	if b.usesMultiValueSlices() {
+		return b.balancesMultiValue.Len(b)
+	}
 	if b.balances == nil {
 		return 0
 	}
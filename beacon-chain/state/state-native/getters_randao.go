@@ -6,20 +6,20 @@ import (
 
 // RandaoMixes of block proposers on the beacon chain.
 func (b *BeaconState) RandaoMixes() [][]byte {
-	if b.randaoMixes == nil {
+	if b.randaoMixes == nil && b.randaoMixesMultiValue == nil {
 		return nil
 	}
 
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	return b.randaoMixes.Slice()
+	return b.randaoMixesVal()
 }
 
 // RandaoMixAtIndex retrieves a specific block root based on an
 // input index value.
 func (b *BeaconState) RandaoMixAtIndex(idx uint64) ([]byte, error) {
-	if b.randaoMixes == nil {
+	if b.randaoMixes == nil && b.randaoMixesMultiValue == nil {
 		return nil, nil
 	}
 
@@ -37,6 +37,9 @@ func (b *BeaconState) RandaoMixAtIndex(idx uint64) ([]byte, error) {
 // input index value.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) randaoMixAtIndex(idx uint64) ([32]byte, error) {
+	if b.usesMultiValueSlices() {
+		return b.randaoMixesMultiValue.At(b, idx)
+	}
 	if uint64(len(b.randaoMixes)) <= idx {
 		return [32]byte{}, fmt.Errorf("index %d out of range", idx)
 	}
@@ -46,7 +49,7 @@ func (b *BeaconState) randaoMixAtIndex(idx uint64) ([32]byte, error) {
 
 // RandaoMixesLength returns the length of the randao mixes slice.
 func (b *BeaconState) RandaoMixesLength() int {
-	if b.randaoMixes == nil {
+	if b.randaoMixes == nil && b.randaoMixesMultiValue == nil {
 		return 0
 	}
 
@@ -59,6 +62,9 @@ func (b *BeaconState) RandaoMixesLength() int {
 // randaoMixesLength returns the length of the randao mixes slice.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) randaoMixesLength() int {
+	if b.usesMultiValueSlices() {
+		return b.randaoMixesMultiValue.Len(b)
+	}
 	if b.randaoMixes == nil {
 		return 0
 	}
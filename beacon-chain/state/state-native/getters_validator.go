@@ -32,7 +32,7 @@ func (e *ValidatorIndexOutOfRangeError) Error() string {
 
 // Validators participating in consensus on the beacon chain.
 func (b *BeaconState) Validators() []*ethpb.Validator {
-	if b.validators == nil {
+	if b.validators == nil && b.validatorsMultiValue == nil {
 		return nil
 	}
 
@@ -45,6 +45,15 @@ func (b *BeaconState) Validators() []*ethpb.Validator {
 // validatorsVal participating in consensus on the beacon chain.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) validatorsVal() []*ethpb.Validator {
+	if b.usesMultiValueSlices() {
+		v := b.validatorsMultiValue.Value(b)
+		for i := 0; i < len(v); i++ {
+			if v[i] != nil {
+				v[i] = ethpb.CopyValidator(v[i])
+			}
+		}
+		return v
+	}
 	if b.validators == nil {
 		return nil
 	}
@@ -64,6 +73,9 @@ func (b *BeaconState) validatorsVal() []*ethpb.Validator {
 // This assumes that a lock is already held on BeaconState. This does not
 // copy fully and instead just copies the reference.
 func (b *BeaconState) validatorsReferences() []*ethpb.Validator {
+	if b.usesMultiValueSlices() {
+		return b.validatorsMultiValue.Value(b)
+	}
 	if b.validators == nil {
 		return nil
 	}
@@ -82,10 +94,10 @@ func (b *BeaconState) validatorsReferences() []*ethpb.Validator {
 
 // ValidatorAtIndex is the validator at the provided index.
 func (b *BeaconState) ValidatorAtIndex(idx primitives.ValidatorIndex) (*ethpb.Validator, error) {
-	if b.validators == nil {
+	if b.validators == nil && b.validatorsMultiValue == nil {
 		return &ethpb.Validator{}, nil
 	}
-	if uint64(len(b.validators)) <= uint64(idx) {
+	if uint64(b.validatorsLength()) <= uint64(idx) {
 		e := NewValidatorIndexOutOfRangeError(idx)
 		return nil, &e
 	}
@@ -93,17 +105,42 @@ func (b *BeaconState) ValidatorAtIndex(idx primitives.ValidatorIndex) (*ethpb.Va
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	val := b.validators[idx]
+	val, err := b.validatorAtIndex(idx)
+	if err != nil {
+		return nil, err
+	}
 	return ethpb.CopyValidator(val), nil
 }
 
+// validatorAtIndex is the validator at the provided index. This does not copy the validator.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) validatorAtIndex(idx primitives.ValidatorIndex) (*ethpb.Validator, error) {
+	if b.usesMultiValueSlices() {
+		return b.validatorsMultiValue.At(b, uint64(idx))
+	}
+	if uint64(len(b.validators)) <= uint64(idx) {
+		e := NewValidatorIndexOutOfRangeError(idx)
+		return nil, &e
+	}
+	return b.validators[idx], nil
+}
+
+// validatorsLength returns the number of validators in the state.
+func (b *BeaconState) validatorsLength() int {
+	if b.usesMultiValueSlices() {
+		return b.validatorsMultiValue.Len(b)
+	}
+	return len(b.validators)
+}
+
 // ValidatorAtIndexReadOnly is the validator at the provided index. This method
 // doesn't clone the validator.
 func (b *BeaconState) ValidatorAtIndexReadOnly(idx primitives.ValidatorIndex) (state.ReadOnlyValidator, error) {
-	if b.validators == nil {
+	// A multi-value slice does not keep track of whether the validators were nil or empty.
+	if b.validators == nil && (b.validatorsMultiValue == nil || b.validatorsMultiValue.Len(b) == 0) {
 		return nil, state.ErrNilValidatorsInState
 	}
-	if uint64(len(b.validators)) <= uint64(idx) {
+	if uint64(b.validatorsLength()) <= uint64(idx) {
 		e := NewValidatorIndexOutOfRangeError(idx)
 		return nil, &e
 	}
@@ -111,7 +148,11 @@ func (b *BeaconState) ValidatorAtIndexReadOnly(idx primitives.ValidatorIndex) (s
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	return NewValidator(b.validators[idx])
+	val, err := b.validatorAtIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	return NewValidator(val)
 }
 
 // ValidatorIndexByPubkey returns a given validator by its 48-byte public key.
@@ -121,7 +162,7 @@ func (b *BeaconState) ValidatorIndexByPubkey(key [fieldparams.BLSPubkeyLength]by
 	}
 	b.lock.RLock()
 	defer b.lock.RUnlock()
-	numOfVals := len(b.validators)
+	numOfVals := b.validatorsLength()
 
 	idx, ok := b.valMapHandler.Get(key)
 	if ok && primitives.ValidatorIndex(numOfVals) <= idx {
@@ -133,16 +174,17 @@ func (b *BeaconState) ValidatorIndexByPubkey(key [fieldparams.BLSPubkeyLength]by
 // PubkeyAtIndex returns the pubkey at the given
 // validator index.
 func (b *BeaconState) PubkeyAtIndex(idx primitives.ValidatorIndex) [fieldparams.BLSPubkeyLength]byte {
-	if uint64(idx) >= uint64(len(b.validators)) {
+	if uint64(idx) >= uint64(b.validatorsLength()) {
 		return [fieldparams.BLSPubkeyLength]byte{}
 	}
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	if b.validators[idx] == nil {
+	val, err := b.validatorAtIndex(idx)
+	if err != nil || val == nil {
 		return [fieldparams.BLSPubkeyLength]byte{}
 	}
-	return bytesutil.ToBytes48(b.validators[idx].PublicKey)
+	return bytesutil.ToBytes48(val.PublicKey)
 }
 
 // NumValidators returns the size of the validator registry.
@@ -150,18 +192,18 @@ func (b *BeaconState) NumValidators() int {
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	return len(b.validators)
+	return b.validatorsLength()
 }
 
 // ReadFromEveryValidator reads values from every validator and applies it to the provided function.
 //
 // WARNING: This method is potentially unsafe, as it exposes the actual validator registry.
 func (b *BeaconState) ReadFromEveryValidator(f func(idx int, val state.ReadOnlyValidator) error) error {
-	if b.validators == nil {
+	if b.validators == nil && b.validatorsMultiValue == nil {
 		return errors.New("nil validators in state")
 	}
 	b.lock.RLock()
-	validators := b.validators
+	validators := b.validatorsField()
 	b.lock.RUnlock()
 
 	for i, v := range validators {
@@ -178,7 +220,7 @@ func (b *BeaconState) ReadFromEveryValidator(f func(idx int, val state.ReadOnlyV
 
 // Balances of validators participating in consensus on the beacon chain.
 func (b *BeaconState) Balances() []uint64 {
-	if b.balances == nil {
+	if b.balances == nil && b.balancesMultiValue == nil {
 		return nil
 	}
 
@@ -191,6 +233,9 @@ func (b *BeaconState) Balances() []uint64 {
 // balancesVal of validators participating in consensus on the beacon chain.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) balancesVal() []uint64 {
+	if b.usesMultiValueSlices() {
+		return b.balancesMultiValue.Value(b)
+	}
 	if b.balances == nil {
 		return nil
 	}
@@ -202,22 +247,31 @@ func (b *BeaconState) balancesVal() []uint64 {
 
 // BalanceAtIndex of validator with the provided index.
 func (b *BeaconState) BalanceAtIndex(idx primitives.ValidatorIndex) (uint64, error) {
-	if b.balances == nil {
+	if b.balances == nil && b.balancesMultiValue == nil {
 		return 0, nil
 	}
 
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	if uint64(len(b.balances)) <= uint64(idx) {
+	return b.balanceAtIndex(idx)
+}
+
+// balanceAtIndex of validator with the provided index.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) balanceAtIndex(idx primitives.ValidatorIndex) (uint64, error) {
+	if uint64(b.balancesLength()) <= uint64(idx) {
 		return 0, fmt.Errorf("index of %d does not exist", idx)
 	}
+	if b.usesMultiValueSlices() {
+		return b.balancesMultiValue.At(b, uint64(idx))
+	}
 	return b.balances[idx], nil
 }
 
 // BalancesLength returns the length of the balances slice.
 func (b *BeaconState) BalancesLength() int {
-	if b.balances == nil {
+	if b.balances == nil && b.balancesMultiValue == nil {
 		return 0
 	}
 
@@ -257,7 +311,7 @@ func (b *BeaconState) InactivityScores() ([]uint64, error) {
 		return nil, errNotSupported("InactivityScores", b.version)
 	}
 
-	if b.inactivityScores == nil {
+	if !b.usesMultiValueSlices() && b.inactivityScores == nil {
 		return nil, nil
 	}
 
@@ -270,6 +324,9 @@ func (b *BeaconState) InactivityScores() ([]uint64, error) {
 // inactivityScoresVal of validators participating in consensus on the beacon chain.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) inactivityScoresVal() []uint64 {
+	if b.usesMultiValueSlices() {
+		return b.inactivityScoresMultiValue.Value(b)
+	}
 	if b.inactivityScores == nil {
 		return nil
 	}
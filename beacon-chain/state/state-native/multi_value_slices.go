@@ -0,0 +1,192 @@
+package state_native
+
+import (
+	"github.com/google/uuid"
+	customtypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native/custom-types"
+	mvslice "github.com/prysmaticlabs/prysm/v4/container/multi-value-slice"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+)
+
+// When the experimental state is enabled, the largest fields of the state are backed by multi-value slices.
+// Copies of a state then share the items of these fields, and only the items changed by a copy are stored
+// separately for it. The mode of a state is decided when the state is initialized and kept by all its copies.
+
+// MultiValueValidators is a multi-value slice of validators.
+type MultiValueValidators = mvslice.Slice[*ethpb.Validator, *BeaconState]
+
+// MultiValueBalances is a multi-value slice of balances.
+type MultiValueBalances = mvslice.Slice[uint64, *BeaconState]
+
+// MultiValueInactivityScores is a multi-value slice of inactivity scores.
+type MultiValueInactivityScores = mvslice.Slice[uint64, *BeaconState]
+
+// MultiValueRandaoMixes is a multi-value slice of randao mixes.
+type MultiValueRandaoMixes = mvslice.Slice[[32]byte, *BeaconState]
+
+// Id is the identifier of the beacon state in the multi-value slices.
+func (b *BeaconState) Id() uuid.UUID {
+	return b.id
+}
+
+// SetId sets the identifier of the beacon state in the multi-value slices.
+func (b *BeaconState) SetId(id uuid.UUID) {
+	b.id = id
+}
+
+// usesMultiValueSlices returns true if the state is backed by multi-value slices.
+func (b *BeaconState) usesMultiValueSlices() bool {
+	return b.validatorsMultiValue != nil
+}
+
+// initMultiValueSlices moves the values of the validators, balances, randao mixes and inactivity scores
+// into new multi-value slices.
+func (b *BeaconState) initMultiValueSlices() {
+	b.id = uuid.New()
+
+	b.validatorsMultiValue = newMultiValueValidators(b.validators)
+	b.validators = nil
+	b.balancesMultiValue = newMultiValueBalances(b.balances)
+	b.balances = nil
+	mixes := make([][32]byte, 0)
+	if b.randaoMixes != nil {
+		mixes = b.randaoMixes[:]
+	}
+	b.randaoMixesMultiValue = newMultiValueRandaoMixes(mixes)
+	b.randaoMixes = nil
+	if b.version >= version.Altair {
+		b.inactivityScoresMultiValue = newMultiValueInactivityScores(b.inactivityScores)
+		b.inactivityScores = nil
+	}
+}
+
+// copyMultiValueSlices shares the multi-value slices of the state with dst. dst is given a new identifier.
+func (b *BeaconState) copyMultiValueSlices(dst *BeaconState) {
+	dst.id = uuid.New()
+
+	b.validatorsMultiValue.Copy(b, dst)
+	dst.validatorsMultiValue = b.validatorsMultiValue
+	b.balancesMultiValue.Copy(b, dst)
+	dst.balancesMultiValue = b.balancesMultiValue
+	b.randaoMixesMultiValue.Copy(b, dst)
+	dst.randaoMixesMultiValue = b.randaoMixesMultiValue
+	if b.version >= version.Altair {
+		b.inactivityScoresMultiValue.Copy(b, dst)
+		dst.inactivityScoresMultiValue = b.inactivityScoresMultiValue
+	}
+}
+
+// detachMultiValueSlices removes the items of the state from the multi-value slices.
+func (b *BeaconState) detachMultiValueSlices() {
+	if !b.usesMultiValueSlices() {
+		return
+	}
+	b.validatorsMultiValue.Detach(b)
+	b.balancesMultiValue.Detach(b)
+	b.randaoMixesMultiValue.Detach(b)
+	if b.version >= version.Altair {
+		b.inactivityScoresMultiValue.Detach(b)
+	}
+}
+
+// validatorsField returns the validators of the state. The returned slice must not be modified.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) validatorsField() []*ethpb.Validator {
+	if b.usesMultiValueSlices() {
+		return b.validatorsMultiValue.Value(b)
+	}
+	return b.validators
+}
+
+// balancesField returns the balances of the state. The returned slice must not be modified.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) balancesField() []uint64 {
+	if b.usesMultiValueSlices() {
+		return b.balancesMultiValue.Value(b)
+	}
+	return b.balances
+}
+
+// inactivityScoresField returns the inactivity scores of the state. The returned slice must not be modified.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) inactivityScoresField() []uint64 {
+	if b.usesMultiValueSlices() {
+		return b.inactivityScoresMultiValue.Value(b)
+	}
+	return b.inactivityScores
+}
+
+// randaoMixesVal returns a copy of the randao mixes of the state.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) randaoMixesVal() [][]byte {
+	if b.usesMultiValueSlices() {
+		mixes := b.randaoMixesMultiValue.Value(b)
+		res := make([][]byte, len(mixes))
+		for i := range mixes {
+			res[i] = make([]byte, len(mixes[i]))
+			copy(res[i], mixes[i][:])
+		}
+		return res
+	}
+	return b.randaoMixes.Slice()
+}
+
+// validatorsTrieElements returns the elements from which the validators field trie is computed.
+// Items of a multi-value slice are accessed without copying the whole field.
+func (b *BeaconState) validatorsTrieElements() interface{} {
+	if b.usesMultiValueSlices() {
+		return b.validatorsMultiValue.ItemsOf(b)
+	}
+	return b.validators
+}
+
+// balancesTrieElements returns the elements from which the balances field trie is computed.
+func (b *BeaconState) balancesTrieElements() interface{} {
+	if b.usesMultiValueSlices() {
+		return b.balancesMultiValue.ItemsOf(b)
+	}
+	return b.balances
+}
+
+// randaoMixesTrieElements returns the elements from which the randao mixes field trie is computed.
+func (b *BeaconState) randaoMixesTrieElements() interface{} {
+	if b.usesMultiValueSlices() {
+		return b.randaoMixesMultiValue.ItemsOf(b)
+	}
+	return b.randaoMixes
+}
+
+// randaoMixesArray returns the randao mixes of the state as a fixed size array.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) randaoMixesArray() *customtypes.RandaoMixes {
+	if b.usesMultiValueSlices() {
+		var mixes customtypes.RandaoMixes
+		copy(mixes[:], b.randaoMixesMultiValue.Value(b))
+		return &mixes
+	}
+	return b.randaoMixes
+}
+
+func newMultiValueValidators(vals []*ethpb.Validator) *MultiValueValidators {
+	mv := &MultiValueValidators{}
+	mv.Init(vals)
+	return mv
+}
+
+func newMultiValueBalances(bals []uint64) *MultiValueBalances {
+	mv := &MultiValueBalances{}
+	mv.Init(bals)
+	return mv
+}
+
+func newMultiValueInactivityScores(scores []uint64) *MultiValueInactivityScores {
+	mv := &MultiValueInactivityScores{}
+	mv.Init(scores)
+	return mv
+}
+
+func newMultiValueRandaoMixes(mixes [][32]byte) *MultiValueRandaoMixes {
+	mv := &MultiValueRandaoMixes{}
+	mv.Init(mixes)
+	return mv
+}
@@ -0,0 +1,176 @@
+package state_native
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func multiValueTestState(t *testing.T, experimental bool) state.BeaconState {
+	resetCfg := features.InitWithReset(&features.Flags{EnableExperimentalState: experimental})
+	defer resetCfg()
+
+	numVals := 100
+	vals := make([]*ethpb.Validator, numVals)
+	bals := make([]uint64, numVals)
+	scores := make([]uint64, numVals)
+	for i := 0; i < numVals; i++ {
+		vals[i] = &ethpb.Validator{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i), byte(i >> 8)}, fieldparams.BLSPubkeyLength),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		bals[i] = params.BeaconConfig().MaxEffectiveBalance
+		scores[i] = uint64(i)
+	}
+	syncCommittee := &ethpb.SyncCommittee{
+		Pubkeys:         make([][]byte, fieldparams.SyncCommitteeLength),
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}
+	for i := range syncCommittee.Pubkeys {
+		syncCommittee.Pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	mixes := make([][]byte, fieldparams.RandaoMixesLength)
+	for i := range mixes {
+		mixes[i] = bytesutil.PadTo([]byte{byte(i)}, 32)
+	}
+	st, err := InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
+		Fork: &ethpb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+		},
+		LatestBlockHeader:           &ethpb.BeaconBlockHeader{ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)},
+		BlockRoots:                  make([][]byte, fieldparams.BlockRootsLength),
+		StateRoots:                  make([][]byte, fieldparams.StateRootsLength),
+		Eth1Data:                    &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+		Validators:                  vals,
+		Balances:                    bals,
+		RandaoMixes:                 mixes,
+		Slashings:                   make([]uint64, fieldparams.SlashingsLength),
+		PreviousEpochParticipation:  make([]byte, numVals),
+		CurrentEpochParticipation:   make([]byte, numVals),
+		JustificationBits:           []byte{0},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: make([]byte, 32)},
+		InactivityScores:            scores,
+		CurrentSyncCommittee:        syncCommittee,
+		NextSyncCommittee:           syncCommittee,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, experimental, st.(*BeaconState).usesMultiValueSlices())
+	return st
+}
+
+func mutateMultiValueFields(t *testing.T, st state.BeaconState) {
+	val, err := st.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+	require.NoError(t, st.UpdateBalancesAtIndex(2, 1))
+	require.NoError(t, st.UpdateRandaoMixesAtIndex(3, bytesutil.PadTo([]byte("mix"), 32)))
+	require.NoError(t, st.AppendValidator(&ethpb.Validator{
+		PublicKey:             bytesutil.PadTo([]byte("new"), fieldparams.BLSPubkeyLength),
+		WithdrawalCredentials: make([]byte, 32),
+	}))
+	require.NoError(t, st.AppendBalance(5))
+	require.NoError(t, st.AppendInactivityScore(6))
+	require.NoError(t, st.ApplyToEveryValidator(func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
+		if idx%10 != 0 {
+			return false, nil, nil
+		}
+		val = ethpb.CopyValidator(val)
+		val.EffectiveBalance = 1
+		return true, val, nil
+	}))
+}
+
+func TestMultiValueSlices_HashTreeRoot(t *testing.T) {
+	ctx := context.Background()
+	st := multiValueTestState(t, false)
+	mvSt := multiValueTestState(t, true)
+
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	mvRoot, err := mvSt.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, mvRoot)
+
+	// Field tries are recomputed from the changed items only.
+	mutateMultiValueFields(t, st)
+	mutateMultiValueFields(t, mvSt)
+	root, err = st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	mvRoot, err = mvSt.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, mvRoot)
+
+	// Field tries are rebuilt from all items.
+	require.NoError(t, st.SetBalances(st.Balances()[:50]))
+	require.NoError(t, mvSt.SetBalances(mvSt.Balances()[:50]))
+	root, err = st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	mvRoot, err = mvSt.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, mvRoot)
+	assert.DeepEqual(t, st.ToProto(), mvSt.ToProto())
+}
+
+func TestMultiValueSlices_CopyIsIndependent(t *testing.T) {
+	ctx := context.Background()
+	a := multiValueTestState(t, true)
+	aRoot, err := a.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	b := a.Copy()
+	assert.Equal(t, true, b.(*BeaconState).usesMultiValueSlices())
+	assert.NotEqual(t, a.(*BeaconState).Id(), b.(*BeaconState).Id())
+	mutateMultiValueFields(t, b)
+
+	// The original state is not affected by the changes of the copy.
+	root, err := a.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, aRoot, root)
+	assert.Equal(t, 100, a.NumValidators())
+	assert.Equal(t, 101, b.NumValidators())
+	bal, err := a.BalanceAtIndex(2)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, bal)
+	bal, err = b.BalanceAtIndex(2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), bal)
+	val, err := a.ValidatorAtIndexReadOnly(1)
+	require.NoError(t, err)
+	assert.Equal(t, false, val.Slashed())
+	val, err = b.ValidatorAtIndexReadOnly(1)
+	require.NoError(t, err)
+	assert.Equal(t, true, val.Slashed())
+	mix, err := a.RandaoMixAtIndex(3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{3}, 32), mix)
+	scores, err := b.InactivityScores()
+	require.NoError(t, err)
+	assert.Equal(t, 101, len(scores))
+	assert.Equal(t, uint64(6), scores[100])
+
+	// A copy of the copy sees the changes of the copy.
+	c := b.Copy()
+	bRoot, err := b.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	cRoot, err := c.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, bRoot, cRoot)
+	idx, ok := c.ValidatorIndexByPubkey(bytesutil.ToBytes48(bytesutil.PadTo([]byte("new"), fieldparams.BLSPubkeyLength)))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, uint64(100), uint64(idx))
+}
@@ -15,15 +15,20 @@ func (b *BeaconState) SetRandaoMixes(val [][]byte) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[types.RandaoMixes].MinusRef()
-	b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
-
 	var mixesArr [fieldparams.RandaoMixesLength][32]byte
 	for i := 0; i < len(mixesArr); i++ {
 		copy(mixesArr[i][:], val[i])
 	}
-	mixes := customtypes.RandaoMixes(mixesArr)
-	b.randaoMixes = &mixes
+	if b.usesMultiValueSlices() {
+		b.randaoMixesMultiValue.Detach(b)
+		b.randaoMixesMultiValue = newMultiValueRandaoMixes(mixesArr[:])
+	} else {
+		b.sharedFieldReferences[types.RandaoMixes].MinusRef()
+		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
+
+		mixes := customtypes.RandaoMixes(mixesArr)
+		b.randaoMixes = &mixes
+	}
 	b.markFieldAsDirty(types.RandaoMixes)
 	b.rebuildTrie[types.RandaoMixes] = true
 	return nil
@@ -32,6 +37,17 @@ func (b *BeaconState) SetRandaoMixes(val [][]byte) error {
 // UpdateRandaoMixesAtIndex for the beacon state. Updates the randao mixes
 // at a specific index to a new value.
 func (b *BeaconState) UpdateRandaoMixesAtIndex(idx uint64, val []byte) error {
+	if b.usesMultiValueSlices() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+
+		if err := b.randaoMixesMultiValue.UpdateAt(b, idx, bytesutil.ToBytes32(val)); err != nil {
+			return errors.Wrap(err, "could not update randao mixes")
+		}
+		b.markFieldAsDirty(types.RandaoMixes)
+		b.addDirtyIndices(types.RandaoMixes, []uint64{idx})
+		return nil
+	}
 	if uint64(len(b.randaoMixes)) <= idx {
 		return errors.Errorf("invalid index provided %d", idx)
 	}
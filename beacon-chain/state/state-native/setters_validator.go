@@ -16,18 +16,26 @@ func (b *BeaconState) SetValidators(val []*ethpb.Validator) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.validators = val
-	b.sharedFieldReferences[types.Validators].MinusRef()
-	b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+	if b.usesMultiValueSlices() {
+		b.validatorsMultiValue.Detach(b)
+		b.validatorsMultiValue = newMultiValueValidators(val)
+	} else {
+		b.validators = val
+		b.sharedFieldReferences[types.Validators].MinusRef()
+		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+	}
 	b.markFieldAsDirty(types.Validators)
 	b.rebuildTrie[types.Validators] = true
-	b.valMapHandler = stateutil.NewValMapHandler(b.validators)
+	b.valMapHandler = stateutil.NewValMapHandler(val)
 	return nil
 }
 
 // ApplyToEveryValidator applies the provided callback function to each validator in the
 // validator registry.
 func (b *BeaconState) ApplyToEveryValidator(f func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error)) error {
+	if b.usesMultiValueSlices() {
+		return b.applyToEveryValidatorMultiValue(f)
+	}
 	b.lock.Lock()
 	v := b.validators
 	if ref := b.sharedFieldReferences[types.Validators]; ref.Refs() > 1 {
@@ -58,9 +66,53 @@ func (b *BeaconState) ApplyToEveryValidator(f func(idx int, val *ethpb.Validator
 	return nil
 }
 
+// applyToEveryValidatorMultiValue is ApplyToEveryValidator for a state backed by multi-value slices.
+// Only the changed validators are stored as items of the state.
+func (b *BeaconState) applyToEveryValidatorMultiValue(f func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error)) error {
+	b.lock.RLock()
+	v := b.validatorsMultiValue.Value(b)
+	b.lock.RUnlock()
+
+	changedVals := make(map[uint64]*ethpb.Validator)
+	for i, val := range v {
+		changed, newVal, err := f(i, val)
+		if err != nil {
+			return err
+		}
+		if changed {
+			changedVals[uint64(i)] = newVal
+		}
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	indices := make([]uint64, 0, len(changedVals))
+	for i, val := range changedVals {
+		if err := b.validatorsMultiValue.UpdateAt(b, i, val); err != nil {
+			return errors.Wrapf(err, "could not update validator at index %d", i)
+		}
+		indices = append(indices, i)
+	}
+	b.markFieldAsDirty(types.Validators)
+	b.addDirtyIndices(types.Validators, indices)
+	return nil
+}
+
 // UpdateValidatorAtIndex for the beacon state. Updates the validator
 // at a specific index to a new value.
 func (b *BeaconState) UpdateValidatorAtIndex(idx primitives.ValidatorIndex, val *ethpb.Validator) error {
+	if b.usesMultiValueSlices() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+
+		if err := b.validatorsMultiValue.UpdateAt(b, uint64(idx), val); err != nil {
+			return errors.Wrap(err, "could not update validator")
+		}
+		b.markFieldAsDirty(types.Validators)
+		b.addDirtyIndices(types.Validators, []uint64{uint64(idx)})
+		return nil
+	}
 	if uint64(len(b.validators)) <= uint64(idx) {
 		return errors.Errorf("invalid index provided %d", idx)
 	}
@@ -88,10 +140,15 @@ func (b *BeaconState) SetBalances(val []uint64) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[types.Balances].MinusRef()
-	b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
+	if b.usesMultiValueSlices() {
+		b.balancesMultiValue.Detach(b)
+		b.balancesMultiValue = newMultiValueBalances(val)
+	} else {
+		b.sharedFieldReferences[types.Balances].MinusRef()
+		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 
-	b.balances = val
+		b.balances = val
+	}
 	b.markFieldAsDirty(types.Balances)
 	b.rebuildTrie[types.Balances] = true
 	return nil
@@ -100,6 +157,17 @@ func (b *BeaconState) SetBalances(val []uint64) error {
 // UpdateBalancesAtIndex for the beacon state. This method updates the balance
 // at a specific index to a new value.
 func (b *BeaconState) UpdateBalancesAtIndex(idx primitives.ValidatorIndex, val uint64) error {
+	if b.usesMultiValueSlices() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+
+		if err := b.balancesMultiValue.UpdateAt(b, uint64(idx), val); err != nil {
+			return errors.Wrap(err, "could not update balances")
+		}
+		b.markFieldAsDirty(types.Balances)
+		b.addDirtyIndices(types.Balances, []uint64{uint64(idx)})
+		return nil
+	}
 	if uint64(len(b.balances)) <= uint64(idx) {
 		return errors.Errorf("invalid index provided %d", idx)
 	}
@@ -164,6 +232,15 @@ func (b *BeaconState) AppendValidator(val *ethpb.Validator) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
+	if b.usesMultiValueSlices() {
+		b.validatorsMultiValue.Append(b, val)
+		valIdx := primitives.ValidatorIndex(b.validatorsMultiValue.Len(b) - 1)
+		b.valMapHandler.Set(bytesutil.ToBytes48(val.PublicKey), valIdx)
+		b.markFieldAsDirty(types.Validators)
+		b.addDirtyIndices(types.Validators, []uint64{uint64(valIdx)})
+		return nil
+	}
+
 	vals := b.validators
 	if b.sharedFieldReferences[types.Validators].Refs() > 1 {
 		vals = b.validatorsReferences()
@@ -188,6 +265,14 @@ func (b *BeaconState) AppendBalance(bal uint64) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
+	if b.usesMultiValueSlices() {
+		b.balancesMultiValue.Append(b, bal)
+		balIdx := b.balancesMultiValue.Len(b) - 1
+		b.markFieldAsDirty(types.Balances)
+		b.addDirtyIndices(types.Balances, []uint64{uint64(balIdx)})
+		return nil
+	}
+
 	bals := b.balances
 	if b.sharedFieldReferences[types.Balances].Refs() > 1 {
 		bals = b.balancesVal()
@@ -211,6 +296,12 @@ func (b *BeaconState) AppendInactivityScore(s uint64) error {
 		return errNotSupported("AppendInactivityScore", b.version)
 	}
 
+	if b.usesMultiValueSlices() {
+		b.inactivityScoresMultiValue.Append(b, s)
+		b.markFieldAsDirty(types.InactivityScores)
+		return nil
+	}
+
 	scores := b.inactivityScores
 	if b.sharedFieldReferences[types.InactivityScores].Refs() > 1 {
 		scores = b.inactivityScoresVal()
@@ -233,10 +324,15 @@ func (b *BeaconState) SetInactivityScores(val []uint64) error {
 		return errNotSupported("SetInactivityScores", b.version)
 	}
 
-	b.sharedFieldReferences[types.InactivityScores].MinusRef()
-	b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
+	if b.usesMultiValueSlices() {
+		b.inactivityScoresMultiValue.Detach(b)
+		b.inactivityScoresMultiValue = newMultiValueInactivityScores(val)
+	} else {
+		b.sharedFieldReferences[types.InactivityScores].MinusRef()
+		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 
-	b.inactivityScores = val
+		b.inactivityScores = val
+	}
 	b.markFieldAsDirty(types.InactivityScores)
 	return nil
 }
@@ -0,0 +1,40 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillLowestSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_lowest_slot",
+			Help: "The slot of the lowest block written to the database by backfill, it reaches genesis once backfill is complete.",
+		},
+	)
+	backfillOriginSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_origin_slot",
+			Help: "The slot of the checkpoint sync origin block, from which backfill works its way back to genesis.",
+		},
+	)
+	backfillBlocksImported = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_imported_total",
+			Help: "The number of verified blocks written to the database by backfill.",
+		},
+	)
+	backfillBatchFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_batch_failures_total",
+			Help: "The number of backfill batches which could not be requested, verified or saved.",
+		},
+	)
+	backfillBatchTime = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "backfill_batch_time_milliseconds",
+			Help:    "Captures the time to request, verify and save a batch of backfilled blocks in a milliseconds distribution.",
+			Buckets: []float64{100, 250, 500, 1000, 2000, 4000, 8000, 16000},
+		},
+	)
+)
@@ -0,0 +1,63 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+)
+
+// ServiceOption is a functional option for the backfill service.
+type ServiceOption func(*Service) error
+
+// WithEnableBackfill enables the backfill service, which otherwise exits as soon as it is started.
+func WithEnableBackfill(enabled bool) ServiceOption {
+	return func(s *Service) error {
+		s.enabled = enabled
+		return nil
+	}
+}
+
+// WithBatchSize sets the number of slots requested from a peer in each backfill batch.
+func WithBatchSize(n uint64) ServiceOption {
+	return func(s *Service) error {
+		if n == 0 || n > params.BeaconNetworkConfig().MaxRequestBlocks {
+			return errors.Errorf("backfill batch size must be between 1 and %d", params.BeaconNetworkConfig().MaxRequestBlocks)
+		}
+		s.batchSize = n
+		return nil
+	}
+}
+
+// WithDatabase sets the database in which backfilled blocks are written.
+func WithDatabase(db Database) ServiceOption {
+	return func(s *Service) error {
+		s.store = db
+		return nil
+	}
+}
+
+// WithP2P sets the p2p service used to request blocks from peers.
+func WithP2P(p p2p.P2P) ServiceOption {
+	return func(s *Service) error {
+		s.p2p = p
+		return nil
+	}
+}
+
+// WithClockWaiter sets the waiter of the clock, which is available once the chain has started.
+func WithClockWaiter(cw startup.ClockWaiter) ServiceOption {
+	return func(s *Service) error {
+		s.clockWaiter = cw
+		return nil
+	}
+}
+
+// WithInitialSyncComplete sets the channel closed by initial sync once it is done. Backfill waits for it, so that
+// initial sync is not slowed down by backfill requests.
+func WithInitialSyncComplete(c chan struct{}) ServiceOption {
+	return func(s *Service) error {
+		s.initialSyncComplete = c
+		return nil
+	}
+}
@@ -0,0 +1,314 @@
+package backfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	prysmsync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v4/crypto/rand"
+	p2ppb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+const (
+	// defaultBatchSize is the default number of slots requested from a peer in each batch.
+	defaultBatchSize = 64
+	// peerRateRatio divides the per peer block rate allowed to initial sync, backfill only uses a part
+	// of it so that peers keep serving the requests of regular sync.
+	peerRateRatio = 2
+	// retryDelay is how long to wait before retrying after a batch failed, or no peer was available.
+	retryDelay = time.Second
+)
+
+// blockLimiterPeriod is the period over which the block rate of each peer is enforced, as in initial sync.
+var blockLimiterPeriod = 30 * time.Second
+
+var errNoPeersAvailable = errors.New("no peer with available bandwidth to backfill from")
+
+// Database describes the set of DB methods that the backfill service needs to function.
+type Database interface {
+	BackfillDB
+	SaveBlocks(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock) error
+	BackfillFinalizedIndex(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, finalizedChildRoot [32]byte) error
+	State(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// Service fills the gap in the block history of a node initialized via checkpoint sync. Blocks are requested
+// by range backwards from the origin block, verified against the chain of parent roots and the proposer
+// signatures, and written to the database. Progress is recorded in the Status, so that backfill resumes
+// where it left off after a restart.
+type Service struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	enabled             bool
+	batchSize           uint64
+	status              *Status
+	store               Database
+	p2p                 p2p.P2P
+	clockWaiter         startup.ClockWaiter
+	clock               *startup.Clock
+	initialSyncComplete chan struct{}
+	rateLimiter         *leakybucket.Collector
+	rand                *rand.Rand
+	verifier            *verifier
+	// cursor is the exclusive upper bound of the next range of slots to request. It is lower than the slot of
+	// the lowest backfilled block when the slots in between are empty.
+	cursor primitives.Slot
+	// parentRoot is the parent root of the lowest backfilled block, i.e. the root of the next block to backfill.
+	parentRoot [32]byte
+}
+
+// NewService initializes the backfill service with the given status, which must have been reloaded from the database.
+func NewService(ctx context.Context, su *Status, opts ...ServiceOption) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		batchSize: defaultBatchSize,
+		status:    su,
+		rand:      rand.NewGenerator(),
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	rate := flags.Get().BlockBatchLimit / peerRateRatio
+	if rate <= 0 {
+		rate = int(s.batchSize)
+	}
+	// Each peer can serve at most a batch at a time, the bucket then drains at the backfill rate.
+	s.rateLimiter = leakybucket.NewCollector(float64(rate), int64(s.batchSize), blockLimiterPeriod, false /* deleteEmptyBuckets */)
+	return s, nil
+}
+
+// Start waits for initial sync to complete, then backfills blocks until genesis is reached.
+func (s *Service) Start() {
+	if !s.enabled {
+		log.Debug("Backfill is disabled")
+		return
+	}
+	if s.status.genesisSync {
+		log.Debug("Node was synced from genesis, there is nothing to backfill")
+		return
+	}
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Backfill failed to receive startup event")
+		return
+	}
+	s.clock = clock
+	select {
+	case <-s.initialSyncComplete:
+	case <-s.ctx.Done():
+		return
+	}
+	if err := s.initialize(s.ctx); err != nil {
+		log.WithError(err).Error("Could not initialize backfill")
+		return
+	}
+	if s.complete() {
+		log.Debug("Backfill is already complete")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"lowestSlot": s.cursor,
+		"originSlot": s.status.origin,
+	}).Info("Starting backfill")
+	for !s.complete() {
+		if err := s.fillBatch(s.ctx); err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			backfillBatchFailures.Inc()
+			log.WithError(err).Debug("Could not backfill batch")
+			select {
+			case <-time.After(retryDelay):
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+	log.WithField("lowestSlot", s.cursor).Info("Backfill complete")
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.rateLimiter != nil {
+		s.rateLimiter.Free()
+	}
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// initialize reads the lowest backfilled block, from which backfill resumes, and the validator registry of the
+// origin state used to verify the proposer signatures.
+func (s *Service) initialize(ctx context.Context) error {
+	s.status.RLock()
+	root, end, origin := s.status.root, s.status.end, s.status.origin
+	s.status.RUnlock()
+	low, err := s.store.Block(ctx, root)
+	if err != nil {
+		return errors.Wrapf(err, "could not retrieve lowest backfilled block with root %#x", root)
+	}
+	if err := blocks.BeaconBlockIsNil(low); err != nil {
+		return err
+	}
+	s.parentRoot = low.Block().ParentRoot()
+	s.cursor = end
+
+	originRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	st, err := s.store.State(ctx, originRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not retrieve origin state with root %#x", originRoot)
+	}
+	if st == nil || st.IsNil() {
+		return errors.Errorf("origin state with root %#x not found", originRoot)
+	}
+	s.verifier = newVerifier(st)
+
+	backfillOriginSlot.Set(float64(origin))
+	backfillLowestSlot.Set(float64(end))
+	return nil
+}
+
+// complete returns true once the parent of the lowest backfilled block is the genesis block, or once the
+// backfill reached the history pruned from the database, which would be deleted again.
+func (s *Service) complete() bool {
+	if pruned := s.status.PrunedSlot(); pruned > params.BeaconConfig().GenesisSlot && s.cursor <= pruned {
+		return true
+	}
+	return s.parentRoot == s.status.genesisRoot
+}
+
+// fillBatch requests the batch of blocks below the cursor from a peer, verifies them and writes them to the database.
+func (s *Service) fillBatch(ctx context.Context) error {
+	start := time.Now()
+	req, err := s.nextRequest()
+	if err != nil {
+		return err
+	}
+	pid, err := s.selectPeer(req.Count)
+	if err != nil {
+		return err
+	}
+	blks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.clock, s.p2p, pid, req, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not request blocks from peer %s", pid)
+	}
+	if len(blks) == 0 {
+		// The range only contains skipped slots, unless the peer withheld blocks, which the next
+		// non-empty batch will reveal as it won't connect to the lowest backfilled block.
+		s.cursor = req.StartSlot
+		return nil
+	}
+	roots, err := s.verifier.verify(blks, s.parentRoot)
+	if err != nil {
+		s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		s.resetCursor()
+		return errors.Wrapf(err, "could not verify blocks from peer %s", pid)
+	}
+	if err := s.save(ctx, blks, roots); err != nil {
+		return err
+	}
+	s.parentRoot = blks[0].Block().ParentRoot()
+	s.cursor = req.StartSlot
+
+	backfillBlocksImported.Add(float64(len(blks)))
+	backfillLowestSlot.Set(float64(blks[0].Block().Slot()))
+	backfillBatchTime.Observe(float64(time.Since(start).Milliseconds()))
+	log.WithFields(logrus.Fields{
+		"peer":       pid,
+		"startSlot":  req.StartSlot,
+		"count":      req.Count,
+		"blocks":     len(blks),
+		"lowestSlot": blks[0].Block().Slot(),
+	}).Debug("Backfilled batch")
+	return nil
+}
+
+// save writes the verified blocks to the database, adds them to the finalized index, and then advances the status.
+func (s *Service) save(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock, roots [][32]byte) error {
+	s.status.RLock()
+	childRoot := s.status.root
+	s.status.RUnlock()
+	if err := s.store.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	if err := s.store.BackfillFinalizedIndex(ctx, blks, childRoot); err != nil {
+		return errors.Wrap(err, "could not update finalized index with backfilled blocks")
+	}
+	return s.status.Advance(ctx, blks[0].Block().Slot(), roots[0])
+}
+
+// nextRequest returns the request for the range of at most a batch of slots below the cursor.
+func (s *Service) nextRequest() (*p2ppb.BeaconBlocksByRangeRequest, error) {
+	// The genesis block is already in the database, the lowest slot to request is the one after, unless
+	// the history was pruned.
+	lowest := params.BeaconConfig().GenesisSlot + 1
+	if pruned := s.status.PrunedSlot(); pruned > lowest {
+		lowest = pruned
+	}
+	if s.cursor <= lowest {
+		// Every slot was requested without finding the parent of the lowest backfilled block, so a peer
+		// withheld blocks. Start over from the lowest backfilled block.
+		s.resetCursor()
+		return nil, errors.Wrap(errChainBroken, "reached genesis without finding the parent of the lowest backfilled block")
+	}
+	startSlot := lowest
+	if s.cursor > lowest+primitives.Slot(s.batchSize) {
+		startSlot = s.cursor - primitives.Slot(s.batchSize)
+	}
+	return &p2ppb.BeaconBlocksByRangeRequest{
+		StartSlot: startSlot,
+		Count:     uint64(s.cursor - startSlot),
+		Step:      1,
+	}, nil
+}
+
+// resetCursor moves the cursor back to the lowest backfilled block, discarding the progress made through empty batches.
+func (s *Service) resetCursor() {
+	s.cursor = s.status.EndGap()
+}
+
+// selectPeer picks a random peer among those which finalized the origin block and have the bandwidth to serve the request.
+func (s *Service) selectPeer(count uint64) (peer.ID, error) {
+	_, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, slots.ToEpoch(s.status.origin))
+	suitable := make([]peer.ID, 0, len(peers))
+	for _, pid := range peers {
+		if s.p2p.Peers().IsBad(pid) || s.rateLimiter.Remaining(pid.String()) < int64(count) {
+			continue
+		}
+		suitable = append(suitable, pid)
+	}
+	if len(suitable) == 0 {
+		return "", errNoPeersAvailable
+	}
+	pid := suitable[s.rand.Intn(len(suitable))]
+	s.rateLimiter.Add(pid.String(), int64(count))
+	return pid, nil
+}
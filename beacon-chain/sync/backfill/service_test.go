@@ -0,0 +1,138 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestNewService_BatchSize(t *testing.T) {
+	s, err := NewService(context.Background(), &Status{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(defaultBatchSize), s.batchSize)
+
+	s, err = NewService(context.Background(), &Status{}, WithBatchSize(32))
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), s.batchSize)
+	require.Equal(t, int64(32), s.rateLimiter.Capacity())
+
+	_, err = NewService(context.Background(), &Status{}, WithBatchSize(0))
+	require.ErrorContains(t, "backfill batch size must be between", err)
+	_, err = NewService(context.Background(), &Status{}, WithBatchSize(params.BeaconNetworkConfig().MaxRequestBlocks+1))
+	require.ErrorContains(t, "backfill batch size must be between", err)
+}
+
+func TestService_nextRequest(t *testing.T) {
+	s := &Service{batchSize: 10, status: &Status{end: 100}}
+	cases := []struct {
+		cursor primitives.Slot
+		start  primitives.Slot
+		count  uint64
+	}{
+		{cursor: 100, start: 90, count: 10},
+		{cursor: 12, start: 2, count: 10},
+		{cursor: 11, start: 1, count: 10},
+		{cursor: 10, start: 1, count: 9},
+		{cursor: 2, start: 1, count: 1},
+	}
+	for _, c := range cases {
+		s.cursor = c.cursor
+		req, err := s.nextRequest()
+		require.NoError(t, err)
+		require.Equal(t, c.start, req.StartSlot)
+		require.Equal(t, c.count, req.Count)
+		require.Equal(t, uint64(1), req.Step)
+	}
+
+	// Every slot down to genesis was requested without finding the parent of the lowest block.
+	s.cursor = 1
+	_, err := s.nextRequest()
+	require.ErrorIs(t, err, errChainBroken)
+	require.Equal(t, primitives.Slot(100), s.cursor)
+
+	// Requests never go below the pruned history, and backfill completes when reaching it.
+	s.status.pruned = 95
+	s.parentRoot = [32]byte{'a'}
+	s.cursor = 100
+	req, err := s.nextRequest()
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(95), req.StartSlot)
+	require.Equal(t, uint64(5), req.Count)
+	require.Equal(t, false, s.complete())
+	s.cursor = 95
+	require.Equal(t, true, s.complete())
+}
+
+func TestService_initializeAndSave(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t)
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, db, genesis)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	blks, originParentRoot := chainOfBlocks(t, genesisRoot, 1, 2, 5, 8)
+	origin := util.NewBeaconBlock()
+	origin.Block.Slot = 10
+	origin.Block.ParentRoot = originParentRoot[:]
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(10))
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := origin.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOrigin(ctx, serState, serBlock))
+	bfRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, originRoot, bfRoot)
+
+	su := NewStatus(db)
+	require.NoError(t, su.Reload(ctx))
+	s, err := NewService(ctx, su, WithDatabase(db))
+	require.NoError(t, err)
+	require.NoError(t, s.initialize(ctx))
+	require.Equal(t, primitives.Slot(10), s.cursor)
+	require.Equal(t, originParentRoot, s.parentRoot)
+	require.Equal(t, false, s.complete())
+	require.Equal(t, false, su.SlotCovered(5))
+
+	roots, err := verifyChain(blks[2:], s.parentRoot)
+	require.NoError(t, err)
+	require.NoError(t, s.save(ctx, blks[2:], roots))
+	require.Equal(t, primitives.Slot(5), su.EndGap())
+	require.Equal(t, true, su.SlotCovered(5))
+	require.Equal(t, false, su.SlotCovered(3))
+	saved, err := db.Block(ctx, roots[0])
+	require.NoError(t, err)
+	require.NoError(t, blocks.BeaconBlockIsNil(saved))
+	require.Equal(t, true, db.IsFinalizedBlock(ctx, roots[1]))
+
+	// A restarted service resumes from the lowest saved block.
+	su = NewStatus(db)
+	require.NoError(t, su.Reload(ctx))
+	s, err = NewService(ctx, su, WithDatabase(db))
+	require.NoError(t, err)
+	require.NoError(t, s.initialize(ctx))
+	require.Equal(t, primitives.Slot(5), s.cursor)
+	require.Equal(t, blks[2].Block().ParentRoot(), s.parentRoot)
+
+	roots, err = verifyChain(blks[:2], s.parentRoot)
+	require.NoError(t, err)
+	require.NoError(t, s.save(ctx, blks[:2], roots))
+	s.parentRoot = blks[0].Block().ParentRoot()
+	require.Equal(t, true, s.complete())
+	for sl := primitives.Slot(0); sl <= 10; sl++ {
+		require.Equal(t, true, su.SlotCovered(sl))
+	}
+}
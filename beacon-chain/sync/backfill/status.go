@@ -2,9 +2,11 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -19,12 +21,18 @@ func NewStatus(store BackfillDB) *Status {
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
-// end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// until the checkpoint sync origin block. The gap is filled backwards, starting from the origin block. Status provides
+// the means to update the value keeping track of the lowest backfilled block via the Advance() method, to check whether
+// a Slot is missing from the database via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// When the history of the database was pruned, the blocks between genesis and PrunedSlot() are missing as well.
 type Status struct {
+	sync.RWMutex
 	start       primitives.Slot
 	end         primitives.Slot
+	origin      primitives.Slot
+	pruned      primitives.Slot
+	root        [32]byte
+	genesisRoot [32]byte
 	store       BackfillDB
 	genesisSync bool
 }
@@ -32,12 +40,18 @@ type Status struct {
 // SlotCovered uses StartGap() and EndGap() to determine if the given slot is covered by the current chain history.
 // If the slot is <= StartGap(), or >= EndGap(), the result is true.
 // If the slot is between StartGap() and EndGap(), the result is false.
+// Slots between genesis and PrunedSlot() are never covered, whether the node was synced from genesis or not.
 func (s *Status) SlotCovered(sl primitives.Slot) bool {
+	s.RLock()
+	defer s.RUnlock()
+	if params.BeaconConfig().GenesisSlot < sl && sl < s.pruned {
+		return false
+	}
 	// short circuit if the node was synced from genesis
 	if s.genesisSync {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
@@ -45,29 +59,54 @@ func (s *Status) SlotCovered(sl primitives.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.start
 }
 
-// EndGap returns the slot at the end of the range that needs to be backfilled.
+// EndGap returns the slot at the end of the range that needs to be backfilled. This is the slot of
+// the lowest block that has been backfilled so far, or the origin checkpoint slot if backfill has not started.
 func (s *Status) EndGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.end
 }
 
+// PrunedSlot returns the lowest slot of the block history retained after pruning, or zero if it was never pruned.
+func (s *Status) PrunedSlot() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
+	return s.pruned
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
-// Advance advances the backfill position to the given slot & root.
-// It updates the backfill block root entry in the database,
+// Advance advances the backfill position to the given slot & root, which should be those of the lowest block
+// written to the database by the backfill process. It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
 func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root [32]byte) error {
-	if upTo > s.end {
-		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
+	s.Lock()
+	defer s.Unlock()
+	if upTo > s.origin {
+		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.origin)
+	}
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
+	s.end = upTo
+	s.root = root
+	return nil
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
+	pruned, err := s.store.PrunedSlot(ctx)
+	if err != nil {
+		return err
+	}
+	s.pruned = pruned
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -84,15 +123,16 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
+	s.origin = cpBlock.Block().Slot()
 
-	_, err = s.store.GenesisBlockRoot(ctx)
+	s.genesisRoot, err = s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
 		}
 		return err
 	}
+	s.start = params.BeaconConfig().GenesisSlot
 
 	bfRoot, err := s.store.BackfillBlockRoot(ctx)
 	if err != nil {
@@ -101,6 +141,11 @@ func (s *Status) Reload(ctx context.Context) error {
 		}
 		return err
 	}
+	// Databases initialized before backfill was implemented store the genesis root as the backfill
+	// starting point. Nothing has been backfilled in that case, the backfill position is the origin.
+	if bfRoot == s.genesisRoot {
+		bfRoot = cpRoot
+	}
 	bfBlock, err := s.store.Block(ctx, bfRoot)
 	if err != nil {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
@@ -108,7 +153,8 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	s.end = bfBlock.Block().Slot()
+	s.root = bfRoot
 	return nil
 }
 
@@ -118,5 +164,6 @@ type BackfillDB interface {
 	GenesisBlockRoot(ctx context.Context) ([32]byte, error)
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	PrunedSlot(ctx context.Context) (primitives.Slot, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
 }
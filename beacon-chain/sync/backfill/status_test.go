@@ -22,6 +22,7 @@ type mockBackfillDB struct {
 	genesisBlockRoot          func(ctx context.Context) ([32]byte, error)
 	originCheckpointBlockRoot func(ctx context.Context) ([32]byte, error)
 	backfillBlockRoot         func(ctx context.Context) ([32]byte, error)
+	prunedSlot                func(ctx context.Context) (primitives.Slot, error)
 	block                     func(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
 }
 
@@ -55,6 +56,14 @@ func (db *mockBackfillDB) BackfillBlockRoot(ctx context.Context) ([32]byte, erro
 	return [32]byte{}, errEmptyMockDBMethod
 }
 
+// PrunedSlot defaults to a database which was never pruned.
+func (db *mockBackfillDB) PrunedSlot(ctx context.Context) (primitives.Slot, error) {
+	if db.prunedSlot != nil {
+		return db.prunedSlot(ctx)
+	}
+	return 0, nil
+}
+
 func (db *mockBackfillDB) Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
 	if db.block != nil {
 		return db.block(ctx, blockRoot)
@@ -105,6 +114,30 @@ func TestSlotCovered(t *testing.T) {
 			slot:   100,
 			result: true,
 		},
+		{
+			name:   "genesisSync below pruned false",
+			status: &Status{genesisSync: true, pruned: 50},
+			slot:   49,
+			result: false,
+		},
+		{
+			name:   "genesisSync equal pruned true",
+			status: &Status{genesisSync: true, pruned: 50},
+			slot:   50,
+			result: true,
+		},
+		{
+			name:   "genesis below pruned true",
+			status: &Status{genesisSync: true, pruned: 50},
+			slot:   0,
+			result: true,
+		},
+		{
+			name:   "above end below pruned false",
+			status: &Status{start: 0, end: 10, pruned: 50},
+			slot:   20,
+			result: false,
+		},
 	}
 	for _, c := range cases {
 		result := c.status.SlotCovered(c.slot)
@@ -121,17 +154,19 @@ func TestAdvance(t *testing.T) {
 			return nil
 		},
 	}
-	s := &Status{end: 100, store: mdb}
+	s := &Status{end: 100, origin: 100, store: mdb}
 	var root [32]byte
 	copy(root[:], []byte{0x23, 0x23})
 	require.NoError(t, s.Advance(ctx, 90, root))
 	require.Equal(t, root, saveBackfillBuf[0])
-	not := s.SlotCovered(95)
+	require.Equal(t, primitives.Slot(90), s.EndGap())
+	require.Equal(t, true, s.SlotCovered(95))
+	not := s.SlotCovered(85)
 	require.Equal(t, false, not)
 
 	// this should still be len 1 after failing to advance
 	require.Equal(t, 1, len(saveBackfillBuf))
-	require.ErrorIs(t, s.Advance(ctx, s.end+1, root), ErrAdvancePastOrigin)
+	require.ErrorIs(t, s.Advance(ctx, s.origin+1, root), ErrAdvancePastOrigin)
 	// this has an element in it from the previous test, there shouldn't be an additional one
 	require.Equal(t, 1, len(saveBackfillBuf))
 }
@@ -163,7 +198,7 @@ func TestReload(t *testing.T) {
 
 	backfillSlot := primitives.Slot(50)
 	var backfillRoot [32]byte
-	copy(originRoot[:], []byte{0x02})
+	copy(backfillRoot[:], []byte{0x02})
 	backfillBlock, err := setupTestBlock(backfillSlot)
 	require.NoError(t, err)
 
@@ -334,7 +369,53 @@ func TestReload(t *testing.T) {
 				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
 			err:      derp,
-			expected: &Status{genesisSync: false, start: backfillSlot, end: originSlot},
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot, origin: originSlot},
+		},
+		{
+			name: "backfill root is genesis, backfill not started",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(params.BeaconConfig().ZeroHash),
+			},
+			expected: &Status{genesisSync: false, start: 0, end: originSlot, origin: originSlot},
+		},
+		{
+			name: "pruned history",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case backfillRoot:
+						return backfillBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(backfillRoot),
+				prunedSlot: func(ctx context.Context) (primitives.Slot, error) {
+					return backfillSlot, nil
+				},
+			},
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot, origin: originSlot, pruned: backfillSlot},
+		},
+		{
+			name: "pruned slot error",
+			err:  derp,
+			db: &mockBackfillDB{
+				prunedSlot: func(ctx context.Context) (primitives.Slot, error) {
+					return 0, derp
+				},
+			},
 		},
 	}
 
@@ -354,5 +435,7 @@ func TestReload(t *testing.T) {
 		require.Equal(t, c.expected.genesisSync, s.genesisSync)
 		require.Equal(t, c.expected.start, s.start)
 		require.Equal(t, c.expected.end, s.end)
+		require.Equal(t, c.expected.origin, s.origin)
+		require.Equal(t, c.expected.pruned, s.pruned)
 	}
 }
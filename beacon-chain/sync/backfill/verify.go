@@ -0,0 +1,107 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+var (
+	errChainBroken      = errors.New("blocks do not form a chain leading to the lowest backfilled block")
+	errUnknownProposer  = errors.New("proposer index is not in the validator registry of the origin state")
+	errInvalidSignature = errors.New("invalid proposer signature")
+)
+
+// verifier checks the blocks received by backfill. The validator registry only grows, so the registry of
+// the origin state contains the public keys of all the proposers of the blocks older than the origin.
+type verifier struct {
+	keys                  [][fieldparams.BLSPubkeyLength]byte
+	genesisValidatorsRoot []byte
+}
+
+func newVerifier(st state.ReadOnlyBeaconState) *verifier {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, st.NumValidators())
+	for i := range keys {
+		keys[i] = st.PubkeyAtIndex(primitives.ValidatorIndex(i))
+	}
+	return &verifier{
+		keys:                  keys,
+		genesisValidatorsRoot: st.GenesisValidatorsRoot(),
+	}
+}
+
+// verify checks that the blocks, sorted by ascending slot, form a chain whose highest block has the
+// given root, and that their proposer signatures are valid. All signatures are verified as a single batch.
+// The roots of the blocks are returned.
+func (v *verifier) verify(blks []interfaces.ReadOnlySignedBeaconBlock, highestRoot [32]byte) ([][32]byte, error) {
+	roots, err := verifyChain(blks, highestRoot)
+	if err != nil {
+		return nil, err
+	}
+	set := bls.NewSet()
+	for i, b := range blks {
+		batch, err := v.signatureBatch(b, roots[i])
+		if err != nil {
+			return nil, err
+		}
+		set.Join(batch)
+	}
+	valid, err := set.Verify()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not verify signature batch")
+	}
+	if !valid {
+		return nil, errInvalidSignature
+	}
+	return roots, nil
+}
+
+func (v *verifier) signatureBatch(b interfaces.ReadOnlySignedBeaconBlock, root [32]byte) (*bls.SignatureBatch, error) {
+	idx := b.Block().ProposerIndex()
+	if uint64(idx) >= uint64(len(v.keys)) {
+		return nil, errors.Wrapf(errUnknownProposer, "proposer index %d", idx)
+	}
+	epoch := slots.ToEpoch(b.Block().Slot())
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, v.genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	sig := b.Signature()
+	return signing.BlockSignatureBatch(v.keys[idx][:], sig[:], domain, func() ([32]byte, error) {
+		return root, nil
+	})
+}
+
+// verifyChain checks that each block is the parent of the next one, and that the highest block has the given root.
+func verifyChain(blks []interfaces.ReadOnlySignedBeaconBlock, highestRoot [32]byte) ([][32]byte, error) {
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		if err := blocks.BeaconBlockIsNil(b); err != nil {
+			return nil, err
+		}
+		root, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = root
+		if i > 0 && b.Block().ParentRoot() != roots[i-1] {
+			return nil, errors.Wrapf(errChainBroken, "parent of block at slot %d is not the previous block", b.Block().Slot())
+		}
+	}
+	if len(roots) > 0 && roots[len(roots)-1] != highestRoot {
+		return nil, errors.Wrapf(errChainBroken, "expected highest block root %#x, got %#x", highestRoot, roots[len(roots)-1])
+	}
+	return roots, nil
+}
@@ -0,0 +1,95 @@
+package backfill
+
+import (
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// chainOfBlocks returns blocks at the given slots, each being the parent of the next one, the first one having the
+// given parent root. The root of the highest block is returned along with the blocks.
+func chainOfBlocks(t *testing.T, parentRoot [32]byte, slots ...primitives.Slot) ([]interfaces.ReadOnlySignedBeaconBlock, [32]byte) {
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, len(slots))
+	for i, sl := range slots {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = sl
+		b.Block.ParentRoot = parentRoot[:]
+		var err error
+		blks[i], err = blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		parentRoot, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	return blks, parentRoot
+}
+
+func TestVerifyChain(t *testing.T) {
+	blks, highest := chainOfBlocks(t, [32]byte{'a'}, 1, 2, 4, 5)
+
+	t.Run("valid chain", func(t *testing.T) {
+		roots, err := verifyChain(blks, highest)
+		require.NoError(t, err)
+		require.Equal(t, len(blks), len(roots))
+		for i := range blks {
+			r, err := blks[i].Block().HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, r, roots[i])
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		roots, err := verifyChain(nil, highest)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(roots))
+	})
+	t.Run("unexpected highest root", func(t *testing.T) {
+		_, err := verifyChain(blks, [32]byte{'b'})
+		require.ErrorIs(t, err, errChainBroken)
+	})
+	t.Run("highest block withheld", func(t *testing.T) {
+		_, err := verifyChain(blks[:len(blks)-1], highest)
+		require.ErrorIs(t, err, errChainBroken)
+	})
+	t.Run("block withheld in the middle", func(t *testing.T) {
+		withheld := []interfaces.ReadOnlySignedBeaconBlock{blks[0], blks[2], blks[3]}
+		_, err := verifyChain(withheld, highest)
+		require.ErrorIs(t, err, errChainBroken)
+	})
+	t.Run("nil block", func(t *testing.T) {
+		_, err := verifyChain([]interfaces.ReadOnlySignedBeaconBlock{nil}, highest)
+		require.ErrorIs(t, err, blocks.ErrNilSignedBeaconBlock)
+	})
+}
+
+func TestVerifier_UnknownProposer(t *testing.T) {
+	vals := make([]*ethpb.Validator, 4)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{PublicKey: bytesutil.PadTo([]byte{byte(i)}, fieldparams.BLSPubkeyLength)}
+	}
+	st, err := util.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.Validators = vals
+		s.GenesisValidatorsRoot = bytesutil.PadTo([]byte{'g'}, fieldparams.RootLength)
+		return nil
+	})
+	require.NoError(t, err)
+	v := newVerifier(st)
+	require.Equal(t, 4, len(v.keys))
+	require.DeepEqual(t, vals[3].PublicKey, v.keys[3][:])
+	require.DeepEqual(t, st.GenesisValidatorsRoot(), v.genesisValidatorsRoot)
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 1
+	b.Block.ProposerIndex = 4
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	_, err = v.verify([]interfaces.ReadOnlySignedBeaconBlock{blk}, root)
+	require.ErrorIs(t, err, errUnknownProposer)
+}
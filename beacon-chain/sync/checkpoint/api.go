@@ -1,12 +1,15 @@
 package checkpoint
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -42,5 +45,46 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
-	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	if err := d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes()); err != nil {
+		return err
+	}
+	// The deposit snapshot is optional, as not every beacon node serves it. Without it the
+	// deposits are retrieved from the deposit contract logs instead.
+	if err := dl.saveDepositSnapshot(ctx, d, od.State().Eth1Data()); err != nil {
+		log.WithError(err).Warn("Could not bootstrap deposit tree from the checkpoint sync deposit snapshot")
+	}
+	return nil
+}
+
+// saveDepositSnapshot downloads the EIP-4881 deposit tree snapshot from the remote beacon node and persists it,
+// so that the execution service bootstraps its deposit tree from it instead of every historical deposit. The
+// snapshot must match the eth1 data of the origin state, so that a snapshot taken at another checkpoint, or
+// a corrupted one, is not trusted.
+func (dl *APIInitializer) saveDepositSnapshot(ctx context.Context, d db.Database, eth1Data *ethpb.Eth1Data) error {
+	snapshot, err := dl.c.GetDepositSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	tree, err := depositsnapshot.NewFromSnapshot(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	if eth1Data == nil {
+		return errors.New("origin state has no eth1 data")
+	}
+	if snapshot.DepositCount != eth1Data.DepositCount {
+		return errors.Errorf("deposit snapshot has %d deposits but the origin state eth1 data has %d", snapshot.DepositCount, eth1Data.DepositCount)
+	}
+	root, err := tree.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit snapshot root")
+	}
+	if !bytes.Equal(root[:], eth1Data.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match the origin state eth1 data deposit root %#x", root, eth1Data.DepositRoot)
+	}
+	if err := d.SaveExecutionChainData(ctx, &ethpb.ETH1ChainData{DepositSnapshot: snapshot}); err != nil {
+		return errors.Wrap(err, "could not save deposit snapshot")
+	}
+	log.WithField("depositCount", snapshot.DepositCount).Info("Saved deposit snapshot from checkpoint sync")
+	return nil
 }
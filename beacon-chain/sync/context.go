@@ -44,14 +44,24 @@ func readContextFromStream(stream network.Stream) ([]byte, error) {
 	return b, nil
 }
 
+// Messages introduced after phase 0 carry context bytes from their first version.
+var contextfulV1Messages = map[string]bool{
+	p2p.BlobSidecarsByRangeName:         true,
+	p2p.BlobSidecarsByRootName:          true,
+	p2p.LightClientBootstrapName:        true,
+	p2p.LightClientUpdatesByRangeName:   true,
+	p2p.LightClientFinalityUpdateName:   true,
+	p2p.LightClientOptimisticUpdateName: true,
+}
+
 func expectRpcContext(stream network.Stream) (bool, error) {
-	_, _, version, err := p2p.TopicDeconstructor(string(stream.Protocol()))
+	_, message, version, err := p2p.TopicDeconstructor(string(stream.Protocol()))
 	if err != nil {
 		return false, err
 	}
 	switch version {
 	case p2p.SchemaVersionV1:
-		return false, nil
+		return contextfulV1Messages[message], nil
 	case p2p.SchemaVersionV2:
 		return true, nil
 	default:
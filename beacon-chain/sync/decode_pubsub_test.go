@@ -22,6 +22,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestService_decodePubsubMessage(t *testing.T) {
@@ -79,6 +80,23 @@ func TestService_decodePubsubMessage(t *testing.T) {
 				return wsb
 			}(),
 		},
+		{
+			name:  "valid message -- blob sidecar",
+			topic: fmt.Sprintf(p2p.BlobSubnetTopicFormat, digest, 1),
+			input: &pubsub.Message{
+				Message: &pb.Message{
+					Data: func() []byte {
+						buf := new(bytes.Buffer)
+						if _, err := p2ptesting.NewTestP2P(t).Encoding().EncodeGossip(buf, util.NewBlobSidecar()); err != nil {
+							t.Fatal(err)
+						}
+						return buf.Bytes()
+					}(),
+				},
+			},
+			wantErr: nil,
+			want:    proto.Clone(util.NewBlobSidecar()),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,6 +1,7 @@
 package initialsync
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -17,9 +18,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
 	"github.com/prysmaticlabs/prysm/v4/crypto/rand"
 	"github.com/prysmaticlabs/prysm/v4/math"
 	p2ppb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -63,6 +67,7 @@ type blocksFetcherConfig struct {
 	chain                    blockchainService
 	p2p                      p2p.P2P
 	db                       db.ReadOnlyDatabase
+	kzgVerifier              kzg.Verifier
 	peerFilterCapacityWeight float64
 	mode                     syncMode
 }
@@ -78,6 +83,7 @@ type blocksFetcher struct {
 	chain           blockchainService
 	p2p             p2p.P2P
 	db              db.ReadOnlyDatabase
+	kzgVerifier     kzg.Verifier
 	blocksPerPeriod uint64
 	rateLimiter     *leakybucket.Collector
 	peerLocks       map[peer.ID]*peerLock
@@ -103,11 +109,13 @@ type fetchRequestParams struct {
 
 // fetchRequestResponse is a combined type to hold results of both successful executions and errors.
 // Valid usage pattern will be to check whether result's `err` is nil, before using `blocks`.
+// The blob sidecars of the blocks which are within the blob retention window are returned in `blobs`.
 type fetchRequestResponse struct {
 	pid    peer.ID
 	start  primitives.Slot
 	count  uint64
 	blocks []interfaces.ReadOnlySignedBeaconBlock
+	blobs  []*p2ppb.BlobSidecar
 	err    error
 }
 
@@ -133,6 +141,7 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		chain:           cfg.chain,
 		p2p:             cfg.p2p,
 		db:              cfg.db,
+		kzgVerifier:     cfg.kzgVerifier,
 		blocksPerPeriod: uint64(blocksPerPeriod),
 		rateLimiter:     rateLimiter,
 		peerLocks:       make(map[peer.ID]*peerLock),
@@ -273,16 +282,16 @@ func (f *blocksFetcher) handleRequest(ctx context.Context, start primitives.Slot
 		}
 	}
 
-	response.blocks, response.pid, response.err = f.fetchBlocksFromPeer(ctx, start, count, peers)
+	response.blocks, response.blobs, response.pid, response.err = f.fetchBlocksFromPeer(ctx, start, count, peers)
 	return response
 }
 
-// fetchBlocksFromPeer fetches blocks from a single randomly selected peer.
+// fetchBlocksFromPeer fetches blocks, and the blob sidecars they commit to, from a single randomly selected peer.
 func (f *blocksFetcher) fetchBlocksFromPeer(
 	ctx context.Context,
 	start primitives.Slot, count uint64,
 	peers []peer.ID,
-) ([]interfaces.ReadOnlySignedBeaconBlock, peer.ID, error) {
+) ([]interfaces.ReadOnlySignedBeaconBlock, []*p2ppb.BlobSidecar, peer.ID, error) {
 	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlocksFromPeer")
 	defer span.End()
 
@@ -294,14 +303,142 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 	}
 	for i := 0; i < len(peers); i++ {
 		blocks, err := f.requestBlocks(ctx, req, peers[i])
-		if err == nil {
-			f.p2p.Peers().Scorers().BlockProviderScorer().Touch(peers[i])
-			return blocks, peers[i], nil
-		} else {
+		if err != nil {
 			log.WithError(err).Debug("Could not request blocks by range")
+			continue
 		}
+		blobs, err := f.fetchBlobsFromPeer(ctx, blocks, peers[i])
+		if err != nil {
+			log.WithError(err).Debug("Could not request blob sidecars by range")
+			continue
+		}
+		f.p2p.Peers().Scorers().BlockProviderScorer().Touch(peers[i])
+		return blocks, blobs, peers[i], nil
+	}
+	return nil, nil, "", errNoPeersAvailable
+}
+
+// fetchBlobsFromPeer requests from the peer the blob sidecars committed to by the given blocks, skipping the
+// blocks outside of the blob retention window. The blocks are expected in ascending slot order. The sidecars are
+// checked against the commitments of their block, so that a block is never processed without all of its blobs.
+func (f *blocksFetcher) fetchBlobsFromPeer(
+	ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock, pid peer.ID,
+) ([]*p2ppb.BlobSidecar, error) {
+	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlobsFromPeer")
+	defer span.End()
+
+	start, count, err := f.blobRange(blocks)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	req := &p2ppb.BlobSidecarsByRangeRequest{
+		StartSlot: start,
+		Count:     count,
+	}
+	blobs, err := f.requestBlobs(ctx, req, pid)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.verifyBlobs(blocks, blobs); err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
+
+// blobRange returns the range of slots, from the first to the last block committing to blobs within the
+// blob retention window. The count is zero if no such block exists.
+func (f *blocksFetcher) blobRange(blocks []interfaces.ReadOnlySignedBeaconBlock) (primitives.Slot, uint64, error) {
+	var start, end primitives.Slot
+	found := false
+	for _, b := range blocks {
+		if b.Version() < version.Deneb || !f.withinBlobRetention(b.Block().Slot()) {
+			continue
+		}
+		commitments, err := b.Block().Body().BlobKzgCommitments()
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(commitments) == 0 {
+			continue
+		}
+		if !found {
+			start = b.Block().Slot()
+			found = true
+		}
+		end = b.Block().Slot()
+	}
+	if !found {
+		return 0, 0, nil
+	}
+	return start, uint64(end-start) + 1, nil
+}
+
+// withinBlobRetention returns true if the blob sidecars of the given slot must be served by peers, that is
+// if the slot is within MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS of the current epoch.
+func (f *blocksFetcher) withinBlobRetention(slot primitives.Slot) bool {
+	return slots.ToEpoch(slot)+params.BeaconConfig().MinEpochsForBlobsSidecarsRequest >= slots.ToEpoch(f.chain.CurrentSlot())
+}
+
+// verifyBlobs checks that the sidecars are exactly the blobs committed to by the blocks within the blob
+// retention window, and that every blob matches its commitment.
+func (f *blocksFetcher) verifyBlobs(blocks []interfaces.ReadOnlySignedBeaconBlock, blobs []*p2ppb.BlobSidecar) error {
+	byRoot := make(map[[32]byte][]*p2ppb.BlobSidecar)
+	for _, sc := range blobs {
+		root, err := sc.SignedBlockHeader.Header.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		byRoot[root] = append(byRoot[root], sc)
+	}
+	verified := 0
+	for _, b := range blocks {
+		if b.Version() < version.Deneb || !f.withinBlobRetention(b.Block().Slot()) {
+			continue
+		}
+		commitments, err := b.Block().Body().BlobKzgCommitments()
+		if err != nil {
+			return err
+		}
+		if len(commitments) == 0 {
+			continue
+		}
+		root, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		// Sidecars are sent in index order, so the sidecars of a block line up with its commitments.
+		scs := byRoot[root]
+		if len(scs) != len(commitments) {
+			return errors.Wrapf(prysmsync.ErrInvalidFetchedData, "block %#x commits to %d blobs, received %d", root, len(commitments), len(scs))
+		}
+		for i, sc := range scs {
+			if sc.Index != uint64(i) || !bytes.Equal(sc.KzgCommitment, commitments[i]) {
+				return errors.Wrapf(prysmsync.ErrInvalidFetchedData, "blob sidecar %d of block %#x does not match its commitment", sc.Index, root)
+			}
+		}
+		verified += len(scs)
+	}
+	// Every sidecar must belong to one of the blocks.
+	if verified != len(blobs) {
+		return errors.Wrap(prysmsync.ErrInvalidFetchedData, "received blob sidecars of unknown blocks")
+	}
+	if len(blobs) == 0 {
+		return nil
+	}
+	if f.kzgVerifier == nil {
+		return errors.New("no KZG verifier to check the blob sidecars")
+	}
+	bs, cs, ps := make([][]byte, len(blobs)), make([][]byte, len(blobs)), make([][]byte, len(blobs))
+	for i, sc := range blobs {
+		bs[i], cs[i], ps[i] = sc.Blob, sc.KzgCommitment, sc.KzgProof
+	}
+	if err := f.kzgVerifier.VerifyBlobKZGProofBatch(bs, cs, ps); err != nil {
+		return errors.Wrap(prysmsync.ErrInvalidFetchedData, err.Error())
 	}
-	return nil, "", errNoPeersAvailable
+	return nil
 }
 
 // requestBlocks is a wrapper for handling BeaconBlocksByRangeRequest requests/streams.
@@ -334,6 +471,36 @@ func (f *blocksFetcher) requestBlocks(
 	return prysmsync.SendBeaconBlocksByRangeRequest(ctx, f.chain, f.p2p, pid, req, nil)
 }
 
+// requestBlobs is a wrapper for handling BlobSidecarsByRangeRequest requests/streams. Requests are rate
+// limited along with the block requests, each requested slot counting as a block.
+func (f *blocksFetcher) requestBlobs(
+	ctx context.Context,
+	req *p2ppb.BlobSidecarsByRangeRequest,
+	pid peer.ID,
+) ([]*p2ppb.BlobSidecar, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	l := f.peerLock(pid)
+	l.Lock()
+	log.WithFields(logrus.Fields{
+		"peer":     pid,
+		"start":    req.StartSlot,
+		"count":    req.Count,
+		"capacity": f.rateLimiter.Remaining(pid.String()),
+		"score":    f.p2p.Peers().Scorers().BlockProviderScorer().FormatScorePretty(pid),
+	}).Debug("Requesting blob sidecars")
+	if f.rateLimiter.Remaining(pid.String()) < int64(req.Count) {
+		if err := f.waitForBandwidth(pid, req.Count); err != nil {
+			l.Unlock()
+			return nil, err
+		}
+	}
+	f.rateLimiter.Add(pid.String(), int64(req.Count))
+	l.Unlock()
+	return prysmsync.SendBlobSidecarsByRangeRequest(ctx, f.chain, f.p2p, pid, req)
+}
+
 // requestBlocksByRoot is a wrapper for handling BeaconBlockByRootsReq requests/streams.
 func (f *blocksFetcher) requestBlocksByRoot(
 	ctx context.Context,
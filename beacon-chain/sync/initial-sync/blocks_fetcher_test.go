@@ -1,7 +1,9 @@
 package initialsync
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
@@ -952,3 +954,76 @@ func TestTimeToWait(t *testing.T) {
 		})
 	}
 }
+
+type mockKZGVerifier struct {
+	err error
+}
+
+func (m *mockKZGVerifier) VerifyBlobKZGProof(_, _, _ []byte) error {
+	return m.err
+}
+
+func (m *mockKZGVerifier) VerifyBlobKZGProofBatch(_, _, _ [][]byte) error {
+	return m.err
+}
+
+func TestBlocksFetcher_verifyBlobs(t *testing.T) {
+	denebBlock := func(slot primitives.Slot, commitments ...byte) (interfaces.ReadOnlySignedBeaconBlock, []*ethpb.BlobSidecar) {
+		b := util.NewBeaconBlockDeneb()
+		b.Block.Slot = slot
+		for _, c := range commitments {
+			b.Block.Body.BlobKzgCommitments = append(b.Block.Body.BlobKzgCommitments, bytes.Repeat([]byte{c}, 48))
+		}
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		header, err := wsb.Header()
+		require.NoError(t, err)
+		scs := make([]*ethpb.BlobSidecar, len(commitments))
+		for i := range scs {
+			scs[i] = util.HydrateBlobSidecar(&ethpb.BlobSidecar{
+				Index:             uint64(i),
+				KzgCommitment:     b.Block.Body.BlobKzgCommitments[i],
+				SignedBlockHeader: header,
+			})
+		}
+		return wsb, scs
+	}
+	capella, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+	require.NoError(t, err)
+	noBlobs, _ := denebBlock(1)
+	first, firstBlobs := denebBlock(2, 'a', 'b')
+	last, lastBlobs := denebBlock(4, 'c')
+	blks := []interfaces.ReadOnlySignedBeaconBlock{capella, noBlobs, first, last}
+	blobs := append(append([]*ethpb.BlobSidecar{}, firstBlobs...), lastBlobs...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		chain:       &mock.ChainService{Genesis: time.Now()},
+		kzgVerifier: &mockKZGVerifier{},
+	})
+
+	start, count, err := fetcher.blobRange(blks)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(2), start)
+	assert.Equal(t, uint64(3), count)
+	_, count, err = fetcher.blobRange(blks[:2])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+
+	require.NoError(t, fetcher.verifyBlobs(blks, blobs))
+	require.ErrorContains(t, "commits to 2 blobs, received 1", fetcher.verifyBlobs(blks, blobs[1:]))
+	require.ErrorContains(t, "blob sidecars of unknown blocks", fetcher.verifyBlobs(blks[:3], blobs))
+
+	wrong := util.HydrateBlobSidecar(&ethpb.BlobSidecar{
+		Index:             1,
+		KzgCommitment:     bytes.Repeat([]byte{'z'}, 48),
+		SignedBlockHeader: firstBlobs[1].SignedBlockHeader,
+	})
+	err = fetcher.verifyBlobs(blks, []*ethpb.BlobSidecar{firstBlobs[0], wrong, lastBlobs[0]})
+	require.ErrorIs(t, err, beaconsync.ErrInvalidFetchedData)
+
+	fetcher.kzgVerifier = &mockKZGVerifier{err: errors.New("invalid proof")}
+	err = fetcher.verifyBlobs(blks, blobs)
+	require.ErrorIs(t, err, beaconsync.ErrInvalidFetchedData)
+}
@@ -20,10 +20,11 @@ import (
 
 // forkData represents alternative chain path supported by a given peer.
 // Blocks are stored in an ascending slot order. The first block is guaranteed to have parent
-// either in DB or initial sync cache.
+// either in DB or initial sync cache. The blob sidecars committed to by the blocks are stored in blobs.
 type forkData struct {
 	peer   peer.ID
 	blocks []interfaces.ReadOnlySignedBeaconBlock
+	blobs  []*p2ppb.BlobSidecar
 }
 
 // nonSkippedSlotAfter checks slots after the given one in an attempt to find a non-empty future slot.
@@ -195,6 +196,14 @@ func (f *blocksFetcher) findFork(ctx context.Context, slot primitives.Slot) (*fo
 			}).Debug("No alternative blocks found for peer")
 			continue
 		}
+		fork.blobs, err = f.fetchBlobsFromPeer(ctx, fork.blocks, pid)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"peer":  pid,
+				"error": err.Error(),
+			}).Debug("Could not fetch blob sidecars of alternative blocks")
+			continue
+		}
 		return fork, nil
 	}
 	return nil, errNoPeersWithAltBlocks
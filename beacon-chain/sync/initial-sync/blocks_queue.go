@@ -11,6 +11,8 @@ import (
 	beaconsync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
 )
@@ -65,6 +67,7 @@ type blocksQueueConfig struct {
 	highestExpectedSlot primitives.Slot
 	p2p                 p2p.P2P
 	db                  db.ReadOnlyDatabase
+	kzgVerifier         kzg.Verifier
 	mode                syncMode
 }
 
@@ -90,6 +93,7 @@ type blocksQueue struct {
 type blocksQueueFetchedData struct {
 	pid    peer.ID
 	blocks []interfaces.ReadOnlySignedBeaconBlock
+	blobs  []*ethpb.BlobSidecar
 }
 
 // newBlocksQueue creates initialized priority queue.
@@ -99,9 +103,10 @@ func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
 	blocksFetcher := cfg.blocksFetcher
 	if blocksFetcher == nil {
 		blocksFetcher = newBlocksFetcher(ctx, &blocksFetcherConfig{
-			chain: cfg.chain,
-			p2p:   cfg.p2p,
-			db:    cfg.db,
+			chain:       cfg.chain,
+			p2p:         cfg.p2p,
+			db:          cfg.db,
+			kzgVerifier: cfg.kzgVerifier,
 		})
 	}
 	highestExpectedSlot := cfg.highestExpectedSlot
@@ -333,6 +338,7 @@ func (q *blocksQueue) onDataReceivedEvent(ctx context.Context) eventHandlerFn {
 		}
 		m.pid = response.pid
 		m.blocks = response.blocks
+		m.blobs = response.blobs
 		return stateDataParsed, nil
 	}
 }
@@ -355,6 +361,7 @@ func (q *blocksQueue) onReadyToSendEvent(ctx context.Context) eventHandlerFn {
 			data := &blocksQueueFetchedData{
 				pid:    m.pid,
 				blocks: m.blocks,
+				blobs:  m.blobs,
 			}
 			select {
 			case <-ctx.Done():
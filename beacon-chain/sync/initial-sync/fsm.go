@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 )
@@ -47,6 +48,7 @@ type stateMachine struct {
 	state   stateID
 	pid     peer.ID
 	blocks  []interfaces.ReadOnlySignedBeaconBlock
+	blobs   []*ethpb.BlobSidecar
 	updated time.Time
 }
 
@@ -91,6 +93,7 @@ func (smm *stateMachineManager) removeStateMachine(startSlot primitives.Slot) er
 		return fmt.Errorf("state for machine %v is not found", startSlot)
 	}
 	smm.machines[startSlot].blocks = nil
+	smm.machines[startSlot].blobs = nil
 	delete(smm.machines, startSlot)
 	smm.recalculateMachineAttribs()
 	return nil
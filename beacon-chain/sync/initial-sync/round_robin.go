@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
 )
@@ -74,6 +75,7 @@ func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time) e
 		db:                  s.cfg.DB,
 		chain:               s.cfg.Chain,
 		highestExpectedSlot: highestFinalizedSlot,
+		kzgVerifier:         s.cfg.KZGVerifier,
 		mode:                modeStopOnFinalizedEpoch,
 	})
 	if err := queue.start(); err != nil {
@@ -103,6 +105,7 @@ func (s *Service) syncToNonFinalizedEpoch(ctx context.Context, genesis time.Time
 		db:                  s.cfg.DB,
 		chain:               s.cfg.Chain,
 		highestExpectedSlot: slots.Since(genesis),
+		kzgVerifier:         s.cfg.KZGVerifier,
 		mode:                modeNonConstrained,
 	})
 	if err := queue.start(); err != nil {
@@ -127,6 +130,10 @@ func (s *Service) processFetchedData(
 	ctx context.Context, genesis time.Time, startSlot primitives.Slot, data *blocksQueueFetchedData) {
 	defer s.updatePeerScorerStats(data.pid, startSlot)
 
+	if err := s.receiveBlobs(ctx, data.blobs); err != nil {
+		log.WithError(err).Warn("Skip processing batched blocks")
+		return
+	}
 	// Use Batch Block Verify to process and verify batches directly.
 	if err := s.processBatchedBlocks(ctx, genesis, data.blocks, s.cfg.Chain.ReceiveBlockBatch); err != nil {
 		log.WithError(err).Warn("Skip processing batched blocks")
@@ -138,6 +145,10 @@ func (s *Service) processFetchedDataRegSync(
 	ctx context.Context, genesis time.Time, startSlot primitives.Slot, data *blocksQueueFetchedData) {
 	defer s.updatePeerScorerStats(data.pid, startSlot)
 
+	if err := s.receiveBlobs(ctx, data.blobs); err != nil {
+		log.WithError(err).Warn("Range is not processed")
+		return
+	}
 	blockReceiver := s.cfg.Chain.ReceiveBlock
 	invalidBlocks := 0
 	blksWithoutParentCount := 0
@@ -169,6 +180,17 @@ func (s *Service) processFetchedDataRegSync(
 	}
 }
 
+// receiveBlobs hands over the blob sidecars fetched along with the blocks to the chain service, before the
+// blocks are processed, so that the blocks are found to be available.
+func (s *Service) receiveBlobs(ctx context.Context, blobs []*ethpb.BlobSidecar) error {
+	for _, sc := range blobs {
+		if err := s.cfg.Chain.ReceiveBlob(ctx, sc); err != nil {
+			return fmt.Errorf("could not receive blob sidecar: %w", err)
+		}
+	}
+	return nil
+}
+
 // highestFinalizedEpoch returns the absolute highest finalized epoch of all connected peers.
 // Note this can be lower than our finalized epoch if we have no peers or peers that are all behind us.
 func (s *Service) highestFinalizedEpoch() primitives.Epoch {
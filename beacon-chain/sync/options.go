@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
 )
 
 type Option func(s *Service) error
@@ -143,3 +144,10 @@ func WithInitialSyncComplete(c chan struct{}) Option {
 		return nil
 	}
 }
+
+func WithKZGVerifier(v kzg.Verifier) Option {
+	return func(s *Service) error {
+		s.cfg.kzgVerifier = v
+		return nil
+	}
+}
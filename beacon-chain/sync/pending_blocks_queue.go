@@ -159,6 +159,14 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 			default:
 			}
 
+			// The blobs of a pending block were likely ignored on gossip, as its parent was unknown back then.
+			// The block is kept in the queue until its blobs are retrieved.
+			if err := s.requestMissingBlobs(ctx, b, blkRoot); err != nil {
+				log.WithError(err).WithField("slot", b.Block().Slot()).Debug("Could not retrieve blobs of pending block")
+				span.End()
+				continue
+			}
+
 			if err := s.cfg.chain.ReceiveBlock(ctx, b, blkRoot); err != nil {
 				if blockchain.IsInvalidBlock(err) {
 					r := blockchain.InvalidBlockRoot(err)
@@ -0,0 +1,153 @@
+package sync
+
+import (
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// blobSidecarsByRangeRPCHandler looks up the canonical blob sidecars in the requested slot range from the database.
+func (s *Service) blobSidecarsByRangeRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.BlobSidecarsByRangeHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "blob_sidecars_by_range")
+
+	m, ok := msg.(*pb.BlobSidecarsByRangeRequest)
+	if !ok {
+		return errors.New("message is not type *pb.BlobSidecarsByRangeRequest")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	rp, err := validateBlobsByRange(m, s.cfg.clock.CurrentSlot())
+	if err != nil {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	span.AddAttributes(
+		trace.Int64Attribute("start", int64(rp.start)), // lint:ignore uintcast -- This conversion is OK for tracing.
+		trace.Int64Attribute("end", int64(rp.end)),     // lint:ignore uintcast -- This conversion is OK for tracing.
+		trace.Int64Attribute("count", int64(m.Count)),
+		trace.StringAttribute("peer", stream.Conn().RemotePeer().Pretty()),
+	)
+	// A request made entirely outside of the retention window is answered with an empty response.
+	if rp.size == 0 {
+		s.rateLimiter.add(stream, 1)
+		closeStream(stream, log)
+		return nil
+	}
+
+	var sent int64
+	for slot := rp.start; slot <= rp.end; slot++ {
+		if err := ctx.Err(); err != nil {
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		scs, err := s.cfg.beaconDB.BlobSidecarsBySlot(ctx, slot)
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				continue
+			}
+			log.WithError(err).Debug("Could not fetch blob sidecars")
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		for _, sc := range scs {
+			root, err := sc.SignedBlockHeader.Header.HashTreeRoot()
+			if err != nil {
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			canonical, err := s.cfg.chain.IsCanonical(ctx, root)
+			if err != nil {
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			if !canonical {
+				continue
+			}
+			SetStreamWriteDeadline(stream, defaultWriteDuration)
+			if chunkErr := WriteBlobSidecarChunk(stream, s.cfg.clock, s.cfg.p2p.Encoding(), sc); chunkErr != nil {
+				log.WithError(chunkErr).Debug("Could not send a chunked response")
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, chunkErr)
+				return chunkErr
+			}
+			sent++
+		}
+	}
+	if sent == 0 {
+		sent = 1
+	}
+	s.rateLimiter.add(stream, sent)
+	closeStream(stream, log)
+	return nil
+}
+
+// blobMinReqEpoch computes the earliest epoch for which blob sidecars must be served.
+//
+// Spec code:
+// [max(current_epoch - MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS, DENEB_FORK_EPOCH), current_epoch]
+func blobMinReqEpoch(current primitives.Epoch) primitives.Epoch {
+	cfg := params.BeaconConfig()
+	minReq := primitives.Epoch(0)
+	if current > cfg.MinEpochsForBlobsSidecarsRequest {
+		minReq = current - cfg.MinEpochsForBlobsSidecarsRequest
+	}
+	if minReq < cfg.DenebForkEpoch {
+		return cfg.DenebForkEpoch
+	}
+	return minReq
+}
+
+// validateBlobsByRange checks the bounds of a blob sidecars by range request and narrows the requested slots
+// down to the ones within the blob retention window. A zero size is returned when no slot is left to serve.
+func validateBlobsByRange(r *pb.BlobSidecarsByRangeRequest, current primitives.Slot) (rangeParams, error) {
+	if r.Count == 0 {
+		return rangeParams{}, errors.Wrap(p2ptypes.ErrInvalidRequest, "invalid request count parameter")
+	}
+	maxRequest := params.BeaconConfig().MaxRequestBlobSidecars / fieldparams.MaxBlobsPerBlock
+	if r.Count > maxRequest {
+		return rangeParams{}, errors.Wrapf(p2ptypes.ErrInvalidRequest, "requested more than %d slots", maxRequest)
+	}
+	end, err := r.StartSlot.SafeAdd(r.Count - 1)
+	if err != nil {
+		return rangeParams{}, errors.Wrap(p2ptypes.ErrInvalidRequest, "overflow start + count -1")
+	}
+	rp := rangeParams{start: r.StartSlot, end: end}
+
+	minStart, err := slots.EpochStart(blobMinReqEpoch(slots.ToEpoch(current)))
+	if err != nil {
+		// The retention window starts at a far future fork epoch, there is nothing to serve.
+		return rangeParams{}, nil
+	}
+	if rp.start < minStart {
+		rp.start = minStart
+	}
+	if rp.end > current {
+		rp.end = current
+	}
+	if rp.end < rp.start {
+		return rangeParams{}, nil
+	}
+	rp.size = uint64(rp.end.SubSlot(rp.start)) + 1
+	return rp, nil
+}
@@ -0,0 +1,107 @@
+package sync
+
+import (
+	"math"
+	"testing"
+
+	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestBlobMinReqEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DenebForkEpoch = 10
+	cfg.MinEpochsForBlobsSidecarsRequest = 100
+	params.OverrideBeaconConfig(cfg)
+
+	require.Equal(t, primitives.Epoch(10), blobMinReqEpoch(0))
+	require.Equal(t, primitives.Epoch(10), blobMinReqEpoch(50))
+	require.Equal(t, primitives.Epoch(10), blobMinReqEpoch(110))
+	require.Equal(t, primitives.Epoch(400), blobMinReqEpoch(500))
+}
+
+func TestValidateBlobsByRange(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DenebForkEpoch = 1
+	cfg.MinEpochsForBlobsSidecarsRequest = 10
+	params.OverrideBeaconConfig(cfg)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	current := slotsPerEpoch * 100
+	minStart := slotsPerEpoch * 90
+	maxCount := params.BeaconConfig().MaxRequestBlobSidecars / fieldparams.MaxBlobsPerBlock
+
+	tests := []struct {
+		name    string
+		req     *ethpb.BlobSidecarsByRangeRequest
+		current primitives.Slot
+		want    rangeParams
+		wantErr error
+	}{
+		{
+			name:    "zero count",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: minStart, Count: 0},
+			current: current,
+			wantErr: p2ptypes.ErrInvalidRequest,
+		},
+		{
+			name:    "over limit count",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: minStart, Count: maxCount + 1},
+			current: current,
+			wantErr: p2ptypes.ErrInvalidRequest,
+		},
+		{
+			name:    "overflow",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: math.MaxUint64, Count: 2},
+			current: current,
+			wantErr: p2ptypes.ErrInvalidRequest,
+		},
+		{
+			name:    "within window",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: minStart + 1, Count: 10},
+			current: current,
+			want:    rangeParams{start: minStart + 1, end: minStart + 10, size: 10},
+		},
+		{
+			name:    "before window",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: 0, Count: 10},
+			current: current,
+			want:    rangeParams{},
+		},
+		{
+			name:    "start clamped to window",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: minStart - 10, Count: 20},
+			current: current,
+			want:    rangeParams{start: minStart, end: minStart + 9, size: 10},
+		},
+		{
+			name:    "end clamped to current slot",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: current - 10, Count: 20},
+			current: current,
+			want:    rangeParams{start: current - 10, end: current, size: 11},
+		},
+		{
+			name:    "before deneb",
+			req:     &ethpb.BlobSidecarsByRangeRequest{StartSlot: 0, Count: 10},
+			current: 10,
+			want:    rangeParams{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp, err := validateBlobsByRange(tt.req, tt.current)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, rp)
+		})
+	}
+}
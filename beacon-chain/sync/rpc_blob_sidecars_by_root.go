@@ -0,0 +1,185 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// requestMissingBlobs requests from peers the blob sidecars committed to by the block which are not stored yet,
+// and hands them over to the chain service. Blocks from before deneb, or outside of the blob retention window,
+// have no blobs to request.
+func (s *Service) requestMissingBlobs(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "sync.requestMissingBlobs")
+	defer span.End()
+
+	commitments, missing, err := s.missingBlobs(ctx, b, root)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	cp := s.cfg.chain.FinalizedCheckpt()
+	_, bestPeers := s.cfg.p2p.Peers().BestFinalized(maxPeerRequest, cp.Epoch)
+	if len(bestPeers) == 0 {
+		return errors.Errorf("no peers to request the %d missing blob sidecars of block %#x from", len(missing), root)
+	}
+	randGen := rand.NewGenerator()
+	for i := 0; i < numOfTries && len(missing) > 0; i++ {
+		pid := bestPeers[randGen.Int()%len(bestPeers)]
+		req := make(types.BlobSidecarsByRootReq, 0, len(missing))
+		for idx := range missing {
+			req = append(req, &ethpb.BlobIdentifier{BlockRoot: bytesutil.SafeCopyBytes(root[:]), Index: idx})
+		}
+		if err := s.sendBlobSidecarsRequest(ctx, &req, pid, commitments, missing); err != nil {
+			tracing.AnnotateError(span, err)
+			log.WithError(err).WithField("peer", pid).Debug("Could not request missing blob sidecars")
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("%d blob sidecars of block %#x are still missing", len(missing), root)
+	}
+	return nil
+}
+
+// sendBlobSidecarsRequest requests blob sidecars by root from the peer. The sidecars are checked against the
+// commitments of their block before being handed over to the chain service, and removed from the missing set.
+func (s *Service) sendBlobSidecarsRequest(
+	ctx context.Context, req *types.BlobSidecarsByRootReq, pid peer.ID, commitments [][]byte, missing map[uint64]bool,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+
+	scs, err := SendBlobSidecarsByRootRequest(ctx, s.cfg.clock, s.cfg.p2p, pid, req)
+	if err != nil {
+		return err
+	}
+	for _, sc := range scs {
+		if sc.Index >= uint64(len(commitments)) || !bytes.Equal(sc.KzgCommitment, commitments[sc.Index]) {
+			return errors.Wrapf(ErrInvalidFetchedData, "blob sidecar %d does not match the commitment of its block", sc.Index)
+		}
+		if err := s.cfg.kzgVerifier.VerifyBlobKZGProof(sc.Blob, sc.KzgCommitment, sc.KzgProof); err != nil {
+			return errors.Wrap(ErrInvalidFetchedData, err.Error())
+		}
+		if err := s.cfg.chain.ReceiveBlob(ctx, sc); err != nil {
+			return errors.Wrap(err, "could not receive blob sidecar")
+		}
+		delete(missing, sc.Index)
+	}
+	return nil
+}
+
+// missingBlobs returns the KZG commitments of the block, along with the indices of the blob sidecars which
+// are not stored.
+func (s *Service) missingBlobs(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock, root [32]byte) ([][]byte, map[uint64]bool, error) {
+	if b.Version() < version.Deneb {
+		return nil, nil, nil
+	}
+	currentEpoch := slots.ToEpoch(s.cfg.clock.CurrentSlot())
+	if slots.ToEpoch(b.Block().Slot())+params.BeaconConfig().MinEpochsForBlobsSidecarsRequest < currentEpoch {
+		return nil, nil, nil
+	}
+	commitments, err := b.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(commitments) == 0 {
+		return nil, nil, nil
+	}
+	missing := make(map[uint64]bool, len(commitments))
+	for i := range commitments {
+		missing[uint64(i)] = true
+	}
+	stored, err := s.cfg.beaconDB.BlobSidecarsByRoot(ctx, root)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, nil, errors.Wrap(err, "could not get blob sidecars")
+	}
+	for _, sc := range stored {
+		delete(missing, sc.Index)
+	}
+	return commitments, missing, nil
+}
+
+// blobSidecarsByRootRPCHandler looks up the requested blob sidecars from the database by their block root and index.
+// Sidecars which are unknown or outside of the retention window are skipped.
+func (s *Service) blobSidecarsByRootRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.BlobSidecarsByRootHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "blob_sidecars_by_root")
+
+	rawMsg, ok := msg.(*types.BlobSidecarsByRootReq)
+	if !ok {
+		return errors.New("message is not type BlobSidecarsByRootReq")
+	}
+	ids := *rawMsg
+	if err := s.rateLimiter.validateRequest(stream, uint64(len(ids))); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		// Add to rate limiter in the event no
+		// sidecars are requested.
+		s.rateLimiter.add(stream, 1)
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, "no blob identifiers provided in request", stream)
+		return errors.New("no blob identifiers provided")
+	}
+	if uint64(len(ids)) > params.BeaconConfig().MaxRequestBlobSidecars {
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, "requested more than the max blob sidecar limit", stream)
+		return errors.New("requested more than the max blob sidecar limit")
+	}
+	s.rateLimiter.add(stream, int64(len(ids)))
+
+	minReqSlot, err := slots.EpochStart(blobMinReqEpoch(slots.ToEpoch(s.cfg.clock.CurrentSlot())))
+	if err != nil {
+		// The retention window starts at a far future fork epoch, there is nothing to serve.
+		closeStream(stream, log)
+		return nil
+	}
+	for _, id := range ids {
+		root := bytesutil.ToBytes32(id.BlockRoot)
+		scs, err := s.cfg.beaconDB.BlobSidecarsByRoot(ctx, root, id.Index)
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				log.WithField("root", root).WithField("index", id.Index).Debug("Peer requested blob sidecar which is not in the db")
+				continue
+			}
+			log.WithError(err).Debug("Could not fetch blob sidecar")
+			s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		for _, sc := range scs {
+			if sc.SignedBlockHeader.Header.Slot < minReqSlot {
+				continue
+			}
+			SetStreamWriteDeadline(stream, defaultWriteDuration)
+			if chunkErr := WriteBlobSidecarChunk(stream, s.cfg.clock, s.cfg.p2p.Encoding(), sc); chunkErr != nil {
+				log.WithError(chunkErr).Debug("Could not send a chunked response")
+				s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, chunkErr)
+				return chunkErr
+			}
+		}
+	}
+
+	closeStream(stream, log)
+	return nil
+}
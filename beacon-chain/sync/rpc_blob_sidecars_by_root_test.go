@@ -0,0 +1,200 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	p2pTypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestBlobSidecarsByRootRPCHandler_ReturnsBlobs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 1
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// Slots are chosen so that the first sidecar is from before the Deneb fork, and must be skipped.
+	var req p2pTypes.BlobSidecarsByRootReq
+	var want []*ethpb.BlobSidecar
+	for i, slot := range []primitives.Slot{slotsPerEpoch - 1, slotsPerEpoch, slotsPerEpoch + 1} {
+		sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: uint64(i)})
+		sc.SignedBlockHeader.Header.Slot = slot
+		require.NoError(t, d.SaveBlobSidecar(context.Background(), []*ethpb.BlobSidecar{sc}))
+		root, err := sc.SignedBlockHeader.Header.HashTreeRoot()
+		require.NoError(t, err)
+		req = append(req, &ethpb.BlobIdentifier{BlockRoot: root[:], Index: sc.Index})
+		if slot >= slotsPerEpoch {
+			want = append(want, sc)
+		}
+	}
+	// Unknown blob sidecars are skipped.
+	req = append(req, &ethpb.BlobIdentifier{BlockRoot: make([]byte, 32), Index: 0})
+
+	genesis := time.Now().Add(-time.Duration(uint64(slotsPerEpoch)*2*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	clock := startup.NewClock(genesis, [32]byte{})
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, clock: clock}, rateLimiter: newRateLimiter(p1)}
+	r.cfg.chain = &mock.ChainService{ValidatorsRoot: [32]byte{}}
+	pcl := protocol.ID(p2p.RPCBlobSidecarsByRootTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for i := range want {
+			sc, err := ReadChunkedBlobSidecar(stream, clock, p2, i == 0)
+			assert.NoError(t, err)
+			assert.DeepSSZEqual(t, want[i], sc)
+		}
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.blobSidecarsByRootRPCHandler(context.Background(), &req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestBlobSidecarsByRootRPCHandler_OverLimit(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, clock: startup.NewClock(time.Unix(0, 0), [32]byte{})}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlobSidecarsByRootTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, time.Second, false)
+
+	req := make(p2pTypes.BlobSidecarsByRootReq, params.BeaconConfig().MaxRequestBlobSidecars+1)
+	for i := range req {
+		req[i] = &ethpb.BlobIdentifier{BlockRoot: make([]byte, 32)}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeInvalidRequest, "requested more than the max blob sidecar limit", stream)
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.ErrorContains(t, "requested more than the max blob sidecar limit", r.blobSidecarsByRootRPCHandler(context.Background(), &req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestService_requestMissingBlobs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 1
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	p1.Peers().SetChainState(p2.PeerID(), &ethpb.Status{FinalizedEpoch: 1})
+	d := db.SetupDB(t)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	b := util.NewBeaconBlockDeneb()
+	b.Block.Slot = slotsPerEpoch + 1
+	b.Block.Body.BlobKzgCommitments = [][]byte{bytes.Repeat([]byte{'a'}, 48), bytes.Repeat([]byte{'b'}, 48)}
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	header, err := wsb.Header()
+	require.NoError(t, err)
+	scs := make([]*ethpb.BlobSidecar, 2)
+	for i := range scs {
+		scs[i] = util.HydrateBlobSidecar(&ethpb.BlobSidecar{
+			Index:             uint64(i),
+			KzgCommitment:     b.Block.Body.BlobKzgCommitments[i],
+			SignedBlockHeader: header,
+		})
+	}
+	// Only the second sidecar is missing.
+	require.NoError(t, d.SaveBlobSidecar(ctx, scs[:1]))
+
+	genesis := time.Now().Add(-time.Duration(uint64(slotsPerEpoch)*2*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	clock := startup.NewClock(genesis, [32]byte{})
+	chain := &mock.ChainService{
+		DB:                  d,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+		ValidatorsRoot:      [32]byte{},
+		Genesis:             genesis,
+	}
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: chain, clock: clock, kzgVerifier: &mockKZGVerifier{}}}
+
+	pcl := protocol.ID(p2p.RPCBlobSidecarsByRootTopicV1 + p1.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		req := new(p2pTypes.BlobSidecarsByRootReq)
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		require.Equal(t, 1, len(*req))
+		assert.DeepEqual(t, root[:], (*req)[0].BlockRoot)
+		assert.Equal(t, uint64(1), (*req)[0].Index)
+		assert.NoError(t, WriteBlobSidecarChunk(stream, clock, p2.Encoding(), scs[1]))
+		assert.NoError(t, stream.Close())
+	})
+
+	require.NoError(t, r.requestMissingBlobs(ctx, wsb, root))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+	require.Equal(t, 1, len(chain.BlobsReceived))
+	stored, err := d.BlobSidecarsByRoot(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stored))
+
+	// Nothing is requested once every sidecar is stored.
+	require.NoError(t, r.requestMissingBlobs(ctx, wsb, root))
+	require.Equal(t, 1, len(chain.BlobsReceived))
+}
@@ -0,0 +1,160 @@
+package sync
+
+import (
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pkg/errors"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// lightClientBootstrapRPCHandler responds with the light client bootstrap of the requested block root.
+// Bootstraps are only derived for finalized checkpoint blocks, other roots are answered with a resource
+// unavailable error.
+func (s *Service) lightClientBootstrapRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.LightClientBootstrapHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_bootstrap")
+
+	root, ok := msg.(*types.LightClientBootstrapReq)
+	if !ok {
+		return errors.New("message is not type LightClientBootstrapReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	bootstrap, err := s.cfg.beaconDB.LightClientBootstrap(ctx, *root)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch light client bootstrap")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	if bootstrap == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return nil
+	}
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if err := WriteLightClientChunk(stream, s.cfg.clock, s.cfg.p2p.Encoding(), bootstrap.Header.Beacon.Slot, bootstrap); err != nil {
+		log.WithError(err).Debug("Could not send a chunked response")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientUpdatesByRangeRPCHandler responds with the best light client updates of consecutive sync committee
+// periods, starting at the requested start period. The response stops at the first period without an update.
+func (s *Service) lightClientUpdatesByRangeRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.LightClientUpdatesByRangeHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_updates_by_range")
+
+	m, ok := msg.(*ethpb.LightClientUpdatesByRangeRequest)
+	if !ok {
+		return errors.New("message is not type *pb.LightClientUpdatesByRangeRequest")
+	}
+	count := m.Count
+	if count > lightclient.MaxRequestLightClientUpdates {
+		count = lightclient.MaxRequestLightClientUpdates
+	}
+	if err := s.rateLimiter.validateRequest(stream, count); err != nil {
+		return err
+	}
+	if count == 0 {
+		s.rateLimiter.add(stream, 1)
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, types.ErrInvalidRequest.Error(), stream)
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		return types.ErrInvalidRequest
+	}
+	s.rateLimiter.add(stream, int64(count))
+
+	updates, err := s.cfg.beaconDB.LightClientUpdates(ctx, m.StartPeriod, count)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch light client updates")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	for _, u := range updates {
+		SetStreamWriteDeadline(stream, defaultWriteDuration)
+		if err := WriteLightClientChunk(stream, s.cfg.clock, s.cfg.p2p.Encoding(), u.AttestedHeader.Beacon.Slot, u); err != nil {
+			log.WithError(err).Debug("Could not send a chunked response")
+			s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientFinalityUpdateRPCHandler responds with the latest light client finality update known to the node.
+func (s *Service) lightClientFinalityUpdateRPCHandler(ctx context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	_, span := trace.StartSpan(ctx, "sync.LightClientFinalityUpdateHandler")
+	defer span.End()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_finality_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientFinalityUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return nil
+	}
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if err := WriteLightClientChunk(stream, s.cfg.clock, s.cfg.p2p.Encoding(), update.AttestedHeader.Beacon.Slot, update); err != nil {
+		log.WithError(err).Debug("Could not send a chunked response")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientOptimisticUpdateRPCHandler responds with the latest light client optimistic update known to the node.
+func (s *Service) lightClientOptimisticUpdateRPCHandler(ctx context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	_, span := trace.StartSpan(ctx, "sync.LightClientOptimisticUpdateHandler")
+	defer span.End()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_optimistic_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientOptimisticUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return nil
+	}
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if err := WriteLightClientChunk(stream, s.cfg.clock, s.cfg.p2p.Encoding(), update.AttestedHeader.Beacon.Slot, update); err != nil {
+		log.WithError(err).Debug("Could not send a chunked response")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
@@ -0,0 +1,189 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	ssz "github.com/prysmaticlabs/fastssz"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	db "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	p2pTypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+func TestLightClientBootstrapRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+
+	bootstrap := util.HydrateLightClientBootstrap(&ethpb.LightClientBootstrap{
+		Header: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 64}},
+	})
+	root := [32]byte{'a'}
+	require.NoError(t, d.SaveLightClientBootstrap(context.Background(), root, bootstrap))
+
+	clock := startup.NewClock(time.Now(), [32]byte{})
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, clock: clock}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCLightClientBootstrapTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		got := &ethpb.LightClientBootstrap{}
+		readLightClientChunk(t, stream, p2, bootstrap.Header.Beacon.Slot, got)
+		assert.DeepSSZEqual(t, bootstrap, got)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2pTypes.LightClientBootstrapReq(root)
+	require.NoError(t, r.lightClientBootstrapRPCHandler(context.Background(), &req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	// An unknown root is answered with a resource unavailable error.
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2pTypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err = p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req = p2pTypes.LightClientBootstrapReq{'b'}
+	require.NoError(t, r.lightClientBootstrapRPCHandler(context.Background(), &req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+
+	slotsPerPeriod := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod))
+	var want []*ethpb.LightClientUpdate
+	for period := uint64(1); period <= 3; period++ {
+		u := util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+			AttestedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: slotsPerPeriod.Mul(period)}},
+		})
+		require.NoError(t, d.SaveLightClientUpdate(context.Background(), period, u))
+		want = append(want, u)
+	}
+
+	clock := startup.NewClock(time.Now(), [32]byte{})
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, clock: clock}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		// The response stops at the first period without an update.
+		for _, u := range want[1:] {
+			got := &ethpb.LightClientUpdate{}
+			readLightClientChunk(t, stream, p2, u.AttestedHeader.Beacon.Slot, got)
+			assert.DeepSSZEqual(t, u, got)
+		}
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 2, Count: 10}
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	// A request for no update is invalid.
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeInvalidRequest, p2pTypes.ErrInvalidRequest.Error(), stream)
+	})
+	stream, err = p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req = &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 1}
+	require.ErrorIs(t, r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream), p2pTypes.ErrInvalidRequest)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientFinalityUpdateRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	clock := startup.NewClock(time.Now(), [32]byte{})
+	chain := &mock.ChainService{}
+	r := &Service{cfg: &config{p2p: p1, chain: chain, clock: clock}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCLightClientFinalityUpdateTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, time.Second, false)
+
+	// No update was derived yet.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2pTypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientFinalityUpdateRPCHandler(context.Background(), nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	chain.LCFinalityUpdate = lightclient.FinalityUpdateFromUpdate(util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+		AttestedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 70}},
+		SignatureSlot:  71,
+	}))
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		got := &ethpb.LightClientFinalityUpdate{}
+		readLightClientChunk(t, stream, p2, 70, got)
+		assert.DeepSSZEqual(t, chain.LCFinalityUpdate, got)
+	})
+	stream, err = p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientFinalityUpdateRPCHandler(context.Background(), nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+// readLightClientChunk reads a successful response chunk, and checks its context bytes against the fork digest of the
+// epoch of the given slot.
+func readLightClientChunk(t *testing.T, stream network.Stream, p *p2ptest.TestP2P, slot primitives.Slot, to ssz.Unmarshaler) {
+	code, errMsg, err := ReadStatusCode(stream, p.Encoding())
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), code, errMsg)
+	digest, err := readContextFromStream(stream)
+	require.NoError(t, err)
+	want, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), make([]byte, 32))
+	require.NoError(t, err)
+	require.DeepEqual(t, want[:], digest)
+	require.NoError(t, p.Encoding().DecodeWithMaxLength(stream, to))
+}
@@ -9,9 +9,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 )
@@ -129,3 +131,104 @@ func SendBeaconBlocksByRootRequest(
 	}
 	return blocks, nil
 }
+
+// SendBlobSidecarsByRangeRequest sends BlobSidecarsByRange and returns fetched blob sidecars, if any.
+func SendBlobSidecarsByRangeRequest(
+	ctx context.Context, tor blockchain.TemporalOracle, p2pProvider p2p.SenderEncoder, pid peer.ID,
+	req *pb.BlobSidecarsByRangeRequest,
+) ([]*pb.BlobSidecar, error) {
+	topic, err := p2p.TopicFromMessage(p2p.BlobSidecarsByRangeName, slots.ToEpoch(tor.CurrentSlot()))
+	if err != nil {
+		return nil, err
+	}
+	stream, err := p2pProvider.Send(ctx, req, topic, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream(stream, log)
+
+	maxSidecars := req.Count * fieldparams.MaxBlobsPerBlock
+	if maxSidecars > params.BeaconConfig().MaxRequestBlobSidecars {
+		maxSidecars = params.BeaconConfig().MaxRequestBlobSidecars
+	}
+	sidecars := make([]*pb.BlobSidecar, 0)
+	var prev *pb.BlobSidecar
+	for i := uint64(0); ; i++ {
+		sc, err := ReadChunkedBlobSidecar(stream, tor, p2pProvider, i == 0)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The response MUST contain no more than `count * MAX_BLOBS_PER_BLOCK` blob sidecars.
+		if i >= maxSidecars {
+			return nil, ErrInvalidFetchedData
+		}
+		// Returned sidecars MUST be in the slot range [start_slot, start_slot + count).
+		slot := sc.SignedBlockHeader.Header.Slot
+		if slot < req.StartSlot || slot >= req.StartSlot.Add(req.Count) {
+			return nil, ErrInvalidFetchedData
+		}
+		// Returned sidecars MUST be sent in consecutive (slot, index) order.
+		if prev != nil {
+			prevSlot := prev.SignedBlockHeader.Header.Slot
+			if slot < prevSlot || (slot == prevSlot && sc.Index <= prev.Index) {
+				return nil, ErrInvalidFetchedData
+			}
+		}
+		prev = sc
+		sidecars = append(sidecars, sc)
+	}
+	return sidecars, nil
+}
+
+// SendBlobSidecarsByRootRequest sends BlobSidecarsByRoot and returns fetched blob sidecars, if any.
+// Sidecars which were not requested are rejected.
+func SendBlobSidecarsByRootRequest(
+	ctx context.Context, clock blockchain.TemporalOracle, p2pProvider p2p.P2P, pid peer.ID,
+	req *p2ptypes.BlobSidecarsByRootReq,
+) ([]*pb.BlobSidecar, error) {
+	topic, err := p2p.TopicFromMessage(p2p.BlobSidecarsByRootName, slots.ToEpoch(clock.CurrentSlot()))
+	if err != nil {
+		return nil, err
+	}
+	stream, err := p2pProvider.Send(ctx, req, topic, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream(stream, log)
+
+	requested := make(map[[32]byte]map[uint64]bool, len(*req))
+	for _, id := range *req {
+		root := bytesutil.ToBytes32(id.BlockRoot)
+		if requested[root] == nil {
+			requested[root] = make(map[uint64]bool)
+		}
+		requested[root][id.Index] = true
+	}
+	sidecars := make([]*pb.BlobSidecar, 0, len(*req))
+	for i := 0; i < len(*req); i++ {
+		// Exit if peer sends more than max request blob sidecars.
+		if uint64(i) >= params.BeaconConfig().MaxRequestBlobSidecars {
+			break
+		}
+		sc, err := ReadChunkedBlobSidecar(stream, clock, p2pProvider, i == 0)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		root, err := sc.SignedBlockHeader.Header.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		if !requested[root][sc.Index] {
+			return nil, ErrInvalidFetchedData
+		}
+		delete(requested[root], sc.Index)
+		sidecars = append(sidecars, sc)
+	}
+	return sidecars, nil
+}
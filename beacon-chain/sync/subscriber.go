@@ -122,6 +122,20 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 				digest,
 			)
 		}
+		if features.Get().EnableLightClient {
+			s.subscribe(
+				p2p.LightClientFinalityUpdateTopicFormat,
+				s.validateLightClientFinalityUpdate,
+				s.lightClientFinalityUpdateSubscriber,
+				digest,
+			)
+			s.subscribe(
+				p2p.LightClientOptimisticUpdateTopicFormat,
+				s.validateLightClientOptimisticUpdate,
+				s.lightClientOptimisticUpdateSubscriber,
+				digest,
+			)
+		}
 	}
 
 	// New Gossip Topic in Capella
@@ -133,6 +147,17 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 			digest,
 		)
 	}
+
+	// New Gossip Topic in Deneb
+	if epoch >= params.BeaconConfig().DenebForkEpoch {
+		for i := uint64(0); i < params.BeaconConfig().BlobsidecarSubnetCount; i++ {
+			s.subscribeWithBase(
+				s.addDigestAndIndexToTopic(p2p.BlobSubnetTopicFormat, digest, i),
+				s.validateBlob,
+				s.blobSubscriber,
+			)
+		}
+	}
 }
 
 // subscribe to a given topic with a given validator and subscription handler.
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition/interop"
@@ -9,6 +10,10 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// missingBlobsRequestDelay is how long the blob sidecars of a gossiped block are awaited on gossip,
+// before being requested from peers.
+const missingBlobsRequestDelay = 2 * time.Second
+
 func (s *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message) error {
 	signed, err := blocks.NewSignedBeaconBlock(msg)
 	if err != nil {
@@ -27,6 +32,21 @@ func (s *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 		return err
 	}
 
+	// The blobs of the block are expected on gossip. Those which have not arrived after a delay are requested
+	// from peers, until the block is processed.
+	blobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-time.After(missingBlobsRequestDelay):
+		case <-blobCtx.Done():
+			return
+		}
+		if err := s.requestMissingBlobs(blobCtx, signed, root); err != nil {
+			log.WithError(err).WithField("slot", block.Slot()).Debug("Could not retrieve missing blobs of block")
+		}
+	}()
+
 	if err := s.cfg.chain.ReceiveBlock(ctx, signed, root); err != nil {
 		if blockchain.IsInvalidBlock(err) {
 			r := blockchain.InvalidBlockRoot(err)
@@ -0,0 +1,17 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Service) blobSubscriber(ctx context.Context, msg proto.Message) error {
+	b, ok := msg.(*ethpb.BlobSidecar)
+	if !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpb.BlobSidecar{}, msg)
+	}
+	return s.cfg.chain.ReceiveBlob(ctx, b)
+}
@@ -0,0 +1,26 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Light client updates are computed locally from imported blocks, and a gossiped update is only accepted
+// if it matches the local one. There is nothing left to process once it was validated.
+
+func (s *Service) lightClientFinalityUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*ethpb.LightClientFinalityUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpb.LightClientFinalityUpdate{}, msg)
+	}
+	return nil
+}
+
+func (s *Service) lightClientOptimisticUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*ethpb.LightClientOptimisticUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpb.LightClientOptimisticUpdate{}, msg)
+	}
+	return nil
+}
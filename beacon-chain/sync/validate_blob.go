@@ -0,0 +1,212 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// validateBlob validates a blob sidecar received on the blob_sidecar_{subnet_id} topic, following
+// the gossip conditions of the Deneb p2p specification.
+func (s *Service) validateBlob(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	receivedTime := prysmTime.Now()
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We should not attempt to process blobs until fully synced, but propagation is OK.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateBlob")
+	defer span.End()
+
+	if msg.Topic == nil {
+		return pubsub.ValidationReject, errInvalidTopic
+	}
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, errors.Wrap(err, "could not decode message")
+	}
+	sc, ok := m.(*ethpb.BlobSidecar)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if sc.SignedBlockHeader == nil || sc.SignedBlockHeader.Header == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	header := sc.SignedBlockHeader.Header
+
+	// [REJECT] The sidecar's index is consistent with MAX_BLOBS_PER_BLOCK -- i.e. blob_sidecar.index < MAX_BLOBS_PER_BLOCK.
+	if sc.Index >= fieldparams.MaxBlobsPerBlock {
+		return pubsub.ValidationReject, errors.Errorf("blob index %d exceeds max blobs per block", sc.Index)
+	}
+
+	// [REJECT] The sidecar is for the correct subnet -- i.e. compute_subnet_for_blob_sidecar(blob_sidecar.index) == subnet_id.
+	digest, err := p2p.ExtractGossipDigest(*msg.Topic)
+	if err != nil {
+		return pubsub.ValidationReject, errors.Wrap(err, "could not extract fork digest from topic")
+	}
+	format := p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.BlobSidecar{})]
+	if !strings.HasPrefix(*msg.Topic, fmt.Sprintf(format, digest, computeSubnetForBlobSidecar(sc.Index))) {
+		return pubsub.ValidationReject, errors.New("blob sidecar received on the wrong subnet")
+	}
+
+	// [IGNORE] The sidecar is not from a future slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+	genesisTime := uint64(s.cfg.clock.GenesisTime().Unix())
+	if err := slots.VerifyTime(genesisTime, header.Slot, params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+		log.WithError(err).WithFields(blobFields(sc)).Debug("Ignored blob: could not verify slot time")
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The sidecar is from a slot greater than the latest finalized slot.
+	startSlot, err := slots.EpochStart(s.cfg.chain.FinalizedCheckpt().Epoch)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	if header.Slot <= startSlot {
+		log.WithFields(blobFields(sc)).Debug("Ignored blob: slot is not greater than the finalized slot")
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The sidecar is the first sidecar for the tuple (block_header.slot, block_header.proposer_index, blob_sidecar.index)
+	// with valid header signature, sidecar inclusion proof, and kzg proof.
+	if s.hasSeenBlobIndex(header.Slot, header.ProposerIndex, sc.Index) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [REJECT] The sidecar's block's parent (defined by block_header.parent_root) passes validation.
+	parentRoot := bytesutil.ToBytes32(header.ParentRoot)
+	if s.hasBadBlock(parentRoot) {
+		return pubsub.ValidationReject, errors.Errorf("bad parent root %#x", parentRoot)
+	}
+
+	// [IGNORE] The sidecar's block's parent (defined by block_header.parent_root) has been seen.
+	if !s.cfg.chain.HasBlock(ctx, parentRoot) {
+		log.WithFields(blobFields(sc)).Debug("Ignored blob: parent block not found")
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [REJECT] The current finalized_checkpoint is an ancestor of the sidecar's block.
+	if !s.cfg.chain.InForkchoice(parentRoot) {
+		return pubsub.ValidationReject, blockchain.ErrNotDescendantOfFinalized
+	}
+
+	// [REJECT] The sidecar's inclusion proof is valid as verified by verify_blob_sidecar_inclusion_proof(blob_sidecar).
+	if err := consensusblocks.VerifyKZGInclusionProof(sc); err != nil {
+		return pubsub.ValidationReject, err
+	}
+
+	// [REJECT] The sidecar's blob is valid as verified by
+	// verify_blob_kzg_proof(blob_sidecar.blob, blob_sidecar.kzg_commitment, blob_sidecar.kzg_proof).
+	if err := s.cfg.kzgVerifier.VerifyBlobKZGProof(sc.Blob, sc.KzgCommitment, sc.KzgProof); err != nil {
+		return pubsub.ValidationReject, errors.Wrap(err, "could not verify blob KZG proof")
+	}
+
+	parentState, err := s.cfg.stateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	// [REJECT] The sidecar is from a higher slot than the sidecar's block's parent.
+	if header.Slot <= parentState.Slot() {
+		return pubsub.ValidationReject, errors.Errorf("blob slot %d is not greater than parent slot %d", header.Slot, parentState.Slot())
+	}
+	parentState, err = transition.ProcessSlotsUsingNextSlotCache(ctx, parentState, parentRoot[:], header.Slot)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+
+	// [REJECT] The sidecar is proposed by the expected proposer_index for the block's slot in the context
+	// of the current shuffling (defined by block_header.parent_root/block_header.slot).
+	idx, err := helpers.BeaconProposerIndex(ctx, parentState)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	if header.ProposerIndex != idx {
+		return pubsub.ValidationReject, errors.Errorf("expected proposer index %d, got %d", idx, header.ProposerIndex)
+	}
+
+	// [REJECT] The proposer signature of blob_sidecar.signed_block_header is valid with respect to the
+	// block_header.proposer_index pubkey.
+	if err := blocks.VerifyBlockHeaderSignature(parentState, sc.SignedBlockHeader); err != nil {
+		return pubsub.ValidationReject, err
+	}
+
+	s.setSeenBlobIndex(header.Slot, header.ProposerIndex, sc.Index)
+	msg.ValidatorData = sc // Used in downstream subscriber
+
+	startTime, err := slots.ToTime(genesisTime, header.Slot)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	fields := blobFields(sc)
+	fields["sinceSlotStartTime"] = receivedTime.Sub(startTime)
+	fields["validationTime"] = prysmTime.Now().Sub(receivedTime)
+	log.WithFields(fields).Debug("Received blob sidecar")
+
+	return pubsub.ValidationAccept, nil
+}
+
+// Returns true if the blob with the same slot, proposer index and blob index has been seen before.
+func (s *Service) hasSeenBlobIndex(slot primitives.Slot, proposerIdx primitives.ValidatorIndex, index uint64) bool {
+	s.seenBlobLock.RLock()
+	defer s.seenBlobLock.RUnlock()
+	_, seen := s.seenBlobCache.Get(seenBlobKey(slot, proposerIdx, index))
+	return seen
+}
+
+// Set blob slot, proposer index and blob index as seen for incoming blobs.
+func (s *Service) setSeenBlobIndex(slot primitives.Slot, proposerIdx primitives.ValidatorIndex, index uint64) {
+	s.seenBlobLock.Lock()
+	defer s.seenBlobLock.Unlock()
+	s.seenBlobCache.Add(seenBlobKey(slot, proposerIdx, index), true)
+}
+
+func seenBlobKey(slot primitives.Slot, proposerIdx primitives.ValidatorIndex, index uint64) string {
+	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(proposerIdx))...)
+	return string(append(b, bytesutil.Bytes32(index)...))
+}
+
+// computeSubnetForBlobSidecar returns the subnet on which the blob sidecar of the given index is gossiped.
+//
+// Spec code:
+// def compute_subnet_for_blob_sidecar(blob_index: BlobIndex) -> SubnetID:
+//
+//	return SubnetID(blob_index % BLOB_SIDECAR_SUBNET_COUNT)
+func computeSubnetForBlobSidecar(index uint64) uint64 {
+	return index % params.BeaconConfig().BlobsidecarSubnetCount
+}
+
+func blobFields(sc *ethpb.BlobSidecar) logrus.Fields {
+	return logrus.Fields{
+		"slot":          sc.SignedBlockHeader.Header.Slot,
+		"proposerIndex": sc.SignedBlockHeader.Header.ProposerIndex,
+		"index":         sc.Index,
+		"parentRoot":    fmt.Sprintf("%#x", sc.SignedBlockHeader.Header.ParentRoot),
+	}
+}
@@ -0,0 +1,175 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func setupBlobService(t *testing.T, genesis time.Time, syncing bool) (*Service, *p2ptest.TestP2P) {
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{
+		cfg: &config{
+			p2p: p,
+			chain: &mock.ChainService{
+				Genesis:             genesis,
+				FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			clock:       startup.NewClock(genesis, [32]byte{}),
+			initialSync: &mockSync.Sync{IsSyncing: syncing},
+		},
+		seenBlobCache: lruwrpr.New(10),
+		badBlockCache: lruwrpr.New(10),
+	}
+	return s, p
+}
+
+func blobMessage(t *testing.T, s *Service, p *p2ptest.TestP2P, sc *ethpb.BlobSidecar, subnet uint64) *pubsub.Message {
+	buf := new(bytes.Buffer)
+	_, err := p.Encoding().EncodeGossip(buf, sc)
+	require.NoError(t, err)
+	digest, err := s.currentForkDigest()
+	require.NoError(t, err)
+	topic := s.addDigestAndIndexToTopic(p2p.BlobSubnetTopicFormat, digest, subnet) + p.Encoding().ProtocolSuffix()
+	return &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+}
+
+func TestValidateBlob_Syncing(t *testing.T) {
+	s, p := setupBlobService(t, time.Now(), true)
+	sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{})
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 0))
+	require.NoError(t, err)
+	require.Equal(t, pubsub.ValidationIgnore, res)
+}
+
+func TestValidateBlob_InvalidIndex(t *testing.T) {
+	s, p := setupBlobService(t, time.Now(), false)
+	sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 6})
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 0))
+	require.ErrorContains(t, "exceeds max blobs per block", err)
+	require.Equal(t, pubsub.ValidationReject, res)
+}
+
+func TestValidateBlob_WrongSubnet(t *testing.T) {
+	s, p := setupBlobService(t, time.Now(), false)
+	sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 1})
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 2))
+	require.ErrorContains(t, "wrong subnet", err)
+	require.Equal(t, pubsub.ValidationReject, res)
+}
+
+func TestValidateBlob_FutureSlot(t *testing.T) {
+	s, p := setupBlobService(t, time.Now(), false)
+	sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 1})
+	sc.SignedBlockHeader.Header.Slot = 1000
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 1))
+	require.NoError(t, err)
+	require.Equal(t, pubsub.ValidationIgnore, res)
+}
+
+func TestValidateBlob_AlreadySeen(t *testing.T) {
+	genesis := time.Now().Add(-time.Duration(10*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s, p := setupBlobService(t, genesis, false)
+	sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 1})
+	sc.SignedBlockHeader.Header.Slot = 5
+	s.setSeenBlobIndex(5, sc.SignedBlockHeader.Header.ProposerIndex, 1)
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 1))
+	require.NoError(t, err)
+	require.Equal(t, pubsub.ValidationIgnore, res)
+}
+
+func TestValidateBlob_BadParent(t *testing.T) {
+	genesis := time.Now().Add(-time.Duration(10*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s, p := setupBlobService(t, genesis, false)
+	sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 1})
+	sc.SignedBlockHeader.Header.Slot = 5
+	sc.SignedBlockHeader.Header.ParentRoot = bytes.Repeat([]byte{'a'}, 32)
+	s.setBadBlock(context.Background(), [32]byte(sc.SignedBlockHeader.Header.ParentRoot))
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 1))
+	require.ErrorContains(t, "bad parent root", err)
+	require.Equal(t, pubsub.ValidationReject, res)
+}
+
+type mockKZGVerifier struct {
+	err error
+}
+
+func (m *mockKZGVerifier) VerifyBlobKZGProof(_, _, _ []byte) error {
+	return m.err
+}
+
+func (m *mockKZGVerifier) VerifyBlobKZGProofBatch(_, _, _ [][]byte) error {
+	return m.err
+}
+
+// sidecarWithInclusionProof returns the first blob sidecar of a Deneb block at the given slot and with the given
+// parent root, along with a valid inclusion proof of its commitment.
+func sidecarWithInclusionProof(t *testing.T, slot primitives.Slot, parentRoot [32]byte) *ethpb.BlobSidecar {
+	b := util.NewBeaconBlockDeneb()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = parentRoot[:]
+	b.Block.Body.BlobKzgCommitments = [][]byte{bytes.Repeat([]byte{'c'}, 48)}
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	header, err := wsb.Header()
+	require.NoError(t, err)
+	proof, err := blocks.MerkleProofKZGCommitment(wsb.Block().Body(), 0)
+	require.NoError(t, err)
+	return util.HydrateBlobSidecar(&ethpb.BlobSidecar{
+		KzgCommitment:            b.Block.Body.BlobKzgCommitments[0],
+		SignedBlockHeader:        header,
+		CommitmentInclusionProof: proof,
+	})
+}
+
+func TestValidateBlob_InvalidInclusionProof(t *testing.T) {
+	genesis := time.Now().Add(-time.Duration(10*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s, p := setupBlobService(t, genesis, false)
+	parentRoot := [32]byte{'p'}
+	s.cfg.chain.(*mock.ChainService).DB = dbtest.SetupDB(t)
+	s.cfg.chain.(*mock.ChainService).InitSyncBlockRoots = map[[32]byte]bool{parentRoot: true}
+	s.cfg.kzgVerifier = &mockKZGVerifier{}
+
+	sc := sidecarWithInclusionProof(t, 5, parentRoot)
+	sc.KzgCommitment = bytes.Repeat([]byte{'d'}, 48)
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 0))
+	require.ErrorContains(t, "invalid KZG commitment inclusion proof", err)
+	require.Equal(t, pubsub.ValidationReject, res)
+}
+
+func TestValidateBlob_InvalidKZGProof(t *testing.T) {
+	genesis := time.Now().Add(-time.Duration(10*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s, p := setupBlobService(t, genesis, false)
+	parentRoot := [32]byte{'p'}
+	s.cfg.chain.(*mock.ChainService).DB = dbtest.SetupDB(t)
+	s.cfg.chain.(*mock.ChainService).InitSyncBlockRoots = map[[32]byte]bool{parentRoot: true}
+	s.cfg.kzgVerifier = &mockKZGVerifier{err: errors.New("bad proof")}
+
+	sc := sidecarWithInclusionProof(t, 5, parentRoot)
+	res, err := s.validateBlob(context.Background(), "", blobMessage(t, s, p, sc, 0))
+	require.ErrorContains(t, "could not verify blob KZG proof", err)
+	require.Equal(t, pubsub.ValidationReject, res)
+}
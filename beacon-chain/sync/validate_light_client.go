@@ -0,0 +1,126 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate validates a light client finality update received on the
+// light_client_finality_update topic, following the gossip conditions of the altair light client p2p specification.
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.AttestedHeader.Beacon == nil ||
+		update.FinalizedHeader == nil || update.FinalizedHeader.Beacon == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+
+	s.seenLightClientUpdateLock.Lock()
+	defer s.seenLightClientUpdateLock.Unlock()
+
+	// [IGNORE] The finalized_header.beacon.slot is greater than that of all previously forwarded finality_updates,
+	// or it matches the highest previously forwarded slot and also has a sync_aggregate indicating supermajority (> 2/3)
+	// sync committee participation while the previously forwarded finality_update for that slot did not indicate it.
+	if !lightclient.IsNewerFinalityUpdate(update, s.seenLightClientFinalityUpdate) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The finality_update is received after the block at signature_slot was given enough time to propagate
+	// through the network.
+	if !s.isLightClientUpdatePropagated(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The received finality_update matches the locally computed one exactly.
+	if !proto.Equal(update, s.cfg.chain.LightClientFinalityUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	s.seenLightClientFinalityUpdate = update
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate validates a light client optimistic update received on the
+// light_client_optimistic_update topic, following the gossip conditions of the altair light client p2p specification.
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.AttestedHeader.Beacon == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+
+	s.seenLightClientUpdateLock.Lock()
+	defer s.seenLightClientUpdateLock.Unlock()
+
+	// [IGNORE] The attested_header.beacon.slot is greater than that of all previously forwarded optimistic_updates.
+	if !lightclient.IsNewerOptimisticUpdate(update, s.seenLightClientOptimisticUpdate) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The optimistic_update is received after the block at signature_slot was given enough time to propagate
+	// through the network.
+	if !s.isLightClientUpdatePropagated(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	// [IGNORE] The received optimistic_update matches the locally computed one exactly.
+	if !proto.Equal(update, s.cfg.chain.LightClientOptimisticUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	s.seenLightClientOptimisticUpdate = update
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// isLightClientUpdatePropagated returns true if a third of the signature slot has elapsed, with a
+// MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance.
+func (s *Service) isLightClientUpdatePropagated(signatureSlot primitives.Slot) bool {
+	start, err := slots.ToTime(uint64(s.cfg.clock.GenesisTime().Unix()), signatureSlot)
+	if err != nil {
+		return false
+	}
+	due := start.Add(time.Duration(params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot) * time.Second)
+	return !prysmTime.Now().Before(due.Add(-params.BeaconNetworkConfig().MaximumGossipClockDisparity))
+}
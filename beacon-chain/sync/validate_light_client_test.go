@@ -0,0 +1,169 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	ssz "github.com/prysmaticlabs/fastssz"
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	mockp2p "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestService_ValidateLightClientFinalityUpdate(t *testing.T) {
+	local := lightclient.FinalityUpdateFromUpdate(util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+		AttestedHeader:  &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 70}},
+		FinalizedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 32}},
+		SignatureSlot:   71,
+	}))
+	other := proto.Clone(local).(*ethpb.LightClientFinalityUpdate)
+	other.AttestedHeader.Beacon.ProposerIndex = 1
+	older := proto.Clone(local).(*ethpb.LightClientFinalityUpdate)
+	older.FinalizedHeader.Beacon.Slot = 0
+
+	tests := []struct {
+		name     string
+		update   *ethpb.LightClientFinalityUpdate
+		seen     *ethpb.LightClientFinalityUpdate
+		genesis  time.Time
+		want     pubsub.ValidationResult
+		wantSeen bool
+	}{
+		{
+			name:     "matches the local update",
+			update:   local,
+			genesis:  time.Now().Add(-time.Hour),
+			want:     pubsub.ValidationAccept,
+			wantSeen: true,
+		},
+		{
+			name:    "does not match the local update",
+			update:  other,
+			genesis: time.Now().Add(-time.Hour),
+			want:    pubsub.ValidationIgnore,
+		},
+		{
+			name:    "not newer than the forwarded update",
+			update:  local,
+			seen:    local,
+			genesis: time.Now().Add(-time.Hour),
+			want:    pubsub.ValidationIgnore,
+		},
+		{
+			name:     "newer than the forwarded update",
+			update:   local,
+			seen:     older,
+			genesis:  time.Now().Add(-time.Hour),
+			want:     pubsub.ValidationAccept,
+			wantSeen: true,
+		},
+		{
+			name:    "received too early in the signature slot",
+			update:  local,
+			genesis: time.Now().Add(-time.Duration(uint64(local.SignatureSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second),
+			want:    pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := mockp2p.NewTestP2P(t)
+			s := &Service{cfg: &config{
+				p2p:   p,
+				chain: &mockChain.ChainService{LCFinalityUpdate: local},
+				clock: startup.NewClock(tt.genesis, [32]byte{}),
+			}}
+			s.seenLightClientFinalityUpdate = tt.seen
+			msg := lightClientPubsubMessage(t, p, p2p.LightClientFinalityUpdateTopicFormat, tt.update)
+
+			got, err := s.validateLightClientFinalityUpdate(context.Background(), "foobar", msg)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			if tt.wantSeen {
+				require.Equal(t, true, proto.Equal(tt.update, s.seenLightClientFinalityUpdate))
+			}
+		})
+	}
+}
+
+func TestService_ValidateLightClientOptimisticUpdate(t *testing.T) {
+	local := lightclient.OptimisticUpdateFromUpdate(util.HydrateLightClientUpdate(&ethpb.LightClientUpdate{
+		AttestedHeader: &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: 70}},
+		SignatureSlot:  71,
+	}))
+	newer := proto.Clone(local).(*ethpb.LightClientOptimisticUpdate)
+	newer.AttestedHeader.Beacon.Slot = 71
+
+	tests := []struct {
+		name   string
+		update *ethpb.LightClientOptimisticUpdate
+		seen   *ethpb.LightClientOptimisticUpdate
+		want   pubsub.ValidationResult
+	}{
+		{
+			name:   "matches the local update",
+			update: local,
+			want:   pubsub.ValidationAccept,
+		},
+		{
+			name:   "not newer than the forwarded update",
+			update: local,
+			seen:   newer,
+			want:   pubsub.ValidationIgnore,
+		},
+		{
+			name:   "does not match the local update",
+			update: newer,
+			want:   pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := mockp2p.NewTestP2P(t)
+			s := &Service{cfg: &config{
+				p2p:   p,
+				chain: &mockChain.ChainService{LCOptimisticUpdate: local},
+				clock: startup.NewClock(time.Now().Add(-time.Hour), [32]byte{}),
+			}}
+			s.seenLightClientOptimisticUpdate = tt.seen
+			msg := lightClientPubsubMessage(t, p, p2p.LightClientOptimisticUpdateTopicFormat, tt.update)
+
+			got, err := s.validateLightClientOptimisticUpdate(context.Background(), "foobar", msg)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_IsLightClientUpdatePropagated(t *testing.T) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	s := &Service{cfg: &config{clock: startup.NewClock(time.Now().Add(-10*slotDuration), [32]byte{})}}
+	require.Equal(t, true, s.isLightClientUpdatePropagated(primitives.Slot(9)))
+	require.Equal(t, false, s.isLightClientUpdatePropagated(primitives.Slot(10)))
+	require.Equal(t, false, s.isLightClientUpdatePropagated(primitives.Slot(11)))
+}
+
+func lightClientPubsubMessage(t *testing.T, p *mockp2p.TestP2P, format string, m ssz.Marshaler) *pubsub.Message {
+	buf := new(bytes.Buffer)
+	_, err := p.Encoding().EncodeGossip(buf, m)
+	require.NoError(t, err)
+	topic := fmt.Sprintf(format, []byte{0xAB, 0x00, 0xCC, 0x9E}) + p.Encoding().ProtocolSuffix()
+	return &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package pruner
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/pruner"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// EnableHistoryPruning enables the pruning of the block and state history older than the retention period.
+	EnableHistoryPruning = &cli.BoolFlag{
+		Name: "enable-history-pruning",
+		Usage: "Deletes the blocks, and the states not lying on an archived point, older than the retention period " +
+			"from the database. The history kept is MIN_EPOCHS_FOR_BLOCK_REQUESTS plus --history-retention-epochs " +
+			"epochs below the finalized epoch.",
+	}
+	// HistoryRetentionEpochs defines the number of epochs of history kept beyond MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name:  "history-retention-epochs",
+		Usage: "The number of epochs of history kept beyond MIN_EPOCHS_FOR_BLOCK_REQUESTS when history pruning is enabled.",
+	}
+)
+
+// FlagOptions for pruner service flag configurations.
+func FlagOptions(c *cli.Context) ([]pruner.ServiceOption, error) {
+	opts := []pruner.ServiceOption{
+		pruner.WithEnablePruning(c.Bool(EnableHistoryPruning.Name)),
+		pruner.WithRetentionEpochs(primitives.Epoch(c.Uint64(HistoryRetentionEpochs.Name))),
+	}
+	return opts, nil
+}
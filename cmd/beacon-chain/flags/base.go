@@ -3,17 +3,25 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v4/cmd"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/urfave/cli/v2"
 )
 
 var (
-	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
-	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
-		Value: "",
+	// MevRelayEndpoint provides HTTP access endpoints to a MEV builder network.
+	MevRelayEndpoint = &cli.StringSliceFlag{
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Multiple relays can be given, separated by commas or by repeating the flag, the highest bid of all relays is used",
+	}
+	// MevRelayGetHeaderTimeout defines the deadline for the MEV relays to answer a header request.
+	MevRelayGetHeaderTimeout = &cli.DurationFlag{
+		Name:  "mev-relay-get-header-timeout",
+		Usage: "The amount of time the MEV builder relays are given to answer a header request. Bids received after the deadline are dropped",
+		Value: 950 * time.Millisecond,
 	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
@@ -30,6 +38,11 @@ var (
 		Usage: "A percentage boost for local block construction. This is used to prioritize local block construction over relay/builder block construction" +
 			"Boost is an additional percentage to multiple local block value. Use builder block if: builder_bid_value * 100 > local_block_value * (local-block-value-boost + 100)",
 	}
+	// MinBuilderBid defines the minimum value of a builder bid, lower bids are dropped in favor of the local payload.
+	MinBuilderBid = &cli.StringFlag{
+		Name:  "min-builder-bid",
+		Usage: "The minimum value in wei of a relay/builder bid. Lower bids are dropped and the local execution payload is used instead. Can be overridden per validator by its proposer settings",
+	}
 	// ExecutionEngineEndpoint provides an HTTP access endpoint to connect to an execution client on the execution layer
 	ExecutionEngineEndpoint = &cli.StringFlag{
 		Name:  "execution-endpoint",
@@ -250,4 +263,48 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherRescanFlag enables the re-scan of the history of the beacon DB by the slasher.
+	SlasherRescanFlag = &cli.BoolFlag{
+		Name: "slasher-rescan",
+		Usage: "Replays the blocks stored in the beacon DB, and the attestations they include, into the slasher when it starts, " +
+			"so that slashable offenses of past epochs are detected. An interrupted re-scan resumes after the last re-scanned epoch.",
+	}
+	// SlasherRescanStartEpochFlag defines the first epoch re-scanned by the slasher.
+	SlasherRescanStartEpochFlag = &cli.Uint64Flag{
+		Name:  "slasher-rescan-start-epoch",
+		Usage: "First epoch re-scanned with --slasher-rescan. Defaults to the oldest epoch of the slasher history, " +
+			"an earlier epoch is raised to it.",
+	}
+	// SlasherRescanEndEpochFlag defines the last epoch re-scanned by the slasher.
+	SlasherRescanEndEpochFlag = &cli.Uint64Flag{
+		Name:  "slasher-rescan-end-epoch",
+		Usage: "Last epoch re-scanned with --slasher-rescan. Defaults to the current epoch.",
+	}
+	// ForkChoiceTraceFileFlag defines the file to which the inputs of fork choice are recorded.
+	ForkChoiceTraceFileFlag = &cli.StringFlag{
+		Name: "forkchoice-trace-file",
+		Usage: "Records the inputs of fork choice to the given file, so that they can be replayed with the forkchoice-replay tool. " +
+			"Recording computes the unrealized checkpoints of every inserted block and writes the justified balances, " +
+			"it is meant for debugging only.",
+	}
+	// ReorgHeadWeightThreshold defines the weight, in percent of a committee, under which a late head block may be reorged.
+	ReorgHeadWeightThreshold = &cli.Uint64Flag{
+		Name:  "reorg-head-weight-threshold",
+		Usage: "Percentage of the committee weight under which a late head block is considered weak and may be orphaned by our proposers. Defaults to the network config value",
+	}
+	// ReorgParentWeightThreshold defines the weight, in percent of a committee, above which the parent of a late head block may be built on.
+	ReorgParentWeightThreshold = &cli.Uint64Flag{
+		Name:  "reorg-parent-weight-threshold",
+		Usage: "Percentage of the committee weight above which the parent of a late head block is considered strong enough for our proposers to orphan the head. Defaults to the network config value",
+	}
+	// ReorgMaxEpochsSinceFinalization defines the number of epochs without finality after which late blocks are no longer reorged.
+	ReorgMaxEpochsSinceFinalization = &cli.Uint64Flag{
+		Name:  "reorg-max-epochs-since-finalization",
+		Usage: "Maximum number of epochs since the last finalized epoch for our proposers to orphan late blocks. Defaults to the network config value",
+	}
+	// ReorgMaxProposalOffset defines the number of seconds into the slot until which a proposer may orphan a late block.
+	ReorgMaxProposalOffset = &cli.Uint64Flag{
+		Name:  "reorg-max-proposal-offset",
+		Usage: "Number of seconds into the slot before which our proposers may orphan a late head block. Proposals made later build on the head",
+	}
 )
@@ -15,9 +15,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/cmd"
 	blockchaincmd "github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/blockchain"
 	dbcommands "github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
 	jwtcommands "github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/jwt"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/sync/checkpoint"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/sync/genesis"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
@@ -70,10 +72,16 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.MevRelayEndpoint,
+	flags.MevRelayGetHeaderTimeout,
 	flags.MaxBuilderEpochMissedSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
 	flags.EngineEndpointTimeoutSeconds,
 	flags.LocalBlockValueBoost,
+	flags.MinBuilderBid,
+	flags.ReorgHeadWeightThreshold,
+	flags.ReorgParentWeightThreshold,
+	flags.ReorgMaxEpochsSinceFinalization,
+	flags.ReorgMaxProposalOffset,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
@@ -129,7 +137,15 @@ var appFlags = []cli.Flag{
 	checkpoint.RemoteURL,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
+	backfill.EnableBackfill,
+	backfill.BackfillBatchSize,
+	pruner.EnableHistoryPruning,
+	pruner.HistoryRetentionEpochs,
 	flags.SlasherDirFlag,
+	flags.SlasherRescanFlag,
+	flags.SlasherRescanStartEpochFlag,
+	flags.SlasherRescanEndEpochFlag,
+	flags.ForkChoiceTraceFileFlag,
 }
 
 func init() {
@@ -266,10 +282,20 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	backfillFlagOpts, err := backfill.FlagOptions(ctx)
+	if err != nil {
+		return err
+	}
+	prunerFlagOpts, err := pruner.FlagOptions(ctx)
+	if err != nil {
+		return err
+	}
 	opts := []node.Option{
 		node.WithBlockchainFlagOptions(blockchainFlagOpts),
 		node.WithExecutionChainOptions(executionFlagOpts),
 		node.WithBuilderFlagOptions(builderFlagOpts),
+		node.WithBackfillFlagOptions(backfillFlagOpts),
+		node.WithPrunerFlagOptions(prunerFlagOpts),
 	}
 
 	optFuncs := []func(*cli.Context) (node.Option, error){
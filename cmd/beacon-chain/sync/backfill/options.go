@@ -0,0 +1,30 @@
+package backfill
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// EnableBackfill enables the backfill of the blocks between genesis and the checkpoint sync origin.
+	EnableBackfill = &cli.BoolFlag{
+		Name: "enable-backfill",
+		Usage: "Backfills the blocks between genesis and the origin of a node initialized via checkpoint sync, " +
+			"so that the node can serve the full history of the chain. Backfill starts once initial sync is complete.",
+	}
+	// BackfillBatchSize defines the number of slots requested from a peer in each backfill batch.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "The number of slots requested from a peer in each backfill batch.",
+		Value: 64,
+	}
+)
+
+// FlagOptions for backfill service flag configurations.
+func FlagOptions(c *cli.Context) ([]backfill.ServiceOption, error) {
+	opts := []backfill.ServiceOption{
+		backfill.WithEnableBackfill(c.Bool(EnableBackfill.Name)),
+		backfill.WithBatchSize(c.Uint64(BackfillBatchSize.Name)),
+	}
+	return opts, nil
+}
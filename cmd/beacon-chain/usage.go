@@ -6,7 +6,9 @@ import (
 	"sort"
 
 	"github.com/prysmaticlabs/prysm/v4/cmd"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/sync/checkpoint"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/sync/genesis"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
@@ -121,16 +123,30 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Eth1HeaderReqLimit,
 			flags.MinPeersPerSubnet,
 			flags.MevRelayEndpoint,
+			flags.MevRelayGetHeaderTimeout,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherRescanFlag,
+			flags.SlasherRescanStartEpochFlag,
+			flags.SlasherRescanEndEpochFlag,
+			flags.ForkChoiceTraceFileFlag,
 			flags.LocalBlockValueBoost,
+			flags.MinBuilderBid,
+			flags.ReorgHeadWeightThreshold,
+			flags.ReorgParentWeightThreshold,
+			flags.ReorgMaxEpochsSinceFinalization,
+			flags.ReorgMaxProposalOffset,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
+			backfill.EnableBackfill,
+			backfill.BackfillBatchSize,
+			pruner.EnableHistoryPruning,
+			pruner.HistoryRetentionEpochs,
 		},
 	},
 	{
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/pruner"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var pruneFlags = struct {
+	Path                  string
+	RetentionEpochs       uint64
+	SlotsPerArchivedPoint uint64
+}{}
+
+var pruneCmd = &cli.Command{
+	Name:  "prune",
+	Usage: "delete the block and state history older than the retention period from a beacon db, the beacon node must not be running",
+	Action: func(cliCtx *cli.Context) error {
+		if err := pruneAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not prune db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &pruneFlags.Path,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "retention-epochs",
+			Usage:       "number of epochs of history kept beyond MIN_EPOCHS_FOR_BLOCK_REQUESTS, below the finalized epoch",
+			Destination: &pruneFlags.RetentionEpochs,
+		},
+		&cli.Uint64Flag{
+			Name:        "slots-per-archive-point",
+			Usage:       "interval of the archived points whose states are kept, must match the one used by the beacon node",
+			Destination: &pruneFlags.SlotsPerArchivedPoint,
+			Value:       uint64(params.BeaconConfig().SlotsPerArchivedPoint),
+		},
+	},
+}
+
+func pruneAction(cliCtx *cli.Context) error {
+	flags := pruneFlags
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	db, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db at %s", flags.Path)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	cutoff := pruner.CutoffSlot(cp.Epoch, primitives.Epoch(flags.RetentionEpochs))
+	log.WithField("cutoffSlot", cutoff).Info("Pruning history")
+	n, err := db.PruneHistory(ctx, cutoff, primitives.Slot(flags.SlotsPerArchivedPoint))
+	if err != nil {
+		return err
+	}
+	log.WithField("blocks", n).Info("Pruned history")
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/db"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/deprecated"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/p2p"
+	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/slasher"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/testnet"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/validator"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/weaksubjectivity"
@@ -34,6 +35,7 @@ func init() {
 	prysmctlCommands = append(prysmctlCommands, checkpointsync.Commands...)
 	prysmctlCommands = append(prysmctlCommands, db.Commands...)
 	prysmctlCommands = append(prysmctlCommands, p2p.Commands...)
+	prysmctlCommands = append(prysmctlCommands, slasher.Commands...)
 	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
 	prysmctlCommands = append(prysmctlCommands, weaksubjectivity.Commands...)
 	prysmctlCommands = append(prysmctlCommands, validator.Commands...)
@@ -0,0 +1,13 @@
+package slasher
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "slasher",
+		Usage: "commands for the slasher of a beacon node",
+		Subcommands: []*cli.Command{
+			exportEvidenceCmd,
+		},
+	},
+}
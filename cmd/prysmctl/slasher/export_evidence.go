@@ -0,0 +1,91 @@
+package slasher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+	"github.com/prysmaticlabs/prysm/v4/api/client/slasher"
+	slasherhttp "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/slasher"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportEvidenceFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	PageSize       uint64
+	Output         string
+}{}
+
+var exportEvidenceCmd = &cli.Command{
+	Name:  "export-evidence",
+	Usage: "Export the evidence of all the slashings detected by the slasher of a beacon node as JSON.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionExportEvidence(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export slashing evidence")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node connection",
+			Destination: &exportEvidenceFlags.BeaconNodeHost,
+			Value:       "localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &exportEvidenceFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+		&cli.Uint64Flag{
+			Name:        "page-size",
+			Usage:       "number of slashings requested from the beacon node at a time",
+			Destination: &exportEvidenceFlags.PageSize,
+			Value:       100,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "path of the JSON file the evidence is written to. The evidence is printed to stdout if not set",
+			Destination: &exportEvidenceFlags.Output,
+		},
+	},
+}
+
+type exportedEvidence struct {
+	Data []*slasherhttp.SlashingEvidence `json:"data"`
+}
+
+func cliActionExportEvidence(_ *cli.Context) error {
+	ctx := context.Background()
+	f := exportEvidenceFlags
+
+	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
+	c, err := slasher.NewClient(f.BeaconNodeHost, opts...)
+	if err != nil {
+		return err
+	}
+	evidence, err := c.GetAllSlashings(ctx, f.PageSize)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(&exportedEvidence{Data: evidence}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if f.Output == "" {
+		fmt.Println(string(b))
+		return nil
+	}
+	if err := file.WriteFile(f.Output, b); err != nil {
+		return err
+	}
+	log.Printf("saved the evidence of %d slashings to %s", len(evidence), f.Output)
+	return nil
+}
@@ -42,8 +42,10 @@ var (
 	}
 	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
 	BeaconRESTApiProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rest-api-provider",
-		Usage: "Beacon node REST API provider endpoint",
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint. A comma-separated list of endpoints can be given, " +
+			"in which case requests are sent to the healthiest beacon node, failing over to the others if it is unavailable, " +
+			"and blocks and attestations are submitted to all of them",
 		Value: "http://127.0.0.1:3500",
 	}
 	// CertFlag defines a flag for the node's TLS certificate.
@@ -297,6 +299,42 @@ var (
 		Name:  "validators-external-signer-public-keys",
 		Usage: "comma separated list of public keys OR an external url endpoint for the validator to retrieve public keys from for usage with web3signer",
 	}
+	// Web3SignerPublicKeysPollingIntervalFlag defines how often the public keys are fetched again from the
+	// web3signer public keys url, so that keys added or removed on web3signer are picked up by the validator.
+	Web3SignerPublicKeysPollingIntervalFlag = &cli.DurationFlag{
+		Name: "validators-external-signer-public-keys-polling-interval",
+		Usage: "Interval at which to fetch the public keys again from the web3signer public keys url, " +
+			"to add and remove validators as they change on web3signer. Disabled if not set",
+	}
+	// Web3SignerFailoverURLsFlag defines web3signer instances to use, in order, when the main one is unavailable.
+	// example: --validators-external-signer-failover-urls=http://localhost:9001,http://localhost:9002
+	Web3SignerFailoverURLsFlag = &cli.StringSliceFlag{
+		Name: "validators-external-signer-failover-urls",
+		Usage: "Comma separated list of web3signer URLs to use, in order, when the one from --validators-external-signer-url " +
+			"is unavailable. All web3signer instances must hold the same keys and share their slashing protection database",
+	}
+	// Web3SignerTLSClientCertFlag defines the path to a client certificate to authenticate with web3signer over TLS.
+	Web3SignerTLSClientCertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-client-cert",
+		Usage: "/path/to/client.crt to authenticate with web3signer over TLS",
+	}
+	// Web3SignerTLSClientKeyFlag defines the path to the key of the web3signer TLS client certificate.
+	Web3SignerTLSClientKeyFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-client-key",
+		Usage: "/path/to/client.key of the client certificate to authenticate with web3signer over TLS",
+	}
+	// Web3SignerTLSCACertFlag defines the path to a CA certificate to verify the web3signer TLS server certificate.
+	Web3SignerTLSCACertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-ca-cert",
+		Usage: "/path/to/ca.crt to verify the web3signer TLS server certificate, instead of the system certificate pool",
+	}
+	// Web3SignerWithLocalKeysFlag keeps signing with the keystores of the local or derived wallet
+	// when a web3signer url is set, so that keys can be moved between the two at runtime.
+	Web3SignerWithLocalKeysFlag = &cli.BoolFlag{
+		Name: "validators-external-signer-with-local-keys",
+		Usage: "Sign with the keystores of the wallet as well as with web3signer when --validators-external-signer-url is set. " +
+			"Keys can then be moved between the wallet and web3signer through the keymanager API without a restart",
+	}
 
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
@@ -323,6 +361,18 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// SlashingProtectionMinimalFlag exports only the highest signed proposal slot and attestation
+	// epochs of each public key, instead of their complete slashing protection history.
+	SlashingProtectionMinimalFlag = &cli.BoolFlag{
+		Name: "slashing-protection-minimal",
+		Usage: "Exports only the highest signed proposal slot and source and target epochs of each public key, " +
+			"which is enough to protect against slashings after import and much smaller than the complete history",
+	}
+	// SlashingProtectionJSONFilesFlag is used to enter the file paths of several slashing protection JSON files to merge.
+	SlashingProtectionJSONFilesFlag = &cli.StringSliceFlag{
+		Name:  "slashing-protection-json-files",
+		Usage: "Paths to EIP-3076 compliant JSON files to merge into a single slashing protection history file",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
@@ -364,6 +414,33 @@ var (
 		Usage: "Sets gas limit for the builder to use for constructing a payload for all the validators",
 		Value: fmt.Sprint(params.BeaconConfig().DefaultBuilderGasLimit),
 	}
+
+	// DistributedFlag enables the use of the selection proof endpoints of a distributed validator middleware.
+	DistributedFlag = &cli.BoolFlag{
+		Name: "distributed",
+		Usage: "Enables distributed validator mode, in which the validator client submits its partial selection proofs to the " +
+			"distributed validator middleware (e.g. Obol, SSV) and uses the aggregated proofs it returns to determine aggregation duties",
+		Value: false,
+	}
+
+	// AttestationDataMajorityFlag enables requesting attestation data from every configured beacon node.
+	AttestationDataMajorityFlag = &cli.BoolFlag{
+		Name: "attestation-data-majority",
+		Usage: "Requests attestation data from every beacon node given with --beacon-rpc-provider, or with --beacon-rest-api-provider " +
+			"when the REST API is enabled, and attests with the data as soon as a majority of them returned it. Otherwise, the data " +
+			"returned by the most beacon nodes is used, or by the one with the highest head slot when tied, so that a single " +
+			"lagging beacon node cannot cause missed head votes",
+		Value: false,
+	}
+
+	// SlashingProtectionURLFlag defines the URL of a remote slashing protection service shared by several validator clients.
+	SlashingProtectionURLFlag = &cli.StringFlag{
+		Name: "slashing-protection-url",
+		Usage: "URL of a remote slashing protection service which keeps the signing history of the validator keys, " +
+			"instead of the local validator database. Several validator clients sharing the service, such as a hot-standby pair, " +
+			"are refused the signing of a message slashable with respect to the history recorded by any of them. " +
+			"The messages accepted by the service are recorded in the local validator database as well",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
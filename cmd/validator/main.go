@@ -74,11 +74,20 @@ var appFlags = []cli.Flag{
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.Web3SignerPublicKeysPollingIntervalFlag,
+	flags.Web3SignerFailoverURLsFlag,
+	flags.Web3SignerTLSClientCertFlag,
+	flags.Web3SignerTLSClientKeyFlag,
+	flags.Web3SignerTLSCACertFlag,
+	flags.Web3SignerWithLocalKeysFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
 	flags.ProposerSettingsFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
+	flags.DistributedFlag,
+	flags.AttestationDataMajorityFlag,
+	flags.SlashingProtectionURLFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
 	cmd.MonitoringHostFlag,
@@ -1,13 +1,16 @@
 package historycmd
 
 import (
-	"encoding/json"
+	"bufio"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/cmd"
 	"github.com/prysmaticlabs/prysm/v4/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
 	"github.com/prysmaticlabs/prysm/v4/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
@@ -26,9 +29,10 @@ const (
 // Steps:
 // 1. Parse a path to the validator's datadir from the CLI context.
 // 2. Open the validator database.
-// 3. Call the function which actually exports the data from
-// the validator's db into an EIP standard slashing protection format
-// 4. Format and save the JSON file to a user's specified output directory.
+// 3. Parse the user's specified output directory.
+// 4. Call the function which actually streams the data from the validator's db
+// into an EIP standard slashing protection JSON file in that directory, either the
+// complete history or, with the minimal flag, only the highest signed messages.
 func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	log.Info(
 		"This command exports your validator's attestation and proposal history into " +
@@ -63,22 +67,6 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 			log.WithError(err).Errorf("Could not close validator DB")
 		}
 	}()
-	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
-	if err != nil {
-		return errors.Wrap(err, "could not export slashing protection history")
-	}
-
-	// Check if JSON data is empty and issue a warning about common problems to the user.
-	if eipJSON == nil || len(eipJSON.Data) == 0 {
-		log.Fatal(
-			"No slashing protection data was found in your database. This is likely because an older version of " +
-				"Prysm would place your validator database in your wallet directory as a validator.db file. Now, " +
-				"Prysm keeps its validator database inside the direct/ or derived/ folder in your wallet directory. " +
-				"Try running this command again, but add direct/ or derived/ to the path where your wallet " +
-				"directory is in and you should obtain your slashing protection history",
-		)
-	}
-
 	outputDir, err := userprompt.InputDirectory(
 		cliCtx,
 		"Enter your desired output directory for your slashing protection history file",
@@ -101,12 +89,33 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	}
 	outputFilePath := filepath.Join(outputDir, jsonExportFileName)
 	log.Infof("Writing slashing protection export JSON file to %s", outputFilePath)
-	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+
+	// The history is streamed to the file one public key at a time, so that
+	// exporting a large number of keys does not require holding them all in memory.
+	export := slashingprotection.StreamStandardProtectionJSON
+	if cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name) {
+		log.Info("Exporting only the highest signed proposal and attestation of each public key")
+		export = slashingprotection.StreamMinimalProtectionJSON
+	}
+	numKeys, err := writeOutputFile(outputFilePath, func(w io.Writer) (int, error) {
+		return export(cliCtx.Context, validatorDB, w)
+	})
 	if err != nil {
-		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+		return errors.Wrap(err, "could not export slashing protection history")
 	}
-	if err := file.WriteFile(outputFilePath, encoded); err != nil {
-		return errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+
+	// Check if JSON data is empty and issue a warning about common problems to the user.
+	if numKeys == 0 {
+		if err := os.Remove(outputFilePath); err != nil {
+			log.WithError(err).Errorf("Could not remove empty slashing protection file %s", outputFilePath)
+		}
+		log.Fatal(
+			"No slashing protection data was found in your database. This is likely because an older version of " +
+				"Prysm would place your validator database in your wallet directory as a validator.db file. Now, " +
+				"Prysm keeps its validator database inside the direct/ or derived/ folder in your wallet directory. " +
+				"Try running this command again, but add direct/ or derived/ to the path where your wallet " +
+				"directory is in and you should obtain your slashing protection history",
+		)
 	}
 	log.Infof(
 		"Successfully wrote %s. You can import this file using Prysm's "+
@@ -115,3 +124,39 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	)
 	return nil
 }
+
+// Creates the file at the given path with read-write permissions for the user only,
+// and writes to it through a buffer with the given function.
+func writeOutputFile(outputFilePath string, fn func(w io.Writer) (int, error)) (int, error) {
+	expanded, err := file.ExpandPath(outputFilePath)
+	if err != nil {
+		return 0, err
+	}
+	if file.FileExists(expanded) {
+		info, err := os.Stat(expanded)
+		if err != nil {
+			return 0, err
+		}
+		if info.Mode() != params.BeaconIoConfig().ReadWritePermissions {
+			return 0, errors.New("file already exists without proper 0600 permissions")
+		}
+	}
+	f, err := os.OpenFile(expanded, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not create file at path %s", outputFilePath)
+	}
+	w := bufio.NewWriter(f)
+	n, err := fn(w)
+	if err != nil {
+		_ = f.Close()
+		return 0, err
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return 0, errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+	}
+	if err := f.Close(); err != nil {
+		return 0, errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+	}
+	return n, nil
+}
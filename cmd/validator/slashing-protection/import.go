@@ -1,8 +1,9 @@
 package historycmd
 
 import (
-	"bytes"
+	"bufio"
 	"fmt"
+	"os"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/cmd"
@@ -20,7 +21,7 @@ import (
 // Steps:
 // 1. Parse a path to the validator's datadir from the CLI context.
 // 2. Open the validator database.
-// 3. Read the JSON file from user input.
+// 3. Open the JSON file from user input.
 // 4. Call the function which actually imports the data from
 // the standard slashing protection JSON file into our database.
 func importSlashingProtectionJSON(cliCtx *cli.Context) error {
@@ -65,17 +66,34 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 			flags.SlashingProtectionJSONFileFlag.Name,
 		)
 	}
-	enc, err := file.ReadFileAsBytes(protectionFilePath)
+	f, err := openInputFile(protectionFilePath)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close slashing protection file")
+		}
+	}()
 	log.Infof("Starting import of slashing protection file %s", protectionFilePath)
-	buf := bytes.NewBuffer(enc)
 	if err := slashingprotection.ImportStandardProtectionJSON(
-		cliCtx.Context, valDB, buf,
+		cliCtx.Context, valDB, bufio.NewReader(f),
 	); err != nil {
 		return err
 	}
 	log.Infof("Slashing protection JSON successfully imported into %s", dataDir)
 	return nil
 }
+
+// Opens the file at the given path for reading, so that it can be decoded as a stream.
+func openInputFile(filePath string) (*os.File, error) {
+	expanded, err := file.ExpandPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(expanded) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open file at path %s", filePath)
+	}
+	return f, nil
+}
@@ -3,6 +3,7 @@ package historycmd
 import (
 	"encoding/json"
 	"flag"
+	"fmt"
 	"path/filepath"
 	"testing"
 
@@ -176,3 +177,41 @@ func TestImportExportSlashingProtectionCli_EmptyData(t *testing.T) {
 		require.DeepEqual(t, make([]*format.SignedAttestation, 0), item.SignedAttestations)
 	}
 }
+
+func TestMergeSlashingProtectionCli(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+
+	// Create two mock slashing protection files for different keys.
+	pubKeys, err := mocks.CreateRandomPubKeys(4)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	protectionFilePaths := make([]string, 2)
+	for i := range protectionFilePaths {
+		mockJSON, err := mocks.MockSlashingProtectionJSON(
+			pubKeys[2*i:2*i+2], attestingHistory[2*i:2*i+2], proposalHistory[2*i:2*i+2],
+		)
+		require.NoError(t, err)
+		encoded, err := json.Marshal(mockJSON)
+		require.NoError(t, err)
+		protectionFilePaths[i] = filepath.Join(outputPath, fmt.Sprintf("slashing_history_%d.json", i))
+		require.NoError(t, file.WriteFile(protectionFilePaths[i], encoded))
+	}
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.SlashingProtectionExportDirFlag.Name, outputPath, "")
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputPath))
+	set.Var(&cli.StringSlice{}, flags.SlashingProtectionJSONFilesFlag.Name, "")
+	for _, path := range protectionFilePaths {
+		require.NoError(t, set.Set(flags.SlashingProtectionJSONFilesFlag.Name, path))
+	}
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, mergeSlashingProtectionJSON(cliCtx))
+
+	enc, err := file.ReadFileAsBytes(filepath.Join(outputPath, jsonMergeFileName))
+	require.NoError(t, err)
+	receivedJSON := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, receivedJSON))
+	require.Equal(t, len(pubKeys), len(receivedJSON.Data))
+}
@@ -0,0 +1,98 @@
+package historycmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	"github.com/prysmaticlabs/prysm/v4/validator/accounts/userprompt"
+	slashingprotection "github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	jsonMergeFileName = "merged_slashing_protection.json"
+)
+
+// Combines several slashing protection EIP-3076 standard JSON files, for example exported
+// from different machines which ran the same keys, into a single file that can be imported.
+//
+// Steps:
+// 1. Parse the paths to the JSON files to merge from the CLI context.
+// 2. Parse the user's specified output directory.
+// 3. Call the function which actually merges the files, streaming them into a single
+// EIP standard slashing protection JSON file in that directory.
+// 4. Warn about every public key whose merged history is slashable.
+func mergeSlashingProtectionJSON(cliCtx *cli.Context) error {
+	protectionFilePaths := cliCtx.StringSlice(flags.SlashingProtectionJSONFilesFlag.Name)
+	if len(protectionFilePaths) < 2 {
+		return fmt.Errorf(
+			"at least two slashing protection JSON files are needed to merge, please specify them with the %s flag",
+			flags.SlashingProtectionJSONFilesFlag.Name,
+		)
+	}
+	readers := make([]io.Reader, len(protectionFilePaths))
+	for i, protectionFilePath := range protectionFilePaths {
+		f, err := openInputFile(protectionFilePath)
+		if err != nil {
+			return err
+		}
+		defer func(path string) {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Errorf("Could not close slashing protection file %s", path)
+			}
+		}(protectionFilePath)
+		readers[i] = bufio.NewReader(f)
+	}
+
+	outputDir, err := userprompt.InputDirectory(
+		cliCtx,
+		"Enter your desired output directory for your merged slashing protection history file",
+		flags.SlashingProtectionExportDirFlag,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if outputDir == "" {
+		return errors.New("output directory not specified")
+	}
+	exists, err := file.HasDir(outputDir)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
+	}
+	if !exists {
+		if err := file.MkdirAll(outputDir); err != nil {
+			return errors.Wrapf(err, "could not create output directory %s", outputDir)
+		}
+	}
+	outputFilePath := filepath.Join(outputDir, jsonMergeFileName)
+	log.Infof("Merging %d slashing protection files into %s", len(readers), outputFilePath)
+	var conflicts []*slashingprotection.MergeConflict
+	if _, err := writeOutputFile(outputFilePath, func(w io.Writer) (int, error) {
+		conflicts, err = slashingprotection.MergeProtectionJSON(cliCtx.Context, readers, w)
+		return len(conflicts), err
+	}); err != nil {
+		return errors.Wrap(err, "could not merge slashing protection history")
+	}
+	for _, conflict := range conflicts {
+		log.WithFields(logrus.Fields{
+			"pubkey": conflict.Pubkey,
+			"reason": conflict.Reason,
+		}).Warn("Merged slashing protection history is slashable for public key")
+	}
+	if len(conflicts) > 0 {
+		log.Warnf(
+			"%d public keys have a slashable history across the merged files. They are kept in %s, "+
+				"but importing it will refuse to import their history",
+			len(conflicts),
+			outputFilePath,
+		)
+	}
+	log.Infof("Successfully wrote %s", outputFilePath)
+	return nil
+}
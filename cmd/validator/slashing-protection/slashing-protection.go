@@ -21,6 +21,7 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionMinimalFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.SepoliaTestnet,
@@ -70,5 +71,22 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "merge",
+			Description: `merges several EIP-3076 compliant slashing protection JSON files into one, reporting slashable public keys`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFilesFlag,
+				flags.SlashingProtectionExportDirFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := mergeSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not merge slashing protection files: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -108,11 +108,20 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GraffitiFileFlag,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerPublicKeysPollingIntervalFlag,
+			flags.Web3SignerFailoverURLsFlag,
+			flags.Web3SignerTLSClientCertFlag,
+			flags.Web3SignerTLSClientKeyFlag,
+			flags.Web3SignerTLSCACertFlag,
+			flags.Web3SignerWithLocalKeysFlag,
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
 			flags.SuggestedFeeRecipientFlag,
 			flags.EnableBuilderFlag,
 			flags.BuilderGasLimitFlag,
+			flags.DistributedFlag,
+			flags.AttestationDataMajorityFlag,
+			flags.SlashingProtectionURLFlag,
 		},
 	},
 	{
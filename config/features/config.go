@@ -72,6 +72,15 @@ type Flags struct {
 	BuildBlockParallel bool // BuildBlockParallel builds beacon block for proposer in parallel.
 	AggregateParallel  bool // AggregateParallel aggregates attestations in parallel.
 
+	EnableLightClient bool // EnableLightClient enables the light client server of the beacon node.
+
+	EnableExperimentalState bool // EnableExperimentalState backs the largest beacon state fields by multi-value slices.
+
+	EnableForkChoicePersistence bool // EnableForkChoicePersistence saves the fork choice store to the database and restores it on startup.
+
+	EnableRewardsIndexer   bool   // EnableRewardsIndexer stores the rewards and penalties of every validator at each epoch transition.
+	RewardsRetentionEpochs uint64 // RewardsRetentionEpochs is the number of epochs of rewards kept by the rewards indexer, 0 keeps them all.
+
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
 	KeystoreImportDebounceInterval time.Duration
@@ -239,6 +248,23 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(disableResourceManager)
 		cfg.DisableResourceManager = true
 	}
+	if ctx.IsSet(enableLightClient.Name) {
+		logEnabled(enableLightClient)
+		cfg.EnableLightClient = true
+	}
+	if ctx.IsSet(enableExperimentalState.Name) {
+		logEnabled(enableExperimentalState)
+		cfg.EnableExperimentalState = true
+	}
+	if ctx.IsSet(enableForkChoicePersistence.Name) {
+		logEnabled(enableForkChoicePersistence)
+		cfg.EnableForkChoicePersistence = true
+	}
+	if ctx.IsSet(enableRewardsIndexer.Name) {
+		logEnabled(enableRewardsIndexer)
+		cfg.EnableRewardsIndexer = true
+	}
+	cfg.RewardsRetentionEpochs = ctx.Uint64(rewardsRetentionEpochs.Name)
 	cfg.AggregateIntervals = [3]time.Duration{aggregateFirstInterval.Value, aggregateSecondInterval.Value, aggregateThirdInterval.Value}
 	Init(cfg)
 	return nil
@@ -159,6 +159,31 @@ var (
 		Name:  "disable-aggregate-parallel",
 		Usage: "Disables parallel aggregation of attestations",
 	}
+	enableLightClient = &cli.BoolFlag{
+		Name:  "enable-lightclient",
+		Usage: "Enables the light client server, which computes, stores and serves light client data over the p2p network and the beacon API",
+	}
+	enableExperimentalState = &cli.BoolFlag{
+		Name: "enable-experimental-state",
+		Usage: "Backs the validators, balances, inactivity scores and randao mixes of beacon states by multi-value slices, " +
+			"so that copies of a state only store the values that differ from the states they were copied from",
+	}
+	enableForkChoicePersistence = &cli.BoolFlag{
+		Name: "enable-forkchoice-persistence",
+		Usage: "Periodically saves the fork choice store to the database and restores it on startup, " +
+			"instead of rebuilding fork choice from the finalized checkpoint",
+	}
+	enableRewardsIndexer = &cli.BoolFlag{
+		Name: "enable-rewards-indexer",
+		Usage: "Stores the rewards and penalties of every validator at each epoch transition, " +
+			"and serves them from the /prysm/v1/validators/rewards endpoint",
+	}
+	rewardsRetentionEpochs = &cli.Uint64Flag{
+		Name: "rewards-retention-epochs",
+		Usage: "The number of epochs of validator rewards kept by the rewards indexer, older rewards are pruned. " +
+			"0 keeps the rewards of every epoch",
+		Value: 225,
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -213,6 +238,11 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disableResourceManager,
 	DisableRegistrationCache,
 	disableAggregateParallel,
+	enableLightClient,
+	enableExperimentalState,
+	enableForkChoicePersistence,
+	enableRewardsIndexer,
+	rewardsRetentionEpochs,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -26,4 +26,9 @@ const (
 	SyncCommitteeAggregationBytesLength   = 16            // SyncCommitteeAggregationBytesLength defines the length of sync committee aggregate bytes.
 	SyncAggregateSyncCommitteeBytesLength = 64            // SyncAggregateSyncCommitteeBytesLength defines the length of sync committee bytes in a sync aggregate.
 	MaxWithdrawalsPerPayload              = 16            // MaxWithdrawalsPerPayloadLength defines the maximum number of withdrawals that can be included in a payload.
+	MaxBlobsPerBlock                      = 6             // MaxBlobsPerBlock defines the maximum number of blobs that can be included in a block.
+	MaxBlobCommitmentsPerBlock            = 4096          // MaxBlobCommitmentsPerBlock defines the theoretical limit of blobs can be included in a block.
+	LogMaxBlobCommitments                 = 12            // LogMaxBlobCommitments is the Log_2 of MaxBlobCommitmentsPerBlock.
+	KzgCommitmentInclusionProofDepth      = 17            // KzgCommitmentInclusionProofDepth defines the depth of the merkle proof of a blob commitment in the block body.
+	BlobLength                            = 131072        // BlobLength defines the byte length of a blob (FIELD_ELEMENTS_PER_BLOB * BYTES_PER_FIELD_ELEMENT).
 )
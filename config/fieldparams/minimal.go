@@ -26,4 +26,9 @@ const (
 	SyncCommitteeAggregationBytesLength   = 1             // SyncCommitteeAggregationBytesLength defines the sync committee aggregate bytes.
 	SyncAggregateSyncCommitteeBytesLength = 4             // SyncAggregateSyncCommitteeBytesLength defines the length of sync committee bytes in a sync aggregate.
 	MaxWithdrawalsPerPayload              = 4             // MaxWithdrawalsPerPayloadLength defines the maximum number of withdrawals that can be included in a payload.
+	MaxBlobsPerBlock                      = 6             // MaxBlobsPerBlock defines the maximum number of blobs that can be included in a block.
+	MaxBlobCommitmentsPerBlock            = 16            // MaxBlobCommitmentsPerBlock defines the theoretical limit of blobs can be included in a block.
+	LogMaxBlobCommitments                 = 4             // LogMaxBlobCommitments is the Log_2 of MaxBlobCommitmentsPerBlock.
+	KzgCommitmentInclusionProofDepth      = 9             // KzgCommitmentInclusionProofDepth defines the depth of the merkle proof of a blob commitment in the block body.
+	BlobLength                            = 131072        // BlobLength defines the byte length of a blob (FIELD_ELEMENTS_PER_BLOB * BYTES_PER_FIELD_ELEMENT).
 )
@@ -4,6 +4,7 @@ const (
 	AltairE2EForkEpoch    = 6
 	BellatrixE2EForkEpoch = 8
 	CapellaE2EForkEpoch   = 10
+	DenebE2EForkEpoch     = 12
 )
 
 // E2ETestConfig retrieves the configurations made specifically for E2E testing.
@@ -38,6 +39,7 @@ func E2ETestConfig() *BeaconChainConfig {
 	e2eConfig.AltairForkEpoch = AltairE2EForkEpoch
 	e2eConfig.BellatrixForkEpoch = BellatrixE2EForkEpoch
 	e2eConfig.CapellaForkEpoch = CapellaE2EForkEpoch
+	e2eConfig.DenebForkEpoch = DenebE2EForkEpoch
 
 	// Terminal Total Difficulty.
 	e2eConfig.TerminalTotalDifficulty = "480"
@@ -48,6 +50,7 @@ func E2ETestConfig() *BeaconChainConfig {
 	e2eConfig.AltairForkVersion = []byte{1, 0, 0, 253}
 	e2eConfig.BellatrixForkVersion = []byte{2, 0, 0, 253}
 	e2eConfig.CapellaForkVersion = []byte{3, 0, 0, 253}
+	e2eConfig.DenebForkVersion = []byte{4, 0, 0, 253}
 
 	e2eConfig.InitializeForkSchedule()
 	return e2eConfig
@@ -78,6 +81,7 @@ func E2EMainnetTestConfig() *BeaconChainConfig {
 	e2eConfig.AltairForkEpoch = AltairE2EForkEpoch
 	e2eConfig.BellatrixForkEpoch = BellatrixE2EForkEpoch
 	e2eConfig.CapellaForkEpoch = CapellaE2EForkEpoch
+	e2eConfig.DenebForkEpoch = DenebE2EForkEpoch
 
 	// Terminal Total Difficulty.
 	e2eConfig.TerminalTotalDifficulty = "480"
@@ -88,6 +92,7 @@ func E2EMainnetTestConfig() *BeaconChainConfig {
 	e2eConfig.AltairForkVersion = []byte{1, 0, 0, 254}
 	e2eConfig.BellatrixForkVersion = []byte{2, 0, 0, 254}
 	e2eConfig.CapellaForkVersion = []byte{3, 0, 0, 254}
+	e2eConfig.DenebForkVersion = []byte{4, 0, 0, 254}
 
 	e2eConfig.InitializeForkSchedule()
 	return e2eConfig
@@ -18,7 +18,7 @@ func ToSettings(ps *validatorpb.ProposerSettingsPayload) (*ProposerSettings, err
 	if ps.ProposerConfig != nil {
 		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption)
 		for key, optionPayload := range ps.ProposerConfig {
-			if optionPayload.FeeRecipient == "" {
+			if optionPayload.FeeRecipient == "" && optionPayload.Graffiti == "" {
 				continue
 			}
 			b, err := hexutil.Decode(key)
@@ -26,9 +26,12 @@ func ToSettings(ps *validatorpb.ProposerSettingsPayload) (*ProposerSettings, err
 				return nil, errors.Wrap(err, fmt.Sprintf("cannot decode public key %s", key))
 			}
 			p := &ProposerOption{
-				FeeRecipientConfig: &FeeRecipientConfig{
+				Graffiti: optionPayload.Graffiti,
+			}
+			if optionPayload.FeeRecipient != "" {
+				p.FeeRecipientConfig = &FeeRecipientConfig{
 					FeeRecipient: common.HexToAddress(optionPayload.FeeRecipient),
-				},
+				}
 			}
 			if optionPayload.Builder != nil {
 				p.BuilderConfig = ToBuilderConfig(optionPayload.Builder)
@@ -37,7 +40,9 @@ func ToSettings(ps *validatorpb.ProposerSettingsPayload) (*ProposerSettings, err
 		}
 	}
 	if ps.DefaultConfig != nil {
-		d := &ProposerOption{}
+		d := &ProposerOption{
+			Graffiti: ps.DefaultConfig.Graffiti,
+		}
 		if ps.DefaultConfig.FeeRecipient != "" {
 			d.FeeRecipientConfig = &FeeRecipientConfig{
 				FeeRecipient: common.HexToAddress(ps.DefaultConfig.FeeRecipient),
@@ -53,10 +58,17 @@ func ToSettings(ps *validatorpb.ProposerSettingsPayload) (*ProposerSettings, err
 
 // BuilderConfig is the struct representation of the JSON config file set in the validator through the CLI.
 // GasLimit is a number set to help the network decide on the maximum gas in each block.
+// Relays are the relays asked for a builder bid, a subset of the relays of the beacon node set with --http-mev-relay,
+// matched by host. All the relays of the beacon node are asked when empty.
+// MinBid is the minimum value in wei of a builder bid as a decimal string, lower bids are dropped in favor of the local payload.
+// LocalBlockValueBoost is the percentage boost of the local payload value over builder bids, when set it
+// overrides the beacon node setting.
 type BuilderConfig struct {
-	Enabled  bool             `json:"enabled" yaml:"enabled"`
-	GasLimit validator.Uint64 `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
-	Relays   []string         `json:"relays,omitempty" yaml:"relays,omitempty"`
+	Enabled              bool             `json:"enabled" yaml:"enabled"`
+	GasLimit             validator.Uint64 `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
+	Relays               []string         `json:"relays,omitempty" yaml:"relays,omitempty"`
+	MinBid               string           `json:"min_bid,omitempty" yaml:"min_bid,omitempty"`
+	LocalBlockValueBoost *uint64          `json:"local_block_value_boost,omitempty" yaml:"local_block_value_boost,omitempty"`
 }
 
 // ToBuilderConfig converts protobuf to a builder config used in inmemory storage
@@ -67,6 +79,11 @@ func ToBuilderConfig(from *validatorpb.BuilderConfig) *BuilderConfig {
 	config := &BuilderConfig{
 		Enabled:  from.Enabled,
 		GasLimit: from.GasLimit,
+		MinBid:   from.MinBid,
+	}
+	if from.LocalBlockValueBoost != nil {
+		boost := *from.LocalBlockValueBoost
+		config.LocalBlockValueBoost = &boost
 	}
 	if from.Relays != nil {
 		relays := make([]string, len(from.Relays))
@@ -102,7 +119,9 @@ func (ps *ProposerSettings) ToPayload() *validatorpb.ProposerSettingsPayload {
 	if ps.ProposeConfig != nil {
 		payload.ProposerConfig = make(map[string]*validatorpb.ProposerOptionPayload)
 		for key, option := range ps.ProposeConfig {
-			p := &validatorpb.ProposerOptionPayload{}
+			p := &validatorpb.ProposerOptionPayload{
+				Graffiti: option.Graffiti,
+			}
 			if option.FeeRecipientConfig != nil {
 				p.FeeRecipient = option.FeeRecipientConfig.FeeRecipient.Hex()
 			}
@@ -113,7 +132,9 @@ func (ps *ProposerSettings) ToPayload() *validatorpb.ProposerSettingsPayload {
 		}
 	}
 	if ps.DefaultConfig != nil {
-		p := &validatorpb.ProposerOptionPayload{}
+		p := &validatorpb.ProposerOptionPayload{
+			Graffiti: ps.DefaultConfig.Graffiti,
+		}
 		if ps.DefaultConfig.FeeRecipientConfig != nil {
 			p.FeeRecipient = ps.DefaultConfig.FeeRecipientConfig.FeeRecipient.Hex()
 		}
@@ -131,9 +152,11 @@ type FeeRecipientConfig struct {
 }
 
 // ProposerOption is a Prysm internal representation of the ProposerOptionPayload on the validator client in bytes format instead of hex.
+// Graffiti may contain template fields, which are rendered when proposing a block.
 type ProposerOption struct {
 	FeeRecipientConfig *FeeRecipientConfig
 	BuilderConfig      *BuilderConfig
+	Graffiti           string
 }
 
 // Clone creates a deep copy of the proposer settings
@@ -173,6 +196,11 @@ func (bc *BuilderConfig) Clone() *BuilderConfig {
 	config := &BuilderConfig{}
 	config.Enabled = bc.Enabled
 	config.GasLimit = bc.GasLimit
+	config.MinBid = bc.MinBid
+	if bc.LocalBlockValueBoost != nil {
+		boost := *bc.LocalBlockValueBoost
+		config.LocalBlockValueBoost = &boost
+	}
 	var relays []string
 	if bc.Relays != nil {
 		relays = make([]string, len(bc.Relays))
@@ -196,6 +224,11 @@ func (bc *BuilderConfig) ToPayload() *validatorpb.BuilderConfig {
 		config.Relays = relays
 	}
 	config.GasLimit = bc.GasLimit
+	config.MinBid = bc.MinBid
+	if bc.LocalBlockValueBoost != nil {
+		boost := *bc.LocalBlockValueBoost
+		config.LocalBlockValueBoost = &boost
+	}
 	return config
 }
 
@@ -204,7 +237,9 @@ func (po *ProposerOption) Clone() *ProposerOption {
 	if po == nil {
 		return nil
 	}
-	p := &ProposerOption{}
+	p := &ProposerOption{
+		Graffiti: po.Graffiti,
+	}
 	if po.FeeRecipientConfig != nil {
 		p.FeeRecipientConfig = po.FeeRecipientConfig.Clone()
 	}
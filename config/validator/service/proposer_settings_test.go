@@ -16,6 +16,7 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 	key1hex := "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
 	key1, err := hexutil.Decode(key1hex)
 	require.NoError(t, err)
+	boost := uint64(10)
 	settings := &ProposerSettings{
 		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*ProposerOption{
 			bytesutil.ToBytes48(key1): {
@@ -23,9 +24,11 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 					FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
 				},
 				BuilderConfig: &BuilderConfig{
-					Enabled:  true,
-					GasLimit: validator.Uint64(40000000),
-					Relays:   []string{"https://example-relay.com"},
+					Enabled:              true,
+					GasLimit:             validator.Uint64(40000000),
+					Relays:               []string{"https://example-relay.com"},
+					MinBid:               "50000000000000000000",
+					LocalBlockValueBoost: &boost,
 				},
 			},
 		},
@@ -66,6 +69,18 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 		require.Equal(t, config.Enabled, clone.Enabled)
 		require.Equal(t, config.GasLimit, clone.GasLimit)
 	})
+	t.Run("Happy Path ToBuilderConfig with bid policy", func(t *testing.T) {
+		clone := settings.ProposeConfig[bytesutil.ToBytes48(key1)].BuilderConfig.Clone()
+		require.DeepEqual(t, settings.ProposeConfig[bytesutil.ToBytes48(key1)].BuilderConfig, clone)
+		*settings.ProposeConfig[bytesutil.ToBytes48(key1)].BuilderConfig.LocalBlockValueBoost = 20
+		require.Equal(t, uint64(10), *clone.LocalBlockValueBoost)
+		config := ToBuilderConfig(clone.ToPayload())
+		require.DeepEqual(t, clone, config)
+		clone.LocalBlockValueBoost = nil
+		config = ToBuilderConfig(clone.ToPayload())
+		require.Equal(t, "50000000000000000000", config.MinBid)
+		require.Equal(t, true, config.LocalBlockValueBoost == nil)
+	})
 	t.Run("To Payload and ToSettings", func(t *testing.T) {
 		payload := settings.ToPayload()
 		option, ok := settings.ProposeConfig[bytesutil.ToBytes48(key1)]
@@ -97,6 +112,25 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 		require.Equal(t, option.BuilderConfig.Enabled, option.BuilderConfig.Enabled)
 
 	})
+	t.Run("To Payload and ToSettings with graffiti", func(t *testing.T) {
+		clone := settings.Clone()
+		clone.ProposeConfig[bytesutil.ToBytes48(key1)].Graffiti = "{{.CLVersion}}"
+		clone.DefaultConfig.Graffiti = "default"
+		payload := clone.ToPayload()
+		require.Equal(t, "{{.CLVersion}}", payload.ProposerConfig[key1hex].Graffiti)
+		require.Equal(t, "default", payload.DefaultConfig.Graffiti)
+
+		// an option with a graffiti but no fee recipient is not skipped
+		payload.ProposerConfig[key1hex].FeeRecipient = ""
+		newSettings, err := ToSettings(payload)
+		require.NoError(t, err)
+		option, ok := newSettings.ProposeConfig[bytesutil.ToBytes48(key1)]
+		require.Equal(t, true, ok)
+		require.Equal(t, "{{.CLVersion}}", option.Graffiti)
+		require.Equal(t, true, option.FeeRecipientConfig == nil)
+		require.Equal(t, "default", newSettings.DefaultConfig.Graffiti)
+		require.Equal(t, "default", newSettings.DefaultConfig.Clone().Graffiti)
+	})
 }
 
 func TestProposerSettings_ShouldBeSaved(t *testing.T) {
@@ -204,6 +204,146 @@ func TestWrapExecutionPayloadHeaderCapella_SSZ(t *testing.T) {
 	assert.NoError(t, payload.UnmarshalSSZ(encoded))
 }
 
+func TestWrapExecutionPayloadDeneb(t *testing.T) {
+	data := &enginev1.ExecutionPayloadDeneb{
+		ParentHash:    []byte("parenthash"),
+		FeeRecipient:  []byte("feerecipient"),
+		StateRoot:     []byte("stateroot"),
+		ReceiptsRoot:  []byte("receiptsroot"),
+		LogsBloom:     []byte("logsbloom"),
+		PrevRandao:    []byte("prevrandao"),
+		BlockNumber:   11,
+		GasLimit:      22,
+		GasUsed:       33,
+		Timestamp:     44,
+		ExtraData:     []byte("extradata"),
+		BaseFeePerGas: []byte("basefeepergas"),
+		BlockHash:     []byte("blockhash"),
+		Transactions:  [][]byte{[]byte("transaction")},
+		BlobGasUsed:   88,
+		ExcessBlobGas: 99,
+		Withdrawals: []*enginev1.Withdrawal{{
+			Index:          55,
+			ValidatorIndex: 66,
+			Address:        []byte("executionaddress"),
+			Amount:         77,
+		}},
+	}
+	payload, err := blocks.WrappedExecutionPayloadDeneb(data, 10)
+	require.NoError(t, err)
+	v, err := payload.ValueInGwei()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), v)
+
+	assert.DeepEqual(t, data, payload.Proto())
+
+	blobGasUsed, err := payload.BlobGasUsed()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(88), blobGasUsed)
+	excessBlobGas, err := payload.ExcessBlobGas()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(99), excessBlobGas)
+}
+
+func TestWrapExecutionPayloadHeaderDeneb(t *testing.T) {
+	data := &enginev1.ExecutionPayloadHeaderDeneb{
+		ParentHash:       []byte("parenthash"),
+		FeeRecipient:     []byte("feerecipient"),
+		StateRoot:        []byte("stateroot"),
+		ReceiptsRoot:     []byte("receiptsroot"),
+		LogsBloom:        []byte("logsbloom"),
+		PrevRandao:       []byte("prevrandao"),
+		BlockNumber:      11,
+		GasLimit:         22,
+		GasUsed:          33,
+		Timestamp:        44,
+		ExtraData:        []byte("extradata"),
+		BaseFeePerGas:    []byte("basefeepergas"),
+		BlockHash:        []byte("blockhash"),
+		TransactionsRoot: []byte("transactionsroot"),
+		WithdrawalsRoot:  []byte("withdrawalsroot"),
+		BlobGasUsed:      88,
+		ExcessBlobGas:    99,
+	}
+	payload, err := blocks.WrappedExecutionPayloadHeaderDeneb(data, 10)
+	require.NoError(t, err)
+
+	v, err := payload.ValueInGwei()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), v)
+
+	assert.DeepEqual(t, data, payload.Proto())
+
+	txRoot, err := payload.TransactionsRoot()
+	require.NoError(t, err)
+	require.DeepEqual(t, txRoot, data.TransactionsRoot)
+
+	wrRoot, err := payload.WithdrawalsRoot()
+	require.NoError(t, err)
+	require.DeepEqual(t, wrRoot, data.WithdrawalsRoot)
+
+	blobGasUsed, err := payload.BlobGasUsed()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(88), blobGasUsed)
+	excessBlobGas, err := payload.ExcessBlobGas()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(99), excessBlobGas)
+}
+
+func TestWrapExecutionPayloadDeneb_IsNil(t *testing.T) {
+	_, err := blocks.WrappedExecutionPayloadDeneb(nil, 0)
+	require.Equal(t, consensus_types.ErrNilObjectWrapped, err)
+
+	data := &enginev1.ExecutionPayloadDeneb{GasUsed: 54}
+	payload, err := blocks.WrappedExecutionPayloadDeneb(data, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, false, payload.IsNil())
+}
+
+func TestWrapExecutionPayloadHeaderDeneb_IsNil(t *testing.T) {
+	_, err := blocks.WrappedExecutionPayloadHeaderDeneb(nil, 0)
+	require.Equal(t, consensus_types.ErrNilObjectWrapped, err)
+
+	data := &enginev1.ExecutionPayloadHeaderDeneb{GasUsed: 54}
+	payload, err := blocks.WrappedExecutionPayloadHeaderDeneb(data, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, false, payload.IsNil())
+}
+
+func TestWrapExecutionPayloadDeneb_SSZ(t *testing.T) {
+	payload := createWrappedPayloadDeneb(t)
+	rt, err := payload.HashTreeRoot()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rt)
+
+	var b []byte
+	b, err = payload.MarshalSSZTo(b)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, len(b))
+	encoded, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+	assert.NotEqual(t, 0, payload.SizeSSZ())
+	assert.NoError(t, payload.UnmarshalSSZ(encoded))
+}
+
+func TestWrapExecutionPayloadHeaderDeneb_SSZ(t *testing.T) {
+	payload := createWrappedPayloadHeaderDeneb(t)
+	rt, err := payload.HashTreeRoot()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rt)
+
+	var b []byte
+	b, err = payload.MarshalSSZTo(b)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, len(b))
+	encoded, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+	assert.NotEqual(t, 0, payload.SizeSSZ())
+	assert.NoError(t, payload.UnmarshalSSZ(encoded))
+}
+
 func Test_executionPayload_Pb(t *testing.T) {
 	payload := createWrappedPayload(t)
 	pb, err := payload.PbBellatrix()
@@ -212,6 +352,9 @@ func Test_executionPayload_Pb(t *testing.T) {
 
 	_, err = payload.PbCapella()
 	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+
+	_, err = payload.PbDeneb()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
 }
 
 func Test_executionPayloadHeader_Pb(t *testing.T) {
@@ -221,6 +364,9 @@ func Test_executionPayloadHeader_Pb(t *testing.T) {
 
 	_, err = payload.PbCapella()
 	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+
+	_, err = payload.PbDeneb()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
 }
 
 func Test_executionPayloadCapella_Pb(t *testing.T) {
@@ -231,6 +377,9 @@ func Test_executionPayloadCapella_Pb(t *testing.T) {
 
 	_, err = payload.PbBellatrix()
 	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+
+	_, err = payload.PbDeneb()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
 }
 
 func Test_executionPayloadHeaderCapella_Pb(t *testing.T) {
@@ -240,6 +389,34 @@ func Test_executionPayloadHeaderCapella_Pb(t *testing.T) {
 
 	_, err = payload.PbCapella()
 	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+
+	_, err = payload.PbDeneb()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+}
+
+func Test_executionPayloadDeneb_Pb(t *testing.T) {
+	payload := createWrappedPayloadDeneb(t)
+	pb, err := payload.PbDeneb()
+	require.NoError(t, err)
+	assert.DeepEqual(t, payload.Proto(), pb)
+
+	_, err = payload.PbCapella()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+
+	_, err = payload.PbBellatrix()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+}
+
+func Test_executionPayloadHeaderDeneb_Pb(t *testing.T) {
+	payload := createWrappedPayloadHeaderDeneb(t)
+	_, err := payload.PbBellatrix()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+
+	_, err = payload.PbCapella()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
+
+	_, err = payload.PbDeneb()
+	require.ErrorIs(t, err, consensus_types.ErrUnsupportedField)
 }
 
 func createWrappedPayload(t testing.TB) interfaces.ExecutionData {
@@ -327,3 +504,47 @@ func createWrappedPayloadHeaderCapella(t testing.TB) interfaces.ExecutionData {
 	require.NoError(t, err)
 	return payload
 }
+
+func createWrappedPayloadDeneb(t testing.TB) interfaces.ExecutionData {
+	payload, err := blocks.WrappedExecutionPayloadDeneb(&enginev1.ExecutionPayloadDeneb{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    make([]byte, fieldparams.RootLength),
+		BlockNumber:   0,
+		GasLimit:      0,
+		GasUsed:       0,
+		Timestamp:     0,
+		ExtraData:     make([]byte, 0),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     make([]byte, fieldparams.RootLength),
+		Transactions:  make([][]byte, 0),
+		Withdrawals:   make([]*enginev1.Withdrawal, 0),
+	}, 0)
+	require.NoError(t, err)
+	return payload
+}
+
+func createWrappedPayloadHeaderDeneb(t testing.TB) interfaces.ExecutionData {
+	payload, err := blocks.WrappedExecutionPayloadHeaderDeneb(&enginev1.ExecutionPayloadHeaderDeneb{
+		ParentHash:       make([]byte, fieldparams.RootLength),
+		FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:        make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+		LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:       make([]byte, fieldparams.RootLength),
+		BlockNumber:      0,
+		GasLimit:         0,
+		GasUsed:          0,
+		Timestamp:        0,
+		ExtraData:        make([]byte, 0),
+		BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+		BlockHash:        make([]byte, fieldparams.RootLength),
+		TransactionsRoot: make([]byte, fieldparams.RootLength),
+		WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+	}, 0)
+	require.NoError(t, err)
+	return payload
+}
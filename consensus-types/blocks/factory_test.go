@@ -121,6 +121,43 @@ func Test_NewSignedBeaconBlock(t *testing.T) {
 		assert.Equal(t, version.Capella, b.Version())
 		assert.Equal(t, true, b.IsBlinded())
 	})
+	t.Run("GenericSignedBeaconBlock_Deneb", func(t *testing.T) {
+		pb := &eth.GenericSignedBeaconBlock_Deneb{
+			Deneb: &eth.SignedBeaconBlockContentsDeneb{
+				Block: &eth.SignedBeaconBlockDeneb{
+					Block: &eth.BeaconBlockDeneb{
+						Body: &eth.BeaconBlockBodyDeneb{}}}}}
+		b, err := NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+	})
+	t.Run("SignedBeaconBlockDeneb", func(t *testing.T) {
+		pb := &eth.SignedBeaconBlockDeneb{
+			Block: &eth.BeaconBlockDeneb{
+				Body: &eth.BeaconBlockBodyDeneb{}}}
+		b, err := NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+	})
+	t.Run("GenericSignedBeaconBlock_BlindedDeneb", func(t *testing.T) {
+		pb := &eth.GenericSignedBeaconBlock_BlindedDeneb{
+			BlindedDeneb: &eth.SignedBlindedBeaconBlockDeneb{
+				Block: &eth.BlindedBeaconBlockDeneb{
+					Body: &eth.BlindedBeaconBlockBodyDeneb{}}}}
+		b, err := NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+		assert.Equal(t, true, b.IsBlinded())
+	})
+	t.Run("SignedBlindedBeaconBlockDeneb", func(t *testing.T) {
+		pb := &eth.SignedBlindedBeaconBlockDeneb{
+			Block: &eth.BlindedBeaconBlockDeneb{
+				Body: &eth.BlindedBeaconBlockBodyDeneb{}}}
+		b, err := NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+		assert.Equal(t, true, b.IsBlinded())
+	})
 	t.Run("nil", func(t *testing.T) {
 		_, err := NewSignedBeaconBlock(nil)
 		assert.ErrorContains(t, "received nil object", err)
@@ -208,6 +245,32 @@ func Test_NewBeaconBlock(t *testing.T) {
 		assert.Equal(t, version.Capella, b.Version())
 		assert.Equal(t, true, b.IsBlinded())
 	})
+	t.Run("GenericBeaconBlock_Deneb", func(t *testing.T) {
+		pb := &eth.GenericBeaconBlock_Deneb{Deneb: &eth.BeaconBlockContentsDeneb{Block: &eth.BeaconBlockDeneb{Body: &eth.BeaconBlockBodyDeneb{}}}}
+		b, err := NewBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+	})
+	t.Run("BeaconBlockDeneb", func(t *testing.T) {
+		pb := &eth.BeaconBlockDeneb{Body: &eth.BeaconBlockBodyDeneb{}}
+		b, err := NewBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+	})
+	t.Run("GenericBeaconBlock_BlindedDeneb", func(t *testing.T) {
+		pb := &eth.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: &eth.BlindedBeaconBlockDeneb{Body: &eth.BlindedBeaconBlockBodyDeneb{}}}
+		b, err := NewBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+		assert.Equal(t, true, b.IsBlinded())
+	})
+	t.Run("BlindedBeaconBlockDeneb", func(t *testing.T) {
+		pb := &eth.BlindedBeaconBlockDeneb{Body: &eth.BlindedBeaconBlockBodyDeneb{}}
+		b, err := NewBeaconBlock(pb)
+		require.NoError(t, err)
+		assert.Equal(t, version.Deneb, b.Version())
+		assert.Equal(t, true, b.IsBlinded())
+	})
 	t.Run("nil", func(t *testing.T) {
 		_, err := NewBeaconBlock(nil)
 		assert.ErrorContains(t, "received nil object", err)
@@ -269,6 +332,23 @@ func Test_NewBeaconBlockBody(t *testing.T) {
 		assert.Equal(t, version.Capella, b.version)
 		assert.Equal(t, true, b.isBlinded)
 	})
+	t.Run("BeaconBlockBodyDeneb", func(t *testing.T) {
+		pb := &eth.BeaconBlockBodyDeneb{}
+		i, err := NewBeaconBlockBody(pb)
+		require.NoError(t, err)
+		b, ok := i.(*BeaconBlockBody)
+		require.Equal(t, true, ok)
+		assert.Equal(t, version.Deneb, b.version)
+	})
+	t.Run("BlindedBeaconBlockBodyDeneb", func(t *testing.T) {
+		pb := &eth.BlindedBeaconBlockBodyDeneb{}
+		i, err := NewBeaconBlockBody(pb)
+		require.NoError(t, err)
+		b, ok := i.(*BeaconBlockBody)
+		require.Equal(t, true, ok)
+		assert.Equal(t, version.Deneb, b.version)
+		assert.Equal(t, true, b.isBlinded)
+	})
 	t.Run("nil", func(t *testing.T) {
 		_, err := NewBeaconBlockBody(nil)
 		assert.ErrorContains(t, "received nil object", err)
@@ -325,6 +405,21 @@ func Test_BuildSignedBeaconBlock(t *testing.T) {
 		assert.Equal(t, version.Capella, sb.Version())
 		assert.Equal(t, true, sb.IsBlinded())
 	})
+	t.Run("Deneb", func(t *testing.T) {
+		b := &BeaconBlock{version: version.Deneb, body: &BeaconBlockBody{version: version.Deneb}}
+		sb, err := BuildSignedBeaconBlock(b, sig[:])
+		require.NoError(t, err)
+		assert.DeepEqual(t, sig, sb.Signature())
+		assert.Equal(t, version.Deneb, sb.Version())
+	})
+	t.Run("DenebBlind", func(t *testing.T) {
+		b := &BeaconBlock{version: version.Deneb, body: &BeaconBlockBody{version: version.Deneb, isBlinded: true}}
+		sb, err := BuildSignedBeaconBlock(b, sig[:])
+		require.NoError(t, err)
+		assert.DeepEqual(t, sig, sb.Signature())
+		assert.Equal(t, version.Deneb, sb.Version())
+		assert.Equal(t, true, sb.IsBlinded())
+	})
 }
 
 func TestBuildSignedBeaconBlockFromExecutionPayload(t *testing.T) {
@@ -401,4 +496,44 @@ func TestBuildSignedBeaconBlockFromExecutionPayload(t *testing.T) {
 		require.NoError(t, err)
 		require.DeepEqual(t, payload, got.Proto())
 	})
+	t.Run("deneb", func(t *testing.T) {
+		payload := &enginev1.ExecutionPayloadDeneb{
+			ParentHash:    make([]byte, fieldparams.RootLength),
+			FeeRecipient:  make([]byte, 20),
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, 256),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     make([]byte, fieldparams.RootLength),
+			Transactions:  make([][]byte, 0),
+			Withdrawals:   make([]*enginev1.Withdrawal, 0),
+			BlobGasUsed:   1,
+			ExcessBlobGas: 2,
+		}
+		wrapped, err := WrappedExecutionPayloadDeneb(payload, 0)
+		require.NoError(t, err)
+		header, err := PayloadToHeaderDeneb(wrapped)
+		require.NoError(t, err)
+		commitments := [][]byte{bytesutil.PadTo([]byte("commitment"), 48)}
+		blindedBlock := &eth.SignedBlindedBeaconBlockDeneb{
+			Block: &eth.BlindedBeaconBlockDeneb{
+				Body: &eth.BlindedBeaconBlockBodyDeneb{
+					SyncAggregate:      &eth.SyncAggregate{},
+					BlobKzgCommitments: commitments,
+				}}}
+		blindedBlock.Block.Body.ExecutionPayloadHeader = header
+
+		blk, err := NewSignedBeaconBlock(blindedBlock)
+		require.NoError(t, err)
+		builtBlock, err := BuildSignedBeaconBlockFromExecutionPayload(blk, payload)
+		require.NoError(t, err)
+
+		got, err := builtBlock.Block().Body().Execution()
+		require.NoError(t, err)
+		require.DeepEqual(t, payload, got.Proto())
+		gotCommitments, err := builtBlock.Block().Body().BlobKzgCommitments()
+		require.NoError(t, err)
+		require.DeepEqual(t, commitments, gotCommitments)
+	})
 }
@@ -380,6 +380,20 @@ func Test_BeaconBlockBody_BLSToExecutionChanges(t *testing.T) {
 	assert.DeepSSZEqual(t, result, changes)
 }
 
+func Test_BeaconBlockBody_BlobKzgCommitments(t *testing.T) {
+	commitments := [][]byte{[]byte("commitment1"), []byte("commitment2")}
+	bb := &SignedBeaconBlock{version: version.Deneb, block: &BeaconBlock{body: &BeaconBlockBody{version: version.Deneb}}}
+	require.NoError(t, bb.SetBlobKzgCommitments(commitments))
+	result, err := bb.Block().Body().BlobKzgCommitments()
+	require.NoError(t, err)
+	assert.DeepEqual(t, result, commitments)
+
+	bb = &SignedBeaconBlock{version: version.Capella, block: &BeaconBlock{body: &BeaconBlockBody{version: version.Capella}}}
+	require.ErrorContains(t, "not supported", bb.SetBlobKzgCommitments(commitments))
+	_, err = bb.Block().Body().BlobKzgCommitments()
+	require.ErrorContains(t, "not supported", err)
+}
+
 func Test_BeaconBlockBody_Execution(t *testing.T) {
 	execution := &pb.ExecutionPayload{BlockNumber: 1}
 	e, err := WrappedExecutionPayload(execution)
@@ -407,6 +421,24 @@ func Test_BeaconBlockBody_Execution(t *testing.T) {
 	result, err = bb.Block().Body().Execution()
 	require.NoError(t, err)
 	assert.DeepEqual(t, result, eCapellaHeader)
+
+	executionDeneb := &pb.ExecutionPayloadDeneb{BlockNumber: 1, ExcessBlobGas: 2}
+	eDeneb, err := WrappedExecutionPayloadDeneb(executionDeneb, 0)
+	require.NoError(t, err)
+	bb = &SignedBeaconBlock{version: version.Deneb, block: &BeaconBlock{body: &BeaconBlockBody{version: version.Deneb}}}
+	require.NoError(t, bb.SetExecution(eDeneb))
+	result, err = bb.Block().Body().Execution()
+	require.NoError(t, err)
+	assert.DeepEqual(t, result, eDeneb)
+
+	executionDenebHeader := &pb.ExecutionPayloadHeaderDeneb{BlockNumber: 1, ExcessBlobGas: 2}
+	eDenebHeader, err := WrappedExecutionPayloadHeaderDeneb(executionDenebHeader, 0)
+	require.NoError(t, err)
+	bb = &SignedBeaconBlock{version: version.Deneb, block: &BeaconBlock{version: version.Deneb, body: &BeaconBlockBody{version: version.Deneb, isBlinded: true}}}
+	require.NoError(t, bb.SetExecution(eDenebHeader))
+	result, err = bb.Block().Body().Execution()
+	require.NoError(t, err)
+	assert.DeepEqual(t, result, eDenebHeader)
 }
 
 func Test_BeaconBlockBody_HashTreeRoot(t *testing.T) {
@@ -0,0 +1,213 @@
+package blocks
+
+import (
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	field_params "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/container/trie"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+const (
+	// bodyLength is the number of fields of a Deneb beacon block body.
+	bodyLength = 12
+	// kzgPosition is the position of the blob KZG commitments field in a Deneb beacon block body.
+	kzgPosition = 11
+	// bodyDepth is the depth of the merkle tree built from the fields of a beacon block body.
+	bodyDepth = 4
+)
+
+var (
+	errInvalidIndex          = errors.New("index out of bounds")
+	errInvalidInclusionProof = errors.New("invalid KZG commitment inclusion proof")
+)
+
+// VerifyKZGInclusionProof verifies that the KZG commitment of a blob sidecar is included in the
+// body of the block described by the sidecar's signed block header.
+//
+// Spec code:
+// def verify_blob_sidecar_inclusion_proof(blob_sidecar: BlobSidecar) -> bool:
+//
+//	gindex = get_subtree_index(get_generalized_index(BeaconBlockBody, 'blob_kzg_commitments', blob_sidecar.index))
+//	return is_valid_merkle_branch(
+//	    leaf=hash_tree_root(blob_sidecar.kzg_commitment),
+//	    branch=blob_sidecar.kzg_commitment_inclusion_proof,
+//	    depth=KZG_COMMITMENT_INCLUSION_PROOF_DEPTH,
+//	    index=gindex,
+//	    root=blob_sidecar.signed_block_header.message.body_root,
+//	)
+func VerifyKZGInclusionProof(sc *ethpb.BlobSidecar) error {
+	if sc == nil || sc.SignedBlockHeader == nil || sc.SignedBlockHeader.Header == nil {
+		return ErrNilObject
+	}
+	if sc.Index >= field_params.MaxBlobCommitmentsPerBlock {
+		return errInvalidIndex
+	}
+	if len(sc.CommitmentInclusionProof) != field_params.KzgCommitmentInclusionProofDepth {
+		return errInvalidInclusionProof
+	}
+	leaf, err := kzgCommitmentRoot(sc.KzgCommitment)
+	if err != nil {
+		return err
+	}
+	// The commitment sits below the list data root (the left child of the list root, whose right child
+	// is the length mix-in), which itself is the field at kzgPosition within the body.
+	gindex := uint64(kzgPosition)<<(field_params.LogMaxBlobCommitments+1) | sc.Index
+	if !trie.VerifyMerkleProofWithDepth(sc.SignedBlockHeader.Header.BodyRoot, leaf[:], gindex, sc.CommitmentInclusionProof, field_params.KzgCommitmentInclusionProofDepth-1) {
+		return errInvalidInclusionProof
+	}
+	return nil
+}
+
+// MerkleProofKZGCommitment constructs the inclusion proof of the blob KZG commitment at the given index
+// against the hash tree root of the block body. The proof consists of the branch within the commitments
+// list, the list length mix-in and the branch within the body, in that order.
+func MerkleProofKZGCommitment(body interfaces.ReadOnlyBeaconBlockBody, index int) ([][]byte, error) {
+	commitments, err := body.BlobKzgCommitments()
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(commitments) {
+		return nil, errInvalidIndex
+	}
+	leaves := make([][]byte, len(commitments))
+	for i, c := range commitments {
+		r, err := kzgCommitmentRoot(c)
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = r[:]
+	}
+	commitmentsTrie, err := trie.GenerateTrieFromItems(leaves, field_params.LogMaxBlobCommitments)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := commitmentsTrie.MerkleProof(index)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := bodyFieldRoots(body)
+	if err != nil {
+		return nil, err
+	}
+	return append(proof, bodyBranch(fields, kzgPosition)...), nil
+}
+
+// kzgCommitmentRoot computes the hash tree root of a 48 byte KZG commitment.
+func kzgCommitmentRoot(commitment []byte) ([32]byte, error) {
+	if len(commitment) != field_params.BLSPubkeyLength {
+		return [32]byte{}, errors.Errorf("invalid KZG commitment length %d", len(commitment))
+	}
+	hh := ssz.NewHasher()
+	hh.PutBytes(commitment)
+	return hh.HashRoot()
+}
+
+// bodyFieldRoots computes the hash tree roots of each field of a Deneb beacon block body, in field order.
+func bodyFieldRoots(body interfaces.ReadOnlyBeaconBlockBody) ([][32]byte, error) {
+	cfg := params.BeaconConfig()
+	roots := make([][32]byte, bodyLength)
+	var err error
+
+	randao := body.RandaoReveal()
+	hh := ssz.NewHasher()
+	hh.PutBytes(randao[:])
+	if roots[0], err = hh.HashRoot(); err != nil {
+		return nil, err
+	}
+	if roots[1], err = body.Eth1Data().HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	roots[2] = body.Graffiti()
+	if roots[3], err = listRoot(body.ProposerSlashings(), cfg.MaxProposerSlashings); err != nil {
+		return nil, err
+	}
+	if roots[4], err = listRoot(body.AttesterSlashings(), cfg.MaxAttesterSlashings); err != nil {
+		return nil, err
+	}
+	if roots[5], err = listRoot(body.Attestations(), cfg.MaxAttestations); err != nil {
+		return nil, err
+	}
+	if roots[6], err = listRoot(body.Deposits(), cfg.MaxDeposits); err != nil {
+		return nil, err
+	}
+	if roots[7], err = listRoot(body.VoluntaryExits(), cfg.MaxVoluntaryExits); err != nil {
+		return nil, err
+	}
+	syncAggregate, err := body.SyncAggregate()
+	if err != nil {
+		return nil, err
+	}
+	if roots[8], err = syncAggregate.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	execution, err := body.Execution()
+	if err != nil {
+		return nil, err
+	}
+	if roots[9], err = execution.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	changes, err := body.BLSToExecutionChanges()
+	if err != nil {
+		return nil, err
+	}
+	if roots[10], err = listRoot(changes, cfg.MaxBlsToExecutionChanges); err != nil {
+		return nil, err
+	}
+	commitments, err := body.BlobKzgCommitments()
+	if err != nil {
+		return nil, err
+	}
+	hh = ssz.NewHasher()
+	indx := hh.Index()
+	for _, c := range commitments {
+		if len(c) != field_params.BLSPubkeyLength {
+			return nil, errors.Errorf("invalid KZG commitment length %d", len(c))
+		}
+		hh.PutBytes(c)
+	}
+	hh.MerkleizeWithMixin(indx, uint64(len(commitments)), field_params.MaxBlobCommitmentsPerBlock)
+	if roots[kzgPosition], err = hh.HashRoot(); err != nil {
+		return nil, err
+	}
+	return roots, nil
+}
+
+// listRoot computes the hash tree root of an SSZ list of containers with the given limit.
+func listRoot[T ssz.HashRoot](items []T, limit uint64) ([32]byte, error) {
+	if uint64(len(items)) > limit {
+		return [32]byte{}, ssz.ErrIncorrectListSize
+	}
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	for _, item := range items {
+		if err := item.HashTreeRootWith(hh); err != nil {
+			return [32]byte{}, err
+		}
+	}
+	hh.MerkleizeWithMixin(indx, uint64(len(items)), limit)
+	return hh.HashRoot()
+}
+
+// bodyBranch returns the merkle branch of the field at the given position, from the leaf level up,
+// in the tree built from the body field roots.
+func bodyBranch(fields [][32]byte, position int) [][]byte {
+	layer := make([][32]byte, 1<<bodyDepth)
+	copy(layer, fields)
+	branch := make([][]byte, 0, bodyDepth)
+	for d := 0; d < bodyDepth; d++ {
+		sibling := layer[position^1]
+		branch = append(branch, sibling[:])
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hash.Hash(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layer = next
+		position /= 2
+	}
+	return branch
+}
@@ -0,0 +1,96 @@
+package blocks
+
+import (
+	"crypto/rand"
+	"testing"
+
+	field_params "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func testDenebBody(t *testing.T, numCommitments int) *ethpb.BeaconBlockBodyDeneb {
+	commitments := make([][]byte, numCommitments)
+	for i := range commitments {
+		commitments[i] = make([]byte, field_params.BLSPubkeyLength)
+		_, err := rand.Read(commitments[i])
+		require.NoError(t, err)
+	}
+	return &ethpb.BeaconBlockBodyDeneb{
+		RandaoReveal: make([]byte, field_params.BLSSignatureLength),
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot: make([]byte, field_params.RootLength),
+			BlockHash:   make([]byte, field_params.RootLength),
+		},
+		Graffiti: make([]byte, field_params.RootLength),
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, field_params.SyncAggregateSyncCommitteeBytesLength),
+			SyncCommitteeSignature: make([]byte, field_params.BLSSignatureLength),
+		},
+		ExecutionPayload: &enginev1.ExecutionPayloadDeneb{
+			ParentHash:    make([]byte, field_params.RootLength),
+			FeeRecipient:  make([]byte, 20),
+			StateRoot:     make([]byte, field_params.RootLength),
+			ReceiptsRoot:  make([]byte, field_params.RootLength),
+			LogsBloom:     make([]byte, 256),
+			PrevRandao:    make([]byte, field_params.RootLength),
+			BaseFeePerGas: make([]byte, field_params.RootLength),
+			BlockHash:     make([]byte, field_params.RootLength),
+		},
+		BlobKzgCommitments: commitments,
+	}
+}
+
+func Test_bodyFieldRoots(t *testing.T) {
+	pb := testDenebBody(t, 3)
+	body, err := NewBeaconBlockBody(pb)
+	require.NoError(t, err)
+	fields, err := bodyFieldRoots(body)
+	require.NoError(t, err)
+
+	// Folding the branch of any field must lead to the body root.
+	want, err := pb.HashTreeRoot()
+	require.NoError(t, err)
+	branch := bodyBranch(fields, 0)
+	node := fields[0]
+	for _, sibling := range branch {
+		node = hash.Hash(append(node[:], sibling...))
+	}
+	require.Equal(t, want, node)
+}
+
+func TestMerkleProofKZGCommitment(t *testing.T) {
+	pb := testDenebBody(t, 4)
+	body, err := NewBeaconBlockBody(pb)
+	require.NoError(t, err)
+	bodyRoot, err := pb.HashTreeRoot()
+	require.NoError(t, err)
+
+	for i, c := range pb.BlobKzgCommitments {
+		proof, err := MerkleProofKZGCommitment(body, i)
+		require.NoError(t, err)
+		require.Equal(t, field_params.KzgCommitmentInclusionProofDepth, len(proof))
+		sc := &ethpb.BlobSidecar{
+			Index:                    uint64(i),
+			KzgCommitment:            c,
+			SignedBlockHeader:        &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{BodyRoot: bodyRoot[:]}},
+			CommitmentInclusionProof: proof,
+		}
+		require.NoError(t, VerifyKZGInclusionProof(sc))
+
+		sc.Index = uint64(i + 1)
+		require.ErrorIs(t, VerifyKZGInclusionProof(sc), errInvalidInclusionProof)
+	}
+
+	_, err = MerkleProofKZGCommitment(body, 4)
+	require.ErrorIs(t, err, errInvalidIndex)
+}
+
+func TestMerkleProofKZGCommitment_PreDeneb(t *testing.T) {
+	body, err := NewBeaconBlockBody(&ethpb.BeaconBlockBodyCapella{})
+	require.NoError(t, err)
+	_, err = MerkleProofKZGCommitment(body, 0)
+	require.ErrorContains(t, "not supported", err)
+}
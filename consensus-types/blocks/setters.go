@@ -125,3 +125,13 @@ func (b *SignedBeaconBlock) SetBLSToExecutionChanges(blsToExecutionChanges []*et
 	b.block.body.blsToExecutionChanges = blsToExecutionChanges
 	return nil
 }
+
+// SetBlobKzgCommitments sets the blob kzg commitments in the block.
+// This function is not thread safe, it is only used during block creation.
+func (b *SignedBeaconBlock) SetBlobKzgCommitments(c [][]byte) error {
+	if b.version < version.Deneb {
+		return consensus_types.ErrNotSupported("BlobKzgCommitments", b.version)
+	}
+	b.block.body.blobKzgCommitments = c
+	return nil
+}
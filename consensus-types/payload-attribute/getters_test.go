@@ -135,6 +135,61 @@ func TestPayloadAttributeGetters(t *testing.T) {
 				require.DeepEqual(t, p, got)
 			},
 		},
+		{
+			name: "Get parent beacon block root (capella)",
+			tc: func(t *testing.T) {
+				a := EmptyWithVersion(version.Capella)
+				_, err := a.ParentBeaconBlockRoot()
+				require.ErrorContains(t, "ParentBeaconBlockRoot is not supported for capella: unsupported getter", err)
+			},
+		},
+		{
+			name: "Get parent beacon block root (deneb)",
+			tc: func(t *testing.T) {
+				r := []byte{7, 8, 9}
+				a, err := New(&enginev1.PayloadAttributesV3{ParentBeaconBlockRoot: r})
+				require.NoError(t, err)
+				got, err := a.ParentBeaconBlockRoot()
+				require.NoError(t, err)
+				require.DeepEqual(t, r, got)
+			},
+		},
+		{
+			name: "Get PbDeneb (bad version)",
+			tc: func(t *testing.T) {
+				a, err := New(&enginev1.PayloadAttributesV2{})
+				require.NoError(t, err)
+				_, err = a.PbV3()
+				require.ErrorContains(t, "PayloadAttributePbV3 is not supported for capella: unsupported getter", err)
+			},
+		},
+		{
+			name: "Get PbDeneb (nil)",
+			tc: func(t *testing.T) {
+				a, err := New(&enginev1.PayloadAttributesV3{})
+				require.NoError(t, err)
+				got, err := a.PbV3()
+				require.NoError(t, err)
+				require.Equal(t, (*enginev1.PayloadAttributesV3)(nil), got)
+			},
+		},
+		{
+			name: "Get PbDeneb",
+			tc: func(t *testing.T) {
+				p := &enginev1.PayloadAttributesV3{
+					Timestamp:             1,
+					PrevRandao:            []byte{1, 2, 3},
+					SuggestedFeeRecipient: []byte{4, 5, 6},
+					Withdrawals:           []*enginev1.Withdrawal{{Index: 1}, {Index: 2}, {Index: 3}},
+					ParentBeaconBlockRoot: []byte{7, 8, 9},
+				}
+				a, err := New(p)
+				require.NoError(t, err)
+				got, err := a.PbV3()
+				require.NoError(t, err)
+				require.DeepEqual(t, p, got)
+			},
+		},
 	}
 
 	for _, test := range tests {
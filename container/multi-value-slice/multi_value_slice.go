@@ -99,7 +99,7 @@ import (
 
 // MultiValueSlice defines an abstraction over all concrete implementations of the generic Slice.
 type MultiValueSlice[O interfaces.Identifiable] interface {
-	Len(obj O) uuid.UUID
+	Len(obj O) int
 }
 
 // Value defines a single value along with one or more IDs that share this value.
@@ -470,6 +470,33 @@ func (s *Slice[V, O]) updateAppendedItem(obj O, index uint64, val V) error {
 	return nil
 }
 
+// ObjectItems is a read-only view of the items of a single object. It allows passing the items to code
+// that is not aware of the object owning them, without copying the items.
+type ObjectItems[V comparable, O interfaces.Identifiable] struct {
+	slice *Slice[V, O]
+	obj   O
+}
+
+// ItemsOf returns a view of the items of the input object.
+func (s *Slice[V, O]) ItemsOf(obj O) ObjectItems[V, O] {
+	return ObjectItems[V, O]{slice: s, obj: obj}
+}
+
+// Len returns the number of items of the object.
+func (i ObjectItems[V, O]) Len() int {
+	return i.slice.Len(i.obj)
+}
+
+// At returns the item of the object at the requested index.
+func (i ObjectItems[V, O]) At(index uint64) (V, error) {
+	return i.slice.At(i.obj, index)
+}
+
+// Value returns all items of the object.
+func (i ObjectItems[V, O]) Value() []V {
+	return i.slice.Value(i.obj)
+}
+
 func containsId(ids []uuid.UUID, wanted uuid.UUID) (int, bool) {
 	for i, id := range ids {
 		if id == wanted {
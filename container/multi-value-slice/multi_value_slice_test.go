@@ -345,6 +345,26 @@ func TestDetach(t *testing.T) {
 // Index 5: Different appended value
 // Index 6: Same appended value
 // Index 7: Appended value ONLY for the second object
+func TestItemsOf(t *testing.T) {
+	s := setup()
+	first := &testObject{id: id1, slice: s}
+	second := &testObject{id: id2, slice: s}
+
+	items := s.ItemsOf(first)
+	assert.Equal(t, 7, items.Len())
+	v, err := items.At(1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.DeepEqual(t, s.Value(first), items.Value())
+
+	items = s.ItemsOf(second)
+	assert.Equal(t, 8, items.Len())
+	v, err = items.At(7)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+	assert.DeepEqual(t, s.Value(second), items.Value())
+}
+
 func setup() *Slice[int, *testObject] {
 	s := &Slice[int, *testObject]{}
 	s.Init([]int{123, 123, 123, 123, 123})
@@ -0,0 +1,217 @@
+// Package kzg implements the KZG polynomial commitment checks of EIP-4844 blobs, as specified in
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/polynomial-commitments.md.
+package kzg
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sync"
+
+	GoKZG "github.com/crate-crypto/go-kzg-4844"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+)
+
+// numGoRoutines is the number of goroutines the underlying library may use to compute a commitment or proof.
+const numGoRoutines = 0
+
+var (
+	//go:embed trusted_setup.json
+	embeddedTrustedSetup []byte
+
+	defaultContext     *Context
+	defaultContextErr  error
+	defaultContextOnce sync.Once
+)
+
+var (
+	errInvalidBlobLength       = errors.New("invalid blob length")
+	errInvalidCommitmentLength = errors.New("invalid KZG commitment length")
+	errInvalidProofLength      = errors.New("invalid KZG proof length")
+	errBatchLengthMismatch     = errors.New("the number of blobs, commitments and proofs must be equal")
+	errIncompleteTrustedSetup  = errors.New("incomplete trusted setup")
+)
+
+// Verifier verifies that blobs match their KZG commitments.
+type Verifier interface {
+	VerifyBlobKZGProof(blob, commitment, proof []byte) error
+	VerifyBlobKZGProofBatch(blobs, commitments, proofs [][]byte) error
+}
+
+// Prover computes the KZG commitments and proofs of blobs.
+type Prover interface {
+	BlobToKZGCommitment(blob []byte) ([]byte, error)
+	ComputeBlobKZGProof(blob, commitment []byte) ([]byte, error)
+}
+
+// KZG is able to both compute and verify the KZG commitments and proofs of blobs.
+type KZG interface {
+	Verifier
+	Prover
+}
+
+var _ KZG = (*Context)(nil)
+
+// Context holds the precomputed trusted setup used to commit to and verify blobs.
+type Context struct {
+	ctx *GoKZG.Context
+}
+
+// NewContext creates a context from a trusted setup in the JSON format of the consensus specs. Parsing
+// the trusted setup takes a few seconds, contexts are meant to be created once and shared.
+func NewContext(trustedSetup []byte) (*Context, error) {
+	setup := &GoKZG.JSONTrustedSetup{}
+	if err := json.Unmarshal(trustedSetup, setup); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal trusted setup")
+	}
+	if !isComplete(setup) {
+		return nil, errIncompleteTrustedSetup
+	}
+	if err := GoKZG.CheckTrustedSetupIsWellFormed(setup); err != nil {
+		return nil, errors.Wrap(err, "malformed trusted setup")
+	}
+	ctx, err := GoKZG.NewContext4096(setup)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create KZG context")
+	}
+	return &Context{ctx: ctx}, nil
+}
+
+// Default returns the context created from the embedded trusted setup. The trusted setup is only
+// parsed on the first call, subsequent calls return the same context.
+func Default() (*Context, error) {
+	defaultContextOnce.Do(func() {
+		defaultContext, defaultContextErr = NewContext(embeddedTrustedSetup)
+	})
+	return defaultContext, defaultContextErr
+}
+
+// VerifyBlobKZGProof verifies that the blob is committed to by the given KZG commitment.
+//
+// Spec code:
+// def verify_blob_kzg_proof(blob: Blob,
+//
+//	                      commitment_bytes: Bytes48,
+//	                      proof_bytes: Bytes48) -> bool:
+//	"""
+//	Given a blob and a KZG proof, verify that the blob data corresponds to the provided commitment.
+//	"""
+func (c *Context) VerifyBlobKZGProof(blob, commitment, proof []byte) error {
+	b, err := toBlob(blob)
+	if err != nil {
+		return err
+	}
+	cm, err := toCommitment(commitment)
+	if err != nil {
+		return err
+	}
+	p, err := toProof(proof)
+	if err != nil {
+		return err
+	}
+	return c.ctx.VerifyBlobKZGProof(*b, cm, p)
+}
+
+// VerifyBlobKZGProofBatch verifies that each blob is committed to by the KZG commitment of the same index.
+// It is more efficient than verifying each blob on its own.
+//
+// Spec code:
+// def verify_blob_kzg_proof_batch(blobs: Sequence[Blob],
+//
+//	                            commitments_bytes: Sequence[Bytes48],
+//	                            proofs_bytes: Sequence[Bytes48]) -> bool:
+//	"""
+//	Given a list of blobs and blob KZG proofs, verify that they correspond to the provided commitments.
+//	"""
+func (c *Context) VerifyBlobKZGProofBatch(blobs, commitments, proofs [][]byte) error {
+	if len(blobs) != len(commitments) || len(blobs) != len(proofs) {
+		return errBatchLengthMismatch
+	}
+	bs := make([]GoKZG.Blob, len(blobs))
+	cms := make([]GoKZG.KZGCommitment, len(commitments))
+	ps := make([]GoKZG.KZGProof, len(proofs))
+	for i := range blobs {
+		b, err := toBlob(blobs[i])
+		if err != nil {
+			return err
+		}
+		bs[i] = *b
+		if cms[i], err = toCommitment(commitments[i]); err != nil {
+			return err
+		}
+		if ps[i], err = toProof(proofs[i]); err != nil {
+			return err
+		}
+	}
+	return c.ctx.VerifyBlobKZGProofBatch(bs, cms, ps)
+}
+
+// BlobToKZGCommitment computes the KZG commitment of the blob.
+func (c *Context) BlobToKZGCommitment(blob []byte) ([]byte, error) {
+	b, err := toBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := c.ctx.BlobToKZGCommitment(*b, numGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+	return commitment[:], nil
+}
+
+// ComputeBlobKZGProof computes the KZG proof that the blob is committed to by the given commitment.
+func (c *Context) ComputeBlobKZGProof(blob, commitment []byte) ([]byte, error) {
+	b, err := toBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	cm, err := toCommitment(commitment)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := c.ctx.ComputeBlobKZGProof(*b, cm, numGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+	return proof[:], nil
+}
+
+// isComplete checks that no point is missing from the trusted setup, the library panics on empty points.
+func isComplete(setup *GoKZG.JSONTrustedSetup) bool {
+	if len(setup.SetupG2) < 2 {
+		return false
+	}
+	for _, p := range setup.SetupG2 {
+		if p == "" {
+			return false
+		}
+	}
+	for i := range setup.SetupG1 {
+		if setup.SetupG1[i] == "" || setup.SetupG1Lagrange[i] == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// toBlob returns a pointer, blobs are too large to be copied around.
+func toBlob(b []byte) (*GoKZG.Blob, error) {
+	if len(b) != fieldparams.BlobLength {
+		return nil, errors.Wrapf(errInvalidBlobLength, "got %d bytes", len(b))
+	}
+	return (*GoKZG.Blob)(b), nil
+}
+
+func toCommitment(c []byte) (GoKZG.KZGCommitment, error) {
+	if len(c) != fieldparams.BLSPubkeyLength {
+		return GoKZG.KZGCommitment{}, errors.Wrapf(errInvalidCommitmentLength, "got %d bytes", len(c))
+	}
+	return GoKZG.KZGCommitment(c), nil
+}
+
+func toProof(p []byte) (GoKZG.KZGProof, error) {
+	if len(p) != fieldparams.BLSPubkeyLength {
+		return GoKZG.KZGProof{}, errors.Wrapf(errInvalidProofLength, "got %d bytes", len(p))
+	}
+	return GoKZG.KZGProof(p), nil
+}
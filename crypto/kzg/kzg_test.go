@@ -0,0 +1,82 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+// randomBlob returns a blob of random canonical field elements.
+func randomBlob(t *testing.T) []byte {
+	blob := make([]byte, fieldparams.BlobLength)
+	_, err := rand.Read(blob)
+	require.NoError(t, err)
+	// Clearing the most significant byte of each 32 byte element keeps it below the BLS modulus.
+	for i := 0; i < len(blob); i += 32 {
+		blob[i] = 0
+	}
+	return blob
+}
+
+func commitAndProve(t *testing.T, ctx *Context, blob []byte) ([]byte, []byte) {
+	commitment, err := ctx.BlobToKZGCommitment(blob)
+	require.NoError(t, err)
+	proof, err := ctx.ComputeBlobKZGProof(blob, commitment)
+	require.NoError(t, err)
+	return commitment, proof
+}
+
+func TestContext_VerifyBlobKZGProof(t *testing.T) {
+	ctx, err := Default()
+	require.NoError(t, err)
+	blob := randomBlob(t)
+	commitment, proof := commitAndProve(t, ctx, blob)
+
+	require.NoError(t, ctx.VerifyBlobKZGProof(blob, commitment, proof))
+
+	otherBlob := randomBlob(t)
+	require.NotNil(t, ctx.VerifyBlobKZGProof(otherBlob, commitment, proof))
+	_, otherProof := commitAndProve(t, ctx, otherBlob)
+	require.NotNil(t, ctx.VerifyBlobKZGProof(blob, commitment, otherProof))
+
+	require.ErrorIs(t, ctx.VerifyBlobKZGProof(blob[1:], commitment, proof), errInvalidBlobLength)
+	require.ErrorIs(t, ctx.VerifyBlobKZGProof(blob, commitment[1:], proof), errInvalidCommitmentLength)
+	require.ErrorIs(t, ctx.VerifyBlobKZGProof(blob, commitment, proof[1:]), errInvalidProofLength)
+}
+
+func TestContext_VerifyBlobKZGProofBatch(t *testing.T) {
+	ctx, err := Default()
+	require.NoError(t, err)
+	var blobs, commitments, proofs [][]byte
+	for i := 0; i < 3; i++ {
+		blob := randomBlob(t)
+		commitment, proof := commitAndProve(t, ctx, blob)
+		blobs = append(blobs, blob)
+		commitments = append(commitments, commitment)
+		proofs = append(proofs, proof)
+	}
+
+	require.NoError(t, ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs))
+	require.NoError(t, ctx.VerifyBlobKZGProofBatch(nil, nil, nil))
+	require.ErrorIs(t, ctx.VerifyBlobKZGProofBatch(blobs, commitments[1:], proofs), errBatchLengthMismatch)
+
+	proofs[0], proofs[1] = proofs[1], proofs[0]
+	require.NotNil(t, ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs))
+}
+
+func TestDefault(t *testing.T) {
+	ctx, err := Default()
+	require.NoError(t, err)
+	again, err := Default()
+	require.NoError(t, err)
+	require.Equal(t, ctx, again)
+}
+
+func TestNewContext_InvalidSetup(t *testing.T) {
+	_, err := NewContext([]byte("{}"))
+	require.ErrorIs(t, err, errIncompleteTrustedSetup)
+	_, err = NewContext([]byte("not json"))
+	require.ErrorContains(t, "could not unmarshal trusted setup", err)
+}
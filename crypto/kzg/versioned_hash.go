@@ -0,0 +1,30 @@
+package kzg
+
+import (
+	"crypto/sha256"
+)
+
+// VersionedHashVersionKZG is the version byte of the versioned hash of a KZG commitment.
+const VersionedHashVersionKZG byte = 0x01
+
+// ToVersionedHash computes the versioned hash of a KZG commitment, which is how the execution layer
+// refers to the blobs of a transaction.
+//
+// Spec code:
+// def kzg_commitment_to_versioned_hash(kzg_commitment: KZGCommitment) -> VersionedHash:
+//
+//	return VERSIONED_HASH_VERSION_KZG + hash(kzg_commitment)[1:]
+func ToVersionedHash(commitment []byte) [32]byte {
+	h := sha256.Sum256(commitment)
+	h[0] = VersionedHashVersionKZG
+	return h
+}
+
+// ToVersionedHashes computes the versioned hashes of a list of KZG commitments.
+func ToVersionedHashes(commitments [][]byte) [][32]byte {
+	hashes := make([][32]byte, len(commitments))
+	for i, c := range commitments {
+		hashes[i] = ToVersionedHash(c)
+	}
+	return hashes
+}
@@ -0,0 +1,22 @@
+package kzg
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestToVersionedHash(t *testing.T) {
+	commitment := make([]byte, 48)
+	commitment[0] = 0xc0
+	h := ToVersionedHash(commitment)
+	want := sha256.Sum256(commitment)
+	require.Equal(t, VersionedHashVersionKZG, h[0])
+	require.DeepEqual(t, want[1:], h[1:])
+
+	hashes := ToVersionedHashes([][]byte{commitment, make([]byte, 48)})
+	require.Equal(t, 2, len(hashes))
+	require.Equal(t, h, hashes[0])
+	require.Equal(t, 0, len(ToVersionedHashes(nil)))
+}
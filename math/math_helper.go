@@ -28,6 +28,7 @@ var (
 	ErrMulOverflow  = errors.New("multiplication overflows")
 	ErrAddOverflow  = errors.New("addition overflows")
 	ErrSubUnderflow = errors.New("subtraction underflow")
+	ErrInvalidWei   = errors.New("wei amount must be a non-negative decimal integer")
 
 	// Sensible guess for 500 000 validators
 	cachedSquareRoot = struct {
@@ -224,3 +225,16 @@ func WeiToGwei(v *big.Int) uint64 {
 	copied.Div(copied, gweiPerEth)
 	return copied.Uint64()
 }
+
+// ParseWei parses an amount of wei given as a decimal string, which may exceed the range of a uint64.
+// An empty string is parsed as zero.
+func ParseWei(s string) (*big.Int, error) {
+	if s == "" {
+		return big.NewInt(0), nil
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 {
+		return nil, ErrInvalidWei
+	}
+	return v, nil
+}
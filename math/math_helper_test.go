@@ -575,3 +575,27 @@ func TestWeiToGwei_CopyOk(t *testing.T) {
 	require.Equal(t, uint64(1), got)
 	require.Equal(t, big.NewInt(1e9).Uint64(), v.Uint64())
 }
+
+func TestParseWei(t *testing.T) {
+	tests := []struct {
+		s    string
+		want *big.Int
+		err  error
+	}{
+		{"", big.NewInt(0), nil},
+		{"1000000000", big.NewInt(1e9), nil},
+		{"100000000000000000000", new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100)), nil},
+		{"-1", nil, math.ErrInvalidWei},
+		{"0x10", nil, math.ErrInvalidWei},
+		{"1.5", nil, math.ErrInvalidWei},
+	}
+	for _, tt := range tests {
+		got, err := math.ParseWei(tt.s)
+		if tt.err != nil {
+			require.ErrorIs(t, err, tt.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, 0, tt.want.Cmp(got), "ParseWei(%q) = %s, want %s", tt.s, got, tt.want)
+	}
+}
@@ -42,6 +42,26 @@ func TestJsonMarshalUnmarshal(t *testing.T) {
 		require.DeepEqual(t, random, payloadPb.PrevRandao)
 		require.DeepEqual(t, feeRecipient, payloadPb.SuggestedFeeRecipient)
 	})
+	t.Run("payload attributes V3", func(t *testing.T) {
+		random := bytesutil.PadTo([]byte("random"), fieldparams.RootLength)
+		feeRecipient := bytesutil.PadTo([]byte("feeRecipient"), fieldparams.FeeRecipientLength)
+		parentRoot := bytesutil.PadTo([]byte("parentRoot"), fieldparams.RootLength)
+		jsonPayload := &enginev1.PayloadAttributesV3{
+			Timestamp:             1,
+			PrevRandao:            random,
+			SuggestedFeeRecipient: feeRecipient,
+			Withdrawals:           []*enginev1.Withdrawal{},
+			ParentBeaconBlockRoot: parentRoot,
+		}
+		enc, err := json.Marshal(jsonPayload)
+		require.NoError(t, err)
+		payloadPb := &enginev1.PayloadAttributesV3{}
+		require.NoError(t, json.Unmarshal(enc, payloadPb))
+		require.DeepEqual(t, uint64(1), payloadPb.Timestamp)
+		require.DeepEqual(t, random, payloadPb.PrevRandao)
+		require.DeepEqual(t, feeRecipient, payloadPb.SuggestedFeeRecipient)
+		require.DeepEqual(t, parentRoot, payloadPb.ParentBeaconBlockRoot)
+	})
 	t.Run("payload status", func(t *testing.T) {
 		hash := bytesutil.PadTo([]byte("hash"), fieldparams.RootLength)
 		jsonPayload := &enginev1.PayloadStatus{
@@ -205,6 +225,88 @@ func TestJsonMarshalUnmarshal(t *testing.T) {
 		require.DeepEqual(t, bytesutil.PadTo([]byte("address"), 20), withdrawal.Address)
 		require.Equal(t, uint64(1), withdrawal.Amount)
 	})
+	t.Run("execution payload Deneb", func(t *testing.T) {
+		parentHash := common.BytesToHash([]byte("parent"))
+		feeRecipient := common.BytesToAddress([]byte("feeRecipient"))
+		stateRoot := common.BytesToHash([]byte("stateRoot"))
+		receiptsRoot := common.BytesToHash([]byte("receiptsRoot"))
+		logsBloom := hexutil.Bytes(bytesutil.PadTo([]byte("logs"), fieldparams.LogsBloomLength))
+		random := common.BytesToHash([]byte("random"))
+		extra := common.BytesToHash([]byte("extra"))
+		hash := common.BytesToHash([]byte("hash"))
+		bn := hexutil.Uint64(1)
+		gl := hexutil.Uint64(2)
+		gu := hexutil.Uint64(3)
+		ts := hexutil.Uint64(4)
+		bgu := hexutil.Uint64(5)
+		ebg := hexutil.Uint64(6)
+
+		resp := &enginev1.GetPayloadV3ResponseJson{
+			BlockValue: fmt.Sprint("0x123"),
+			ExecutionPayload: &enginev1.ExecutionPayloadDenebJSON{
+				ParentHash:    &parentHash,
+				FeeRecipient:  &feeRecipient,
+				StateRoot:     &stateRoot,
+				ReceiptsRoot:  &receiptsRoot,
+				LogsBloom:     &logsBloom,
+				PrevRandao:    &random,
+				BlockNumber:   &bn,
+				GasLimit:      &gl,
+				GasUsed:       &gu,
+				Timestamp:     &ts,
+				ExtraData:     hexutil.Bytes(extra[:]),
+				BaseFeePerGas: "0x123",
+				BlockHash:     &hash,
+				Transactions:  []hexutil.Bytes{{}},
+				Withdrawals: []*enginev1.Withdrawal{{
+					Index:          1,
+					ValidatorIndex: 1,
+					Address:        bytesutil.PadTo([]byte("address"), 20),
+					Amount:         1,
+				}},
+				BlobGasUsed:   &bgu,
+				ExcessBlobGas: &ebg,
+			},
+			BlobsBundle: &enginev1.BlobBundleJSON{
+				Commitments: []hexutil.Bytes{bytesutil.PadTo([]byte("commitment"), 48)},
+				Proofs:      []hexutil.Bytes{bytesutil.PadTo([]byte("proof"), 48)},
+				Blobs:       []hexutil.Bytes{bytesutil.PadTo([]byte("blob"), fieldparams.BlobLength)},
+			},
+			ShouldOverrideBuilder: true,
+		}
+		enc, err := json.Marshal(resp)
+		require.NoError(t, err)
+		pb := &enginev1.ExecutionPayloadDenebWithValueAndBlobsBundle{}
+		require.NoError(t, json.Unmarshal(enc, pb))
+		require.DeepEqual(t, parentHash.Bytes(), pb.Payload.ParentHash)
+		require.DeepEqual(t, feeRecipient.Bytes(), pb.Payload.FeeRecipient)
+		require.DeepEqual(t, stateRoot.Bytes(), pb.Payload.StateRoot)
+		require.DeepEqual(t, receiptsRoot.Bytes(), pb.Payload.ReceiptsRoot)
+		require.DeepEqual(t, logsBloom, hexutil.Bytes(pb.Payload.LogsBloom))
+		require.DeepEqual(t, random.Bytes(), pb.Payload.PrevRandao)
+		require.DeepEqual(t, uint64(1), pb.Payload.BlockNumber)
+		require.DeepEqual(t, uint64(2), pb.Payload.GasLimit)
+		require.DeepEqual(t, uint64(3), pb.Payload.GasUsed)
+		require.DeepEqual(t, uint64(4), pb.Payload.Timestamp)
+		require.DeepEqual(t, uint64(5), pb.Payload.BlobGasUsed)
+		require.DeepEqual(t, uint64(6), pb.Payload.ExcessBlobGas)
+		require.DeepEqual(t, extra.Bytes(), pb.Payload.ExtraData)
+		require.DeepEqual(t, hash.Bytes(), pb.Payload.BlockHash)
+		require.DeepEqual(t, [][]byte{{}}, pb.Payload.Transactions)
+		require.Equal(t, 1, len(pb.Payload.Withdrawals))
+		require.Equal(t, 1, len(pb.BlobsBundle.KzgCommitments))
+		require.DeepEqual(t, bytesutil.PadTo([]byte("commitment"), 48), pb.BlobsBundle.KzgCommitments[0])
+		require.DeepEqual(t, bytesutil.PadTo([]byte("proof"), 48), pb.BlobsBundle.Proofs[0])
+		require.Equal(t, fieldparams.BlobLength, len(pb.BlobsBundle.Blobs[0]))
+		require.Equal(t, true, pb.ShouldOverrideBuilder)
+
+		enc, err = json.Marshal(pb.Payload)
+		require.NoError(t, err)
+		payload := &enginev1.ExecutionPayloadDenebJSON{}
+		require.NoError(t, json.Unmarshal(enc, payload))
+		require.Equal(t, bgu, *payload.BlobGasUsed)
+		require.Equal(t, ebg, *payload.ExcessBlobGas)
+	})
 	t.Run("execution block", func(t *testing.T) {
 		baseFeePerGas := big.NewInt(1770307273)
 		want := &gethtypes.Header{
@@ -0,0 +1,711 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.15.8
+// source: proto/prysm/v1alpha1/forkchoice.proto
+
+package eth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ForkChoiceSnapshot is a compact snapshot of the fork choice store, persisted so
+// that the store can be restored after a restart instead of being rebuilt from
+// the finalized checkpoint.
+type ForkChoiceSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JustifiedCheckpoint           *Checkpoint `protobuf:"bytes,1,opt,name=justified_checkpoint,json=justifiedCheckpoint,proto3" json:"justified_checkpoint,omitempty"`
+	UnrealizedJustifiedCheckpoint *Checkpoint `protobuf:"bytes,2,opt,name=unrealized_justified_checkpoint,json=unrealizedJustifiedCheckpoint,proto3" json:"unrealized_justified_checkpoint,omitempty"`
+	UnrealizedFinalizedCheckpoint *Checkpoint `protobuf:"bytes,3,opt,name=unrealized_finalized_checkpoint,json=unrealizedFinalizedCheckpoint,proto3" json:"unrealized_finalized_checkpoint,omitempty"`
+	PreviousJustifiedCheckpoint   *Checkpoint `protobuf:"bytes,4,opt,name=previous_justified_checkpoint,json=previousJustifiedCheckpoint,proto3" json:"previous_justified_checkpoint,omitempty"`
+	FinalizedCheckpoint           *Checkpoint `protobuf:"bytes,5,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3" json:"finalized_checkpoint,omitempty"`
+	ProposerBoostRoot             []byte      `protobuf:"bytes,6,opt,name=proposer_boost_root,json=proposerBoostRoot,proto3" json:"proposer_boost_root,omitempty"`
+	PreviousProposerBoostRoot     []byte      `protobuf:"bytes,7,opt,name=previous_proposer_boost_root,json=previousProposerBoostRoot,proto3" json:"previous_proposer_boost_root,omitempty"`
+	PreviousProposerBoostScore    uint64      `protobuf:"varint,8,opt,name=previous_proposer_boost_score,json=previousProposerBoostScore,proto3" json:"previous_proposer_boost_score,omitempty"`
+	OriginRoot                    []byte      `protobuf:"bytes,9,opt,name=origin_root,json=originRoot,proto3" json:"origin_root,omitempty"`
+	GenesisTime                   uint64      `protobuf:"varint,10,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	HeadRoot                      []byte      `protobuf:"bytes,11,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"`
+	// Nodes of the store, every node is listed after its parent.
+	Nodes []*ForkChoiceSnapshotNode `protobuf:"bytes,12,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Distinct roots the validators voted for, referenced by index from the votes.
+	VoteRoots        [][]byte `protobuf:"bytes,13,rep,name=vote_roots,json=voteRoots,proto3" json:"vote_roots,omitempty"`
+	VoteCurrentRoots []uint32 `protobuf:"varint,14,rep,packed,name=vote_current_roots,json=voteCurrentRoots,proto3" json:"vote_current_roots,omitempty"`
+	VoteNextRoots    []uint32 `protobuf:"varint,15,rep,packed,name=vote_next_roots,json=voteNextRoots,proto3" json:"vote_next_roots,omitempty"`
+	VoteNextEpochs   []uint64 `protobuf:"varint,16,rep,packed,name=vote_next_epochs,json=voteNextEpochs,proto3" json:"vote_next_epochs,omitempty"`
+	// Validator balances last accounted in the votes.
+	Balances []uint64 `protobuf:"varint,17,rep,packed,name=balances,proto3" json:"balances,omitempty"`
+	// Hash of the justified balances, used to validate the snapshot on restore.
+	JustifiedBalancesRoot []byte   `protobuf:"bytes,18,opt,name=justified_balances_root,json=justifiedBalancesRoot,proto3" json:"justified_balances_root,omitempty"`
+	SlashedIndices        []uint64 `protobuf:"varint,19,rep,packed,name=slashed_indices,json=slashedIndices,proto3" json:"slashed_indices,omitempty"`
+}
+
+func (x *ForkChoiceSnapshot) Reset() {
+	*x = ForkChoiceSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkChoiceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkChoiceSnapshot) ProtoMessage() {}
+
+func (x *ForkChoiceSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkChoiceSnapshot.ProtoReflect.Descriptor instead.
+func (*ForkChoiceSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_forkchoice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForkChoiceSnapshot) GetJustifiedCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.JustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetUnrealizedJustifiedCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.UnrealizedJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetUnrealizedFinalizedCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.UnrealizedFinalizedCheckpoint
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetPreviousJustifiedCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.PreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetFinalizedCheckpoint() *Checkpoint {
+	if x != nil {
+		return x.FinalizedCheckpoint
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetProposerBoostRoot() []byte {
+	if x != nil {
+		return x.ProposerBoostRoot
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetPreviousProposerBoostRoot() []byte {
+	if x != nil {
+		return x.PreviousProposerBoostRoot
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetPreviousProposerBoostScore() uint64 {
+	if x != nil {
+		return x.PreviousProposerBoostScore
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshot) GetOriginRoot() []byte {
+	if x != nil {
+		return x.OriginRoot
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetGenesisTime() uint64 {
+	if x != nil {
+		return x.GenesisTime
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshot) GetHeadRoot() []byte {
+	if x != nil {
+		return x.HeadRoot
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetNodes() []*ForkChoiceSnapshotNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetVoteRoots() [][]byte {
+	if x != nil {
+		return x.VoteRoots
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetVoteCurrentRoots() []uint32 {
+	if x != nil {
+		return x.VoteCurrentRoots
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetVoteNextRoots() []uint32 {
+	if x != nil {
+		return x.VoteNextRoots
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetVoteNextEpochs() []uint64 {
+	if x != nil {
+		return x.VoteNextEpochs
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetBalances() []uint64 {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetJustifiedBalancesRoot() []byte {
+	if x != nil {
+		return x.JustifiedBalancesRoot
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshot) GetSlashedIndices() []uint64 {
+	if x != nil {
+		return x.SlashedIndices
+	}
+	return nil
+}
+
+// ForkChoiceSnapshotNode is a block node of the fork choice store.
+type ForkChoiceSnapshotNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot                     uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                     []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	ParentRoot               []byte `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	PayloadHash              []byte `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	JustifiedEpoch           uint64 `protobuf:"varint,5,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	UnrealizedJustifiedEpoch uint64 `protobuf:"varint,6,opt,name=unrealized_justified_epoch,json=unrealizedJustifiedEpoch,proto3" json:"unrealized_justified_epoch,omitempty"`
+	FinalizedEpoch           uint64 `protobuf:"varint,7,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	UnrealizedFinalizedEpoch uint64 `protobuf:"varint,8,opt,name=unrealized_finalized_epoch,json=unrealizedFinalizedEpoch,proto3" json:"unrealized_finalized_epoch,omitempty"`
+	Balance                  uint64 `protobuf:"varint,9,opt,name=balance,proto3" json:"balance,omitempty"`
+	Optimistic               bool   `protobuf:"varint,10,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
+	Timestamp                uint64 `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ForkChoiceSnapshotNode) Reset() {
+	*x = ForkChoiceSnapshotNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkChoiceSnapshotNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkChoiceSnapshotNode) ProtoMessage() {}
+
+func (x *ForkChoiceSnapshotNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkChoiceSnapshotNode.ProtoReflect.Descriptor instead.
+func (*ForkChoiceSnapshotNode) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_forkchoice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForkChoiceSnapshotNode) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshotNode) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshotNode) GetParentRoot() []byte {
+	if x != nil {
+		return x.ParentRoot
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshotNode) GetPayloadHash() []byte {
+	if x != nil {
+		return x.PayloadHash
+	}
+	return nil
+}
+
+func (x *ForkChoiceSnapshotNode) GetJustifiedEpoch() uint64 {
+	if x != nil {
+		return x.JustifiedEpoch
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshotNode) GetUnrealizedJustifiedEpoch() uint64 {
+	if x != nil {
+		return x.UnrealizedJustifiedEpoch
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshotNode) GetFinalizedEpoch() uint64 {
+	if x != nil {
+		return x.FinalizedEpoch
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshotNode) GetUnrealizedFinalizedEpoch() uint64 {
+	if x != nil {
+		return x.UnrealizedFinalizedEpoch
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshotNode) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ForkChoiceSnapshotNode) GetOptimistic() bool {
+	if x != nil {
+		return x.Optimistic
+	}
+	return false
+}
+
+func (x *ForkChoiceSnapshotNode) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// LateBlockReorgEvent is a decision of the late block reorg heuristic on
+// whether a proposer orphans the head of fork choice.
+type LateBlockReorgEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stage of the decision, "fcu" when withholding the forkchoice update of
+	// the head in the slot before proposing, "proposal" when choosing the
+	// parent of the proposed block.
+	Stage        string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	ProposalSlot uint64 `protobuf:"varint,2,opt,name=proposal_slot,json=proposalSlot,proto3" json:"proposal_slot,omitempty"`
+	HeadRoot     []byte `protobuf:"bytes,3,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"`
+	HeadSlot     uint64 `protobuf:"varint,4,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	ParentRoot   []byte `protobuf:"bytes,5,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	HeadWeight   uint64 `protobuf:"varint,6,opt,name=head_weight,json=headWeight,proto3" json:"head_weight,omitempty"`
+	ParentWeight uint64 `protobuf:"varint,7,opt,name=parent_weight,json=parentWeight,proto3" json:"parent_weight,omitempty"`
+	// Weight of a committee, the reorg thresholds are percentages of it.
+	CommitteeWeight uint64 `protobuf:"varint,8,opt,name=committee_weight,json=committeeWeight,proto3" json:"committee_weight,omitempty"`
+	Reorg           bool   `protobuf:"varint,9,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	// Reason for which the head is not orphaned, empty when it is.
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *LateBlockReorgEvent) Reset() {
+	*x = LateBlockReorgEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LateBlockReorgEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LateBlockReorgEvent) ProtoMessage() {}
+
+func (x *LateBlockReorgEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LateBlockReorgEvent.ProtoReflect.Descriptor instead.
+func (*LateBlockReorgEvent) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_forkchoice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LateBlockReorgEvent) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *LateBlockReorgEvent) GetProposalSlot() uint64 {
+	if x != nil {
+		return x.ProposalSlot
+	}
+	return 0
+}
+
+func (x *LateBlockReorgEvent) GetHeadRoot() []byte {
+	if x != nil {
+		return x.HeadRoot
+	}
+	return nil
+}
+
+func (x *LateBlockReorgEvent) GetHeadSlot() uint64 {
+	if x != nil {
+		return x.HeadSlot
+	}
+	return 0
+}
+
+func (x *LateBlockReorgEvent) GetParentRoot() []byte {
+	if x != nil {
+		return x.ParentRoot
+	}
+	return nil
+}
+
+func (x *LateBlockReorgEvent) GetHeadWeight() uint64 {
+	if x != nil {
+		return x.HeadWeight
+	}
+	return 0
+}
+
+func (x *LateBlockReorgEvent) GetParentWeight() uint64 {
+	if x != nil {
+		return x.ParentWeight
+	}
+	return 0
+}
+
+func (x *LateBlockReorgEvent) GetCommitteeWeight() uint64 {
+	if x != nil {
+		return x.CommitteeWeight
+	}
+	return 0
+}
+
+func (x *LateBlockReorgEvent) GetReorg() bool {
+	if x != nil {
+		return x.Reorg
+	}
+	return false
+}
+
+func (x *LateBlockReorgEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_proto_prysm_v1alpha1_forkchoice_proto protoreflect.FileDescriptor
+
+var file_proto_prysm_v1alpha1_forkchoice_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x66, 0x6f, 0x72, 0x6b, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x26,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x08, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x54, 0x0a,
+	0x14, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x13,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x69, 0x0a, 0x1f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x1d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x69,
+	0x0a, 0x1f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x1d, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x65, 0x0a, 0x1d, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x1b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x54, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x73, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x73,
+	0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x41, 0x0a, 0x1d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f,
+	0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x6f, 0x74,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x4e, 0x65, 0x78, 0x74,
+	0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0e, 0x76, 0x6f, 0x74, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0xaa, 0x03, 0x0a,
+	0x16, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x1a,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x18, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x1a, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xca, 0x02, 0x0a, 0x13, 0x4c, 0x61,
+	0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x68, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x61,
+	0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x65,
+	0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x65,
+	0x61, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x6f, 0x72,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x9a, 0x01, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x42, 0x0f, 0x46, 0x6f, 0x72, 0x6b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x34, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b,
+	0x65, 0x74, 0x68, 0xaa, 0x02, 0x15, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45,
+	0x74, 0x68, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x15, 0x45, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_prysm_v1alpha1_forkchoice_proto_rawDescOnce sync.Once
+	file_proto_prysm_v1alpha1_forkchoice_proto_rawDescData = file_proto_prysm_v1alpha1_forkchoice_proto_rawDesc
+)
+
+func file_proto_prysm_v1alpha1_forkchoice_proto_rawDescGZIP() []byte {
+	file_proto_prysm_v1alpha1_forkchoice_proto_rawDescOnce.Do(func() {
+		file_proto_prysm_v1alpha1_forkchoice_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_prysm_v1alpha1_forkchoice_proto_rawDescData)
+	})
+	return file_proto_prysm_v1alpha1_forkchoice_proto_rawDescData
+}
+
+var file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_prysm_v1alpha1_forkchoice_proto_goTypes = []interface{}{
+	(*ForkChoiceSnapshot)(nil),     // 0: ethereum.eth.v1alpha1.ForkChoiceSnapshot
+	(*ForkChoiceSnapshotNode)(nil), // 1: ethereum.eth.v1alpha1.ForkChoiceSnapshotNode
+	(*LateBlockReorgEvent)(nil),    // 2: ethereum.eth.v1alpha1.LateBlockReorgEvent
+	(*Checkpoint)(nil),             // 3: ethereum.eth.v1alpha1.Checkpoint
+}
+var file_proto_prysm_v1alpha1_forkchoice_proto_depIdxs = []int32{
+	3, // 0: ethereum.eth.v1alpha1.ForkChoiceSnapshot.justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	3, // 1: ethereum.eth.v1alpha1.ForkChoiceSnapshot.unrealized_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	3, // 2: ethereum.eth.v1alpha1.ForkChoiceSnapshot.unrealized_finalized_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	3, // 3: ethereum.eth.v1alpha1.ForkChoiceSnapshot.previous_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	3, // 4: ethereum.eth.v1alpha1.ForkChoiceSnapshot.finalized_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	1, // 5: ethereum.eth.v1alpha1.ForkChoiceSnapshot.nodes:type_name -> ethereum.eth.v1alpha1.ForkChoiceSnapshotNode
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_prysm_v1alpha1_forkchoice_proto_init() }
+func file_proto_prysm_v1alpha1_forkchoice_proto_init() {
+	if File_proto_prysm_v1alpha1_forkchoice_proto != nil {
+		return
+	}
+	file_proto_prysm_v1alpha1_attestation_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkChoiceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkChoiceSnapshotNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LateBlockReorgEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_prysm_v1alpha1_forkchoice_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_prysm_v1alpha1_forkchoice_proto_goTypes,
+		DependencyIndexes: file_proto_prysm_v1alpha1_forkchoice_proto_depIdxs,
+		MessageInfos:      file_proto_prysm_v1alpha1_forkchoice_proto_msgTypes,
+	}.Build()
+	File_proto_prysm_v1alpha1_forkchoice_proto = out.File
+	file_proto_prysm_v1alpha1_forkchoice_proto_rawDesc = nil
+	file_proto_prysm_v1alpha1_forkchoice_proto_goTypes = nil
+	file_proto_prysm_v1alpha1_forkchoice_proto_depIdxs = nil
+}
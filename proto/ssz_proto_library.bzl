@@ -21,6 +21,9 @@ mainnet = {
     "sync_committee_aggregate_bytes.size": "16",
     "sync_committee_aggregate_bits.type": "github.com/prysmaticlabs/go-bitfield.Bitvector128",
     "withdrawal.size": "16",
+    "max_blobs_per_block.size": "6",
+    "max_blob_commitments.size": "4096",
+    "kzg_commitment_inclusion_proof_depth.size": "17",
 }
 
 minimal = {
@@ -37,6 +40,9 @@ minimal = {
     "sync_committee_aggregate_bytes.size": "1",
     "sync_committee_aggregate_bits.type": "github.com/prysmaticlabs/go-bitfield.Bitvector8",
     "withdrawal.size": "4",
+    "max_blobs_per_block.size": "6",
+    "max_blob_commitments.size": "16",
+    "kzg_commitment_inclusion_proof_depth.size": "9",
 }
 
 ###### Rules definitions #######
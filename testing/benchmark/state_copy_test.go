@@ -0,0 +1,108 @@
+package benchmark
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+// statesKept is the number of state copies kept in memory by the copy benchmarks, roughly the number of
+// states held by the state caches of a beacon node.
+const statesKept = 64
+
+// validatorsUpdated is the number of validators and balances changed by each state copy.
+const validatorsUpdated = 128
+
+// BenchmarkStateCopy_Default and BenchmarkStateCopy_ExperimentalState compare the memory used by state copies
+// which change a few validators and balances. Run them with:
+//
+//	go test ./testing/benchmark -run=^$ -bench=BenchmarkStateCopy -benchmem
+//
+// The heap-B metric is the heap in use by the kept copies after a garbage collection.
+func BenchmarkStateCopy_Default(b *testing.B) {
+	benchmarkStateCopy(b, false)
+}
+
+func BenchmarkStateCopy_ExperimentalState(b *testing.B) {
+	benchmarkStateCopy(b, true)
+}
+
+func benchmarkStateCopy(b *testing.B, experimental bool) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableExperimentalState: experimental})
+	defer resetCfg()
+	st := newCopyBenchmarkState(b)
+
+	var before runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	kept := make([]state.BeaconState, 0, statesKept)
+	for i := 0; i < b.N; i++ {
+		cp := st.Copy()
+		for j := 0; j < validatorsUpdated; j++ {
+			idx := primitives.ValidatorIndex((i*validatorsUpdated + j) % int(ValidatorCount))
+			val, err := cp.ValidatorAtIndex(idx)
+			require.NoError(b, err)
+			val.EffectiveBalance--
+			require.NoError(b, cp.UpdateValidatorAtIndex(idx, val))
+			require.NoError(b, cp.UpdateBalancesAtIndex(idx, val.EffectiveBalance))
+		}
+		require.NoError(b, cp.UpdateRandaoMixesAtIndex(uint64(i%fieldparams.RandaoMixesLength), bytesutil.PadTo([]byte{byte(i)}, 32)))
+		if len(kept) == statesKept {
+			kept = kept[1:]
+		}
+		kept = append(kept, cp)
+	}
+	b.StopTimer()
+
+	var after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	if after.HeapInuse > before.HeapInuse {
+		b.ReportMetric(float64(after.HeapInuse-before.HeapInuse), "heap-B")
+	}
+	runtime.KeepAlive(kept)
+}
+
+// newCopyBenchmarkState returns an Altair state with ValidatorCount validators. The validators are not valid,
+// the state is only meant to be copied and updated.
+func newCopyBenchmarkState(b *testing.B) state.BeaconState {
+	vals := make([]*ethpb.Validator, ValidatorCount)
+	bals := make([]uint64, ValidatorCount)
+	scores := make([]uint64, ValidatorCount)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{
+			PublicKey:             bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(uint64(i)), fieldparams.BLSPubkeyLength),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		bals[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	mixes := make([][]byte, fieldparams.RandaoMixesLength)
+	for i := range mixes {
+		mixes[i] = make([]byte, 32)
+	}
+	st, err := state_native.InitializeFromProtoUnsafeAltair(&ethpb.BeaconStateAltair{
+		Validators:                 vals,
+		Balances:                   bals,
+		RandaoMixes:                mixes,
+		InactivityScores:           scores,
+		PreviousEpochParticipation: make([]byte, ValidatorCount),
+		CurrentEpochParticipation:  make([]byte, ValidatorCount),
+	})
+	require.NoError(b, err)
+	return st
+}
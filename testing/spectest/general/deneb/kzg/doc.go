@@ -0,0 +1,3 @@
+// Package kzg includes tests to ensure conformity with the KZG polynomial commitment specification of Deneb.
+// See https://github.com/ethereum/consensus-spec-tests/tree/master/tests/general/deneb/kzg for details.
+package kzg
@@ -0,0 +1,52 @@
+package kzg
+
+import (
+	"encoding/hex"
+	"path"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestVerifyBlobKZGProofBatch(t *testing.T) {
+	testFolders, testFolderPath := utils.TestFolders(t, "general", "deneb", "kzg/verify_blob_kzg_proof_batch/small")
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", "general", "deneb", "kzg/verify_blob_kzg_proof_batch/small")
+	}
+	ctx, err := kzg.Default()
+	require.NoError(t, err)
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			file, err := util.BazelFileBytes(path.Join(testFolderPath, folder.Name(), "data.yaml"))
+			require.NoError(t, err)
+			test := &VerifyBlobKZGProofBatchTest{}
+			require.NoError(t, yaml.Unmarshal(file, test))
+
+			blobs := decodeHexList(t, test.Input.Blobs)
+			commitments := decodeHexList(t, test.Input.Commitments)
+			proofs := decodeHexList(t, test.Input.Proofs)
+
+			err = ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs)
+			// A null output denotes an invalid input, which must fail verification as well.
+			if test.Output != nil && *test.Output {
+				require.NoError(t, err)
+			} else {
+				require.NotNil(t, err, "Expected verification to fail")
+			}
+		})
+	}
+}
+
+func decodeHexList(t *testing.T, items []string) [][]byte {
+	decoded := make([][]byte, len(items))
+	for i, item := range items {
+		b, err := hex.DecodeString(item[2:])
+		require.NoError(t, err)
+		decoded[i] = b
+	}
+	return decoded
+}
@@ -0,0 +1,13 @@
+// Code generated by yaml_to_go. DO NOT EDIT.
+// source: verify_blob_kzg_proof_batch.yaml
+
+package kzg
+
+type VerifyBlobKZGProofBatchTest struct {
+	Input struct {
+		Blobs       []string `json:"blobs"`
+		Commitments []string `json:"commitments"`
+		Proofs      []string `json:"proofs"`
+	} `json:"input"`
+	Output *bool `json:"output"`
+}
@@ -0,0 +1,45 @@
+package kzg
+
+import (
+	"encoding/hex"
+	"path"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/prysmaticlabs/prysm/v4/crypto/kzg"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestVerifyBlobKZGProof(t *testing.T) {
+	testFolders, testFolderPath := utils.TestFolders(t, "general", "deneb", "kzg/verify_blob_kzg_proof/small")
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", "general", "deneb", "kzg/verify_blob_kzg_proof/small")
+	}
+	ctx, err := kzg.Default()
+	require.NoError(t, err)
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			file, err := util.BazelFileBytes(path.Join(testFolderPath, folder.Name(), "data.yaml"))
+			require.NoError(t, err)
+			test := &VerifyBlobKZGProofTest{}
+			require.NoError(t, yaml.Unmarshal(file, test))
+
+			blob, err := hex.DecodeString(test.Input.Blob[2:])
+			require.NoError(t, err)
+			commitment, err := hex.DecodeString(test.Input.Commitment[2:])
+			require.NoError(t, err)
+			proof, err := hex.DecodeString(test.Input.Proof[2:])
+			require.NoError(t, err)
+
+			err = ctx.VerifyBlobKZGProof(blob, commitment, proof)
+			// A null output denotes an invalid input, which must fail verification as well.
+			if test.Output != nil && *test.Output {
+				require.NoError(t, err)
+			} else {
+				require.NotNil(t, err, "Expected verification to fail")
+			}
+		})
+	}
+}
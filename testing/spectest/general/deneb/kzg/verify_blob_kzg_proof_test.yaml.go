@@ -0,0 +1,13 @@
+// Code generated by yaml_to_go. DO NOT EDIT.
+// source: verify_blob_kzg_proof.yaml
+
+package kzg
+
+type VerifyBlobKZGProofTest struct {
+	Input struct {
+		Blob       string `json:"blob"`
+		Commitment string `json:"commitment"`
+		Proof      string `json:"proof"`
+	} `json:"input"`
+	Output *bool `json:"output"`
+}
@@ -0,0 +1,11 @@
+package epoch_processing
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/epoch_processing"
+)
+
+func TestMainnet_Deneb_EpochProcessing_HistoricalSummariesUpdate(t *testing.T) {
+	epoch_processing.RunHistoricalSummariesUpdateTests(t, "mainnet")
+}
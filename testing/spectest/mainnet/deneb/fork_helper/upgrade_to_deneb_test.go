@@ -0,0 +1,11 @@
+package fork_helper
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/fork"
+)
+
+func TestMainnet_Deneb_UpgradeToDeneb(t *testing.T) {
+	fork.RunUpgradeToDeneb(t, "mainnet")
+}
@@ -0,0 +1,11 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/rewards"
+)
+
+func TestMainnet_Deneb_Rewards(t *testing.T) {
+	rewards.RunPrecomputeRewardsAndPenaltiesTests(t, "mainnet")
+}
@@ -0,0 +1,11 @@
+package sanity
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/sanity"
+)
+
+func TestMainnet_Deneb_Sanity_Blocks(t *testing.T) {
+	sanity.RunBlockProcessingTest(t, "mainnet", "sanity/blocks/pyspec_tests")
+}
@@ -0,0 +1,11 @@
+package epoch_processing
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/epoch_processing"
+)
+
+func TestMinimal_Deneb_EpochProcessing_JustificationAndFinalization(t *testing.T) {
+	epoch_processing.RunJustificationAndFinalizationTests(t, "minimal")
+}
@@ -0,0 +1,11 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/operations"
+)
+
+func TestMinimal_Deneb_Operations_SyncCommittee(t *testing.T) {
+	operations.RunProposerSlashingTest(t, "minimal")
+}
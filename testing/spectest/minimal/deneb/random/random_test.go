@@ -0,0 +1,11 @@
+package random
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/sanity"
+)
+
+func TestMinimal_Deneb_Random(t *testing.T) {
+	sanity.RunBlockProcessingTest(t, "minimal", "random/random/pyspec_tests")
+}
@@ -0,0 +1,11 @@
+package ssz_static
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/deneb/ssz_static"
+)
+
+func TestMinimal_Deneb_SSZStatic(t *testing.T) {
+	ssz_static.RunSSZStaticTests(t, "minimal")
+}
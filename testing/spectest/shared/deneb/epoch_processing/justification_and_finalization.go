@@ -0,0 +1,43 @@
+package epoch_processing
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/utils"
+)
+
+// RunJustificationAndFinalizationTests executes "epoch_processing/justification_and_finalization" tests.
+func RunJustificationAndFinalizationTests(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testPath := "epoch_processing/justification_and_finalization/pyspec_tests"
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "deneb", testPath)
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", config, "deneb", testPath)
+	}
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			RunEpochOperationTest(t, folderPath, processJustificationAndFinalizationPrecomputeWrapper)
+		})
+	}
+}
+
+func processJustificationAndFinalizationPrecomputeWrapper(t *testing.T, st state.BeaconState) (state.BeaconState, error) {
+	ctx := context.Background()
+	vp, bp, err := altair.InitializePrecomputeValidators(ctx, st)
+	require.NoError(t, err)
+	_, bp, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
+	require.NoError(t, err)
+
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+	require.NoError(t, err, "Could not process justification")
+
+	return st, nil
+}
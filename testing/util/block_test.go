@@ -359,6 +359,60 @@ func TestHydrateBlindedBeaconBlockBodyCapella_NoError(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestHydrateSignedBeaconBlockDeneb_NoError(t *testing.T) {
+	b := &ethpbalpha.SignedBeaconBlockDeneb{}
+	b = HydrateSignedBeaconBlockDeneb(b)
+	_, err := b.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = b.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+}
+
+func TestHydrateBeaconBlockDeneb_NoError(t *testing.T) {
+	b := &ethpbalpha.BeaconBlockDeneb{}
+	b = HydrateBeaconBlockDeneb(b)
+	_, err := b.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = b.Body.HashTreeRoot()
+	require.NoError(t, err)
+}
+
+func TestHydrateBeaconBlockBodyDeneb_NoError(t *testing.T) {
+	b := &ethpbalpha.BeaconBlockBodyDeneb{}
+	b = HydrateBeaconBlockBodyDeneb(b)
+	_, err := b.HashTreeRoot()
+	require.NoError(t, err)
+}
+
+func TestHydrateSignedBlindedBeaconBlockDeneb_NoError(t *testing.T) {
+	b := &ethpbalpha.SignedBlindedBeaconBlockDeneb{}
+	b = HydrateSignedBlindedBeaconBlockDeneb(b)
+	_, err := b.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = b.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+}
+
+func TestHydrateBlindedBeaconBlockDeneb_NoError(t *testing.T) {
+	b := &ethpbalpha.BlindedBeaconBlockDeneb{}
+	b = HydrateBlindedBeaconBlockDeneb(b)
+	_, err := b.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = b.Body.HashTreeRoot()
+	require.NoError(t, err)
+}
+
+func TestHydrateBlindedBeaconBlockBodyDeneb_NoError(t *testing.T) {
+	b := &ethpbalpha.BlindedBeaconBlockBodyDeneb{}
+	b = HydrateBlindedBeaconBlockBodyDeneb(b)
+	_, err := b.HashTreeRoot()
+	require.NoError(t, err)
+}
+
 func TestGenerateVoluntaryExits(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	config := params.BeaconConfig()
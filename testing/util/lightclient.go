@@ -0,0 +1,84 @@
+package util
+
+import (
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// HydrateLightClientUpdate hydrates a light client update with correct field length sizes
+// to comply with fssz marshalling and unmarshalling rules.
+func HydrateLightClientUpdate(u *ethpb.LightClientUpdate) *ethpb.LightClientUpdate {
+	if u == nil {
+		u = &ethpb.LightClientUpdate{}
+	}
+	u.AttestedHeader = hydrateLightClientHeader(u.AttestedHeader)
+	u.NextSyncCommittee = hydrateSyncCommittee(u.NextSyncCommittee)
+	if u.NextSyncCommitteeBranch == nil {
+		u.NextSyncCommitteeBranch = emptyRoots(5)
+	}
+	u.FinalizedHeader = hydrateLightClientHeader(u.FinalizedHeader)
+	if u.FinalityBranch == nil {
+		u.FinalityBranch = emptyRoots(6)
+	}
+	u.SyncAggregate = hydrateSyncAggregate(u.SyncAggregate)
+	return u
+}
+
+// HydrateLightClientBootstrap hydrates a light client bootstrap with correct field length sizes
+// to comply with fssz marshalling and unmarshalling rules.
+func HydrateLightClientBootstrap(b *ethpb.LightClientBootstrap) *ethpb.LightClientBootstrap {
+	if b == nil {
+		b = &ethpb.LightClientBootstrap{}
+	}
+	b.Header = hydrateLightClientHeader(b.Header)
+	b.CurrentSyncCommittee = hydrateSyncCommittee(b.CurrentSyncCommittee)
+	if b.CurrentSyncCommitteeBranch == nil {
+		b.CurrentSyncCommitteeBranch = emptyRoots(5)
+	}
+	return b
+}
+
+func hydrateLightClientHeader(h *ethpb.LightClientHeader) *ethpb.LightClientHeader {
+	if h == nil {
+		h = &ethpb.LightClientHeader{}
+	}
+	h.Beacon = HydrateBeaconHeader(h.Beacon)
+	return h
+}
+
+func hydrateSyncCommittee(c *ethpb.SyncCommittee) *ethpb.SyncCommittee {
+	if c == nil {
+		c = &ethpb.SyncCommittee{}
+	}
+	if c.Pubkeys == nil {
+		c.Pubkeys = make([][]byte, fieldparams.SyncCommitteeLength)
+		for i := range c.Pubkeys {
+			c.Pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+		}
+	}
+	if c.AggregatePubkey == nil {
+		c.AggregatePubkey = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return c
+}
+
+func hydrateSyncAggregate(a *ethpb.SyncAggregate) *ethpb.SyncAggregate {
+	if a == nil {
+		a = &ethpb.SyncAggregate{}
+	}
+	if a.SyncCommitteeBits == nil {
+		a.SyncCommitteeBits = make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength)
+	}
+	if a.SyncCommitteeSignature == nil {
+		a.SyncCommitteeSignature = make([]byte, fieldparams.BLSSignatureLength)
+	}
+	return a
+}
+
+func emptyRoots(n int) [][]byte {
+	roots := make([][]byte, n)
+	for i := range roots {
+		roots[i] = make([]byte, fieldparams.RootLength)
+	}
+	return roots
+}
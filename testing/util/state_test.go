@@ -57,6 +57,18 @@ func TestNewBeaconStateCapella(t *testing.T) {
 	}
 }
 
+func TestNewBeaconStateDeneb(t *testing.T) {
+	st, err := NewBeaconStateDeneb()
+	require.NoError(t, err)
+	b, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	got := &ethpb.BeaconStateDeneb{}
+	require.NoError(t, got.UnmarshalSSZ(b))
+	if !reflect.DeepEqual(st.ToProtoUnsafe(), got) {
+		t.Fatal("State did not match after round trip marshal")
+	}
+}
+
 func TestNewBeaconState_HashTreeRoot(t *testing.T) {
 	st, err := NewBeaconState()
 	require.NoError(t, err)
@@ -74,4 +86,8 @@ func TestNewBeaconState_HashTreeRoot(t *testing.T) {
 	require.NoError(t, err)
 	_, err = st.HashTreeRoot(context.Background())
 	require.NoError(t, err)
+	st, err = NewBeaconStateDeneb()
+	require.NoError(t, err)
+	_, err = st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
 }
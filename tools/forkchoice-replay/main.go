@@ -0,0 +1,129 @@
+/*
+Package main replays a fork choice trace against a new fork choice store, printing the head changes and
+the weights of the tips at every check, and reporting the checks fork choice does not satisfy.
+
+A trace is either recorded by a beacon node running with --forkchoice-trace-file, or converted from a
+consensus spec fork_choice test case directory:
+
+	bazel run //tools/forkchoice-replay -- -trace=/tmp/forkchoice.trace
+	bazel run //tools/forkchoice-replay -- -spec-test=<path>/fork_choice/ex_ante/pyspec_tests/ex_ante_sandwich -fork=capella -config=minimal
+
+A converted test case can be written out with -out, edited by hand and replayed with -trace.
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/recorder"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	traceFile = flag.String("trace", "", "Path to a fork choice trace to replay.")
+	specTest  = flag.String("spec-test", "", "Path to a consensus spec fork_choice test case directory to replay.")
+	forkName  = flag.String("fork", "deneb", "Fork of the spec test case (phase0, altair, bellatrix, capella, deneb).")
+	config    = flag.String("config", "minimal", "Config of the spec test case (mainnet, minimal).")
+	outFile   = flag.String("out", "", "If set, write the replayed trace to this path.")
+	tips      = flag.Bool("tips", false, "Print the weight of every tip at each check, rather than on head changes only.")
+)
+
+func main() {
+	flag.Parse()
+	if (*traceFile == "") == (*specTest == "") {
+		log.Fatal("Exactly one of -trace or -spec-test must be set")
+	}
+
+	var steps []*recorder.Step
+	var err error
+	if *traceFile != "" {
+		steps, err = readTrace(*traceFile)
+	} else {
+		steps, err = convertSpecTest()
+	}
+	if err != nil {
+		log.WithError(err).Fatal("Could not load fork choice steps")
+	}
+	if *outFile != "" {
+		if err := writeTrace(*outFile, steps); err != nil {
+			log.WithError(err).Fatal("Could not write fork choice trace")
+		}
+	}
+
+	failures := 0
+	err = recorder.NewReplayer(steps).Run(context.Background(), func(res *recorder.Result) {
+		if res.Err != nil {
+			log.WithError(res.Err).WithField("step", res.Index).Warn("Fork choice rejected step")
+		}
+		if res.Step.Check == nil {
+			return
+		}
+		if res.HeadChanged || *tips {
+			fmt.Printf("step %d: head %#x at slot %d with weight %d\n", res.Index, res.Head, res.HeadSlot, res.HeadWeight)
+			for _, tip := range res.Tips {
+				fmt.Printf("\ttip %#x at slot %d with weight %d\n", tip.Root, tip.Slot, tip.Weight)
+			}
+		}
+		for _, m := range res.Mismatches {
+			failures++
+			fmt.Printf("step %d: mismatch %s\n", res.Index, m)
+		}
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Could not replay fork choice steps")
+	}
+	fmt.Printf("replayed %d steps, %d mismatches\n", len(steps), failures)
+	if failures > 0 {
+		os.Exit(1)
+	}
+}
+
+func readTrace(path string) ([]*recorder.Step, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close trace file")
+		}
+	}()
+	return recorder.ReadSteps(f)
+}
+
+func writeTrace(path string, steps []*recorder.Step) error {
+	f, err := os.Create(path) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if err := recorder.WriteSteps(f, steps); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func convertSpecTest() ([]*recorder.Step, error) {
+	fork, err := version.FromString(strings.ToLower(*forkName))
+	if err != nil {
+		return nil, err
+	}
+	var cfg *params.BeaconChainConfig
+	switch *config {
+	case "mainnet":
+		cfg = params.MainnetConfig().Copy()
+	case "minimal":
+		cfg = params.MinimalSpecConfig().Copy()
+	default:
+		return nil, fmt.Errorf("unknown config %q", *config)
+	}
+	if err := params.SetActive(cfg); err != nil {
+		return nil, err
+	}
+	return specTestSteps(context.Background(), *specTest, fork)
+}
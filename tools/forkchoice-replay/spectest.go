@@ -0,0 +1,425 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/recorder"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/container/slice"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	log "github.com/sirupsen/logrus"
+)
+
+// specStep is a step of the steps.yaml file of a fork_choice spec test case.
+type specStep struct {
+	Tick             *uint64         `json:"tick"`
+	Block            *string         `json:"block"`
+	Valid            *bool           `json:"valid"`
+	Attestation      *string         `json:"attestation"`
+	AttesterSlashing *string         `json:"attester_slashing"`
+	PayloadStatus    *map[string]any `json:"payload_status"`
+	PowBlock         *string         `json:"pow_block"`
+	Checks           *specCheck      `json:"checks"`
+}
+
+type specCheck struct {
+	Head                *struct{ Root string } `json:"head"`
+	JustifiedCheckpoint *specCheckpoint        `json:"justified_checkpoint"`
+	FinalizedCheckpoint *specCheckpoint        `json:"finalized_checkpoint"`
+	ProposerBoostRoot   *string                `json:"proposer_boost_root"`
+}
+
+type specCheckpoint struct {
+	Epoch primitives.Epoch `json:"epoch"`
+	Root  string           `json:"root"`
+}
+
+// converter turns a spec test case into a fork choice trace. Blocks are run through the state transition
+// to obtain the checkpoints, unrealized checkpoints and balances fork choice receives from the node.
+type converter struct {
+	dir         string
+	fork        int
+	genesisTime uint64
+	time        uint64 // unix time in seconds.
+	slot        primitives.Slot
+	states      map[[32]byte]state.BeaconState
+	steps       []*recorder.Step
+}
+
+// specTestSteps converts the fork_choice spec test case in dir to the steps of a fork choice trace.
+// Payload statuses and PoW blocks are not supported, and invalid blocks and attestations are skipped.
+func specTestSteps(ctx context.Context, dir string, fork int) ([]*recorder.Step, error) {
+	c := &converter{dir: dir, fork: fork, states: make(map[[32]byte]state.BeaconState)}
+	if err := c.anchor(); err != nil {
+		return nil, errors.Wrap(err, "could not load anchor")
+	}
+	raw, err := os.ReadFile(filepath.Join(dir, "steps.yaml")) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	var steps []*specStep
+	if err := yaml.Unmarshal(raw, &steps); err != nil {
+		return nil, errors.Wrap(err, "could not decode steps")
+	}
+	for i, s := range steps {
+		if err := c.convert(ctx, s); err != nil {
+			return nil, errors.Wrapf(err, "could not convert step %d", i)
+		}
+	}
+	return c.steps, nil
+}
+
+func (c *converter) add(step *recorder.Step) {
+	step.Time = c.time * 1000
+	c.steps = append(c.steps, step)
+}
+
+func (c *converter) anchor() error {
+	raw, err := c.readSSZ("anchor_state")
+	if err != nil {
+		return err
+	}
+	st, err := unmarshalState(c.fork, raw)
+	if err != nil {
+		return err
+	}
+	raw, err = c.readSSZ("anchor_block")
+	if err != nil {
+		return err
+	}
+	blk, err := unmarshalBlock(c.fork, raw, false)
+	if err != nil {
+		return err
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	c.genesisTime = st.GenesisTime()
+	c.slot = st.Slot()
+	c.time = c.genesisTime + uint64(c.slot)*params.BeaconConfig().SecondsPerSlot
+	c.states[root] = st
+
+	genesisTime := c.genesisTime
+	c.add(&recorder.Step{GenesisTime: &genesisTime})
+	c.add(&recorder.Step{Balances: &recorder.Balances{Root: root[:], Balances: activeBalances(st)}})
+	cp := &recorder.Checkpoint{Epoch: slots.ToEpoch(st.Slot()), Root: root[:]}
+	c.add(&recorder.Step{JustifiedCheckpoint: cp})
+	c.add(&recorder.Step{FinalizedCheckpoint: cp})
+	parentRoot := blk.Block().ParentRoot()
+	var payloadHash [32]byte
+	if blk.Version() >= version.Bellatrix {
+		payload, err := blk.Block().Body().Execution()
+		if err != nil {
+			return err
+		}
+		copy(payloadHash[:], payload.BlockHash())
+	}
+	c.add(&recorder.Step{Block: &recorder.Block{
+		Slot:                st.Slot(),
+		Root:                root[:],
+		ParentRoot:          parentRoot[:],
+		PayloadHash:         payloadHash[:],
+		JustifiedCheckpoint: cp,
+		FinalizedCheckpoint: cp,
+	}})
+	return nil
+}
+
+func (c *converter) convert(ctx context.Context, s *specStep) error {
+	invalid := s.Valid != nil && !*s.Valid
+	switch {
+	case s.Tick != nil:
+		c.tick(c.genesisTime + *s.Tick)
+	case s.Block != nil && invalid:
+		log.WithField("block", *s.Block).Info("Skipping invalid block")
+	case s.Block != nil:
+		return c.block(ctx, *s.Block)
+	case s.Attestation != nil && invalid:
+		log.WithField("attestation", *s.Attestation).Info("Skipping invalid attestation")
+	case s.Attestation != nil:
+		raw, err := c.readSSZ(*s.Attestation)
+		if err != nil {
+			return err
+		}
+		att := &ethpb.Attestation{}
+		if err := att.UnmarshalSSZ(raw); err != nil {
+			return err
+		}
+		return c.attestation(ctx, att)
+	case s.AttesterSlashing != nil:
+		raw, err := c.readSSZ(*s.AttesterSlashing)
+		if err != nil {
+			return err
+		}
+		slashing := &ethpb.AttesterSlashing{}
+		if err := slashing.UnmarshalSSZ(raw); err != nil {
+			return err
+		}
+		c.slashing(slashing)
+	case s.PayloadStatus != nil, s.PowBlock != nil:
+		log.Warn("Payload statuses and PoW blocks are not supported, skipping step")
+	case s.Checks != nil:
+		return c.check(s.Checks)
+	}
+	return nil
+}
+
+// tick moves the time forward, starting every slot crossed on the way.
+func (c *converter) tick(t uint64) {
+	sps := params.BeaconConfig().SecondsPerSlot
+	for slot := c.slot + 1; c.genesisTime+uint64(slot)*sps <= t; slot++ {
+		c.time = c.genesisTime + uint64(slot)*sps
+		c.slot = slot
+		newSlot := slot
+		c.add(&recorder.Step{NewSlot: &newSlot})
+	}
+	c.time = t
+}
+
+func (c *converter) block(ctx context.Context, name string) error {
+	raw, err := c.readSSZ(name)
+	if err != nil {
+		return err
+	}
+	blk, err := unmarshalBlock(c.fork, raw, true)
+	if err != nil {
+		return err
+	}
+	pre, ok := c.states[blk.Block().ParentRoot()]
+	if !ok {
+		log.WithField("block", name).Info("Skipping block with unknown parent")
+		return nil
+	}
+	_, post, err := transition.ExecuteStateTransitionNoVerifyAnySig(ctx, pre.Copy(), blk)
+	if err != nil {
+		return errors.Wrapf(err, "could not process block %s", name)
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	c.states[root] = post
+	b, err := recorder.BlockFromState(post, root)
+	if err != nil {
+		return err
+	}
+	c.add(&recorder.Step{Balances: &recorder.Balances{Root: root[:], Balances: activeBalances(post)}})
+	c.add(&recorder.Step{Block: b})
+	// The node processes the attestations and attester slashings included in the block as well.
+	for _, att := range blk.Block().Body().Attestations() {
+		if err := c.attestation(ctx, att); err != nil {
+			log.WithError(err).WithField("block", name).Debug("Could not convert block attestation")
+		}
+	}
+	for _, slashing := range blk.Block().Body().AttesterSlashings() {
+		c.slashing(slashing)
+	}
+	return nil
+}
+
+func (c *converter) attestation(ctx context.Context, att *ethpb.Attestation) error {
+	data := att.Data
+	st, ok := c.states[bytesToRoot(data.Target.Root)]
+	if !ok {
+		return errors.New("unknown target root")
+	}
+	start, err := slots.EpochStart(data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	if st.Slot() < start {
+		st, err = transition.ProcessSlots(ctx, st.Copy(), start)
+		if err != nil {
+			return err
+		}
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		return err
+	}
+	indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return err
+	}
+	c.add(&recorder.Step{Attestation: &recorder.Attestation{
+		ValidatorIndices: indices,
+		BlockRoot:        data.BeaconBlockRoot,
+		TargetEpoch:      data.Target.Epoch,
+	}})
+	return nil
+}
+
+func (c *converter) slashing(s *ethpb.AttesterSlashing) {
+	for _, i := range slice.IntersectionUint64(s.Attestation_1.AttestingIndices, s.Attestation_2.AttestingIndices) {
+		index := primitives.ValidatorIndex(i)
+		c.add(&recorder.Step{SlashedIndex: &index})
+	}
+}
+
+func (c *converter) check(s *specCheck) error {
+	check := &recorder.Check{}
+	var err error
+	if s.Head != nil {
+		if check.Head, err = decodeRoot(s.Head.Root); err != nil {
+			return err
+		}
+	}
+	if check.JustifiedCheckpoint, err = s.JustifiedCheckpoint.toCheckpoint(); err != nil {
+		return err
+	}
+	if check.FinalizedCheckpoint, err = s.FinalizedCheckpoint.toCheckpoint(); err != nil {
+		return err
+	}
+	if s.ProposerBoostRoot != nil {
+		if check.ProposerBoostRoot, err = decodeRoot(*s.ProposerBoostRoot); err != nil {
+			return err
+		}
+	}
+	c.add(&recorder.Step{Check: check})
+	return nil
+}
+
+func (cp *specCheckpoint) toCheckpoint() (*recorder.Checkpoint, error) {
+	if cp == nil {
+		return nil, nil
+	}
+	root, err := decodeRoot(cp.Root)
+	if err != nil {
+		return nil, err
+	}
+	return &recorder.Checkpoint{Epoch: cp.Epoch, Root: root}, nil
+}
+
+func decodeRoot(s string) ([]byte, error) {
+	root, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(root) != fieldparams.RootLength {
+		return nil, fmt.Errorf("invalid root length %d", len(root))
+	}
+	return root, nil
+}
+
+func bytesToRoot(b []byte) [32]byte {
+	var root [32]byte
+	copy(root[:], b)
+	return root
+}
+
+func (c *converter) readSSZ(name string) ([]byte, error) {
+	raw, err := os.ReadFile(filepath.Join(c.dir, name+".ssz_snappy")) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Decode(nil /* dst */, raw)
+}
+
+// activeBalances returns the balances fork choice uses for the given state, computed the same way as by
+// the state generator of the node.
+func activeBalances(st state.BeaconState) []uint64 {
+	epoch := coreTime.CurrentEpoch(st)
+	balances := make([]uint64, st.NumValidators())
+	for i := range balances {
+		val, err := st.ValidatorAtIndexReadOnly(primitives.ValidatorIndex(i))
+		if err != nil {
+			continue
+		}
+		if helpers.IsActiveNonSlashedValidatorUsingTrie(val, epoch) {
+			balances[i] = val.EffectiveBalance()
+		}
+	}
+	return balances
+}
+
+func unmarshalState(fork int, raw []byte) (state.BeaconState, error) {
+	switch fork {
+	case version.Phase0:
+		base := &ethpb.BeaconState{}
+		if err := base.UnmarshalSSZ(raw); err != nil {
+			return nil, err
+		}
+		return state_native.InitializeFromProtoPhase0(base)
+	case version.Altair:
+		base := &ethpb.BeaconStateAltair{}
+		if err := base.UnmarshalSSZ(raw); err != nil {
+			return nil, err
+		}
+		return state_native.InitializeFromProtoAltair(base)
+	case version.Bellatrix:
+		base := &ethpb.BeaconStateBellatrix{}
+		if err := base.UnmarshalSSZ(raw); err != nil {
+			return nil, err
+		}
+		return state_native.InitializeFromProtoBellatrix(base)
+	case version.Capella:
+		base := &ethpb.BeaconStateCapella{}
+		if err := base.UnmarshalSSZ(raw); err != nil {
+			return nil, err
+		}
+		return state_native.InitializeFromProtoCapella(base)
+	case version.Deneb:
+		base := &ethpb.BeaconStateDeneb{}
+		if err := base.UnmarshalSSZ(raw); err != nil {
+			return nil, err
+		}
+		return state_native.InitializeFromProtoDeneb(base)
+	default:
+		return nil, fmt.Errorf("unsupported fork %s", version.String(fork))
+	}
+}
+
+// unmarshalBlock decodes a signed block, or an unsigned one such as the anchor block when signed is false.
+func unmarshalBlock(fork int, raw []byte, signed bool) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	sig := make([]byte, fieldparams.BLSSignatureLength)
+	var unsignedMsg, signedMsg ssz.Unmarshaler
+	switch fork {
+	case version.Phase0:
+		b := &ethpb.BeaconBlock{}
+		unsignedMsg, signedMsg = b, &ethpb.SignedBeaconBlock{Block: b, Signature: sig}
+	case version.Altair:
+		b := &ethpb.BeaconBlockAltair{}
+		unsignedMsg, signedMsg = b, &ethpb.SignedBeaconBlockAltair{Block: b, Signature: sig}
+	case version.Bellatrix:
+		b := &ethpb.BeaconBlockBellatrix{}
+		unsignedMsg, signedMsg = b, &ethpb.SignedBeaconBlockBellatrix{Block: b, Signature: sig}
+	case version.Capella:
+		b := &ethpb.BeaconBlockCapella{}
+		unsignedMsg, signedMsg = b, &ethpb.SignedBeaconBlockCapella{Block: b, Signature: sig}
+	case version.Deneb:
+		b := &ethpb.BeaconBlockDeneb{}
+		unsignedMsg, signedMsg = b, &ethpb.SignedBeaconBlockDeneb{Block: b, Signature: sig}
+	default:
+		return nil, fmt.Errorf("unsupported fork %s", version.String(fork))
+	}
+	msg := unsignedMsg
+	if signed {
+		msg = signedMsg
+	}
+	if err := msg.UnmarshalSSZ(raw); err != nil {
+		return nil, err
+	}
+	return blocks.NewSignedBeaconBlock(signedMsg)
+}
@@ -0,0 +1,45 @@
+// Package main implements a reference remote slashing protection service, which several validator
+// clients can share through the --slashing-protection-url flag. The signing history is stored in
+// its own bolt file in the configured directory, and records are saved with compare-and-set
+// semantics. It is meant for tests and as an example of the protection API, not for production.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "slashing-protection-server")
+
+func main() {
+	dataDir := flag.String("datadir", "", "directory of the slashing protection database")
+	host := flag.String("host", "127.0.0.1", "host to listen on")
+	port := flag.Int("port", 8580, "port to listen on")
+	flag.Parse()
+	if *dataDir == "" {
+		log.Fatal("Needs a -datadir path")
+	}
+
+	db, err := kv.NewKVStore(context.Background(), *dataDir, &kv.Config{})
+	if err != nil {
+		log.WithError(err).Fatal("Could not open slashing protection database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close slashing protection database")
+		}
+	}()
+
+	addr := fmt.Sprintf("%s:%d", *host, *port)
+	srv := &http.Server{Addr: addr, Handler: newServer(db).router(), ReadHeaderTimeout: time.Second}
+	log.WithField("address", addr).Info("Serving slashing protection API")
+	if err := srv.ListenAndServe(); err != nil {
+		log.WithError(err).Error("Slashing protection server stopped")
+	}
+}
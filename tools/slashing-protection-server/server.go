@@ -0,0 +1,339 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/remote"
+)
+
+type server struct {
+	db *kv.Store
+	// Locks of the public keys, which serialize the check and the write of the records of a
+	// public key, so that a record which is slashable with respect to the history saved by a
+	// concurrent request is refused. The records of different public keys are saved concurrently,
+	// as saving an attestation waits for the batch of attestations to be flushed.
+	locksLock sync.Mutex
+	locks     map[[fieldparams.BLSPubkeyLength]byte]*sync.Mutex
+}
+
+func newServer(db *kv.Store) *server {
+	return &server{db: db, locks: make(map[[fieldparams.BLSPubkeyLength]byte]*sync.Mutex)}
+}
+
+// lock locks the records of a public key, and returns the function unlocking them.
+func (s *server) lock(pubKey [fieldparams.BLSPubkeyLength]byte) func() {
+	s.locksLock.Lock()
+	l, ok := s.locks[pubKey]
+	if !ok {
+		l = &sync.Mutex{}
+		s.locks[pubKey] = l
+	}
+	s.locksLock.Unlock()
+	l.Lock()
+	return l.Unlock
+}
+
+func (s *server) router() *mux.Router {
+	r := mux.NewRouter()
+	// The lowest and highest proposal routes are registered before the route of the proposal at a slot.
+	r.HandleFunc(remote.LowestSignedProposalPath, s.lowestSignedProposal).Methods(http.MethodGet)
+	r.HandleFunc(remote.HighestSignedProposalPath, s.highestSignedProposal).Methods(http.MethodGet)
+	r.HandleFunc(remote.ProposalAtSlotPath, s.proposalAtSlot).Methods(http.MethodGet)
+	r.HandleFunc(remote.ProposalsPath, s.saveProposal).Methods(http.MethodPost)
+	r.HandleFunc(remote.LowestSignedSourceEpochPath, s.lowestSignedSourceEpoch).Methods(http.MethodGet)
+	r.HandleFunc(remote.LowestSignedTargetEpochPath, s.lowestSignedTargetEpoch).Methods(http.MethodGet)
+	r.HandleFunc(remote.SigningRootAtTargetEpochPath, s.signingRootAtTargetEpoch).Methods(http.MethodGet)
+	r.HandleFunc(remote.CheckAttestationPath, s.checkAttestation).Methods(http.MethodPost)
+	r.HandleFunc(remote.AttestationsPath, s.saveAttestation).Methods(http.MethodPost)
+	return r
+}
+
+func (s *server) lowestSignedProposal(w http.ResponseWriter, r *http.Request) {
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+	slot, exists, err := s.db.LowestSignedProposal(r.Context(), pubKey)
+	if err != nil {
+		http2.HandleError(w, "Could not get lowest signed proposal: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &remote.SlotResponse{Slot: strconv.FormatUint(uint64(slot), 10), Exists: exists})
+}
+
+func (s *server) highestSignedProposal(w http.ResponseWriter, r *http.Request) {
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+	slot, exists, err := s.db.HighestSignedProposal(r.Context(), pubKey)
+	if err != nil {
+		http2.HandleError(w, "Could not get highest signed proposal: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &remote.SlotResponse{Slot: strconv.FormatUint(uint64(slot), 10), Exists: exists})
+}
+
+func (s *server) proposalAtSlot(w http.ResponseWriter, r *http.Request) {
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+	slot, ok := uintFromString(w, "slot", mux.Vars(r)["slot"])
+	if !ok {
+		return
+	}
+	root, exists, err := s.db.ProposalHistoryForSlot(r.Context(), pubKey, primitives.Slot(slot))
+	if err != nil {
+		http2.HandleError(w, "Could not get proposal history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &remote.SigningRootResponse{SigningRoot: hexutil.Encode(root[:]), Exists: exists})
+}
+
+// Records a proposal, unless a different proposal was signed at the same slot, or the slot is not
+// after the lowest signed proposal slot, as checked by the validator client against its local DB.
+func (s *server) saveProposal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+	var req remote.ProposalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	slot, ok := uintFromString(w, "slot", req.Slot)
+	if !ok {
+		return
+	}
+	root, ok := rootFromString(w, req.SigningRoot)
+	if !ok {
+		return
+	}
+
+	defer s.lock(pubKey)()
+	prevRoot, exists, err := s.db.ProposalHistoryForSlot(ctx, pubKey, primitives.Slot(slot))
+	if err != nil {
+		http2.HandleError(w, "Could not get proposal history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rootIsDifferent := prevRoot == params.BeaconConfig().ZeroHash || prevRoot != root
+	if exists && rootIsDifferent {
+		writeConflict(w, kv.NotSlashable, fmt.Sprintf("a different proposal was already signed at slot %d", slot))
+		return
+	}
+	lowestSlot, lowestExists, err := s.db.LowestSignedProposal(ctx, pubKey)
+	if err != nil {
+		http2.HandleError(w, "Could not get lowest signed proposal: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if lowestExists && rootIsDifferent && lowestSlot >= primitives.Slot(slot) {
+		writeConflict(w, kv.NotSlashable, fmt.Sprintf("slot %d is not after the lowest signed proposal slot %d", slot, lowestSlot))
+		return
+	}
+	if err := s.db.SaveProposalHistoryForSlot(ctx, pubKey, primitives.Slot(slot), root[:]); err != nil {
+		http2.HandleError(w, "Could not save proposal history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *server) lowestSignedSourceEpoch(w http.ResponseWriter, r *http.Request) {
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+	epoch, exists, err := s.db.LowestSignedSourceEpoch(r.Context(), pubKey)
+	if err != nil {
+		http2.HandleError(w, "Could not get lowest signed source epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &remote.EpochResponse{Epoch: strconv.FormatUint(uint64(epoch), 10), Exists: exists})
+}
+
+func (s *server) lowestSignedTargetEpoch(w http.ResponseWriter, r *http.Request) {
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+	epoch, exists, err := s.db.LowestSignedTargetEpoch(r.Context(), pubKey)
+	if err != nil {
+		http2.HandleError(w, "Could not get lowest signed target epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &remote.EpochResponse{Epoch: strconv.FormatUint(uint64(epoch), 10), Exists: exists})
+}
+
+func (s *server) signingRootAtTargetEpoch(w http.ResponseWriter, r *http.Request) {
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+	target, ok := uintFromString(w, "target epoch", mux.Vars(r)["target_epoch"])
+	if !ok {
+		return
+	}
+	root, err := s.db.SigningRootAtTargetEpoch(r.Context(), pubKey, primitives.Epoch(target))
+	if err != nil {
+		http2.HandleError(w, "Could not get signing root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &remote.SigningRootResponse{SigningRoot: hexutil.Encode(root[:]), Exists: root != [32]byte{}})
+}
+
+func (s *server) checkAttestation(w http.ResponseWriter, r *http.Request) {
+	pubKey, root, att, ok := attestationFromRequest(w, r)
+	if !ok {
+		return
+	}
+	kind, err := s.db.CheckSlashableAttestation(r.Context(), pubKey, root, att)
+	resp := &remote.CheckResponse{SlashingKind: remote.SlashingKindToString(kind)}
+	if err != nil {
+		if kind == kv.NotSlashable {
+			http2.HandleError(w, "Could not check attestation: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Message = err.Error()
+	}
+	http2.WriteJson(w, resp)
+}
+
+// Records an attestation, unless it is slashable with respect to the saved history, or its source
+// or target epochs are lower than the lowest signed ones, as checked by the validator client
+// against its local DB.
+func (s *server) saveAttestation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pubKey, root, att, ok := attestationFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	defer s.lock(pubKey)()
+	lowestSource, exists, err := s.db.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		http2.HandleError(w, "Could not get lowest signed source epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if exists && att.Data.Source.Epoch < lowestSource {
+		writeConflict(w, kv.NotSlashable, fmt.Sprintf(
+			"source epoch %d is lower than the lowest signed source epoch %d", att.Data.Source.Epoch, lowestSource,
+		))
+		return
+	}
+	existingRoot, err := s.db.SigningRootAtTargetEpoch(ctx, pubKey, att.Data.Target.Epoch)
+	if err != nil {
+		http2.HandleError(w, "Could not get signing root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	lowestTarget, exists, err := s.db.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		http2.HandleError(w, "Could not get lowest signed target epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if slashings.SigningRootsDiffer(existingRoot, root) && exists && att.Data.Target.Epoch <= lowestTarget {
+		writeConflict(w, kv.NotSlashable, fmt.Sprintf(
+			"target epoch %d is lower than or equal to the lowest signed target epoch %d", att.Data.Target.Epoch, lowestTarget,
+		))
+		return
+	}
+	kind, err := s.db.CheckSlashableAttestation(ctx, pubKey, root, att)
+	if err != nil {
+		if kind == kv.NotSlashable {
+			http2.HandleError(w, "Could not check attestation: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeConflict(w, kind, err.Error())
+		return
+	}
+	if err := s.db.SaveAttestationForPubKey(ctx, pubKey, root, att); err != nil {
+		http2.HandleError(w, "Could not save attestation: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func attestationFromRequest(
+	w http.ResponseWriter, r *http.Request,
+) ([fieldparams.BLSPubkeyLength]byte, [32]byte, *ethpb.IndexedAttestation, bool) {
+	pubKey, ok := pubKeyFromRequest(w, r)
+	if !ok {
+		return pubKey, [32]byte{}, nil, false
+	}
+	var req remote.AttestationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return pubKey, [32]byte{}, nil, false
+	}
+	source, ok := uintFromString(w, "source epoch", req.SourceEpoch)
+	if !ok {
+		return pubKey, [32]byte{}, nil, false
+	}
+	target, ok := uintFromString(w, "target epoch", req.TargetEpoch)
+	if !ok {
+		return pubKey, [32]byte{}, nil, false
+	}
+	root, ok := rootFromString(w, req.SigningRoot)
+	if !ok {
+		return pubKey, [32]byte{}, nil, false
+	}
+	att := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: primitives.Epoch(source)},
+			Target: &ethpb.Checkpoint{Epoch: primitives.Epoch(target)},
+		},
+	}
+	return pubKey, root, att, true
+}
+
+func pubKeyFromRequest(w http.ResponseWriter, r *http.Request) ([fieldparams.BLSPubkeyLength]byte, bool) {
+	raw := mux.Vars(r)["pubkey"]
+	pubKey, err := hexutil.Decode(raw)
+	if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+		http2.HandleError(w, "Invalid public key "+raw, http.StatusBadRequest)
+		return [fieldparams.BLSPubkeyLength]byte{}, false
+	}
+	return bytesutil.ToBytes48(pubKey), true
+}
+
+func uintFromString(w http.ResponseWriter, name, raw string) (uint64, bool) {
+	v, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		http2.HandleError(w, fmt.Sprintf("Invalid %s %s", name, raw), http.StatusBadRequest)
+		return 0, false
+	}
+	return v, true
+}
+
+func rootFromString(w http.ResponseWriter, raw string) ([32]byte, bool) {
+	root, err := hexutil.Decode(raw)
+	if err != nil || len(root) != fieldparams.RootLength {
+		http2.HandleError(w, "Invalid signing root "+raw, http.StatusBadRequest)
+		return [32]byte{}, false
+	}
+	return bytesutil.ToBytes32(root), true
+}
+
+func writeConflict(w http.ResponseWriter, kind kv.SlashingKind, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusConflict)
+	resp := &remote.CheckResponse{SlashingKind: remote.SlashingKindToString(kind), Message: message}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write conflict response")
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/remote"
+)
+
+func setupClients(t *testing.T, n int) []*remote.Client {
+	db, err := kv.NewKVStore(context.Background(), t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	srv := httptest.NewServer(newServer(db).router())
+	t.Cleanup(srv.Close)
+	clients := make([]*remote.Client, n)
+	for i := range clients {
+		clients[i], err = remote.NewClient(srv.URL)
+		require.NoError(t, err)
+	}
+	return clients
+}
+
+func createAttestation(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+func TestServer_Proposals(t *testing.T) {
+	ctx := context.Background()
+	clients := setupClients(t, 2)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	root1, root2 := [32]byte{1}, [32]byte{2}
+
+	_, exists, err := clients[0].LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	require.NoError(t, clients[0].SaveProposalHistoryForSlot(ctx, pubKey, 10, root1[:]))
+	// Recording the same proposal again is allowed.
+	require.NoError(t, clients[1].SaveProposalHistoryForSlot(ctx, pubKey, 10, root1[:]))
+	// The standby client cannot record a different proposal at the same slot.
+	err = clients[1].SaveProposalHistoryForSlot(ctx, pubKey, 10, root2[:])
+	assert.Equal(t, true, errors.Is(err, remote.ErrSlashable))
+	// Nor a proposal before the lowest signed slot.
+	err = clients[1].SaveProposalHistoryForSlot(ctx, pubKey, 9, root2[:])
+	assert.Equal(t, true, errors.Is(err, remote.ErrSlashable))
+	require.NoError(t, clients[1].SaveProposalHistoryForSlot(ctx, pubKey, 12, root2[:]))
+
+	root, exists, err := clients[1].ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, [32]byte{1}, root)
+	_, exists, err = clients[1].ProposalHistoryForSlot(ctx, pubKey, 11)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	lowest, exists, err := clients[0].LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, primitives.Slot(10), lowest)
+	highest, exists, err := clients[0].HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, primitives.Slot(12), highest)
+}
+
+func TestServer_Attestations(t *testing.T) {
+	ctx := context.Background()
+	clients := setupClients(t, 2)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	require.NoError(t, clients[0].SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(2, 3)))
+	// Recording the same attestation again is allowed.
+	require.NoError(t, clients[1].SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(2, 3)))
+
+	// The standby client cannot record a double vote.
+	kind, err := clients[1].CheckSlashableAttestation(ctx, pubKey, [32]byte{2}, createAttestation(2, 3))
+	require.ErrorContains(t, "double vote", err)
+	assert.Equal(t, kv.DoubleVote, kind)
+	err = clients[1].SaveAttestationForPubKey(ctx, pubKey, [32]byte{2}, createAttestation(2, 3))
+	assert.Equal(t, true, errors.Is(err, remote.ErrSlashable))
+
+	// Nor a surrounding vote.
+	require.NoError(t, clients[0].SaveAttestationForPubKey(ctx, pubKey, [32]byte{3}, createAttestation(5, 6)))
+	kind, err = clients[1].CheckSlashableAttestation(ctx, pubKey, [32]byte{4}, createAttestation(4, 7))
+	require.ErrorContains(t, "surrounds", err)
+	assert.Equal(t, kv.SurroundingVote, kind)
+	err = clients[1].SaveAttestationForPubKey(ctx, pubKey, [32]byte{4}, createAttestation(4, 7))
+	assert.Equal(t, true, errors.Is(err, remote.ErrSlashable))
+
+	// Nor an attestation with a source epoch lower than the lowest signed one.
+	err = clients[1].SaveAttestationForPubKey(ctx, pubKey, [32]byte{5}, createAttestation(1, 8))
+	assert.Equal(t, true, errors.Is(err, remote.ErrSlashable))
+
+	kind, err = clients[1].CheckSlashableAttestation(ctx, pubKey, [32]byte{5}, createAttestation(6, 7))
+	require.NoError(t, err)
+	assert.Equal(t, kv.NotSlashable, kind)
+	require.NoError(t, clients[1].SaveAttestationForPubKey(ctx, pubKey, [32]byte{5}, createAttestation(6, 7)))
+
+	root, err := clients[0].SigningRootAtTargetEpoch(ctx, pubKey, 7)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{5}, root)
+	source, exists, err := clients[0].LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, primitives.Epoch(2), source)
+	target, exists, err := clients[0].LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, primitives.Epoch(3), target)
+}
+
+func TestServer_Attestations_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	clients := setupClients(t, 2)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	// Of two concurrent double votes of the same public key, only one is recorded, while the attestations of
+	// other public keys are recorded concurrently.
+	var wg sync.WaitGroup
+	errs := make([]error, len(clients))
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = clients[i].SaveAttestationForPubKey(ctx, pubKey, [32]byte{byte(i + 1)}, createAttestation(2, 3))
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := [fieldparams.BLSPubkeyLength]byte{2, byte(i)}
+			assert.NoError(t, clients[i%2].SaveAttestationForPubKey(ctx, key, [32]byte{1}, createAttestation(2, 3)))
+		}(i)
+	}
+	wg.Wait()
+	slashable := 0
+	for _, err := range errs {
+		if err != nil {
+			require.Equal(t, true, errors.Is(err, remote.ErrSlashable))
+			slashable++
+		}
+	}
+	assert.Equal(t, 1, slashable)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/accounts/iface"
 	accountsprompt "github.com/prysmaticlabs/prysm/v4/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/composite"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
@@ -302,6 +303,21 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
+	// A web3signer config given along with a local or derived wallet means keys are signed
+	// with both the wallet keystores and web3signer.
+	if cfg.Web3SignerConfig != nil && w.KeymanagerKind() != keymanager.Web3Signer {
+		if !bytesutil.IsValidRoot(cfg.Web3SignerConfig.GenesisValidatorsRoot) {
+			return nil, errors.New("web3signer requires a genesis validators root value")
+		}
+		remoteKm, err := remoteweb3signer.NewKeymanager(ctx, cfg.Web3SignerConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
+		}
+		km, err = composite.NewKeymanager(ctx, km, remoteKm)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize composite keymanager")
+		}
+	}
 	return km, nil
 }
 
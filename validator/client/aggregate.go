@@ -50,9 +50,14 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	v.aggregatedSlotCommitteeIDCache.Add(k, true)
 	v.aggregatedSlotCommitteeIDCacheLock.Unlock()
 
-	slotSig, err := v.signSlotWithSelectionProof(ctx, pubKey, slot)
+	var slotSig []byte
+	if v.distributed {
+		slotSig, err = v.attSelection(ctx, pubKey, slot, duty.ValidatorIndex)
+	} else {
+		slotSig, err = v.signSlotWithSelectionProof(ctx, pubKey, slot)
+	}
 	if err != nil {
-		log.WithError(err).Error("Could not sign slot")
+		log.WithError(err).Error("Could not get selection proof")
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/prysmaticlabs/go-bitfield"
@@ -26,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // SubmitAttestation completes the validator client's attester responsibility at a given slot.
@@ -75,7 +77,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		Slot:           slot,
 		CommitteeIndex: duty.CommitteeIndex,
 	}
-	data, err := v.validatorClient.GetAttestationData(ctx, req)
+	data, err := v.getAttestationData(ctx, req)
 	if err != nil {
 		log.WithError(err).Error("Could not request attestation to sign at slot")
 		if v.emitAccountMetrics {
@@ -180,6 +182,78 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 	}
 }
 
+// attestationDataSource is a beacon node which attestation data is requested from, when
+// attesting with the data agreed by the majority of the beacon nodes.
+type attestationDataSource struct {
+	host            string
+	validatorClient iface.ValidatorClient
+	beaconClient    iface.BeaconChainClient
+}
+
+type attestationDataCandidate struct {
+	data    *ethpb.AttestationData
+	sources []*attestationDataSource
+}
+
+// attestationDataKey identifies the attestation data of a committee at a slot, which is resolved
+// once for all the validators of the committee.
+type attestationDataKey struct {
+	slot           primitives.Slot
+	committeeIndex primitives.CommitteeIndex
+}
+
+// attestationDataResolution is the attestation data chosen among the beacon nodes for a committee,
+// which is available once done is closed.
+type attestationDataResolution struct {
+	done chan struct{}
+	data *ethpb.AttestationData
+	err  error
+}
+
+type attestationDataResult struct {
+	sourceIndex int
+	data        *ethpb.AttestationData
+	root        [32]byte
+	err         error
+}
+
+// Each beacon node is given a quarter of a slot to return its attestation data or chain head,
+// so that an unresponsive beacon node cannot delay the attestation past its deadline.
+func attestationDataRequestTimeout() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 4
+}
+
+// Returns the highest head slot of the beacon nodes which returned each candidate. The chain
+// heads are requested from all of them concurrently, and a beacon node which could not be
+// requested does not count.
+func highestHeadSlots(ctx context.Context, candidates []*attestationDataCandidate) []primitives.Slot {
+	headSlots := make([]primitives.Slot, len(candidates))
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for i, candidate := range candidates {
+		for _, source := range candidate.sources {
+			wg.Add(1)
+			go func(i int, source *attestationDataSource) {
+				defer wg.Done()
+				reqCtx, cancel := context.WithTimeout(ctx, attestationDataRequestTimeout())
+				defer cancel()
+				head, err := source.beaconClient.GetChainHead(reqCtx, &emptypb.Empty{})
+				if err != nil {
+					log.WithError(err).WithField("host", source.host).Warn("Could not request chain head from beacon node")
+					return
+				}
+				lock.Lock()
+				if head.HeadSlot > headSlots[i] {
+					headSlots[i] = head.HeadSlot
+				}
+				lock.Unlock()
+			}(i, source)
+		}
+	}
+	wg.Wait()
+	return headSlots
+}
+
 // Given the validator public key, this gets the validator assignment.
 func (v *validator) duty(pubKey [fieldparams.BLSPubkeyLength]byte) (*ethpb.DutiesResponse_Duty, error) {
 	if v.duties == nil {
@@ -195,6 +269,154 @@ func (v *validator) duty(pubKey [fieldparams.BLSPubkeyLength]byte) (*ethpb.Dutie
 	return nil, fmt.Errorf("pubkey %#x not in duties", bytesutil.Trunc(pubKey[:]))
 }
 
+// Requests the attestation data to sign. When attestation data sources are configured, the data
+// is requested from all of them, so that a single lagging or faulty beacon node cannot make the
+// validator vote for a wrong head. The data is chosen once per committee and slot, and shared by
+// the validators of the committee.
+func (v *validator) getAttestationData(ctx context.Context, req *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	if len(v.attestationDataSources) == 0 {
+		return v.validatorClient.GetAttestationData(ctx, req)
+	}
+	key := attestationDataKey{slot: req.Slot, committeeIndex: req.CommitteeIndex}
+	v.attestationDataLock.Lock()
+	res, ok := v.attestationData[key]
+	if ok {
+		v.attestationDataLock.Unlock()
+		select {
+		case <-res.done:
+			return res.data, res.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if v.attestationData == nil {
+		v.attestationData = make(map[attestationDataKey]*attestationDataResolution)
+	}
+	// The attestation data of the previous slots is not requested anymore.
+	for k := range v.attestationData {
+		if k.slot < req.Slot {
+			delete(v.attestationData, k)
+		}
+	}
+	res = &attestationDataResolution{done: make(chan struct{})}
+	v.attestationData[key] = res
+	v.attestationDataLock.Unlock()
+
+	res.data, res.err = v.resolveAttestationData(ctx, req)
+	if res.err != nil {
+		// The validators waiting for the data get the error, the next ones request it again.
+		v.attestationDataLock.Lock()
+		if v.attestationData[key] == res {
+			delete(v.attestationData, key)
+		}
+		v.attestationDataLock.Unlock()
+	}
+	close(res.done)
+	return res.data, res.err
+}
+
+// Requests the attestation data from all the attestation data sources. The data is used as soon as
+// a majority of the beacon nodes returned it. Otherwise, once all of them responded or timed out,
+// the data returned by the most beacon nodes is used, and ties are broken in favor of the data
+// returned by the beacon node with the highest head slot.
+func (v *validator) resolveAttestationData(ctx context.Context, req *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.resolveAttestationData")
+	defer span.End()
+	// Cancels the requests still in flight once the attestation data is chosen.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resultsChan := make(chan *attestationDataResult, len(v.attestationDataSources))
+	for i, source := range v.attestationDataSources {
+		go func(i int, source *attestationDataSource) {
+			reqCtx, reqCancel := context.WithTimeout(ctx, attestationDataRequestTimeout())
+			defer reqCancel()
+			res := &attestationDataResult{sourceIndex: i}
+			res.data, res.err = source.validatorClient.GetAttestationData(reqCtx, req)
+			if res.err == nil {
+				res.root, res.err = res.data.HashTreeRoot()
+			}
+			resultsChan <- res
+		}(i, source)
+	}
+
+	majority := len(v.attestationDataSources)/2 + 1
+	results := make([]*attestationDataResult, len(v.attestationDataSources))
+	agreements := make(map[[32]byte]int)
+	for range v.attestationDataSources {
+		res := <-resultsChan
+		results[res.sourceIndex] = res
+		if res.err != nil {
+			log.WithError(res.err).WithField("host", v.attestationDataSources[res.sourceIndex].host).Warn("Could not request attestation data from beacon node")
+			continue
+		}
+		agreements[res.root]++
+		if agreements[res.root] >= majority {
+			break
+		}
+	}
+
+	// Candidates are in the order of the first beacon node which returned them, so that the
+	// first configured beacon node wins if the head slots are tied as well.
+	var candidates []*attestationDataCandidate
+	candidatesByRoot := make(map[[32]byte]*attestationDataCandidate)
+	for i, source := range v.attestationDataSources {
+		if results[i] == nil || results[i].err != nil {
+			continue
+		}
+		candidate, ok := candidatesByRoot[results[i].root]
+		if !ok {
+			candidate = &attestationDataCandidate{data: results[i].data}
+			candidatesByRoot[results[i].root] = candidate
+			candidates = append(candidates, candidate)
+		}
+		candidate.sources = append(candidate.sources, source)
+	}
+	if len(candidates) == 0 {
+		return nil, errors.New("could not request attestation data from any beacon node")
+	}
+	if len(candidates) == 1 {
+		return candidates[0].data, nil
+	}
+
+	for _, candidate := range candidates {
+		hosts := make([]string, len(candidate.sources))
+		for i, source := range candidate.sources {
+			hosts[i] = source.host
+		}
+		log.WithFields(logrus.Fields{
+			"slot":            req.Slot,
+			"committeeIndex":  req.CommitteeIndex,
+			"beaconBlockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(candidate.data.BeaconBlockRoot)),
+			"sourceEpoch":     candidate.data.Source.Epoch,
+			"targetEpoch":     candidate.data.Target.Epoch,
+			"targetRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(candidate.data.Target.Root)),
+			"hosts":           hosts,
+		}).Warn("Beacon nodes disagree on attestation data")
+	}
+	AttestationDataDisagreementsTotal.Inc()
+
+	var mostAgreed []*attestationDataCandidate
+	for _, candidate := range candidates {
+		if len(mostAgreed) == 0 || len(candidate.sources) > len(mostAgreed[0].sources) {
+			mostAgreed = []*attestationDataCandidate{candidate}
+		} else if len(candidate.sources) == len(mostAgreed[0].sources) {
+			mostAgreed = append(mostAgreed, candidate)
+		}
+	}
+	if len(mostAgreed) == 1 {
+		return mostAgreed[0].data, nil
+	}
+	headSlots := highestHeadSlots(ctx, mostAgreed)
+	best := 0
+	for i := range mostAgreed[1:] {
+		if headSlots[i+1] > headSlots[best] {
+			best = i + 1
+		}
+	}
+	return mostAgreed[best].data, nil
+}
+
 // Given validator's public key, this function returns the signature of an attestation data and its signing root.
 func (v *validator) signAtt(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, data *ethpb.AttestationData, slot primitives.Slot) ([]byte, [32]byte, error) {
 	domain, root, err := v.getDomainAndSigningRoot(ctx, data)
@@ -31,7 +31,7 @@ func (v *validator) slashableAttestationCheck(
 
 	// Based on EIP3076, validator should refuse to sign any attestation with source epoch less
 	// than the minimum source epoch present in that signer’s attestations.
-	lowestSourceEpoch, exists, err := v.db.LowestSignedSourceEpoch(ctx, pubKey)
+	lowestSourceEpoch, exists, err := v.protection().LowestSignedSourceEpoch(ctx, pubKey)
 	if err != nil {
 		return err
 	}
@@ -42,7 +42,7 @@ func (v *validator) slashableAttestationCheck(
 			lowestSourceEpoch,
 		)
 	}
-	existingSigningRoot, err := v.db.SigningRootAtTargetEpoch(ctx, pubKey, indexedAtt.Data.Target.Epoch)
+	existingSigningRoot, err := v.protection().SigningRootAtTargetEpoch(ctx, pubKey, indexedAtt.Data.Target.Epoch)
 	if err != nil {
 		return err
 	}
@@ -50,7 +50,7 @@ func (v *validator) slashableAttestationCheck(
 
 	// Based on EIP3076, validator should refuse to sign any attestation with target epoch less
 	// than or equal to the minimum target epoch present in that signer’s attestations.
-	lowestTargetEpoch, exists, err := v.db.LowestSignedTargetEpoch(ctx, pubKey)
+	lowestTargetEpoch, exists, err := v.protection().LowestSignedTargetEpoch(ctx, pubKey)
 	if err != nil {
 		return err
 	}
@@ -62,7 +62,7 @@ func (v *validator) slashableAttestationCheck(
 		)
 	}
 	fmtKey := "0x" + hex.EncodeToString(pubKey[:])
-	slashingKind, err := v.db.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
+	slashingKind, err := v.protection().CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
 	if err != nil {
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -78,7 +78,7 @@ func (v *validator) slashableAttestationCheck(
 		return errors.Wrap(err, failedAttLocalProtectionErr)
 	}
 
-	if err := v.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt); err != nil {
+	if err := v.saveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt); err != nil {
 		return errors.Wrap(err, "could not save attestation history for validator public key")
 	}
 
@@ -5,12 +5,15 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	vdb "github.com/prysmaticlabs/prysm/v4/validator/db"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
 )
 
 func Test_slashableAttestationCheck(t *testing.T) {
@@ -197,3 +200,76 @@ func Test_slashableAttestationCheck_GenesisEpoch(t *testing.T) {
 	require.Equal(t, true, exists)
 	require.Equal(t, primitives.Epoch(0), e)
 }
+
+// remoteProtection stands for the remote slashing protection service, which only refuses double votes.
+type remoteProtection struct {
+	vdb.SlashingProtection
+	roots map[primitives.Epoch][32]byte
+}
+
+func (r *remoteProtection) LowestSignedSourceEpoch(context.Context, [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	return 0, false, nil
+}
+
+func (r *remoteProtection) LowestSignedTargetEpoch(context.Context, [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	return 0, false, nil
+}
+
+func (r *remoteProtection) SigningRootAtTargetEpoch(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte, target primitives.Epoch) ([32]byte, error) {
+	return r.roots[target], nil
+}
+
+func (r *remoteProtection) CheckSlashableAttestation(
+	_ context.Context, _ [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	if root, ok := r.roots[att.Data.Target.Epoch]; ok && root != signingRoot {
+		return kv.DoubleVote, errors.New("double vote")
+	}
+	return kv.NotSlashable, nil
+}
+
+func (r *remoteProtection) SaveAttestationForPubKey(
+	_ context.Context, _ [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	r.roots[att.Data.Target.Epoch] = signingRoot
+	return nil
+}
+
+func Test_slashableAttestationCheck_RemoteSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	remote := &remoteProtection{roots: make(map[primitives.Epoch][32]byte)}
+	validator.slashingProtection = remote
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2},
+		Data: &ethpb.AttestationData{
+			Slot:            5,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: bytesutil.PadTo([]byte("great block"), 32),
+			Source: &ethpb.Checkpoint{
+				Epoch: 4,
+				Root:  bytesutil.PadTo([]byte("good source"), 32),
+			},
+			Target: &ethpb.Checkpoint{
+				Epoch: 10,
+				Root:  bytesutil.PadTo([]byte("good target"), 32),
+			},
+		},
+	}
+
+	require.NoError(t, validator.slashableAttestationCheck(ctx, att, pubKey, [32]byte{1}))
+	// The attestation accepted by the remote service is recorded in the validator DB as well.
+	require.Equal(t, [32]byte{1}, remote.roots[10])
+	root, err := validator.db.SigningRootAtTargetEpoch(ctx, pubKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{1}, root)
+
+	// The attestation refused by the remote service is not.
+	require.ErrorContains(t, failedAttLocalProtectionErr, validator.slashableAttestationCheck(ctx, att, pubKey, [32]byte{2}))
+	root, err = validator.db.SigningRootAtTargetEpoch(ctx, pubKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{1}, root)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"reflect"
 	"sync"
 	"testing"
@@ -24,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	validatormock "github.com/prysmaticlabs/prysm/v4/testing/validator-mock"
 	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"gopkg.in/d4l3k/messagediff.v1"
@@ -544,3 +546,187 @@ func TestServer_WaitToSlotOneThird_ReceiveBlockSlot(t *testing.T) {
 		t.Errorf("Wanted %d time for slot one third but got %d", uint64(time.Now().Unix()), currentTime)
 	}
 }
+
+func TestGetAttestationData_Majority(t *testing.T) {
+	dataA := &ethpb.AttestationData{
+		Slot:            10,
+		BeaconBlockRoot: bytesutil.PadTo([]byte("A"), 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+	dataB := &ethpb.AttestationData{
+		Slot:            10,
+		BeaconBlockRoot: bytesutil.PadTo([]byte("B"), 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+	type response struct {
+		data     *ethpb.AttestationData
+		err      error
+		headSlot primitives.Slot
+		delay    time.Duration
+		// Blocks until the request is canceled.
+		unresponsive bool
+	}
+	tests := []struct {
+		name      string
+		responses []response
+		want      *ethpb.AttestationData
+		wantErr   string
+	}{
+		{
+			name:      "all agree",
+			responses: []response{{data: dataA}, {data: dataA}, {data: dataA}},
+			want:      dataA,
+		},
+		{
+			name: "majority wins over the first beacon node",
+			responses: []response{
+				{data: dataA, headSlot: 10}, {data: dataB, delay: 50 * time.Millisecond}, {data: dataB, delay: 50 * time.Millisecond},
+			},
+			want: dataB,
+		},
+		{
+			name:      "majority does not wait for unresponsive beacon node",
+			responses: []response{{data: dataA}, {unresponsive: true}, {data: dataA}},
+			want:      dataA,
+		},
+		{
+			name:      "tie broken by highest head slot",
+			responses: []response{{data: dataA, headSlot: 9}, {data: dataB, headSlot: 10}, {err: errors.New("unavailable")}},
+			want:      dataB,
+		},
+		{
+			name:      "tie with equal head slots goes to the first beacon node",
+			responses: []response{{data: dataA, headSlot: 10}, {data: dataB, headSlot: 10}, {err: errors.New("unavailable")}},
+			want:      dataA,
+		},
+		{
+			name: "all fail",
+			responses: []response{
+				{err: errors.New("unavailable")}, {err: errors.New("unavailable")}, {err: errors.New("unavailable")},
+			},
+			wantErr: "could not request attestation data from any beacon node",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			validator, _, _, finish := setup(t)
+			defer finish()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			disagree := false
+			// Waits for all beacon nodes to be requested before the mocks are checked, as the
+			// attestation data may be returned before the slowest ones are.
+			var requested sync.WaitGroup
+			defer requested.Wait()
+			for i, resp := range tt.responses {
+				resp := resp
+				validatorClient := validatormock.NewMockValidatorClient(ctrl)
+				requested.Add(1)
+				validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, _ *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+						requested.Done()
+						if resp.unresponsive {
+							<-ctx.Done()
+							return nil, ctx.Err()
+						}
+						time.Sleep(resp.delay)
+						return resp.data, resp.err
+					})
+				beaconClient := validatormock.NewMockBeaconChainClient(ctrl)
+				beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: resp.headSlot}, nil).AnyTimes()
+				validator.attestationDataSources = append(validator.attestationDataSources, &attestationDataSource{
+					host:            fmt.Sprintf("beacon-node-%d", i),
+					validatorClient: validatorClient,
+					beaconClient:    beaconClient,
+				})
+				if resp.data != nil && resp.data != tt.responses[0].data {
+					disagree = true
+				}
+			}
+
+			data, err := validator.getAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 10})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, data)
+			if disagree {
+				require.LogsContain(t, hook, "Beacon nodes disagree on attestation data")
+			} else {
+				require.LogsDoNotContain(t, hook, "Beacon nodes disagree on attestation data")
+			}
+		})
+	}
+}
+
+func TestGetAttestationData_OncePerCommittee(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, _, finish := setup(t)
+	defer finish()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	for i := 0; i < 2; i++ {
+		data := &ethpb.AttestationData{
+			Slot:            10,
+			BeaconBlockRoot: bytesutil.PadTo([]byte{byte(i)}, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		}
+		validatorClient := validatormock.NewMockValidatorClient(ctrl)
+		// The attestation data of a committee is requested once per beacon node.
+		validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+				time.Sleep(10 * time.Millisecond)
+				return data, nil
+			}).Times(2)
+		beaconClient := validatormock.NewMockBeaconChainClient(ctrl)
+		beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: 10}, nil).AnyTimes()
+		validator.attestationDataSources = append(validator.attestationDataSources, &attestationDataSource{
+			host:            fmt.Sprintf("beacon-node-%d", i),
+			validatorClient: validatorClient,
+			beaconClient:    beaconClient,
+		})
+	}
+
+	// The validators of two committees request the attestation data concurrently.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := &ethpb.AttestationDataRequest{Slot: 10, CommitteeIndex: primitives.CommitteeIndex(i % 2)}
+			data, err := validator.getAttestationData(context.Background(), req)
+			assert.NoError(t, err)
+			assert.DeepEqual(t, bytesutil.PadTo([]byte{0}, 32), data.BeaconBlockRoot)
+		}(i)
+	}
+	wg.Wait()
+	// Each candidate is logged once per committee.
+	disagreements := 0
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Beacon nodes disagree on attestation data" {
+			disagreements++
+		}
+	}
+	assert.Equal(t, 4, disagreements)
+
+	// The attestation data of the previous slots is dropped.
+	next := &ethpb.AttestationData{
+		Slot:            11,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+	}
+	for _, source := range validator.attestationDataSources {
+		validatorClient, ok := source.validatorClient.(*validatormock.MockValidatorClient)
+		require.Equal(t, true, ok)
+		validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(next, nil)
+	}
+	_, err := validator.getAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 11})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(validator.attestationData))
+}
@@ -113,7 +113,7 @@ func TestActivation_Nominal(t *testing.T) {
 	// Instantiate a cancellable context.
 	ctx, cancel := context.WithCancel(context.Background())
 
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	// GetRestJsonResponse does not return any result for non existing key
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
@@ -238,7 +238,7 @@ func TestActivation_InvalidData(t *testing.T) {
 
 				ctx := context.Background()
 
-				jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+				jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 				jsonRestHandler.EXPECT().GetRestJsonResponse(
 					ctx,
 					gomock.Any(),
@@ -278,7 +278,7 @@ func TestActivation_JsonResponseError(t *testing.T) {
 
 	ctx := context.Background()
 
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
 		gomock.Any(),
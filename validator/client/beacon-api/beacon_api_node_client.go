@@ -2,14 +2,13 @@ package beacon_api
 
 import (
 	"context"
-	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/node"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -17,7 +16,7 @@ import (
 
 type beaconApiNodeClient struct {
 	fallbackClient  iface.NodeClient
-	jsonRestHandler jsonRestHandler
+	jsonRestHandler JsonRestHandler
 	genesisProvider genesisProvider
 }
 
@@ -75,13 +74,27 @@ func (c *beaconApiNodeClient) GetGenesis(ctx context.Context, _ *empty.Empty) (*
 	}, nil
 }
 
-func (c *beaconApiNodeClient) GetVersion(ctx context.Context, in *empty.Empty) (*ethpb.Version, error) {
-	if c.fallbackClient != nil {
-		return c.fallbackClient.GetVersion(ctx, in)
+func (c *beaconApiNodeClient) GetVersion(ctx context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+	versionResponse := apimiddleware.VersionResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, "/eth/v1/node/version", &versionResponse); err != nil {
+		return nil, errors.Wrap(err, "failed to get version")
 	}
 
-	// TODO: Implement me
-	panic("beaconApiNodeClient.GetVersion is not implemented. To use a fallback client, pass a fallback client as the last argument of NewBeaconApiNodeClientWithFallback.")
+	if versionResponse.Data == nil {
+		return nil, errors.New("version data is nil")
+	}
+
+	v := &ethpb.Version{
+		Version: versionResponse.Data.Version,
+	}
+
+	// The execution client version is left out if the beacon node or its execution client does not support it.
+	clientVersionResponse := node.ExecutionClientVersionResponse{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, "/prysm/node/execution_client_version", &clientVersionResponse); err == nil && clientVersionResponse.Data != nil {
+		v.ExecutionClientVersion = clientVersionResponse.Data.Name + "/" + clientVersionResponse.Data.Version
+	}
+
+	return v, nil
 }
 
 func (c *beaconApiNodeClient) ListPeers(ctx context.Context, in *empty.Empty) (*ethpb.Peers, error) {
@@ -93,12 +106,7 @@ func (c *beaconApiNodeClient) ListPeers(ctx context.Context, in *empty.Empty) (*
 	panic("beaconApiNodeClient.ListPeers is not implemented. To use a fallback client, pass a fallback client as the last argument of NewBeaconApiNodeClientWithFallback.")
 }
 
-func NewNodeClientWithFallback(host string, timeout time.Duration, fallbackClient iface.NodeClient) iface.NodeClient {
-	jsonRestHandler := beaconApiJsonRestHandler{
-		httpClient: http.Client{Timeout: timeout},
-		host:       host,
-	}
-
+func NewNodeClientWithFallback(jsonRestHandler JsonRestHandler, fallbackClient iface.NodeClient) iface.NodeClient {
 	return &beaconApiNodeClient{
 		jsonRestHandler: jsonRestHandler,
 		fallbackClient:  fallbackClient,
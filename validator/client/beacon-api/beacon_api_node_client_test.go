@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/node"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/validator/client/beacon-api/mock"
@@ -122,7 +123,7 @@ func TestGetGenesis(t *testing.T) {
 			)
 
 			depositContractJson := apimiddleware.DepositContractResponseJson{}
-			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 			if testCase.queriesDepositContract {
 				jsonRestHandler.EXPECT().GetRestJsonResponse(
@@ -204,7 +205,7 @@ func TestGetSyncStatus(t *testing.T) {
 			ctx := context.Background()
 
 			syncingResponse := apimiddleware.SyncingResponseJson{}
-			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 			jsonRestHandler.EXPECT().GetRestJsonResponse(
 				ctx,
 				syncingEndpoint,
@@ -228,3 +229,110 @@ func TestGetSyncStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVersion(t *testing.T) {
+	const versionEndpoint = "/eth/v1/node/version"
+	const clientVersionEndpoint = "/prysm/node/execution_client_version"
+
+	testCases := []struct {
+		name                        string
+		restEndpointResponse        apimiddleware.VersionResponseJson
+		restEndpointError           error
+		clientVersionEndpointCalled bool
+		clientVersionResponse       node.ExecutionClientVersionResponse
+		clientVersionError          error
+		expectedResponse            *ethpb.Version
+		expectedError               string
+	}{
+		{
+			name:              "fails to query REST endpoint",
+			restEndpointError: errors.New("foo error"),
+			expectedError:     "failed to get version: foo error",
+		},
+		{
+			name:                 "returns nil version data",
+			restEndpointResponse: apimiddleware.VersionResponseJson{Data: nil},
+			expectedError:        "version data is nil",
+		},
+		{
+			name: "returns proper version response",
+			restEndpointResponse: apimiddleware.VersionResponseJson{
+				Data: &apimiddleware.VersionJson{
+					Version: "Prysm/v4.1.0 (linux amd64)",
+				},
+			},
+			clientVersionEndpointCalled: true,
+			clientVersionResponse: node.ExecutionClientVersionResponse{
+				Data: &node.ExecutionClientVersion{
+					Code:    "GE",
+					Name:    "Geth",
+					Version: "v1.13.5",
+				},
+			},
+			expectedResponse: &ethpb.Version{
+				Version:                "Prysm/v4.1.0 (linux amd64)",
+				ExecutionClientVersion: "Geth/v1.13.5",
+			},
+		},
+		{
+			name: "leaves out unavailable execution client version",
+			restEndpointResponse: apimiddleware.VersionResponseJson{
+				Data: &apimiddleware.VersionJson{
+					Version: "Prysm/v4.1.0 (linux amd64)",
+				},
+			},
+			clientVersionEndpointCalled: true,
+			clientVersionError:          errors.New("bar error"),
+			expectedResponse: &ethpb.Version{
+				Version: "Prysm/v4.1.0 (linux amd64)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			versionResponse := apimiddleware.VersionResponseJson{}
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+			jsonRestHandler.EXPECT().GetRestJsonResponse(
+				ctx,
+				versionEndpoint,
+				&versionResponse,
+			).Return(
+				nil,
+				testCase.restEndpointError,
+			).SetArg(
+				2,
+				testCase.restEndpointResponse,
+			)
+
+			if testCase.clientVersionEndpointCalled {
+				clientVersionResponse := node.ExecutionClientVersionResponse{}
+				jsonRestHandler.EXPECT().GetRestJsonResponse(
+					ctx,
+					clientVersionEndpoint,
+					&clientVersionResponse,
+				).Return(
+					nil,
+					testCase.clientVersionError,
+				).SetArg(
+					2,
+					testCase.clientVersionResponse,
+				)
+			}
+
+			nodeClient := &beaconApiNodeClient{jsonRestHandler: jsonRestHandler}
+			version, err := nodeClient.GetVersion(ctx, &emptypb.Empty{})
+
+			if testCase.expectedResponse == nil {
+				assert.ErrorContains(t, testCase.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.DeepEqual(t, testCase.expectedResponse, version)
+			}
+		})
+	}
+}
@@ -2,8 +2,6 @@ package beacon_api
 
 import (
 	"context"
-	"net/http"
-	"time"
 
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
@@ -11,7 +9,7 @@ import (
 
 type beaconApiSlasherClient struct {
 	fallbackClient  iface.SlasherClient
-	jsonRestHandler jsonRestHandler
+	jsonRestHandler JsonRestHandler
 }
 
 func (c beaconApiSlasherClient) IsSlashableAttestation(ctx context.Context, in *ethpb.IndexedAttestation) (*ethpb.AttesterSlashingResponse, error) {
@@ -32,12 +30,7 @@ func (c beaconApiSlasherClient) IsSlashableBlock(ctx context.Context, in *ethpb.
 	panic("beaconApiSlasherClient.IsSlashableBlock is not implemented. To use a fallback client, pass a fallback client as the last argument of NewBeaconApiSlasherClientWithFallback.")
 }
 
-func NewSlasherClientWithFallback(host string, timeout time.Duration, fallbackClient iface.SlasherClient) iface.SlasherClient {
-	jsonRestHandler := beaconApiJsonRestHandler{
-		httpClient: http.Client{Timeout: timeout},
-		host:       host,
-	}
-
+func NewSlasherClientWithFallback(jsonRestHandler JsonRestHandler, fallbackClient iface.SlasherClient) iface.SlasherClient {
 	return &beaconApiSlasherClient{
 		jsonRestHandler: jsonRestHandler,
 		fallbackClient:  fallbackClient,
@@ -503,3 +503,260 @@ func TestGetBeaconBlockConverter_CapellaError(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBeaconBlockConverter_BlindedCapellaValid(t *testing.T) {
+	expectedBeaconBlock := test_helpers.GenerateProtoBlindedCapellaBeaconBlock()
+	beaconBlockConverter := &beaconApiBeaconBlockConverter{}
+	beaconBlock, err := beaconBlockConverter.ConvertRESTBlindedCapellaBlockToProto(test_helpers.GenerateJsonBlindedCapellaBeaconBlock())
+	require.NoError(t, err)
+	assert.DeepEqual(t, expectedBeaconBlock, beaconBlock)
+}
+
+func TestGetBeaconBlockConverter_BlindedCapellaError(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		expectedErrorMessage string
+		generateData         func() *apimiddleware.BlindedBeaconBlockCapellaJson
+	}{
+		{
+			name:                 "nil body",
+			expectedErrorMessage: "block body is nil",
+			generateData: func() *apimiddleware.BlindedBeaconBlockCapellaJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedCapellaBeaconBlock()
+				beaconBlock.Body = nil
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "nil execution payload header",
+			expectedErrorMessage: "execution payload header is nil",
+			generateData: func() *apimiddleware.BlindedBeaconBlockCapellaJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedCapellaBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader = nil
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad bellatrix fields",
+			expectedErrorMessage: "failed to get the bellatrix fields of the blinded capella block",
+			generateData: func() *apimiddleware.BlindedBeaconBlockCapellaJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedCapellaBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader.ParentHash = "foo"
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad transactions root",
+			expectedErrorMessage: "failed to decode execution payload header transactions root `foo`",
+			generateData: func() *apimiddleware.BlindedBeaconBlockCapellaJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedCapellaBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader.TransactionsRoot = "foo"
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad withdrawals root",
+			expectedErrorMessage: "failed to decode execution payload header withdrawals root `foo`",
+			generateData: func() *apimiddleware.BlindedBeaconBlockCapellaJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedCapellaBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader.WithdrawalsRoot = "foo"
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad bls execution changes",
+			expectedErrorMessage: "failed to get bls to execution changes",
+			generateData: func() *apimiddleware.BlindedBeaconBlockCapellaJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedCapellaBeaconBlock()
+				beaconBlock.Body.BLSToExecutionChanges[0] = nil
+				return beaconBlock
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			beaconBlockJson := testCase.generateData()
+
+			beaconBlockConverter := &beaconApiBeaconBlockConverter{}
+			_, err := beaconBlockConverter.ConvertRESTBlindedCapellaBlockToProto(beaconBlockJson)
+			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+		})
+	}
+}
+
+func TestGetBeaconBlockConverter_DenebValid(t *testing.T) {
+	expectedBlockContents := test_helpers.GenerateProtoDenebBeaconBlockContents()
+	beaconBlockConverter := &beaconApiBeaconBlockConverter{}
+	blockContents, err := beaconBlockConverter.ConvertRESTDenebBlockContentsToProto(test_helpers.GenerateJsonDenebBeaconBlockContents())
+	require.NoError(t, err)
+	assert.DeepEqual(t, expectedBlockContents, blockContents)
+}
+
+func TestGetBeaconBlockConverter_DenebError(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		expectedErrorMessage string
+		generateData         func() *apimiddleware.BeaconBlockContentsDenebJson
+	}{
+		{
+			name:                 "nil block",
+			expectedErrorMessage: "block is nil",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.Block = nil
+				return blockContents
+			},
+		},
+		{
+			name:                 "nil execution payload",
+			expectedErrorMessage: "execution payload is nil",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.Block.Body.ExecutionPayload = nil
+				return blockContents
+			},
+		},
+		{
+			name:                 "bad capella fields",
+			expectedErrorMessage: "failed to get the capella fields of the deneb block",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.Block.Body.ExecutionPayload.Withdrawals[0].Amount = "foo"
+				return blockContents
+			},
+		},
+		{
+			name:                 "bad blob gas used",
+			expectedErrorMessage: "failed to parse execution payload blob gas used `foo`",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.Block.Body.ExecutionPayload.BlobGasUsed = "foo"
+				return blockContents
+			},
+		},
+		{
+			name:                 "bad excess blob gas",
+			expectedErrorMessage: "failed to parse execution payload excess blob gas `bar`",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.Block.Body.ExecutionPayload.ExcessBlobGas = "bar"
+				return blockContents
+			},
+		},
+		{
+			name:                 "bad blob kzg commitments",
+			expectedErrorMessage: "failed to get blob kzg commitments",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.Block.Body.BlobKzgCommitments[0] = "foo"
+				return blockContents
+			},
+		},
+		{
+			name:                 "bad kzg proofs",
+			expectedErrorMessage: "failed to get kzg proofs",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.KzgProofs[0] = "foo"
+				return blockContents
+			},
+		},
+		{
+			name:                 "bad blobs",
+			expectedErrorMessage: "failed to get blobs",
+			generateData: func() *apimiddleware.BeaconBlockContentsDenebJson {
+				blockContents := test_helpers.GenerateJsonDenebBeaconBlockContents()
+				blockContents.Blobs[0] = "foo"
+				return blockContents
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			beaconBlockConverter := &beaconApiBeaconBlockConverter{}
+			_, err := beaconBlockConverter.ConvertRESTDenebBlockContentsToProto(testCase.generateData())
+			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+		})
+	}
+}
+
+func TestGetBeaconBlockConverter_BlindedDenebValid(t *testing.T) {
+	expectedBeaconBlock := test_helpers.GenerateProtoBlindedDenebBeaconBlock()
+	beaconBlockConverter := &beaconApiBeaconBlockConverter{}
+	beaconBlock, err := beaconBlockConverter.ConvertRESTBlindedDenebBlockToProto(test_helpers.GenerateJsonBlindedDenebBeaconBlock())
+	require.NoError(t, err)
+	assert.DeepEqual(t, expectedBeaconBlock, beaconBlock)
+}
+
+func TestGetBeaconBlockConverter_BlindedDenebError(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		expectedErrorMessage string
+		generateData         func() *apimiddleware.BlindedBeaconBlockDenebJson
+	}{
+		{
+			name:                 "nil body",
+			expectedErrorMessage: "block body is nil",
+			generateData: func() *apimiddleware.BlindedBeaconBlockDenebJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedDenebBeaconBlock()
+				beaconBlock.Body = nil
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "nil execution payload header",
+			expectedErrorMessage: "execution payload header is nil",
+			generateData: func() *apimiddleware.BlindedBeaconBlockDenebJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedDenebBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader = nil
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad capella fields",
+			expectedErrorMessage: "failed to get the capella fields of the blinded deneb block",
+			generateData: func() *apimiddleware.BlindedBeaconBlockDenebJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedDenebBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader.WithdrawalsRoot = "foo"
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad blob gas used",
+			expectedErrorMessage: "failed to parse execution payload header blob gas used `foo`",
+			generateData: func() *apimiddleware.BlindedBeaconBlockDenebJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedDenebBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader.BlobGasUsed = "foo"
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad excess blob gas",
+			expectedErrorMessage: "failed to parse execution payload header excess blob gas `bar`",
+			generateData: func() *apimiddleware.BlindedBeaconBlockDenebJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedDenebBeaconBlock()
+				beaconBlock.Body.ExecutionPayloadHeader.ExcessBlobGas = "bar"
+				return beaconBlock
+			},
+		},
+		{
+			name:                 "bad blob kzg commitments",
+			expectedErrorMessage: "failed to get blob kzg commitments",
+			generateData: func() *apimiddleware.BlindedBeaconBlockDenebJson {
+				beaconBlock := test_helpers.GenerateJsonBlindedDenebBeaconBlock()
+				beaconBlock.Body.BlobKzgCommitments[0] = "foo"
+				return beaconBlock
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			beaconBlockConverter := &beaconApiBeaconBlockConverter{}
+			_, err := beaconBlockConverter.ConvertRESTBlindedDenebBlockToProto(testCase.generateData())
+			assert.ErrorContains(t, testCase.expectedErrorMessage, err)
+		})
+	}
+}
@@ -364,6 +364,21 @@ func convertTransactionsToProto(jsonTransactions []string) ([][]byte, error) {
 	return transactions, nil
 }
 
+func convertHexListToProto(jsonValues []string, name string) ([][]byte, error) {
+	values := make([][]byte, len(jsonValues))
+
+	for index, jsonValue := range jsonValues {
+		value, err := hexutil.Decode(jsonValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s `%s`", name, jsonValue)
+		}
+
+		values[index] = value
+	}
+
+	return values, nil
+}
+
 func convertWithdrawalsToProto(jsonWithdrawals []*apimiddleware.WithdrawalJson) ([]*enginev1.Withdrawal, error) {
 	withdrawals := make([]*enginev1.Withdrawal, len(jsonWithdrawals))
 
@@ -18,7 +18,7 @@ type genesisProvider interface {
 }
 
 type beaconApiGenesisProvider struct {
-	jsonRestHandler jsonRestHandler
+	jsonRestHandler JsonRestHandler
 }
 
 func (c beaconApiValidatorClient) waitForChainStart(ctx context.Context) (*ethpb.ChainStartResponse, error) {
@@ -20,7 +20,7 @@ func TestGetGenesis_ValidGenesis(t *testing.T) {
 	ctx := context.Background()
 
 	genesisResponseJson := rpcmiddleware.GenesisResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
 		"/eth/v1/beacon/genesis",
@@ -54,7 +54,7 @@ func TestGetGenesis_NilData(t *testing.T) {
 	ctx := context.Background()
 
 	genesisResponseJson := rpcmiddleware.GenesisResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
 		"/eth/v1/beacon/genesis",
@@ -85,7 +85,7 @@ func TestGetGenesis_JsonResponseError(t *testing.T) {
 	}
 
 	genesisResponseJson := rpcmiddleware.GenesisResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
 		"/eth/v1/beacon/genesis",
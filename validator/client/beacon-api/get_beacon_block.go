@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	neturl "net/url"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
@@ -15,24 +17,64 @@ import (
 )
 
 type abstractProduceBlockResponseJson struct {
-	Version string          `json:"version" enum:"true"`
-	Data    json.RawMessage `json:"data"`
+	Version                 string          `json:"version" enum:"true"`
+	ExecutionPayloadBlinded bool            `json:"execution_payload_blinded"`
+	Data                    json.RawMessage `json:"data"`
 }
 
-func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primitives.Slot, randaoReveal []byte, graffiti []byte) (*ethpb.GenericBeaconBlock, error) {
+func (c beaconApiValidatorClient) getBeaconBlock(
+	ctx context.Context,
+	slot primitives.Slot,
+	randaoReveal []byte,
+	graffiti []byte,
+	builderMinBid string,
+	localBlockValueBoost *uint64,
+	builderRelays []string,
+) (*ethpb.GenericBeaconBlock, error) {
 	queryParams := neturl.Values{}
 	queryParams.Add("randao_reveal", hexutil.Encode(randaoReveal))
 
 	if len(graffiti) > 0 {
 		queryParams.Add("graffiti", hexutil.Encode(graffiti))
 	}
-
-	queryUrl := buildURL(fmt.Sprintf("/eth/v2/validator/blocks/%d", slot), queryParams)
+	// The v3 endpoint returns either a full or a blinded block, whichever is more valuable. Beacon nodes which don't
+	// serve it yet are asked for a full block.
+	queryUrls := []string{
+		buildURL(fmt.Sprintf("/eth/v3/validator/blocks/%d", slot), queryParams),
+		buildURL(fmt.Sprintf("/eth/v2/validator/blocks/%d", slot), queryParams),
+	}
+	// The builder settings of the validator override those of the beacon node. They are not part of the standard API,
+	// so they are sent to the Prysm specific endpoint, falling back to the standard ones when it isn't served.
+	if builderMinBid != "" || localBlockValueBoost != nil || len(builderRelays) > 0 {
+		prysmParams := neturl.Values{}
+		for k, v := range queryParams {
+			prysmParams[k] = v
+		}
+		if builderMinBid != "" {
+			prysmParams.Add("builder_min_bid", builderMinBid)
+		}
+		if localBlockValueBoost != nil {
+			prysmParams.Add("local_block_value_boost", strconv.FormatUint(*localBlockValueBoost, 10))
+		}
+		for _, r := range builderRelays {
+			prysmParams.Add("builder_relays", r)
+		}
+		queryUrls = append([]string{buildURL(fmt.Sprintf("/prysm/v1/validator/blocks/%d", slot), prysmParams)}, queryUrls...)
+	}
 
 	// Since we don't know yet what the json looks like, we unmarshal into an abstract structure that has only a version
 	// and a blob of data
-	produceBlockResponseJson := abstractProduceBlockResponseJson{}
-	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, queryUrl, &produceBlockResponseJson); err != nil {
+	var produceBlockResponseJson abstractProduceBlockResponseJson
+	var err error
+	for _, queryUrl := range queryUrls {
+		produceBlockResponseJson = abstractProduceBlockResponseJson{}
+		errJson, queryErr := c.jsonRestHandler.GetRestJsonResponse(ctx, queryUrl, &produceBlockResponseJson)
+		err = queryErr
+		if errJson == nil || errJson.Code != http.StatusNotFound {
+			break
+		}
+	}
+	if err != nil {
 		return nil, errors.Wrap(err, "failed to query GET REST endpoint")
 	}
 
@@ -40,7 +82,7 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 	decoder := json.NewDecoder(bytes.NewReader(produceBlockResponseJson.Data))
 	decoder.DisallowUnknownFields()
 
-	response := &ethpb.GenericBeaconBlock{}
+	response := &ethpb.GenericBeaconBlock{IsBlinded: produceBlockResponseJson.ExecutionPayloadBlinded}
 
 	switch produceBlockResponseJson.Version {
 	case "phase0":
@@ -72,6 +114,22 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 		}
 
 	case "bellatrix":
+		if produceBlockResponseJson.ExecutionPayloadBlinded {
+			jsonBellatrixBlock := apimiddleware.BlindedBeaconBlockBellatrixJson{}
+			if err := decoder.Decode(&jsonBellatrixBlock); err != nil {
+				return nil, errors.Wrap(err, "failed to decode blinded bellatrix block response json")
+			}
+
+			bellatrixBlock, err := c.beaconBlockConverter.ConvertRESTBlindedBellatrixBlockToProto(&jsonBellatrixBlock)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get blinded bellatrix block")
+			}
+			response.Block = &ethpb.GenericBeaconBlock_BlindedBellatrix{
+				BlindedBellatrix: bellatrixBlock,
+			}
+			break
+		}
+
 		jsonBellatrixBlock := apimiddleware.BeaconBlockBellatrixJson{}
 		if err := decoder.Decode(&jsonBellatrixBlock); err != nil {
 			return nil, errors.Wrap(err, "failed to decode bellatrix block response json")
@@ -86,6 +144,22 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 		}
 
 	case "capella":
+		if produceBlockResponseJson.ExecutionPayloadBlinded {
+			jsonCapellaBlock := apimiddleware.BlindedBeaconBlockCapellaJson{}
+			if err := decoder.Decode(&jsonCapellaBlock); err != nil {
+				return nil, errors.Wrap(err, "failed to decode blinded capella block response json")
+			}
+
+			capellaBlock, err := c.beaconBlockConverter.ConvertRESTBlindedCapellaBlockToProto(&jsonCapellaBlock)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get blinded capella block")
+			}
+			response.Block = &ethpb.GenericBeaconBlock_BlindedCapella{
+				BlindedCapella: capellaBlock,
+			}
+			break
+		}
+
 		jsonCapellaBlock := apimiddleware.BeaconBlockCapellaJson{}
 		if err := decoder.Decode(&jsonCapellaBlock); err != nil {
 			return nil, errors.Wrap(err, "failed to decode capella block response json")
@@ -99,6 +173,36 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 			Capella: capellaBlock,
 		}
 
+	case "deneb":
+		if produceBlockResponseJson.ExecutionPayloadBlinded {
+			jsonDenebBlock := apimiddleware.BlindedBeaconBlockDenebJson{}
+			if err := decoder.Decode(&jsonDenebBlock); err != nil {
+				return nil, errors.Wrap(err, "failed to decode blinded deneb block response json")
+			}
+
+			denebBlock, err := c.beaconBlockConverter.ConvertRESTBlindedDenebBlockToProto(&jsonDenebBlock)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get blinded deneb block")
+			}
+			response.Block = &ethpb.GenericBeaconBlock_BlindedDeneb{
+				BlindedDeneb: denebBlock,
+			}
+			break
+		}
+
+		jsonDenebBlockContents := apimiddleware.BeaconBlockContentsDenebJson{}
+		if err := decoder.Decode(&jsonDenebBlockContents); err != nil {
+			return nil, errors.Wrap(err, "failed to decode deneb block response json")
+		}
+
+		denebBlockContents, err := c.beaconBlockConverter.ConvertRESTDenebBlockContentsToProto(&jsonDenebBlockContents)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get deneb block")
+		}
+		response.Block = &ethpb.GenericBeaconBlock_Deneb{
+			Deneb: denebBlockContents,
+		}
+
 	default:
 		return nil, errors.Errorf("unsupported consensus version `%s`", produceBlockResponseJson.Version)
 	}
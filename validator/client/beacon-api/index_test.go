@@ -36,7 +36,7 @@ func TestIndex_Nominal(t *testing.T) {
 	ctx := context.Background()
 
 	stateValidatorsResponseJson := rpcmiddleware.StateValidatorsResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
@@ -85,7 +85,7 @@ func TestIndex_UnexistingValidator(t *testing.T) {
 	ctx := context.Background()
 
 	stateValidatorsResponseJson := rpcmiddleware.StateValidatorsResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
@@ -126,7 +126,7 @@ func TestIndex_BadIndexError(t *testing.T) {
 	ctx := context.Background()
 
 	stateValidatorsResponseJson := rpcmiddleware.StateValidatorsResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
@@ -174,7 +174,7 @@ func TestIndex_JsonResponseError(t *testing.T) {
 	ctx := context.Background()
 
 	stateValidatorsResponseJson := rpcmiddleware.StateValidatorsResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
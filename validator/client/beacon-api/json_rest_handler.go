@@ -10,7 +10,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/api/gateway/apimiddleware"
 )
 
-type jsonRestHandler interface {
+// JsonRestHandler sends requests to the REST API of the beacon nodes. A single handler is shared by
+// the REST clients of a validator client, so that they share the health of the beacon nodes.
+type JsonRestHandler interface {
 	GetRestJsonResponse(ctx context.Context, query string, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error)
 	PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error)
 }
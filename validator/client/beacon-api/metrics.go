@@ -0,0 +1,29 @@
+package beacon_api
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	beaconNodeRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_beacon_node_requests_total",
+			Help: "Number of REST API requests sent to each beacon node, by HTTP method and outcome: success, error or unavailable.",
+		},
+		[]string{"host", "method", "outcome"},
+	)
+	beaconNodeScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "validator_beacon_node_score",
+			Help: "Score of each beacon node based on its sync status, head slot and error rate. Requests are sent to the highest scored one.",
+		},
+		[]string{"host"},
+	)
+	beaconNodeFailoversTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "validator_beacon_node_failovers_total",
+			Help: "Number of REST API requests retried on another beacon node because the previous one was unavailable.",
+		},
+	)
+)
@@ -65,6 +65,51 @@ func (mr *MockbeaconBlockConverterMockRecorder) ConvertRESTBellatrixBlockToProto
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertRESTBellatrixBlockToProto", reflect.TypeOf((*MockbeaconBlockConverter)(nil).ConvertRESTBellatrixBlockToProto), block)
 }
 
+// ConvertRESTBlindedBellatrixBlockToProto mocks base method.
+func (m *MockbeaconBlockConverter) ConvertRESTBlindedBellatrixBlockToProto(block *apimiddleware.BlindedBeaconBlockBellatrixJson) (*eth.BlindedBeaconBlockBellatrix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertRESTBlindedBellatrixBlockToProto", block)
+	ret0, _ := ret[0].(*eth.BlindedBeaconBlockBellatrix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertRESTBlindedBellatrixBlockToProto indicates an expected call of ConvertRESTBlindedBellatrixBlockToProto.
+func (mr *MockbeaconBlockConverterMockRecorder) ConvertRESTBlindedBellatrixBlockToProto(block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertRESTBlindedBellatrixBlockToProto", reflect.TypeOf((*MockbeaconBlockConverter)(nil).ConvertRESTBlindedBellatrixBlockToProto), block)
+}
+
+// ConvertRESTBlindedCapellaBlockToProto mocks base method.
+func (m *MockbeaconBlockConverter) ConvertRESTBlindedCapellaBlockToProto(block *apimiddleware.BlindedBeaconBlockCapellaJson) (*eth.BlindedBeaconBlockCapella, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertRESTBlindedCapellaBlockToProto", block)
+	ret0, _ := ret[0].(*eth.BlindedBeaconBlockCapella)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertRESTBlindedCapellaBlockToProto indicates an expected call of ConvertRESTBlindedCapellaBlockToProto.
+func (mr *MockbeaconBlockConverterMockRecorder) ConvertRESTBlindedCapellaBlockToProto(block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertRESTBlindedCapellaBlockToProto", reflect.TypeOf((*MockbeaconBlockConverter)(nil).ConvertRESTBlindedCapellaBlockToProto), block)
+}
+
+// ConvertRESTBlindedDenebBlockToProto mocks base method.
+func (m *MockbeaconBlockConverter) ConvertRESTBlindedDenebBlockToProto(block *apimiddleware.BlindedBeaconBlockDenebJson) (*eth.BlindedBeaconBlockDeneb, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertRESTBlindedDenebBlockToProto", block)
+	ret0, _ := ret[0].(*eth.BlindedBeaconBlockDeneb)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertRESTBlindedDenebBlockToProto indicates an expected call of ConvertRESTBlindedDenebBlockToProto.
+func (mr *MockbeaconBlockConverterMockRecorder) ConvertRESTBlindedDenebBlockToProto(block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertRESTBlindedDenebBlockToProto", reflect.TypeOf((*MockbeaconBlockConverter)(nil).ConvertRESTBlindedDenebBlockToProto), block)
+}
+
 // ConvertRESTCapellaBlockToProto mocks base method.
 func (m *MockbeaconBlockConverter) ConvertRESTCapellaBlockToProto(block *apimiddleware.BeaconBlockCapellaJson) (*eth.BeaconBlockCapella, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +125,21 @@ func (mr *MockbeaconBlockConverterMockRecorder) ConvertRESTCapellaBlockToProto(b
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertRESTCapellaBlockToProto", reflect.TypeOf((*MockbeaconBlockConverter)(nil).ConvertRESTCapellaBlockToProto), block)
 }
 
+// ConvertRESTDenebBlockContentsToProto mocks base method.
+func (m *MockbeaconBlockConverter) ConvertRESTDenebBlockContentsToProto(contents *apimiddleware.BeaconBlockContentsDenebJson) (*eth.BeaconBlockContentsDeneb, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertRESTDenebBlockContentsToProto", contents)
+	ret0, _ := ret[0].(*eth.BeaconBlockContentsDeneb)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertRESTDenebBlockContentsToProto indicates an expected call of ConvertRESTDenebBlockContentsToProto.
+func (mr *MockbeaconBlockConverterMockRecorder) ConvertRESTDenebBlockContentsToProto(contents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertRESTDenebBlockContentsToProto", reflect.TypeOf((*MockbeaconBlockConverter)(nil).ConvertRESTDenebBlockContentsToProto), contents)
+}
+
 // ConvertRESTPhase0BlockToProto mocks base method.
 func (m *MockbeaconBlockConverter) ConvertRESTPhase0BlockToProto(block *apimiddleware.BeaconBlockJson) (*eth.BeaconBlock, error) {
 	m.ctrl.T.Helper()
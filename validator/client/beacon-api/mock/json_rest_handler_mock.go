@@ -13,31 +13,31 @@ import (
 	apimiddleware "github.com/prysmaticlabs/prysm/v4/api/gateway/apimiddleware"
 )
 
-// MockjsonRestHandler is a mock of jsonRestHandler interface.
-type MockjsonRestHandler struct {
+// MockJsonRestHandler is a mock of JsonRestHandler interface.
+type MockJsonRestHandler struct {
 	ctrl     *gomock.Controller
-	recorder *MockjsonRestHandlerMockRecorder
+	recorder *MockJsonRestHandlerMockRecorder
 }
 
-// MockjsonRestHandlerMockRecorder is the mock recorder for MockjsonRestHandler.
-type MockjsonRestHandlerMockRecorder struct {
-	mock *MockjsonRestHandler
+// MockJsonRestHandlerMockRecorder is the mock recorder for MockJsonRestHandler.
+type MockJsonRestHandlerMockRecorder struct {
+	mock *MockJsonRestHandler
 }
 
-// NewMockjsonRestHandler creates a new mock instance.
-func NewMockjsonRestHandler(ctrl *gomock.Controller) *MockjsonRestHandler {
-	mock := &MockjsonRestHandler{ctrl: ctrl}
-	mock.recorder = &MockjsonRestHandlerMockRecorder{mock}
+// NewMockJsonRestHandler creates a new mock instance.
+func NewMockJsonRestHandler(ctrl *gomock.Controller) *MockJsonRestHandler {
+	mock := &MockJsonRestHandler{ctrl: ctrl}
+	mock.recorder = &MockJsonRestHandlerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockjsonRestHandler) EXPECT() *MockjsonRestHandlerMockRecorder {
+func (m *MockJsonRestHandler) EXPECT() *MockJsonRestHandlerMockRecorder {
 	return m.recorder
 }
 
 // GetRestJsonResponse mocks base method.
-func (m *MockjsonRestHandler) GetRestJsonResponse(ctx context.Context, query string, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+func (m *MockJsonRestHandler) GetRestJsonResponse(ctx context.Context, query string, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRestJsonResponse", ctx, query, responseJson)
 	ret0, _ := ret[0].(*apimiddleware.DefaultErrorJson)
@@ -46,13 +46,13 @@ func (m *MockjsonRestHandler) GetRestJsonResponse(ctx context.Context, query str
 }
 
 // GetRestJsonResponse indicates an expected call of GetRestJsonResponse.
-func (mr *MockjsonRestHandlerMockRecorder) GetRestJsonResponse(ctx, query, responseJson interface{}) *gomock.Call {
+func (mr *MockJsonRestHandlerMockRecorder) GetRestJsonResponse(ctx, query, responseJson interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestJsonResponse", reflect.TypeOf((*MockjsonRestHandler)(nil).GetRestJsonResponse), ctx, query, responseJson)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestJsonResponse", reflect.TypeOf((*MockJsonRestHandler)(nil).GetRestJsonResponse), ctx, query, responseJson)
 }
 
 // PostRestJson mocks base method.
-func (m *MockjsonRestHandler) PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+func (m *MockJsonRestHandler) PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PostRestJson", ctx, apiEndpoint, headers, data, responseJson)
 	ret0, _ := ret[0].(*apimiddleware.DefaultErrorJson)
@@ -61,7 +61,7 @@ func (m *MockjsonRestHandler) PostRestJson(ctx context.Context, apiEndpoint stri
 }
 
 // PostRestJson indicates an expected call of PostRestJson.
-func (mr *MockjsonRestHandlerMockRecorder) PostRestJson(ctx, apiEndpoint, headers, data, responseJson interface{}) *gomock.Call {
+func (mr *MockJsonRestHandlerMockRecorder) PostRestJson(ctx, apiEndpoint, headers, data, responseJson interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRestJson", reflect.TypeOf((*MockjsonRestHandler)(nil).PostRestJson), ctx, apiEndpoint, headers, data, responseJson)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostRestJson", reflect.TypeOf((*MockJsonRestHandler)(nil).PostRestJson), ctx, apiEndpoint, headers, data, responseJson)
 }
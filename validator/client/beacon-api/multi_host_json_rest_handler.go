@@ -0,0 +1,323 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/gateway/apimiddleware"
+	rpcmiddleware "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Weight of the latest request in the moving average of the error rate of a beacon node.
+	errorRateWeight = 0.1
+	// Number of slots after which a beacon node lagging behind the highest head slot stops losing score.
+	maxScoredHeadSlotLag = 50
+)
+
+// Submissions sent to all beacon nodes, so that they reach the network even if the node
+// the validator client is using is badly connected. Proposer preparations, validator
+// registrations and subnet subscriptions are also sent to all beacon nodes, as the validator
+// client may fail over to any of them when proposing, attesting or aggregating.
+var broadcastEndpoints = map[string]bool{
+	"/eth/v1/beacon/blocks":                            true,
+	"/eth/v1/beacon/blinded_blocks":                    true,
+	"/eth/v1/beacon/pool/attestations":                 true,
+	"/eth/v1/validator/aggregate_and_proofs":           true,
+	"/eth/v1/validator/prepare_beacon_proposer":        true,
+	"/eth/v1/validator/register_validator":             true,
+	"/eth/v1/validator/beacon_committee_subscriptions": true,
+	"/eth/v1/validator/sync_committee_subscriptions":   true,
+}
+
+// NewJsonRestHandler returns a handler for a comma-separated list of beacon node hosts. When
+// several hosts are given, requests are routed to the healthiest beacon node.
+func NewJsonRestHandler(hosts string, timeout time.Duration) JsonRestHandler {
+	hostList := strings.Split(hosts, ",")
+	if len(hostList) == 1 {
+		return beaconApiJsonRestHandler{
+			httpClient: http.Client{Timeout: timeout},
+			host:       hosts,
+		}
+	}
+	return newMultiHostJsonRestHandler(
+		hostList,
+		timeout,
+		time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second,
+	)
+}
+
+type beaconNodeHost struct {
+	jsonRestHandler beaconApiJsonRestHandler
+	lock            sync.RWMutex
+	reachable       bool
+	syncing         bool
+	headSlot        primitives.Slot
+	errorRate       float64
+}
+
+// multiHostJsonRestHandler sends requests to the beacon node with the highest score, and to the
+// next ones in order when it is unavailable. Beacon nodes are scored by their sync status, head
+// slot and error rate, which are checked again at most once per health check interval as long as
+// requests are being made. Block and attestation submissions, proposer preparations, validator
+// registrations and subnet subscriptions are sent to all beacon nodes.
+type multiHostJsonRestHandler struct {
+	hosts               []*beaconNodeHost
+	healthCheckInterval time.Duration
+	lock                sync.Mutex
+	lastHealthCheck     time.Time
+	checkingHealth      bool
+}
+
+func newMultiHostJsonRestHandler(hosts []string, timeout, healthCheckInterval time.Duration) *multiHostJsonRestHandler {
+	m := &multiHostJsonRestHandler{
+		hosts:               make([]*beaconNodeHost, len(hosts)),
+		healthCheckInterval: healthCheckInterval,
+	}
+	for i, host := range hosts {
+		// Beacon nodes are assumed to be reachable until checked, so that they are first used in
+		// the order they were given.
+		m.hosts[i] = &beaconNodeHost{
+			jsonRestHandler: beaconApiJsonRestHandler{
+				httpClient: http.Client{Timeout: timeout},
+				host:       strings.TrimSpace(host),
+			},
+			reachable: true,
+		}
+	}
+	return m
+}
+
+// GetRestJsonResponse sends a GET request to the beacon node with the highest score, failing
+// over to the next ones if it is unavailable.
+func (m *multiHostJsonRestHandler) GetRestJsonResponse(ctx context.Context, apiEndpoint string, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+	m.maybeCheckHealth()
+	return m.failover(ctx, http.MethodGet, func(h *beaconNodeHost) (*apimiddleware.DefaultErrorJson, error) {
+		return h.jsonRestHandler.GetRestJsonResponse(ctx, apiEndpoint, responseJson)
+	})
+}
+
+// PostRestJson sends the requests to the broadcast endpoints to all beacon nodes, and other POST
+// requests to the beacon node with the highest score, failing over to the next ones if it is
+// unavailable. The responses of the broadcast endpoints are not decoded, responseJson must be nil.
+func (m *multiHostJsonRestHandler) PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+	if data == nil {
+		return nil, errors.New("POST data is nil")
+	}
+	m.maybeCheckHealth()
+	body := data.Bytes()
+	if broadcastEndpoints[apiEndpoint] {
+		if responseJson != nil {
+			return nil, errors.Errorf("response of broadcast endpoint %s can not be decoded", apiEndpoint)
+		}
+		return m.broadcast(func(h *beaconNodeHost) (*apimiddleware.DefaultErrorJson, error) {
+			return h.jsonRestHandler.PostRestJson(ctx, apiEndpoint, headers, bytes.NewBuffer(body), nil)
+		})
+	}
+	return m.failover(ctx, http.MethodPost, func(h *beaconNodeHost) (*apimiddleware.DefaultErrorJson, error) {
+		return h.jsonRestHandler.PostRestJson(ctx, apiEndpoint, headers, bytes.NewBuffer(body), responseJson)
+	})
+}
+
+func (m *multiHostJsonRestHandler) failover(
+	ctx context.Context, method string, request func(*beaconNodeHost) (*apimiddleware.DefaultErrorJson, error),
+) (*apimiddleware.DefaultErrorJson, error) {
+	var (
+		errorJson *apimiddleware.DefaultErrorJson
+		err       error
+	)
+	for i, h := range m.rankedHosts() {
+		if i > 0 {
+			beaconNodeFailoversTotal.Inc()
+			log.WithError(err).WithField("host", h.jsonRestHandler.host).Debug("Beacon node unavailable, trying next one")
+		}
+		errorJson, err = request(h)
+		h.recordRequest(method, errorJson, err)
+		if !isUnavailable(errorJson, err) || ctx.Err() != nil {
+			return errorJson, err
+		}
+	}
+	return errorJson, err
+}
+
+// Sends the request to all beacon nodes concurrently. It succeeds if any beacon node succeeded, otherwise
+// the result of the highest scored beacon node is returned.
+func (m *multiHostJsonRestHandler) broadcast(
+	request func(*beaconNodeHost) (*apimiddleware.DefaultErrorJson, error),
+) (*apimiddleware.DefaultErrorJson, error) {
+	hosts := m.rankedHosts()
+	errorJsons := make([]*apimiddleware.DefaultErrorJson, len(hosts))
+	errs := make([]error, len(hosts))
+	var wg sync.WaitGroup
+	for i, h := range hosts {
+		wg.Add(1)
+		go func(i int, h *beaconNodeHost) {
+			defer wg.Done()
+			errorJsons[i], errs[i] = request(h)
+			h.recordRequest(http.MethodPost, errorJsons[i], errs[i])
+		}(i, h)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err == nil {
+			return nil, nil
+		}
+	}
+	return errorJsons[0], errs[0]
+}
+
+// A beacon node is unavailable if the request could not be sent, or if it returned a server error.
+func isUnavailable(errorJson *apimiddleware.DefaultErrorJson, err error) bool {
+	if err == nil {
+		return false
+	}
+	return errorJson == nil || errorJson.Code >= http.StatusInternalServerError
+}
+
+func (h *beaconNodeHost) recordRequest(method string, errorJson *apimiddleware.DefaultErrorJson, err error) {
+	outcome := "success"
+	unavailable := isUnavailable(errorJson, err)
+	if unavailable {
+		outcome = "unavailable"
+	} else if err != nil {
+		outcome = "error"
+	}
+	beaconNodeRequestsTotal.WithLabelValues(h.jsonRestHandler.host, method, outcome).Inc()
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.errorRate *= 1 - errorRateWeight
+	if unavailable {
+		h.errorRate += errorRateWeight
+	}
+}
+
+// Returns the beacon nodes from the highest to the lowest score, in the order they were given
+// for equal scores.
+func (m *multiHostJsonRestHandler) rankedHosts() []*beaconNodeHost {
+	highestHeadSlot := m.highestHeadSlot()
+	hosts := make([]*beaconNodeHost, len(m.hosts))
+	scores := make(map[*beaconNodeHost]int, len(m.hosts))
+	copy(hosts, m.hosts)
+	for _, h := range hosts {
+		scores[h] = h.score(highestHeadSlot)
+	}
+	sort.SliceStable(hosts, func(i, j int) bool {
+		return scores[hosts[i]] > scores[hosts[j]]
+	})
+	return hosts
+}
+
+func (m *multiHostJsonRestHandler) highestHeadSlot() primitives.Slot {
+	var highestHeadSlot primitives.Slot
+	for _, h := range m.hosts {
+		h.lock.RLock()
+		if h.reachable && h.headSlot > highestHeadSlot {
+			highestHeadSlot = h.headSlot
+		}
+		h.lock.RUnlock()
+	}
+	return highestHeadSlot
+}
+
+// The score of a beacon node is 0 if it is unreachable. Otherwise, starting from 1000, it loses
+// 500 if it is syncing, 10 per slot its head is behind the highest head slot of all beacon nodes,
+// and up to 200 with its error rate.
+func (h *beaconNodeHost) score(highestHeadSlot primitives.Slot) int {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	if !h.reachable {
+		return 0
+	}
+	score := 1000
+	if h.syncing {
+		score -= 500
+	}
+	lag := uint64(0)
+	if highestHeadSlot > h.headSlot {
+		lag = uint64(highestHeadSlot - h.headSlot)
+	}
+	if lag > maxScoredHeadSlotLag {
+		lag = maxScoredHeadSlotLag
+	}
+	score -= 10 * int(lag)
+	score -= int(h.errorRate * 200)
+	return score
+}
+
+// Checks the health of the beacon nodes in the background if it was not checked during the
+// last health check interval.
+func (m *multiHostJsonRestHandler) maybeCheckHealth() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.checkingHealth || time.Since(m.lastHealthCheck) < m.healthCheckInterval {
+		return
+	}
+	m.checkingHealth = true
+	go func() {
+		m.checkHealth(context.Background())
+		m.lock.Lock()
+		m.checkingHealth = false
+		m.lastHealthCheck = time.Now()
+		m.lock.Unlock()
+	}()
+}
+
+// Updates the sync status and head slot of all beacon nodes.
+func (m *multiHostJsonRestHandler) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, h := range m.hosts {
+		wg.Add(1)
+		go func(h *beaconNodeHost) {
+			defer wg.Done()
+			h.checkHealth(ctx)
+		}(h)
+	}
+	wg.Wait()
+	highestHeadSlot := m.highestHeadSlot()
+	for _, h := range m.hosts {
+		beaconNodeScore.WithLabelValues(h.jsonRestHandler.host).Set(float64(h.score(highestHeadSlot)))
+	}
+}
+
+func (h *beaconNodeHost) checkHealth(ctx context.Context) {
+	syncingResponse := &rpcmiddleware.SyncingResponseJson{}
+	_, err := h.jsonRestHandler.GetRestJsonResponse(ctx, "/eth/v1/node/syncing", syncingResponse)
+	if err == nil && syncingResponse.Data == nil {
+		err = errors.New("syncing data is nil")
+	}
+	var headSlot uint64
+	if err == nil {
+		headSlot, err = strconv.ParseUint(syncingResponse.Data.HeadSlot, 10, 64)
+		err = errors.Wrapf(err, "failed to parse head slot %s", syncingResponse.Data.HeadSlot)
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if err != nil {
+		if h.reachable {
+			log.WithError(err).WithField("host", h.jsonRestHandler.host).Warn("Beacon node is unreachable")
+		}
+		h.reachable = false
+		return
+	}
+	if !h.reachable {
+		log.WithField("host", h.jsonRestHandler.host).Info("Beacon node is reachable again")
+	}
+	h.reachable = true
+	h.syncing = syncingResponse.Data.IsSyncing
+	h.headSlot = primitives.Slot(headSlot)
+	log.WithFields(logrus.Fields{
+		"host":     h.jsonRestHandler.host,
+		"syncing":  h.syncing,
+		"headSlot": h.headSlot,
+	}).Debug("Checked beacon node health")
+}
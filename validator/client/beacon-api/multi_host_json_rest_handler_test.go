@@ -0,0 +1,196 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/gateway/apimiddleware"
+	rpcmiddleware "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type testBeaconNode struct {
+	server   *httptest.Server
+	requests atomic.Int64
+	status   atomic.Int64
+	syncing  *shared.SyncDetails
+}
+
+func newTestBeaconNode(t *testing.T, status int, syncing *shared.SyncDetails) *testBeaconNode {
+	node := &testBeaconNode{syncing: syncing}
+	node.status.Store(int64(status))
+	node.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/eth/v1/node/syncing" {
+			require.NoError(t, json.NewEncoder(w).Encode(&rpcmiddleware.SyncingResponseJson{Data: node.syncing}))
+			return
+		}
+		node.requests.Add(1)
+		status := int(node.status.Load())
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			require.NoError(t, json.NewEncoder(w).Encode(&apimiddleware.DefaultErrorJson{Code: status, Message: "error"}))
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(&rpcmiddleware.GenesisResponseJson{
+			Data: &rpcmiddleware.GenesisResponse_GenesisJson{GenesisTime: node.server.URL},
+		}))
+	}))
+	t.Cleanup(node.server.Close)
+	return node
+}
+
+func newTestMultiHostJsonRestHandler(nodes ...*testBeaconNode) *multiHostJsonRestHandler {
+	hosts := make([]string, len(nodes))
+	for i, node := range nodes {
+		hosts[i] = node.server.URL
+	}
+	// A long health check interval makes sure health is only checked when the test asks for it.
+	m := newMultiHostJsonRestHandler(hosts, time.Second*5, time.Hour)
+	m.lastHealthCheck = time.Now()
+	return m
+}
+
+func TestNewJsonRestHandler(t *testing.T) {
+	handler := NewJsonRestHandler("http://localhost:3500", time.Second)
+	assert.DeepEqual(t, beaconApiJsonRestHandler{httpClient: http.Client{Timeout: time.Second}, host: "http://localhost:3500"}, handler)
+
+	handler = NewJsonRestHandler("http://localhost:3500, http://localhost:3501", time.Second)
+	m, ok := handler.(*multiHostJsonRestHandler)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(m.hosts))
+	assert.Equal(t, "http://localhost:3501", m.hosts[1].jsonRestHandler.host)
+}
+
+func TestMultiHostJsonRestHandler_GetRestJsonResponse_Failover(t *testing.T) {
+	unavailable := newTestBeaconNode(t, http.StatusServiceUnavailable, nil)
+	available := newTestBeaconNode(t, http.StatusOK, nil)
+	m := newTestMultiHostJsonRestHandler(unavailable, available)
+
+	resp := &rpcmiddleware.GenesisResponseJson{}
+	_, err := m.GetRestJsonResponse(context.Background(), "/eth/v1/beacon/genesis", resp)
+	require.NoError(t, err)
+	assert.Equal(t, available.server.URL, resp.Data.GenesisTime)
+	assert.Equal(t, int64(1), unavailable.requests.Load())
+	assert.Equal(t, true, m.hosts[0].errorRate > 0)
+	assert.Equal(t, 0.0, m.hosts[1].errorRate)
+
+	// Errors which are not caused by the beacon node being unavailable are not retried.
+	notFound := newTestBeaconNode(t, http.StatusNotFound, nil)
+	m = newTestMultiHostJsonRestHandler(notFound, available)
+	errorJson, err := m.GetRestJsonResponse(context.Background(), "/eth/v1/beacon/genesis", resp)
+	assert.ErrorContains(t, "error 404", err)
+	require.NotNil(t, errorJson)
+	assert.Equal(t, http.StatusNotFound, errorJson.Code)
+	assert.Equal(t, int64(1), available.requests.Load())
+}
+
+func TestMultiHostJsonRestHandler_PostRestJson_Broadcast(t *testing.T) {
+	first := newTestBeaconNode(t, http.StatusServiceUnavailable, nil)
+	second := newTestBeaconNode(t, http.StatusOK, nil)
+	third := newTestBeaconNode(t, http.StatusOK, nil)
+	m := newTestMultiHostJsonRestHandler(first, second, third)
+
+	// Attestations are sent to all beacon nodes, and succeed if any of them accepted them.
+	_, err := m.PostRestJson(context.Background(), "/eth/v1/beacon/pool/attestations", nil, bytes.NewBufferString("[]"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.requests.Load())
+	assert.Equal(t, int64(1), second.requests.Load())
+	assert.Equal(t, int64(1), third.requests.Load())
+
+	// Other submissions are only sent to the highest scored beacon node, which is no longer the
+	// first one as it failed.
+	_, err = m.PostRestJson(context.Background(), "/eth/v1/beacon/pool/voluntary_exits", nil, bytes.NewBufferString("{}"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.requests.Load())
+	assert.Equal(t, int64(2), second.requests.Load())
+	assert.Equal(t, int64(1), third.requests.Load())
+
+	// Proposer preparations are sent to all beacon nodes, as any of them may be asked for a block.
+	_, err = m.PostRestJson(context.Background(), "/eth/v1/validator/prepare_beacon_proposer", nil, bytes.NewBufferString("[]"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), first.requests.Load())
+	assert.Equal(t, int64(3), second.requests.Load())
+	assert.Equal(t, int64(2), third.requests.Load())
+
+	second.status.Store(http.StatusBadRequest)
+	third.status.Store(http.StatusBadRequest)
+	_, err = m.PostRestJson(context.Background(), "/eth/v1/beacon/blocks", nil, bytes.NewBufferString("{}"), nil)
+	assert.ErrorContains(t, "error", err)
+
+	// The response of a broadcast endpoint is not decoded, as the beacon nodes may answer differently.
+	_, err = m.PostRestJson(context.Background(), "/eth/v1/beacon/blocks", nil, bytes.NewBufferString("{}"), &rpcmiddleware.GenesisResponseJson{})
+	assert.ErrorContains(t, "response of broadcast endpoint /eth/v1/beacon/blocks can not be decoded", err)
+	assert.Equal(t, int64(3), first.requests.Load())
+}
+
+func TestMultiHostJsonRestHandler_RankedHosts(t *testing.T) {
+	syncing := newTestBeaconNode(t, http.StatusOK, &shared.SyncDetails{HeadSlot: "100", IsSyncing: true})
+	lagging := newTestBeaconNode(t, http.StatusOK, &shared.SyncDetails{HeadSlot: "98"})
+	synced := newTestBeaconNode(t, http.StatusOK, &shared.SyncDetails{HeadSlot: "100"})
+	unreachable := newTestBeaconNode(t, http.StatusOK, nil)
+	unreachable.server.Close()
+	m := newTestMultiHostJsonRestHandler(unreachable, syncing, lagging, synced)
+
+	m.checkHealth(context.Background())
+	ranked := m.rankedHosts()
+	require.Equal(t, 4, len(ranked))
+	assert.Equal(t, synced.server.URL, ranked[0].jsonRestHandler.host)
+	assert.Equal(t, lagging.server.URL, ranked[1].jsonRestHandler.host)
+	assert.Equal(t, syncing.server.URL, ranked[2].jsonRestHandler.host)
+	assert.Equal(t, unreachable.server.URL, ranked[3].jsonRestHandler.host)
+
+	// Beacon nodes which keep failing are ranked lower.
+	for i := 0; i < 10; i++ {
+		ranked[0].recordRequest(http.MethodGet, nil, errors.New("connection refused"))
+	}
+	ranked = m.rankedHosts()
+	assert.Equal(t, lagging.server.URL, ranked[0].jsonRestHandler.host)
+}
+
+func TestBeaconNodeHost_Score(t *testing.T) {
+	tests := []struct {
+		name            string
+		host            *beaconNodeHost
+		highestHeadSlot primitives.Slot
+		want            int
+	}{
+		{
+			name: "unreachable",
+			host: &beaconNodeHost{headSlot: 10},
+			want: 0,
+		},
+		{
+			name:            "synced",
+			host:            &beaconNodeHost{reachable: true, headSlot: 10},
+			highestHeadSlot: 10,
+			want:            1000,
+		},
+		{
+			name:            "syncing and lagging",
+			host:            &beaconNodeHost{reachable: true, syncing: true, headSlot: 8},
+			highestHeadSlot: 10,
+			want:            480,
+		},
+		{
+			name:            "lag is capped",
+			host:            &beaconNodeHost{reachable: true, headSlot: 0, errorRate: 0.5},
+			highestHeadSlot: 1000,
+			want:            400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.host.score(tt.highestHeadSlot))
+		})
+	}
+}
@@ -19,7 +19,7 @@ import (
 func TestProposeBeaconBlock_Altair(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	altairBlock := generateSignedAltairBlock()
 
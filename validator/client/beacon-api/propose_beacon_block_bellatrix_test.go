@@ -20,7 +20,7 @@ import (
 func TestProposeBeaconBlock_Bellatrix(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	bellatrixBlock := generateSignedBellatrixBlock()
 
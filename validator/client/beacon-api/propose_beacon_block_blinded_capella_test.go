@@ -21,7 +21,7 @@ import (
 func TestProposeBeaconBlock_BlindedCapella(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	blindedCapellaBlock := generateSignedBlindedCapellaBlock()
 
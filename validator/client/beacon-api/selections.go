@@ -0,0 +1,123 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/validator"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+func (c *beaconApiValidatorClient) getAggregatedSelections(ctx context.Context, selections []iface.BeaconCommitteeSelection) ([]iface.BeaconCommitteeSelection, error) {
+	const endpoint = "/eth/v1/validator/beacon_committee_selections"
+
+	jsonSelections := make([]*shared.BeaconCommitteeSelection, len(selections))
+	for i, s := range selections {
+		jsonSelections[i] = &shared.BeaconCommitteeSelection{
+			ValidatorIndex: strconv.FormatUint(uint64(s.ValidatorIndex), 10),
+			Slot:           strconv.FormatUint(uint64(s.Slot), 10),
+			SelectionProof: hexutil.Encode(s.SelectionProof),
+		}
+	}
+	body, err := json.Marshal(jsonSelections)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal selections")
+	}
+
+	var resp validator.BeaconCommitteeSelectionsResponse
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, endpoint, nil, bytes.NewBuffer(body), &resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to send POST data to `%s` REST endpoint", endpoint)
+	}
+	if len(resp.Data) == 0 {
+		return nil, errors.New("no aggregated selection returned")
+	}
+
+	aggregatedSelections := make([]iface.BeaconCommitteeSelection, len(resp.Data))
+	for i, s := range resp.Data {
+		if s == nil {
+			return nil, errors.Errorf("aggregated selection at index %d is nil", i)
+		}
+		validatorIndex, err := strconv.ParseUint(s.ValidatorIndex, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse validator index `%s`", s.ValidatorIndex)
+		}
+		slot, err := strconv.ParseUint(s.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse slot `%s`", s.Slot)
+		}
+		selectionProof, err := hexutil.Decode(s.SelectionProof)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode selection proof `%s`", s.SelectionProof)
+		}
+		aggregatedSelections[i] = iface.BeaconCommitteeSelection{
+			SelectionProof: selectionProof,
+			Slot:           primitives.Slot(slot),
+			ValidatorIndex: primitives.ValidatorIndex(validatorIndex),
+		}
+	}
+
+	return aggregatedSelections, nil
+}
+
+func (c *beaconApiValidatorClient) getAggregatedSyncSelections(ctx context.Context, selections []iface.SyncCommitteeSelection) ([]iface.SyncCommitteeSelection, error) {
+	const endpoint = "/eth/v1/validator/sync_committee_selections"
+
+	jsonSelections := make([]*shared.SyncCommitteeSelection, len(selections))
+	for i, s := range selections {
+		jsonSelections[i] = &shared.SyncCommitteeSelection{
+			ValidatorIndex:    strconv.FormatUint(uint64(s.ValidatorIndex), 10),
+			Slot:              strconv.FormatUint(uint64(s.Slot), 10),
+			SubcommitteeIndex: strconv.FormatUint(uint64(s.SubcommitteeIndex), 10),
+			SelectionProof:    hexutil.Encode(s.SelectionProof),
+		}
+	}
+	body, err := json.Marshal(jsonSelections)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal selections")
+	}
+
+	var resp validator.SyncCommitteeSelectionsResponse
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, endpoint, nil, bytes.NewBuffer(body), &resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to send POST data to `%s` REST endpoint", endpoint)
+	}
+	if len(resp.Data) == 0 {
+		return nil, errors.New("no aggregated sync selection returned")
+	}
+
+	aggregatedSelections := make([]iface.SyncCommitteeSelection, len(resp.Data))
+	for i, s := range resp.Data {
+		if s == nil {
+			return nil, errors.Errorf("aggregated sync selection at index %d is nil", i)
+		}
+		validatorIndex, err := strconv.ParseUint(s.ValidatorIndex, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse validator index `%s`", s.ValidatorIndex)
+		}
+		slot, err := strconv.ParseUint(s.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse slot `%s`", s.Slot)
+		}
+		subcommitteeIndex, err := strconv.ParseUint(s.SubcommitteeIndex, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse subcommittee index `%s`", s.SubcommitteeIndex)
+		}
+		selectionProof, err := hexutil.Decode(s.SelectionProof)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode selection proof `%s`", s.SelectionProof)
+		}
+		aggregatedSelections[i] = iface.SyncCommitteeSelection{
+			SelectionProof:    selectionProof,
+			Slot:              primitives.Slot(slot),
+			SubcommitteeIndex: primitives.CommitteeIndex(subcommitteeIndex),
+			ValidatorIndex:    primitives.ValidatorIndex(validatorIndex),
+		}
+	}
+
+	return aggregatedSelections, nil
+}
@@ -0,0 +1,150 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/validator"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/beacon-api/mock"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+func TestGetAggregatedSelections(t *testing.T) {
+	testcases := []struct {
+		name                 string
+		res                  []*shared.BeaconCommitteeSelection
+		endpointError        error
+		expectedErrorMessage string
+	}{
+		{
+			name: "valid",
+			res: []*shared.BeaconCommitteeSelection{
+				{ValidatorIndex: "2", Slot: "1", SelectionProof: "0x02"},
+			},
+		},
+		{
+			name:                 "endpoint error",
+			endpointError:        errors.New("bad request"),
+			expectedErrorMessage: "bad request",
+		},
+		{
+			name:                 "no response",
+			expectedErrorMessage: "no aggregated selection returned",
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+			reqBody, err := json.Marshal([]*shared.BeaconCommitteeSelection{
+				{ValidatorIndex: "2", Slot: "1", SelectionProof: "0x01"},
+			})
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			jsonRestHandler.EXPECT().PostRestJson(
+				ctx,
+				"/eth/v1/validator/beacon_committee_selections",
+				nil,
+				bytes.NewBuffer(reqBody),
+				&validator.BeaconCommitteeSelectionsResponse{},
+			).SetArg(
+				4,
+				validator.BeaconCommitteeSelectionsResponse{Data: test.res},
+			).Return(
+				nil,
+				test.endpointError,
+			).Times(1)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			res, err := validatorClient.GetAggregatedSelections(ctx, []iface.BeaconCommitteeSelection{
+				{SelectionProof: []byte{0x01}, Slot: 1, ValidatorIndex: 2},
+			})
+			if test.expectedErrorMessage != "" {
+				require.ErrorContains(t, test.expectedErrorMessage, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, []iface.BeaconCommitteeSelection{
+				{SelectionProof: []byte{0x02}, Slot: 1, ValidatorIndex: 2},
+			}, res)
+		})
+	}
+}
+
+func TestGetAggregatedSyncSelections(t *testing.T) {
+	testcases := []struct {
+		name                 string
+		res                  []*shared.SyncCommitteeSelection
+		endpointError        error
+		expectedErrorMessage string
+	}{
+		{
+			name: "valid",
+			res: []*shared.SyncCommitteeSelection{
+				{ValidatorIndex: "2", Slot: "1", SubcommitteeIndex: "3", SelectionProof: "0x02"},
+			},
+		},
+		{
+			name:                 "endpoint error",
+			endpointError:        errors.New("bad request"),
+			expectedErrorMessage: "bad request",
+		},
+		{
+			name: "invalid proof",
+			res: []*shared.SyncCommitteeSelection{
+				{ValidatorIndex: "2", Slot: "1", SubcommitteeIndex: "3", SelectionProof: "foo"},
+			},
+			expectedErrorMessage: "failed to decode selection proof `foo`",
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+			reqBody, err := json.Marshal([]*shared.SyncCommitteeSelection{
+				{ValidatorIndex: "2", Slot: "1", SubcommitteeIndex: "3", SelectionProof: "0x01"},
+			})
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			jsonRestHandler.EXPECT().PostRestJson(
+				ctx,
+				"/eth/v1/validator/sync_committee_selections",
+				nil,
+				bytes.NewBuffer(reqBody),
+				&validator.SyncCommitteeSelectionsResponse{},
+			).SetArg(
+				4,
+				validator.SyncCommitteeSelectionsResponse{Data: test.res},
+			).Return(
+				nil,
+				test.endpointError,
+			).Times(1)
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+			res, err := validatorClient.GetAggregatedSyncSelections(ctx, []iface.SyncCommitteeSelection{
+				{SelectionProof: []byte{0x01}, Slot: 1, SubcommitteeIndex: 3, ValidatorIndex: 2},
+			})
+			if test.expectedErrorMessage != "" {
+				require.ErrorContains(t, test.expectedErrorMessage, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, []iface.SyncCommitteeSelection{
+				{SelectionProof: []byte{0x02}, Slot: 1, SubcommitteeIndex: 3, ValidatorIndex: 2},
+			}, res)
+		})
+	}
+}
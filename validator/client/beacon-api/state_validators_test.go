@@ -28,7 +28,7 @@ func TestGetStateValidators_Nominal(t *testing.T) {
 	}, "")
 
 	stateValidatorsResponseJson := rpcmiddleware.StateValidatorsResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	wanted := []*rpcmiddleware.ValidatorContainerJson{
 		{
@@ -102,7 +102,7 @@ func TestGetStateValidators_GetRestJsonResponseOnError(t *testing.T) {
 	url := "/eth/v1/beacon/states/head/validators?id=0x8000091c2ae64ee414a54c1cc1fc67dec663408bc636cb86756e0200e41a75c8f86603f104f02c856983d2783116be13"
 
 	stateValidatorsResponseJson := rpcmiddleware.StateValidatorsResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	ctx := context.Background()
 
@@ -134,7 +134,7 @@ func TestGetStateValidators_DataIsNil(t *testing.T) {
 
 	ctx := context.Background()
 	stateValidatorsResponseJson := rpcmiddleware.StateValidatorsResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
@@ -44,7 +44,7 @@ func TestSubmitSignedContributionAndProof_Valid(t *testing.T) {
 
 	ctx := context.Background()
 
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().PostRestJson(
 		ctx,
 		submitSignedContributionAndProofTestEndpoint,
@@ -119,7 +119,7 @@ func TestSubmitSignedContributionAndProof_Error(t *testing.T) {
 
 			ctx := context.Background()
 
-			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 			if testCase.httpRequestExpected {
 				jsonRestHandler.EXPECT().PostRestJson(
 					ctx,
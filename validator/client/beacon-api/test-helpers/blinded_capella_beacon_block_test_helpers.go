@@ -0,0 +1,89 @@
+package test_helpers
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// GenerateProtoBlindedCapellaBeaconBlock returns the blinded equivalent of GenerateProtoCapellaBeaconBlock.
+func GenerateProtoBlindedCapellaBeaconBlock() *ethpb.BlindedBeaconBlockCapella {
+	block := GenerateProtoCapellaBeaconBlock()
+	payload := block.Body.ExecutionPayload
+	return &ethpb.BlindedBeaconBlockCapella{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     block.StateRoot,
+		Body: &ethpb.BlindedBeaconBlockBodyCapella{
+			RandaoReveal:      block.Body.RandaoReveal,
+			Eth1Data:          block.Body.Eth1Data,
+			Graffiti:          block.Body.Graffiti,
+			ProposerSlashings: block.Body.ProposerSlashings,
+			AttesterSlashings: block.Body.AttesterSlashings,
+			Attestations:      block.Body.Attestations,
+			Deposits:          block.Body.Deposits,
+			VoluntaryExits:    block.Body.VoluntaryExits,
+			SyncAggregate:     block.Body.SyncAggregate,
+			ExecutionPayloadHeader: &enginev1.ExecutionPayloadHeaderCapella{
+				ParentHash:       payload.ParentHash,
+				FeeRecipient:     payload.FeeRecipient,
+				StateRoot:        payload.StateRoot,
+				ReceiptsRoot:     payload.ReceiptsRoot,
+				LogsBloom:        payload.LogsBloom,
+				PrevRandao:       payload.PrevRandao,
+				BlockNumber:      payload.BlockNumber,
+				GasLimit:         payload.GasLimit,
+				GasUsed:          payload.GasUsed,
+				Timestamp:        payload.Timestamp,
+				ExtraData:        payload.ExtraData,
+				BaseFeePerGas:    payload.BaseFeePerGas,
+				BlockHash:        payload.BlockHash,
+				TransactionsRoot: FillByteSlice(32, 120),
+				WithdrawalsRoot:  FillByteSlice(32, 121),
+			},
+			BlsToExecutionChanges: block.Body.BlsToExecutionChanges,
+		},
+	}
+}
+
+// GenerateJsonBlindedCapellaBeaconBlock returns the blinded equivalent of GenerateJsonCapellaBeaconBlock.
+func GenerateJsonBlindedCapellaBeaconBlock() *apimiddleware.BlindedBeaconBlockCapellaJson {
+	block := GenerateJsonCapellaBeaconBlock()
+	payload := block.Body.ExecutionPayload
+	return &apimiddleware.BlindedBeaconBlockCapellaJson{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     block.StateRoot,
+		Body: &apimiddleware.BlindedBeaconBlockBodyCapellaJson{
+			RandaoReveal:      block.Body.RandaoReveal,
+			Eth1Data:          block.Body.Eth1Data,
+			Graffiti:          block.Body.Graffiti,
+			ProposerSlashings: block.Body.ProposerSlashings,
+			AttesterSlashings: block.Body.AttesterSlashings,
+			Attestations:      block.Body.Attestations,
+			Deposits:          block.Body.Deposits,
+			VoluntaryExits:    block.Body.VoluntaryExits,
+			SyncAggregate:     block.Body.SyncAggregate,
+			ExecutionPayloadHeader: &apimiddleware.ExecutionPayloadHeaderCapellaJson{
+				ParentHash:       payload.ParentHash,
+				FeeRecipient:     payload.FeeRecipient,
+				StateRoot:        payload.StateRoot,
+				ReceiptsRoot:     payload.ReceiptsRoot,
+				LogsBloom:        payload.LogsBloom,
+				PrevRandao:       payload.PrevRandao,
+				BlockNumber:      payload.BlockNumber,
+				GasLimit:         payload.GasLimit,
+				GasUsed:          payload.GasUsed,
+				TimeStamp:        payload.TimeStamp,
+				ExtraData:        payload.ExtraData,
+				BaseFeePerGas:    payload.BaseFeePerGas,
+				BlockHash:        payload.BlockHash,
+				TransactionsRoot: FillEncodedByteSlice(32, 120),
+				WithdrawalsRoot:  FillEncodedByteSlice(32, 121),
+			},
+			BLSToExecutionChanges: block.Body.BLSToExecutionChanges,
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package test_helpers
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+func GenerateProtoDenebBeaconBlockContents() *ethpb.BeaconBlockContentsDeneb {
+	capellaBlock := GenerateProtoCapellaBeaconBlock()
+	payload := capellaBlock.Body.ExecutionPayload
+	return &ethpb.BeaconBlockContentsDeneb{
+		Block: &ethpb.BeaconBlockDeneb{
+			Slot:          capellaBlock.Slot,
+			ProposerIndex: capellaBlock.ProposerIndex,
+			ParentRoot:    capellaBlock.ParentRoot,
+			StateRoot:     capellaBlock.StateRoot,
+			Body: &ethpb.BeaconBlockBodyDeneb{
+				RandaoReveal:      capellaBlock.Body.RandaoReveal,
+				Eth1Data:          capellaBlock.Body.Eth1Data,
+				Graffiti:          capellaBlock.Body.Graffiti,
+				ProposerSlashings: capellaBlock.Body.ProposerSlashings,
+				AttesterSlashings: capellaBlock.Body.AttesterSlashings,
+				Attestations:      capellaBlock.Body.Attestations,
+				Deposits:          capellaBlock.Body.Deposits,
+				VoluntaryExits:    capellaBlock.Body.VoluntaryExits,
+				SyncAggregate:     capellaBlock.Body.SyncAggregate,
+				ExecutionPayload: &enginev1.ExecutionPayloadDeneb{
+					ParentHash:    payload.ParentHash,
+					FeeRecipient:  payload.FeeRecipient,
+					StateRoot:     payload.StateRoot,
+					ReceiptsRoot:  payload.ReceiptsRoot,
+					LogsBloom:     payload.LogsBloom,
+					PrevRandao:    payload.PrevRandao,
+					BlockNumber:   payload.BlockNumber,
+					GasLimit:      payload.GasLimit,
+					GasUsed:       payload.GasUsed,
+					Timestamp:     payload.Timestamp,
+					ExtraData:     payload.ExtraData,
+					BaseFeePerGas: payload.BaseFeePerGas,
+					BlockHash:     payload.BlockHash,
+					Transactions:  payload.Transactions,
+					Withdrawals:   payload.Withdrawals,
+					BlobGasUsed:   101,
+					ExcessBlobGas: 102,
+				},
+				BlsToExecutionChanges: capellaBlock.Body.BlsToExecutionChanges,
+				BlobKzgCommitments:    [][]byte{FillByteSlice(48, 103), FillByteSlice(48, 104)},
+			},
+		},
+		KzgProofs: [][]byte{FillByteSlice(48, 105), FillByteSlice(48, 106)},
+		Blobs:     [][]byte{FillByteSlice(131072, 107), FillByteSlice(131072, 108)},
+	}
+}
+
+func GenerateJsonDenebBeaconBlockContents() *apimiddleware.BeaconBlockContentsDenebJson {
+	capellaBlock := GenerateJsonCapellaBeaconBlock()
+	payload := capellaBlock.Body.ExecutionPayload
+	return &apimiddleware.BeaconBlockContentsDenebJson{
+		Block: &apimiddleware.BeaconBlockDenebJson{
+			Slot:          capellaBlock.Slot,
+			ProposerIndex: capellaBlock.ProposerIndex,
+			ParentRoot:    capellaBlock.ParentRoot,
+			StateRoot:     capellaBlock.StateRoot,
+			Body: &apimiddleware.BeaconBlockBodyDenebJson{
+				RandaoReveal:      capellaBlock.Body.RandaoReveal,
+				Eth1Data:          capellaBlock.Body.Eth1Data,
+				Graffiti:          capellaBlock.Body.Graffiti,
+				ProposerSlashings: capellaBlock.Body.ProposerSlashings,
+				AttesterSlashings: capellaBlock.Body.AttesterSlashings,
+				Attestations:      capellaBlock.Body.Attestations,
+				Deposits:          capellaBlock.Body.Deposits,
+				VoluntaryExits:    capellaBlock.Body.VoluntaryExits,
+				SyncAggregate:     capellaBlock.Body.SyncAggregate,
+				ExecutionPayload: &apimiddleware.ExecutionPayloadDenebJson{
+					ParentHash:    payload.ParentHash,
+					FeeRecipient:  payload.FeeRecipient,
+					StateRoot:     payload.StateRoot,
+					ReceiptsRoot:  payload.ReceiptsRoot,
+					LogsBloom:     payload.LogsBloom,
+					PrevRandao:    payload.PrevRandao,
+					BlockNumber:   payload.BlockNumber,
+					GasLimit:      payload.GasLimit,
+					GasUsed:       payload.GasUsed,
+					TimeStamp:     payload.TimeStamp,
+					ExtraData:     payload.ExtraData,
+					BaseFeePerGas: payload.BaseFeePerGas,
+					BlockHash:     payload.BlockHash,
+					Transactions:  payload.Transactions,
+					Withdrawals:   payload.Withdrawals,
+					BlobGasUsed:   "101",
+					ExcessBlobGas: "102",
+				},
+				BLSToExecutionChanges: capellaBlock.Body.BLSToExecutionChanges,
+				BlobKzgCommitments:    []string{FillEncodedByteSlice(48, 103), FillEncodedByteSlice(48, 104)},
+			},
+		},
+		KzgProofs: []string{FillEncodedByteSlice(48, 105), FillEncodedByteSlice(48, 106)},
+		Blobs:     []string{FillEncodedByteSlice(131072, 107), FillEncodedByteSlice(131072, 108)},
+	}
+}
+
+func GenerateProtoBlindedDenebBeaconBlock() *ethpb.BlindedBeaconBlockDeneb {
+	capellaBlock := GenerateProtoBlindedCapellaBeaconBlock()
+	header := capellaBlock.Body.ExecutionPayloadHeader
+	return &ethpb.BlindedBeaconBlockDeneb{
+		Slot:          capellaBlock.Slot,
+		ProposerIndex: capellaBlock.ProposerIndex,
+		ParentRoot:    capellaBlock.ParentRoot,
+		StateRoot:     capellaBlock.StateRoot,
+		Body: &ethpb.BlindedBeaconBlockBodyDeneb{
+			RandaoReveal:      capellaBlock.Body.RandaoReveal,
+			Eth1Data:          capellaBlock.Body.Eth1Data,
+			Graffiti:          capellaBlock.Body.Graffiti,
+			ProposerSlashings: capellaBlock.Body.ProposerSlashings,
+			AttesterSlashings: capellaBlock.Body.AttesterSlashings,
+			Attestations:      capellaBlock.Body.Attestations,
+			Deposits:          capellaBlock.Body.Deposits,
+			VoluntaryExits:    capellaBlock.Body.VoluntaryExits,
+			SyncAggregate:     capellaBlock.Body.SyncAggregate,
+			ExecutionPayloadHeader: &enginev1.ExecutionPayloadHeaderDeneb{
+				ParentHash:       header.ParentHash,
+				FeeRecipient:     header.FeeRecipient,
+				StateRoot:        header.StateRoot,
+				ReceiptsRoot:     header.ReceiptsRoot,
+				LogsBloom:        header.LogsBloom,
+				PrevRandao:       header.PrevRandao,
+				BlockNumber:      header.BlockNumber,
+				GasLimit:         header.GasLimit,
+				GasUsed:          header.GasUsed,
+				Timestamp:        header.Timestamp,
+				ExtraData:        header.ExtraData,
+				BaseFeePerGas:    header.BaseFeePerGas,
+				BlockHash:        header.BlockHash,
+				TransactionsRoot: header.TransactionsRoot,
+				WithdrawalsRoot:  header.WithdrawalsRoot,
+				BlobGasUsed:      101,
+				ExcessBlobGas:    102,
+			},
+			BlsToExecutionChanges: capellaBlock.Body.BlsToExecutionChanges,
+			BlobKzgCommitments:    [][]byte{FillByteSlice(48, 103), FillByteSlice(48, 104)},
+		},
+	}
+}
+
+func GenerateJsonBlindedDenebBeaconBlock() *apimiddleware.BlindedBeaconBlockDenebJson {
+	capellaBlock := GenerateJsonBlindedCapellaBeaconBlock()
+	header := capellaBlock.Body.ExecutionPayloadHeader
+	return &apimiddleware.BlindedBeaconBlockDenebJson{
+		Slot:          capellaBlock.Slot,
+		ProposerIndex: capellaBlock.ProposerIndex,
+		ParentRoot:    capellaBlock.ParentRoot,
+		StateRoot:     capellaBlock.StateRoot,
+		Body: &apimiddleware.BlindedBeaconBlockBodyDenebJson{
+			RandaoReveal:      capellaBlock.Body.RandaoReveal,
+			Eth1Data:          capellaBlock.Body.Eth1Data,
+			Graffiti:          capellaBlock.Body.Graffiti,
+			ProposerSlashings: capellaBlock.Body.ProposerSlashings,
+			AttesterSlashings: capellaBlock.Body.AttesterSlashings,
+			Attestations:      capellaBlock.Body.Attestations,
+			Deposits:          capellaBlock.Body.Deposits,
+			VoluntaryExits:    capellaBlock.Body.VoluntaryExits,
+			SyncAggregate:     capellaBlock.Body.SyncAggregate,
+			ExecutionPayloadHeader: &apimiddleware.ExecutionPayloadHeaderDenebJson{
+				ParentHash:       header.ParentHash,
+				FeeRecipient:     header.FeeRecipient,
+				StateRoot:        header.StateRoot,
+				ReceiptsRoot:     header.ReceiptsRoot,
+				LogsBloom:        header.LogsBloom,
+				PrevRandao:       header.PrevRandao,
+				BlockNumber:      header.BlockNumber,
+				GasLimit:         header.GasLimit,
+				GasUsed:          header.GasUsed,
+				TimeStamp:        header.TimeStamp,
+				ExtraData:        header.ExtraData,
+				BaseFeePerGas:    header.BaseFeePerGas,
+				BlockHash:        header.BlockHash,
+				TransactionsRoot: header.TransactionsRoot,
+				WithdrawalsRoot:  header.WithdrawalsRoot,
+				BlobGasUsed:      "101",
+				ExcessBlobGas:    "102",
+			},
+			BLSToExecutionChanges: capellaBlock.Body.BLSToExecutionChanges,
+			BlobKzgCommitments:    []string{FillEncodedByteSlice(48, 103), FillEncodedByteSlice(48, 104)},
+		},
+	}
+}
@@ -22,7 +22,7 @@ func TestWaitForChainStart_ValidGenesis(t *testing.T) {
 
 	ctx := context.Background()
 	genesisResponseJson := rpcmiddleware.GenesisResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
 		"/eth/v1/beacon/genesis",
@@ -90,7 +90,7 @@ func TestWaitForChainStart_BadGenesis(t *testing.T) {
 
 			ctx := context.Background()
 			genesisResponseJson := rpcmiddleware.GenesisResponseJson{}
-			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 			jsonRestHandler.EXPECT().GetRestJsonResponse(
 				ctx,
 				"/eth/v1/beacon/genesis",
@@ -119,7 +119,7 @@ func TestWaitForChainStart_JsonResponseError(t *testing.T) {
 
 	ctx := context.Background()
 	genesisResponseJson := rpcmiddleware.GenesisResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
 		ctx,
 		"/eth/v1/beacon/genesis",
@@ -143,7 +143,7 @@ func TestWaitForChainStart_JsonResponseError404(t *testing.T) {
 
 	ctx := context.Background()
 	genesisResponseJson := rpcmiddleware.GenesisResponseJson{}
-	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
 
 	// First, mock a request that receives a 404 error (which means that the genesis data is not available yet)
 	jsonRestHandler.EXPECT().GetRestJsonResponse(
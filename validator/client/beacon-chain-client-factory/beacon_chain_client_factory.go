@@ -13,7 +13,7 @@ func NewBeaconChainClient(validatorConn validatorHelpers.NodeConnection) iface.B
 	featureFlags := features.Get()
 
 	if featureFlags.EnableBeaconRESTApi {
-		return beaconApi.NewBeaconApiBeaconChainClientWithFallback(validatorConn.GetBeaconApiUrl(), validatorConn.GetBeaconApiTimeout(), grpcClient)
+		return beaconApi.NewBeaconApiBeaconChainClientWithFallback(validatorConn.GetBeaconApiRestHandler(), grpcClient)
 	} else {
 		return grpcClient
 	}
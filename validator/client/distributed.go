@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+// In distributed mode the key of a validator is split among the operators of a distributed validator cluster,
+// the selection proof signed by this client is only a partial proof. The partial proofs are exchanged for the
+// proofs combined by the distributed validator middleware, and those are used to determine aggregation duties.
+
+// attSelectionKey identifies the beacon committee selection proof of a validator for a slot.
+type attSelectionKey struct {
+	slot  primitives.Slot
+	index primitives.ValidatorIndex
+}
+
+// syncSelectionKey identifies the sync committee selection proof of a validator for a slot and subcommittee.
+type syncSelectionKey struct {
+	slot              primitives.Slot
+	index             primitives.ValidatorIndex
+	subcommitteeIndex primitives.CommitteeIndex
+}
+
+// requestAttSelections signs the partial selection proofs of the given attester duties and caches the aggregated
+// proofs returned by the middleware. Cached proofs of slots before the earliest duty are dropped.
+func (v *validator) requestAttSelections(ctx context.Context, duties []*ethpb.DutiesResponse_Duty) error {
+	if len(duties) == 0 {
+		return nil
+	}
+	selections := make([]iface.BeaconCommitteeSelection, len(duties))
+	minSlot := duties[0].AttesterSlot
+	for i, duty := range duties {
+		slotSig, err := v.signSlotWithSelectionProof(ctx, bytesutil.ToBytes48(duty.PublicKey), duty.AttesterSlot)
+		if err != nil {
+			return errors.Wrap(err, "could not sign slot")
+		}
+		selections[i] = iface.BeaconCommitteeSelection{
+			SelectionProof: slotSig,
+			Slot:           duty.AttesterSlot,
+			ValidatorIndex: duty.ValidatorIndex,
+		}
+		if duty.AttesterSlot < minSlot {
+			minSlot = duty.AttesterSlot
+		}
+	}
+
+	aggregated, err := v.validatorClient.GetAggregatedSelections(ctx, selections)
+	if err != nil {
+		return errors.Wrap(err, "could not get aggregated selection proofs")
+	}
+
+	v.attSelectionLock.Lock()
+	defer v.attSelectionLock.Unlock()
+	if v.attSelections == nil {
+		v.attSelections = make(map[attSelectionKey][]byte)
+	}
+	for key := range v.attSelections {
+		if key.slot < minSlot {
+			delete(v.attSelections, key)
+		}
+	}
+	for _, s := range aggregated {
+		v.attSelections[attSelectionKey{slot: s.Slot, index: s.ValidatorIndex}] = s.SelectionProof
+	}
+	return nil
+}
+
+// attSelection returns the aggregated selection proof of the validator for the slot. A proof missing from the
+// cache, e.g. because the subnet subscription of the duties has not completed yet, is requested on its own.
+func (v *validator) attSelection(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, index primitives.ValidatorIndex) ([]byte, error) {
+	key := attSelectionKey{slot: slot, index: index}
+	v.attSelectionLock.Lock()
+	proof, ok := v.attSelections[key]
+	v.attSelectionLock.Unlock()
+	if ok {
+		return proof, nil
+	}
+
+	if err := v.requestAttSelections(ctx, []*ethpb.DutiesResponse_Duty{{
+		PublicKey:      pubKey[:],
+		AttesterSlot:   slot,
+		ValidatorIndex: index,
+	}}); err != nil {
+		return nil, err
+	}
+	v.attSelectionLock.Lock()
+	proof, ok = v.attSelections[key]
+	v.attSelectionLock.Unlock()
+	if !ok {
+		return nil, errors.Errorf("no aggregated selection proof for validator %d at slot %d", index, slot)
+	}
+	return proof, nil
+}
+
+// requestSyncSelections signs the partial sync committee selection proofs of the given validators for each of
+// their subcommittees at the slot and caches the aggregated proofs returned by the middleware. Cached proofs of
+// earlier slots are dropped.
+func (v *validator) requestSyncSelections(ctx context.Context, slot primitives.Slot, validators map[[fieldparams.BLSPubkeyLength]byte]primitives.ValidatorIndex) error {
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	var selections []iface.SyncCommitteeSelection
+	for pubKey, index := range validators {
+		res, err := v.validatorClient.GetSyncSubcommitteeIndex(ctx, &ethpb.SyncSubcommitteeIndexRequest{
+			PublicKey: pubKey[:],
+			Slot:      slot,
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not get sync subcommittee index")
+		}
+		seen := make(map[uint64]bool)
+		for _, comIdx := range res.Indices {
+			subnet := uint64(comIdx) / subCommitteeSize
+			if seen[subnet] {
+				continue
+			}
+			seen[subnet] = true
+			sig, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+			if err != nil {
+				return errors.Wrap(err, "could not sign sync selection data")
+			}
+			selections = append(selections, iface.SyncCommitteeSelection{
+				SelectionProof:    sig,
+				Slot:              slot,
+				SubcommitteeIndex: primitives.CommitteeIndex(subnet),
+				ValidatorIndex:    index,
+			})
+		}
+	}
+	if len(selections) == 0 {
+		return nil
+	}
+
+	aggregated, err := v.validatorClient.GetAggregatedSyncSelections(ctx, selections)
+	if err != nil {
+		return errors.Wrap(err, "could not get aggregated sync selection proofs")
+	}
+
+	v.syncSelectionLock.Lock()
+	defer v.syncSelectionLock.Unlock()
+	if v.syncSelections == nil {
+		v.syncSelections = make(map[syncSelectionKey][]byte)
+	}
+	for key := range v.syncSelections {
+		if key.slot < slot {
+			delete(v.syncSelections, key)
+		}
+	}
+	for _, s := range aggregated {
+		v.syncSelections[syncSelectionKey{slot: s.Slot, index: s.ValidatorIndex, subcommitteeIndex: s.SubcommitteeIndex}] = s.SelectionProof
+	}
+	return nil
+}
+
+// syncSelection returns the aggregated sync committee selection proof of the validator for the slot and subnet.
+// A proof missing from the cache is requested together with the other proofs of the validator at the slot.
+func (v *validator) syncSelection(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, index primitives.ValidatorIndex, subnet uint64) ([]byte, error) {
+	key := syncSelectionKey{slot: slot, index: index, subcommitteeIndex: primitives.CommitteeIndex(subnet)}
+	v.syncSelectionLock.Lock()
+	proof, ok := v.syncSelections[key]
+	v.syncSelectionLock.Unlock()
+	if ok {
+		return proof, nil
+	}
+
+	if err := v.requestSyncSelections(ctx, slot, map[[fieldparams.BLSPubkeyLength]byte]primitives.ValidatorIndex{pubKey: index}); err != nil {
+		return nil, err
+	}
+	v.syncSelectionLock.Lock()
+	proof, ok = v.syncSelections[key]
+	v.syncSelectionLock.Unlock()
+	if !ok {
+		return nil, errors.Errorf("no aggregated sync selection proof for validator %d at slot %d and subnet %d", index, slot, subnet)
+	}
+	return proof, nil
+}
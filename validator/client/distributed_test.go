@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+func TestIsAggregator_Distributed(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.distributed = true
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	aggregatedProof := bytesutil.PadTo([]byte("aggregated"), 96)
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().GetAggregatedSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).DoAndReturn(func(_ context.Context, selections []iface.BeaconCommitteeSelection) ([]iface.BeaconCommitteeSelection, error) {
+		require.Equal(t, 1, len(selections))
+		assert.Equal(t, primitives.Slot(1), selections[0].Slot)
+		assert.Equal(t, primitives.ValidatorIndex(2), selections[0].ValidatorIndex)
+		return []iface.BeaconCommitteeSelection{{SelectionProof: aggregatedProof, Slot: 1, ValidatorIndex: 2}}, nil
+	}).Times(1)
+
+	aggregator, err := v.isAggregator(context.Background(), []primitives.ValidatorIndex{2}, 1, pubKey, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, aggregator)
+
+	// The aggregated proof is served from the cache.
+	proof, err := v.attSelection(context.Background(), pubKey, 1, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, aggregatedProof, proof)
+}
+
+func TestAttSelection_MiddlewareError(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.distributed = true
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().GetAggregatedSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).Return(nil, errors.New("middleware unavailable"))
+
+	_, err := v.attSelection(context.Background(), pubKey, 1, 2)
+	require.ErrorContains(t, "middleware unavailable", err)
+}
+
+func TestRequestAttSelections_PrunesOldSlots(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.distributed = true
+	v.attSelections = map[attSelectionKey][]byte{
+		{slot: 1, index: 2}:  {0x01},
+		{slot: 40, index: 2}: {0x02},
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().GetAggregatedSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).Return([]iface.BeaconCommitteeSelection{{SelectionProof: []byte{0x03}, Slot: 33, ValidatorIndex: 2}}, nil)
+
+	require.NoError(t, v.requestAttSelections(context.Background(), []*ethpb.DutiesResponse_Duty{{
+		PublicKey:      validatorKey.PublicKey().Marshal(),
+		AttesterSlot:   33,
+		ValidatorIndex: 2,
+	}}))
+	assert.DeepEqual(t, map[attSelectionKey][]byte{
+		{slot: 33, index: 2}: {0x03},
+		{slot: 40, index: 2}: {0x02},
+	}, v.attSelections)
+}
+
+func TestIsSyncCommitteeAggregator_Distributed(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.distributed = true
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+
+	c := params.BeaconConfig().Copy()
+	c.TargetAggregatorsPerSyncSubcommittee = math.MaxUint64
+	params.OverrideBeaconConfig(c)
+
+	m.validatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		&ethpb.SyncSubcommitteeIndexRequest{
+			PublicKey: pubKey[:],
+			Slot:      1,
+		},
+	).Return(&ethpb.SyncSubcommitteeIndexResponse{Indices: []primitives.CommitteeIndex{0}}, nil /*err*/).Times(2)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().GetAggregatedSyncSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).DoAndReturn(func(_ context.Context, selections []iface.SyncCommitteeSelection) ([]iface.SyncCommitteeSelection, error) {
+		require.Equal(t, 1, len(selections))
+		assert.Equal(t, primitives.CommitteeIndex(0), selections[0].SubcommitteeIndex)
+		return []iface.SyncCommitteeSelection{{SelectionProof: bytesutil.PadTo([]byte{0x01}, 96), Slot: 1, SubcommitteeIndex: 0, ValidatorIndex: 2}}, nil
+	}).Times(1)
+
+	require.NoError(t, v.requestSyncSelections(context.Background(), 1, map[[48]byte]primitives.ValidatorIndex{pubKey: 2}))
+	aggregator, err := v.isSyncCommitteeAggregator(context.Background(), 1, pubKey, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, aggregator)
+}
+
+func TestSubscribeToSubnets_Distributed(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.distributed = true
+	pubKey := validatorKey.PublicKey().Marshal()
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).AnyTimes()
+	m.validatorClient.EXPECT().GetAggregatedSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).DoAndReturn(func(_ context.Context, selections []iface.BeaconCommitteeSelection) ([]iface.BeaconCommitteeSelection, error) {
+		// Only the active duties are requested, all of them at once.
+		require.Equal(t, 2, len(selections))
+		return selections, nil
+	}).Times(1)
+	m.validatorClient.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+		[]primitives.ValidatorIndex{1, 1},
+	).Return(nil, nil)
+
+	require.NoError(t, v.subscribeToSubnets(context.Background(), &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey, AttesterSlot: 1, CommitteeIndex: 0, ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE, Committee: []primitives.ValidatorIndex{1}},
+			{PublicKey: pubKey, AttesterSlot: 2, CommitteeIndex: 0, ValidatorIndex: 3, Status: ethpb.ValidatorStatus_PENDING},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey, AttesterSlot: 33, CommitteeIndex: 1, ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE, Committee: []primitives.ValidatorIndex{1}},
+		},
+	}))
+	assert.Equal(t, 2, len(v.attSelections))
+}
+
+func TestSubscribeToSubnets_DistributedMiddlewareError(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.distributed = true
+	pubKey := validatorKey.PublicKey().Marshal()
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).AnyTimes()
+	m.validatorClient.EXPECT().GetAggregatedSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).Return(nil, errors.New("middleware unavailable")).AnyTimes()
+	// The validators are still subscribed, as non-aggregators.
+	m.validatorClient.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(), // ctx
+		&ethpb.CommitteeSubnetsSubscribeRequest{
+			Slots:        []primitives.Slot{1, 33},
+			CommitteeIds: []primitives.CommitteeIndex{0, 1},
+			IsAggregator: []bool{false, false},
+		},
+		[]primitives.ValidatorIndex{1, 1},
+	).Return(nil, nil)
+
+	require.NoError(t, v.subscribeToSubnets(context.Background(), &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey, AttesterSlot: 1, CommitteeIndex: 0, ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE, Committee: []primitives.ValidatorIndex{1}},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey, AttesterSlot: 33, CommitteeIndex: 1, ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE, Committee: []primitives.ValidatorIndex{1}},
+		},
+	}))
+}
+
+func TestRolesAt_DistributedMiddlewareError(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.distributed = true
+	pubKey := validatorKey.PublicKey().Marshal()
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{CommitteeIndex: 1, AttesterSlot: 1, ProposerSlots: []primitives.Slot{1}, PublicKey: pubKey, ValidatorIndex: 2, IsSyncCommittee: true},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{CommitteeIndex: 1, AttesterSlot: 33, PublicKey: pubKey, ValidatorIndex: 2, IsSyncCommittee: true},
+		},
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).AnyTimes()
+	m.validatorClient.EXPECT().GetSyncSubcommitteeIndex(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+	).Return(&ethpb.SyncSubcommitteeIndexResponse{Indices: []primitives.CommitteeIndex{0}}, nil /*err*/).AnyTimes()
+	m.validatorClient.EXPECT().GetAggregatedSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).Return(nil, errors.New("middleware unavailable")).AnyTimes()
+	m.validatorClient.EXPECT().GetAggregatedSyncSelections(
+		gomock.Any(), // ctx
+		gomock.Any(), // selections
+	).Return(nil, errors.New("middleware unavailable")).AnyTimes()
+
+	// The other duties of the slot are kept, the validator is only not an aggregator.
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []iface.ValidatorRole{iface.RoleProposer, iface.RoleAttester, iface.RoleSyncCommittee}, roleMap[bytesutil.ToBytes48(pubKey)])
+}
@@ -8,6 +8,23 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
+// BeaconCommitteeSelection is the beacon committee selection proof of a validator for a slot. In distributed
+// validator setups the proof is either the partial proof of a single operator or the proof combined by the middleware.
+type BeaconCommitteeSelection struct {
+	SelectionProof []byte
+	Slot           primitives.Slot
+	ValidatorIndex primitives.ValidatorIndex
+}
+
+// SyncCommitteeSelection is the sync committee selection proof of a validator for a slot and subcommittee. In distributed
+// validator setups the proof is either the partial proof of a single operator or the proof combined by the middleware.
+type SyncCommitteeSelection struct {
+	SelectionProof    []byte
+	Slot              primitives.Slot
+	SubcommitteeIndex primitives.CommitteeIndex
+	ValidatorIndex    primitives.ValidatorIndex
+}
+
 type ValidatorClient interface {
 	GetDuties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error)
 	DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error)
@@ -34,4 +51,6 @@ type ValidatorClient interface {
 	SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error)
 	StreamBlocksAltair(ctx context.Context, in *ethpb.StreamBlocksRequest) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error)
 	SubmitValidatorRegistrations(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error)
+	GetAggregatedSelections(ctx context.Context, selections []BeaconCommitteeSelection) ([]BeaconCommitteeSelection, error)
+	GetAggregatedSyncSelections(ctx context.Context, selections []SyncCommitteeSelection) ([]SyncCommitteeSelection, error)
 }
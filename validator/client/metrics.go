@@ -223,6 +223,15 @@ var (
 			"pubkey",
 		},
 	)
+	// AttestationDataDisagreementsTotal used to count the attestation data requests for which beacon nodes returned
+	// differing data, when attesting with the data agreed by the majority of them.
+	AttestationDataDisagreementsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "attestation_data_disagreements_total",
+			Help:      "Number of attestation data requests for which beacon nodes returned differing data",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
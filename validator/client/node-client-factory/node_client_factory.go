@@ -13,7 +13,7 @@ func NewNodeClient(validatorConn validatorHelpers.NodeConnection) iface.NodeClie
 	featureFlags := features.Get()
 
 	if featureFlags.EnableBeaconRESTApi {
-		return beaconApi.NewNodeClientWithFallback(validatorConn.GetBeaconApiUrl(), validatorConn.GetBeaconApiTimeout(), grpcClient)
+		return beaconApi.NewNodeClientWithFallback(validatorConn.GetBeaconApiRestHandler(), grpcClient)
 	} else {
 		return grpcClient
 	}
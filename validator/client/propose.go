@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -24,8 +26,10 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v4/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const domainDataErr = "could not get domain data"
@@ -64,7 +68,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 		return
 	}
 
-	g, err := v.getGraffiti(ctx, pubKey)
+	g, err := v.getGraffiti(ctx, pubKey, slot)
 	if err != nil {
 		// Graffiti is not a critical enough to fail block production and cause
 		// validator to miss block reward. When failed, validator should continue
@@ -73,11 +77,20 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	}
 
 	// Request block from beacon node
-	b, err := v.validatorClient.GetBeaconBlock(ctx, &ethpb.BlockRequest{
+	req := &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
 		Graffiti:     g,
-	})
+	}
+	if bc := v.builderConfig(pubKey); bc != nil {
+		req.BuilderMinBid = bc.MinBid
+		req.BuilderRelays = bc.Relays
+		if bc.LocalBlockValueBoost != nil {
+			boost := *bc.LocalBlockValueBoost
+			req.LocalBlockValueBoost = &boost
+		}
+	}
+	b, err := v.validatorClient.GetBeaconBlock(ctx, req)
 	if err != nil {
 		log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
 		if v.emitAccountMetrics {
@@ -355,18 +368,73 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
-func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
+// builderConfig returns the builder config of the validator public key from the proposer settings, falling
+// back to the default config. The builder policy of a proposal, its minimum bid and local block value boost,
+// is taken from it.
+func (v *validator) builderConfig(pubKey [fieldparams.BLSPubkeyLength]byte) *validatorserviceconfig.BuilderConfig {
+	settings := v.ProposerSettings()
+	if settings == nil {
+		return nil
+	}
+	if settings.ProposeConfig != nil {
+		if option, ok := settings.ProposeConfig[pubKey]; ok && option != nil && option.BuilderConfig != nil {
+			return option.BuilderConfig
+		}
+	}
+	if settings.DefaultConfig != nil {
+		return settings.DefaultConfig.BuilderConfig
+	}
+	return nil
+}
+
+// Gets the graffiti for the validator public key, with its template fields rendered for the proposal slot.
+func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([]byte, error) {
+	g, err := v.rawGraffiti(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !graffiti.IsTemplate(string(g)) {
+		return g, nil
+	}
+	data, err := v.graffitiTemplateData(ctx, pubKey, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get graffiti template data")
+	}
+	return graffiti.Render(string(g), data)
+}
+
+// Gets the graffiti from proposer settings, cli or file for the validator public key.
+func (v *validator) rawGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	settings := v.ProposerSettings()
+
+	// When specified, graffiti set for the validator in the proposer settings, such as from the
+	// keymanager API, takes the first priority.
+	if settings != nil && settings.ProposeConfig != nil {
+		option, ok := settings.ProposeConfig[pubKey]
+		if ok && option != nil && option.Graffiti != "" {
+			return []byte(option.Graffiti), nil
+		}
+	}
+
+	// When specified, default graffiti from the command line takes the second priority.
 	if len(v.graffiti) != 0 {
 		return v.graffiti, nil
 	}
 
+	var defaultGraffiti []byte
+	if settings != nil && settings.DefaultConfig != nil && settings.DefaultConfig.Graffiti != "" {
+		defaultGraffiti = []byte(settings.DefaultConfig.Graffiti)
+	}
+
 	if v.graffitiStruct == nil {
+		// Default graffiti from the proposer settings is used when there is no graffiti file.
+		if defaultGraffiti != nil {
+			return defaultGraffiti, nil
+		}
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
+	// When specified, individual validator specified graffiti takes the third priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return []byte{}, err
@@ -376,7 +444,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(g), nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take fourth priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
@@ -387,7 +455,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(graffiti), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take fifth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
@@ -395,10 +463,49 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(v.graffitiStruct.Random[i]), nil
 	}
 
-	// Finally, default graffiti if specified in the file will be used.
+	// Then, default graffiti if specified in the file will be used.
 	if v.graffitiStruct.Default != "" {
 		return []byte(v.graffitiStruct.Default), nil
 	}
 
+	// Finally, default graffiti if specified in the proposer settings will be used.
+	if defaultGraffiti != nil {
+		return defaultGraffiti, nil
+	}
+
 	return []byte{}, nil
 }
+
+// Gets the values of the graffiti template fields for a proposal of the validator at the slot.
+func (v *validator) graffitiTemplateData(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot,
+) (*graffiti.TemplateData, error) {
+	nodeVersion, err := v.node.GetVersion(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get beacon node version")
+	}
+	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator index")
+	}
+	return &graffiti.TemplateData{
+		CLVersion:      shortClientVersion(nodeVersion.Version),
+		ELClient:       nodeVersion.ExecutionClientVersion,
+		ValidatorIndex: idx.Index,
+		Epoch:          slots.ToEpoch(slot),
+	}, nil
+}
+
+// Shortens a client version such as "Prysm/v4.1.0/<commit>. Built at: <date>" to its name and
+// version, "Prysm/v4.1.0", so that it fits in the graffiti.
+func shortClientVersion(version string) string {
+	parts := strings.SplitN(version, "/", 3)
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	short := strings.Join(parts, "/")
+	if i := strings.Index(short, " "); i >= 0 {
+		short = short[:i]
+	}
+	return short
+}
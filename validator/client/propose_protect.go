@@ -21,7 +21,7 @@ func (v *validator) slashableProposalCheck(
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
 	blk := signedBlock.Block()
-	prevSigningRoot, proposalAtSlotExists, err := v.db.ProposalHistoryForSlot(ctx, pubKey, blk.Slot())
+	prevSigningRoot, proposalAtSlotExists, err := v.protection().ProposalHistoryForSlot(ctx, pubKey, blk.Slot())
 	if err != nil {
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -29,7 +29,7 @@ func (v *validator) slashableProposalCheck(
 		return errors.Wrap(err, "failed to get proposal history")
 	}
 
-	lowestSignedProposalSlot, lowestProposalExists, err := v.db.LowestSignedProposal(ctx, pubKey)
+	lowestSignedProposalSlot, lowestProposalExists, err := v.protection().LowestSignedProposal(ctx, pubKey)
 	if err != nil {
 		return err
 	}
@@ -74,7 +74,7 @@ func (v *validator) slashableProposalCheck(
 			return errors.New(failedBlockSignExternalErr)
 		}
 	}
-	if err := v.db.SaveProposalHistoryForSlot(ctx, pubKey, blk.Slot(), signingRoot[:]); err != nil {
+	if err := v.saveProposalHistoryForSlot(ctx, pubKey, blk.Slot(), signingRoot[:]); err != nil {
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -12,6 +12,7 @@ import (
 	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	blocktest "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks/testing"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
@@ -921,13 +922,87 @@ func TestGetGraffiti_Ok(t *testing.T) {
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
 			}
-			got, err := tt.v.getGraffiti(context.Background(), pubKey)
+			got, err := tt.v.getGraffiti(context.Background(), pubKey, 0)
 			require.NoError(t, err)
 			require.DeepEqual(t, tt.want, got)
 		})
 	}
 }
 
+func TestGetGraffiti_ProposerSettings(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	settings := &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+			pubKey: {Graffiti: "key"},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOption{Graffiti: "default"},
+	}
+	tests := []struct {
+		name string
+		v    *validator
+		want []byte
+	}{
+		{name: "key graffiti takes priority over cli graffiti",
+			v: &validator{
+				graffiti:         []byte{'b'},
+				proposerSettings: settings,
+			},
+			want: []byte("key"),
+		},
+		{name: "default graffiti without cli graffiti or file",
+			v: &validator{
+				proposerSettings: &validatorserviceconfig.ProposerSettings{DefaultConfig: settings.DefaultConfig},
+			},
+			want: []byte("default"),
+		},
+		{name: "cli graffiti takes priority over default graffiti",
+			v: &validator{
+				graffiti:         []byte{'b'},
+				proposerSettings: &validatorserviceconfig.ProposerSettings{DefaultConfig: settings.DefaultConfig},
+			},
+			want: []byte{'b'},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.v.getGraffiti(context.Background(), pubKey, 0)
+			require.NoError(t, err)
+			require.DeepEqual(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetGraffiti_Template(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: validatormock.NewMockValidatorClient(ctrl),
+		nodeClient:      validatormock.NewMockNodeClient(ctrl),
+	}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	m.nodeClient.EXPECT().
+		GetVersion(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Version{Version: "Prysm/v4.1.0/6d7bb4f3. Built at: 2023-10-17", ExecutionClientVersion: "Geth/v1.13.4"}, nil)
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	v := &validator{
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffiti:        []byte("{{.CLVersion}}+{{.ELClient}} {{.ValidatorIndex}}@{{.Epoch}}"),
+	}
+	got, err := v.getGraffiti(context.Background(), pubKey, params.BeaconConfig().SlotsPerEpoch*3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("Prysm/v4.1.0+Geth/v1.13.4 2@3"), got)
+}
+
+func Test_shortClientVersion(t *testing.T) {
+	assert.Equal(t, "Prysm/v4.1.0", shortClientVersion("Prysm/v4.1.0/6d7bb4f3. Built at: 2023-10-17"))
+	assert.Equal(t, "Prysm/v4.1.0", shortClientVersion("Prysm/v4.1.0 (linux amd64)"))
+	assert.Equal(t, "Lighthouse/v4.5.0-441fc16", shortClientVersion("Lighthouse/v4.5.0-441fc16/x86_64-linux"))
+	assert.Equal(t, "", shortClientVersion(""))
+}
+
 func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
 	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
@@ -949,7 +1024,7 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		},
 	}
 	for _, want := range [][]byte{{'a'}, {'b'}, {'c'}, {'d'}, {'d'}} {
-		got, err := v.getGraffiti(context.Background(), pubKey)
+		got, err := v.getGraffiti(context.Background(), pubKey, 0)
 		require.NoError(t, err)
 		require.DeepEqual(t, want, got)
 	}
@@ -14,6 +14,7 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v4/api/grpc"
 	"github.com/prysmaticlabs/prysm/v4/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
@@ -71,10 +72,20 @@ type ValidatorService struct {
 	ctx                   context.Context
 	validator             iface.Validator
 	db                    db.Database
+	slashingProtection    db.SlashingProtection
 	grpcHeaders           []string
 	graffiti              []byte
 	Web3SignerConfig      *remoteweb3signer.SetupConfig
 	proposerSettings      *validatorserviceconfig.ProposerSettings
+	distributed           bool
+	beaconNodeConns       []*beaconNodeConn
+}
+
+// Connection to one of the configured beacon nodes, used to request attestation data from
+// every beacon node.
+type beaconNodeConn struct {
+	host string
+	conn validatorHelpers.NodeConnection
 }
 
 // Config for the validator service.
@@ -91,6 +102,7 @@ type Config struct {
 	GraffitiStruct             *graffiti.Graffiti
 	Validator                  iface.Validator
 	ValDB                      db.Database
+	SlashingProtection         db.SlashingProtection
 	CertFlag                   string
 	DataDir                    string
 	GrpcHeadersFlag            string
@@ -100,6 +112,8 @@ type Config struct {
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
 	BeaconApiEndpoint          string
 	BeaconApiTimeout           time.Duration
+	Distributed                bool
+	AttestationDataMajority    bool
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -121,6 +135,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		grpcHeaders:           strings.Split(cfg.GrpcHeadersFlag, ","),
 		validator:             cfg.Validator,
 		db:                    cfg.ValDB,
+		slashingProtection:    cfg.SlashingProtection,
 		wallet:                cfg.Wallet,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
@@ -128,6 +143,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		graffitiStruct:        cfg.GraffitiStruct,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
 		proposerSettings:      cfg.ProposerSettings,
+		distributed:           cfg.Distributed,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -154,10 +170,52 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		cfg.BeaconApiEndpoint,
 		cfg.BeaconApiTimeout,
 	)
+	if cfg.AttestationDataMajority {
+		s.beaconNodeConns, err = s.dialBeaconNodes(ctx, dialOpts)
+		if err != nil {
+			return s, err
+		}
+	}
 
 	return s, nil
 }
 
+// Connects to each of the configured beacon nodes separately, so that attestation data can be
+// requested from all of them. The REST API clients share the gRPC connection of the service,
+// while a gRPC connection is made to each beacon node otherwise.
+func (v *ValidatorService) dialBeaconNodes(ctx context.Context, dialOpts []grpc.DialOption) ([]*beaconNodeConn, error) {
+	useBeaconApi := features.Get().EnableBeaconRESTApi
+	endpoints := strings.Split(v.endpoint, ",")
+	if useBeaconApi {
+		endpoints = strings.Split(v.conn.GetBeaconApiUrl(), ",")
+	}
+	if len(endpoints) < 2 {
+		log.Warn("Attestation data majority is enabled with a single beacon node, attestation data will only be requested from it")
+		return nil, nil
+	}
+	conns := make([]*beaconNodeConn, len(endpoints))
+	for i, endpoint := range endpoints {
+		endpoint = strings.TrimSpace(endpoint)
+		if useBeaconApi {
+			conns[i] = &beaconNodeConn{
+				host: endpoint,
+				conn: validatorHelpers.NewNodeConnection(v.conn.GetGrpcClientConn(), endpoint, v.conn.GetBeaconApiTimeout()),
+			}
+			continue
+		}
+		grpcConn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not dial beacon node %s", endpoint)
+		}
+		conns[i] = &beaconNodeConn{
+			host: endpoint,
+			conn: validatorHelpers.NewNodeConnection(grpcConn, v.conn.GetBeaconApiUrl(), v.conn.GetBeaconApiTimeout()),
+		}
+	}
+	log.WithField("beaconNodes", len(conns)).Info("Requesting attestation data from every beacon node")
+	return conns, nil
+}
+
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
@@ -193,6 +251,7 @@ func (v *ValidatorService) Start() {
 
 	valStruct := &validator{
 		db:                             v.db,
+		slashingProtection:             v.slashingProtection,
 		validatorClient:                validatorClient,
 		beaconClient:                   beaconClient,
 		slashingProtectionClient:       slasherClientFactory.NewSlasherClient(v.conn),
@@ -220,6 +279,15 @@ func (v *ValidatorService) Start() {
 		Web3SignerConfig:               v.Web3SignerConfig,
 		proposerSettings:               v.proposerSettings,
 		walletInitializedChannel:       make(chan *wallet.Wallet, 1),
+		distributed:                    v.distributed,
+	}
+
+	for _, c := range v.beaconNodeConns {
+		valStruct.attestationDataSources = append(valStruct.attestationDataSources, &attestationDataSource{
+			host:            c.host,
+			validatorClient: validatorClientFactory.NewValidatorClient(c.conn),
+			beaconClient:    beaconChainClientFactory.NewBeaconChainClient(c.conn),
+		})
 	}
 
 	// To resolve a race condition at startup due to the interface
@@ -240,6 +308,14 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	for _, c := range v.beaconNodeConns {
+		if c.conn.GetGrpcClientConn() == v.conn.GetGrpcClientConn() {
+			continue
+		}
+		if err := c.conn.GetGrpcClientConn().Close(); err != nil {
+			log.WithError(err).WithField("host", c.host).Error("Could not close beacon node connection")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.GetGrpcClientConn().Close()
 	}
@@ -259,6 +335,11 @@ func (v *ValidatorService) InteropKeysConfig() *local.InteropKeymanagerConfig {
 	return v.interopKeysConfig
 }
 
+// Graffiti returns the default graffiti set from the command line.
+func (v *ValidatorService) Graffiti() []byte {
+	return v.graffiti
+}
+
 // Keymanager returns the underlying keymanager in the validator
 func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
@@ -13,7 +13,7 @@ func NewSlasherClient(validatorConn validatorHelpers.NodeConnection) iface.Slash
 	featureFlags := features.Get()
 
 	if featureFlags.EnableBeaconRESTApi {
-		return beaconApi.NewSlasherClientWithFallback(validatorConn.GetBeaconApiUrl(), validatorConn.GetBeaconApiTimeout(), grpcClient)
+		return beaconApi.NewSlasherClientWithFallback(validatorConn.GetBeaconApiRestHandler(), grpcClient)
 	} else {
 		return grpcClient
 	}
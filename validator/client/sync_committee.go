@@ -116,7 +116,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 		return
 	}
 
-	selectionProofs, err := v.selectionProofs(ctx, slot, pubKey, indexRes)
+	selectionProofs, err := v.selectionProofs(ctx, slot, pubKey, indexRes, duty.ValidatorIndex)
 	if err != nil {
 		log.WithError(err).Error("Could not get selection proofs")
 		return
@@ -187,7 +187,8 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 }
 
 // Signs and returns selection proofs per validator for slot and pub key.
-func (v *validator) selectionProofs(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, indexRes *ethpb.SyncSubcommitteeIndexResponse) ([][]byte, error) {
+// In distributed mode the aggregated selection proofs are returned instead of the partial ones.
+func (v *validator) selectionProofs(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, indexRes *ethpb.SyncSubcommitteeIndexResponse, validatorIndex primitives.ValidatorIndex) ([][]byte, error) {
 	selectionProofs := make([][]byte, len(indexRes.Indices))
 	cfg := params.BeaconConfig()
 	size := cfg.SyncCommitteeSize
@@ -195,7 +196,13 @@ func (v *validator) selectionProofs(ctx context.Context, slot primitives.Slot, p
 	for i, index := range indexRes.Indices {
 		subSize := size / subCount
 		subnet := uint64(index) / subSize
-		selectionProof, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+		var selectionProof []byte
+		var err error
+		if v.distributed {
+			selectionProof, err = v.syncSelection(ctx, pubKey, slot, validatorIndex, subnet)
+		} else {
+			selectionProof, err = v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+		}
 		if err != nil {
 			return nil, err
 		}
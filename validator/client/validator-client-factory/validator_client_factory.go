@@ -12,7 +12,7 @@ func NewValidatorClient(validatorConn validatorHelpers.NodeConnection) iface.Val
 	featureFlags := features.Get()
 
 	if featureFlags.EnableBeaconRESTApi {
-		return beaconApi.NewBeaconApiValidatorClient(validatorConn.GetBeaconApiUrl(), validatorConn.GetBeaconApiTimeout())
+		return beaconApi.NewBeaconApiValidatorClient(validatorConn.GetBeaconApiRestHandler())
 	} else {
 		return grpcApi.NewGrpcValidatorClient(validatorConn.GetGrpcClientConn())
 	}
@@ -74,6 +74,9 @@ type validator struct {
 	highestValidSlotLock               sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
+	attSelectionLock                   sync.Mutex
+	syncSelectionLock                  sync.Mutex
+	attestationDataLock                sync.Mutex
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
 	walletInitializedFeed              *event.Feed
 	attLogs                            map[[32]byte]*attSubmitted
@@ -94,6 +97,7 @@ type validator struct {
 	node                               iface.NodeClient
 	slashingProtectionClient           iface.SlasherClient
 	db                                 vdb.Database
+	slashingProtection                 vdb.SlashingProtection
 	beaconClient                       iface.BeaconChainClient
 	keyManager                         keymanager.IKeymanager
 	ticker                             slots.Ticker
@@ -104,6 +108,11 @@ type validator struct {
 	Web3SignerConfig                   *remoteweb3signer.SetupConfig
 	proposerSettings                   *validatorserviceconfig.ProposerSettings
 	walletInitializedChannel           chan *wallet.Wallet
+	distributed                        bool
+	attSelections                      map[attSelectionKey][]byte
+	syncSelections                     map[syncSelectionKey][]byte
+	attestationDataSources             []*attestationDataSource
+	attestationData                    map[attestationDataKey]*attestationDataResolution
 }
 
 type validatorStatus struct {
@@ -112,6 +121,50 @@ type validatorStatus struct {
 	index     primitives.ValidatorIndex
 }
 
+// Returns the store used to protect against signing slashable messages, which defaults
+// to the validator DB when no remote slashing protection is configured.
+func (v *validator) protection() vdb.SlashingProtection {
+	if v.slashingProtection != nil {
+		return v.slashingProtection
+	}
+	return v.db
+}
+
+// Records a signed proposal in the slashing protection store. When the remote slashing protection
+// is used, the proposal is recorded in the validator DB as well once the remote service accepted
+// it, so that the local history can be exported and used when failing back to local protection.
+func (v *validator) saveProposalHistoryForSlot(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot []byte,
+) error {
+	if err := v.protection().SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot); err != nil {
+		return err
+	}
+	if v.slashingProtection == nil {
+		return nil
+	}
+	if err := v.db.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot); err != nil {
+		return errors.Wrap(err, "could not save proposal history to the validator database")
+	}
+	return nil
+}
+
+// Records a signed attestation in the slashing protection store, and in the validator DB as well
+// once the remote service accepted it when the remote slashing protection is used.
+func (v *validator) saveAttestationForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	if err := v.protection().SaveAttestationForPubKey(ctx, pubKey, signingRoot, att); err != nil {
+		return err
+	}
+	if v.slashingProtection == nil {
+		return nil
+	}
+	if err := v.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, att); err != nil {
+		return errors.Wrap(err, "could not save attestation history to the validator database")
+	}
+	return nil
+}
+
 // Done cleans up the validator.
 func (v *validator) Done() {
 	v.ticker.Done()
@@ -642,6 +695,22 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 	subscribeValidatorIndices := make([]primitives.ValidatorIndex, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
 	alreadySubscribed := make(map[[64]byte]bool)
 
+	if v.distributed {
+		// Request the aggregated selection proofs of all the duties at once.
+		duties := make([]*ethpb.DutiesResponse_Duty, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
+		for _, epochDuties := range [][]*ethpb.DutiesResponse_Duty{res.CurrentEpochDuties, res.NextEpochDuties} {
+			for _, duty := range epochDuties {
+				if duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING {
+					duties = append(duties, duty)
+				}
+			}
+		}
+		// The proofs missing because of a failure are requested again for each validator below.
+		if err := v.requestAttSelections(ctx, duties); err != nil {
+			log.WithError(err).Error("Could not get aggregated selection proofs")
+		}
+	}
+
 	for _, duty := range res.CurrentEpochDuties {
 		pk := bytesutil.ToBytes48(duty.PublicKey)
 		if duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING {
@@ -654,9 +723,12 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 				continue
 			}
 
-			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, pk)
+			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, pk, validatorIndex)
 			if err != nil {
-				return errors.Wrap(err, "could not check if a validator is an aggregator")
+				if !v.distributed {
+					return errors.Wrap(err, "could not check if a validator is an aggregator")
+				}
+				log.WithError(err).WithField("validatorIndex", validatorIndex).Error("Could not check if validator is an aggregator, subscribing as non-aggregator")
 			}
 			if aggregator {
 				alreadySubscribed[alreadySubscribedKey] = true
@@ -680,9 +752,12 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 				continue
 			}
 
-			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, bytesutil.ToBytes48(duty.PublicKey))
+			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, bytesutil.ToBytes48(duty.PublicKey), validatorIndex)
 			if err != nil {
-				return errors.Wrap(err, "could not check if a validator is an aggregator")
+				if !v.distributed {
+					return errors.Wrap(err, "could not check if a validator is an aggregator")
+				}
+				log.WithError(err).WithField("validatorIndex", validatorIndex).Error("Could not check if validator is an aggregator, subscribing as non-aggregator")
 			}
 			if aggregator {
 				alreadySubscribed[alreadySubscribedKey] = true
@@ -712,6 +787,28 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
 func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole, error) {
 	rolesAt := make(map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole)
+
+	if v.distributed {
+		// Request the aggregated sync selection proofs of all the sync committee members at once.
+		syncCommitteeValidators := make(map[[fieldparams.BLSPubkeyLength]byte]primitives.ValidatorIndex)
+		for validator, duty := range v.duties.Duties {
+			if duty == nil {
+				continue
+			}
+			inSyncCommittee := duty.IsSyncCommittee
+			if slots.IsEpochEnd(slot) {
+				inSyncCommittee = v.duties.NextEpochDuties[validator].IsSyncCommittee
+			}
+			if inSyncCommittee {
+				syncCommitteeValidators[bytesutil.ToBytes48(duty.PublicKey)] = duty.ValidatorIndex
+			}
+		}
+		// The proofs missing because of a failure are requested again for each validator below.
+		if err := v.requestSyncSelections(ctx, slot, syncCommitteeValidators); err != nil {
+			log.WithError(err).Error("Could not get aggregated sync selection proofs")
+		}
+	}
+
 	for validator, duty := range v.duties.Duties {
 		var roles []iface.ValidatorRole
 
@@ -729,9 +826,13 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 		if duty.AttesterSlot == slot {
 			roles = append(roles, iface.RoleAttester)
 
-			aggregator, err := v.isAggregator(ctx, duty.Committee, slot, bytesutil.ToBytes48(duty.PublicKey))
+			aggregator, err := v.isAggregator(ctx, duty.Committee, slot, bytesutil.ToBytes48(duty.PublicKey), duty.ValidatorIndex)
 			if err != nil {
-				return nil, errors.Wrap(err, "could not check if a validator is an aggregator")
+				// In distributed mode a missing aggregated selection proof must not prevent the other duties.
+				if !v.distributed {
+					return nil, errors.Wrap(err, "could not check if a validator is an aggregator")
+				}
+				log.WithError(err).WithField("validatorIndex", duty.ValidatorIndex).Error("Could not check if validator is an aggregator, treating it as non-aggregator")
 			}
 			if aggregator {
 				roles = append(roles, iface.RoleAggregator)
@@ -754,9 +855,12 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 			}
 		}
 		if inSyncCommittee {
-			aggregator, err := v.isSyncCommitteeAggregator(ctx, slot, bytesutil.ToBytes48(duty.PublicKey))
+			aggregator, err := v.isSyncCommitteeAggregator(ctx, slot, bytesutil.ToBytes48(duty.PublicKey), duty.ValidatorIndex)
 			if err != nil {
-				return nil, errors.Wrap(err, "could not check if a validator is a sync committee aggregator")
+				if !v.distributed {
+					return nil, errors.Wrap(err, "could not check if a validator is a sync committee aggregator")
+				}
+				log.WithError(err).WithField("validatorIndex", duty.ValidatorIndex).Error("Could not check if validator is a sync committee aggregator, treating it as non-aggregator")
 			}
 			if aggregator {
 				roles = append(roles, iface.RoleSyncCommitteeAggregator)
@@ -784,13 +888,20 @@ func (v *validator) Keymanager() (keymanager.IKeymanager, error) {
 
 // isAggregator checks if a validator is an aggregator of a given slot and committee,
 // it uses a modulo calculated by validator count in committee and samples randomness around it.
-func (v *validator) isAggregator(ctx context.Context, committee []primitives.ValidatorIndex, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) (bool, error) {
+// In distributed mode the aggregated selection proof of the validator is used instead of the partial one.
+func (v *validator) isAggregator(ctx context.Context, committee []primitives.ValidatorIndex, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, validatorIndex primitives.ValidatorIndex) (bool, error) {
 	modulo := uint64(1)
 	if len(committee)/int(params.BeaconConfig().TargetAggregatorsPerCommittee) > 1 {
 		modulo = uint64(len(committee)) / params.BeaconConfig().TargetAggregatorsPerCommittee
 	}
 
-	slotSig, err := v.signSlotWithSelectionProof(ctx, pubKey, slot)
+	var slotSig []byte
+	var err error
+	if v.distributed {
+		slotSig, err = v.attSelection(ctx, pubKey, slot, validatorIndex)
+	} else {
+		slotSig, err = v.signSlotWithSelectionProof(ctx, pubKey, slot)
+	}
 	if err != nil {
 		return false, err
 	}
@@ -808,7 +919,9 @@ func (v *validator) isAggregator(ctx context.Context, committee []primitives.Val
 //
 //	modulo = max(1, SYNC_COMMITTEE_SIZE // SYNC_COMMITTEE_SUBNET_COUNT // TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE)
 //	return bytes_to_uint64(hash(signature)[0:8]) % modulo == 0
-func (v *validator) isSyncCommitteeAggregator(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) (bool, error) {
+//
+// In distributed mode the aggregated selection proofs of the validator are used instead of the partial ones.
+func (v *validator) isSyncCommitteeAggregator(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, validatorIndex primitives.ValidatorIndex) (bool, error) {
 	res, err := v.validatorClient.GetSyncSubcommitteeIndex(ctx, &ethpb.SyncSubcommitteeIndexRequest{
 		PublicKey: pubKey[:],
 		Slot:      slot,
@@ -820,7 +933,12 @@ func (v *validator) isSyncCommitteeAggregator(ctx context.Context, slot primitiv
 	for _, index := range res.Indices {
 		subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
 		subnet := uint64(index) / subCommitteeSize
-		sig, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+		var sig []byte
+		if v.distributed {
+			sig, err = v.syncSelection(ctx, pubKey, slot, validatorIndex, subnet)
+		} else {
+			sig, err = v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+		}
 		if err != nil {
 			return false, err
 		}
@@ -6,3 +6,7 @@ import "github.com/prysmaticlabs/prysm/v4/validator/db/iface"
 // key-value or relational database in practice. This is the full database interface which should
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.ValidatorDB
+
+// SlashingProtection defines the methods used by the validator client to protect against signing
+// slashable messages, which may be backed by the local database or by a remote protection service.
+type SlashingProtection = iface.SlashingProtection
@@ -0,0 +1,230 @@
+// Package remote implements the slashing protection of the validator client on top of a remote
+// protection service, so that several validator clients, such as a hot-standby pair, share a
+// single signing history. Records are saved with compare-and-set semantics: the service refuses
+// to record a proposal or an attestation which is slashable with respect to the shared history.
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+)
+
+// ErrSlashable is returned when the remote service refuses to record a proposal or an
+// attestation which is slashable with respect to the shared signing history.
+var ErrSlashable = errors.New("rejected by remote slashing protection")
+
+// Ensure the client implements the slashing protection interface.
+var _ = iface.SlashingProtection(&Client{})
+
+// Client provides slashing protection by calling the endpoints of a remote protection service.
+type Client struct {
+	*client.Client
+}
+
+// NewClient returns a new Client for the remote slashing protection service at the given host.
+func NewClient(host string, opts ...client.ClientOpt) (*Client, error) {
+	c, err := client.NewClient(host, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{c}, nil
+}
+
+// HighestSignedProposal returns the highest slot signed for a proposal by a validator public key.
+func (c *Client) HighestSignedProposal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	return c.getSlot(ctx, pubKeyPath(HighestSignedProposalPath, pubKey))
+}
+
+// LowestSignedProposal returns the lowest slot signed for a proposal by a validator public key.
+func (c *Client) LowestSignedProposal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	return c.getSlot(ctx, pubKeyPath(LowestSignedProposalPath, pubKey))
+}
+
+// ProposalHistoryForSlot returns the signing root of the proposal signed at a slot by a validator
+// public key, and whether such a proposal exists.
+func (c *Client) ProposalHistoryForSlot(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot,
+) ([32]byte, bool, error) {
+	path := strings.Replace(pubKeyPath(ProposalAtSlotPath, pubKey), "{slot}", strconv.FormatUint(uint64(slot), 10), 1)
+	return c.getSigningRoot(ctx, path)
+}
+
+// SaveProposalHistoryForSlot records the proposal signed at a slot by a validator public key. The
+// remote service refuses to record a proposal conflicting with the shared history, in which case
+// an error wrapping ErrSlashable is returned.
+func (c *Client) SaveProposalHistoryForSlot(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot []byte,
+) error {
+	req := &ProposalRequest{
+		Slot:        strconv.FormatUint(uint64(slot), 10),
+		SigningRoot: hexutil.Encode(signingRoot),
+	}
+	return c.post(ctx, pubKeyPath(ProposalsPath, pubKey), req, nil)
+}
+
+// SigningRootAtTargetEpoch returns the signing root of the attestation signed at a target epoch by
+// a validator public key, or a zero root if there is none.
+func (c *Client) SigningRootAtTargetEpoch(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, target primitives.Epoch,
+) ([32]byte, error) {
+	path := strings.Replace(
+		pubKeyPath(SigningRootAtTargetEpochPath, pubKey), "{target_epoch}", strconv.FormatUint(uint64(target), 10), 1,
+	)
+	root, _, err := c.getSigningRoot(ctx, path)
+	return root, err
+}
+
+// LowestSignedSourceEpoch returns the lowest source epoch signed by a validator public key.
+func (c *Client) LowestSignedSourceEpoch(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	return c.getEpoch(ctx, pubKeyPath(LowestSignedSourceEpochPath, pubKey))
+}
+
+// LowestSignedTargetEpoch returns the lowest target epoch signed by a validator public key.
+func (c *Client) LowestSignedTargetEpoch(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	return c.getEpoch(ctx, pubKeyPath(LowestSignedTargetEpochPath, pubKey))
+}
+
+// CheckSlashableAttestation verifies an attestation is neither a double vote nor a surround vote
+// with respect to the shared history of a validator public key.
+func (c *Client) CheckSlashableAttestation(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	resp := &CheckResponse{}
+	if err := c.post(ctx, pubKeyPath(CheckAttestationPath, pubKey), attestationRequest(signingRoot, att), resp); err != nil {
+		return kv.NotSlashable, err
+	}
+	kind, err := SlashingKindFromString(resp.SlashingKind)
+	if err != nil {
+		return kv.NotSlashable, err
+	}
+	if kind != kv.NotSlashable {
+		return kind, errors.New(resp.Message)
+	}
+	return kv.NotSlashable, nil
+}
+
+// SaveAttestationForPubKey records an attestation signed by a validator public key. The remote
+// service refuses to record an attestation which is slashable with respect to the shared history,
+// in which case an error wrapping ErrSlashable is returned.
+func (c *Client) SaveAttestationForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	return c.post(ctx, pubKeyPath(AttestationsPath, pubKey), attestationRequest(signingRoot, att), nil)
+}
+
+func (c *Client) getSlot(ctx context.Context, path string) (primitives.Slot, bool, error) {
+	b, err := c.Get(ctx, path)
+	if err != nil {
+		return 0, false, err
+	}
+	resp := &SlotResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return 0, false, errors.Wrap(err, "failed to parse slot response")
+	}
+	slot, err := strconv.ParseUint(resp.Slot, 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "invalid slot %s", resp.Slot)
+	}
+	return primitives.Slot(slot), resp.Exists, nil
+}
+
+func (c *Client) getEpoch(ctx context.Context, path string) (primitives.Epoch, bool, error) {
+	b, err := c.Get(ctx, path)
+	if err != nil {
+		return 0, false, err
+	}
+	resp := &EpochResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return 0, false, errors.Wrap(err, "failed to parse epoch response")
+	}
+	epoch, err := strconv.ParseUint(resp.Epoch, 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "invalid epoch %s", resp.Epoch)
+	}
+	return primitives.Epoch(epoch), resp.Exists, nil
+}
+
+func (c *Client) getSigningRoot(ctx context.Context, path string) ([32]byte, bool, error) {
+	b, err := c.Get(ctx, path)
+	if err != nil {
+		return [32]byte{}, false, err
+	}
+	resp := &SigningRootResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return [32]byte{}, false, errors.Wrap(err, "failed to parse signing root response")
+	}
+	root, err := hexutil.Decode(resp.SigningRoot)
+	if err != nil {
+		return [32]byte{}, false, errors.Wrapf(err, "invalid signing root %s", resp.SigningRoot)
+	}
+	return bytesutil.ToBytes32(root), resp.Exists, nil
+}
+
+// Posts the JSON encoding of the request to the path, and decodes the response into resp when it
+// is not nil. A 409 Conflict response is returned as an error wrapping ErrSlashable.
+func (c *Client) post(ctx context.Context, path string, req, resp interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal request")
+	}
+	u := c.BaseURL().ResolveReference(&url.URL{Path: path})
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	res, err := c.Do(r)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	switch res.StatusCode {
+	case http.StatusOK:
+		if resp == nil {
+			return nil
+		}
+		if err := json.NewDecoder(res.Body).Decode(resp); err != nil {
+			return errors.Wrap(err, "failed to parse response")
+		}
+		return nil
+	case http.StatusConflict:
+		conflict := &CheckResponse{}
+		if err := json.NewDecoder(res.Body).Decode(conflict); err != nil {
+			return errors.Wrap(ErrSlashable, "could not parse conflict response")
+		}
+		return errors.Wrap(ErrSlashable, conflict.Message)
+	default:
+		return client.Non200Err(res)
+	}
+}
+
+func pubKeyPath(template string, pubKey [fieldparams.BLSPubkeyLength]byte) string {
+	return strings.Replace(template, "{pubkey}", hexutil.Encode(pubKey[:]), 1)
+}
+
+func attestationRequest(signingRoot [32]byte, att *ethpb.IndexedAttestation) *AttestationRequest {
+	return &AttestationRequest{
+		SourceEpoch: strconv.FormatUint(uint64(att.Data.Source.Epoch), 10),
+		TargetEpoch: strconv.FormatUint(uint64(att.Data.Target.Epoch), 10),
+		SigningRoot: hexutil.Encode(signingRoot[:]),
+	}
+}
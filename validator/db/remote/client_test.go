@@ -0,0 +1,73 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+)
+
+func TestClient_LowestSignedProposal(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/slashing-protection/v1/"+hexutil.Encode(pubKey[:])+"/proposals/lowest", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(&SlotResponse{Slot: "5", Exists: true}))
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	slot, exists, err := c.LowestSignedProposal(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, primitives.Slot(5), slot)
+}
+
+func TestClient_SaveAttestationForPubKey(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	att := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+	}
+	conflict := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/slashing-protection/v1/"+hexutil.Encode(pubKey[:])+"/attestations", r.URL.Path)
+		req := &AttestationRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		assert.DeepEqual(t, &AttestationRequest{
+			SourceEpoch: "1",
+			TargetEpoch: "2",
+			SigningRoot: hexutil.Encode(make([]byte, 32)),
+		}, req)
+		if conflict {
+			w.WriteHeader(http.StatusConflict)
+			require.NoError(t, json.NewEncoder(w).Encode(&CheckResponse{
+				SlashingKind: SlashingKindToString(kv.DoubleVote),
+				Message:      "double vote",
+			}))
+		}
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	require.NoError(t, c.SaveAttestationForPubKey(context.Background(), pubKey, [32]byte{}, att))
+	conflict = true
+	err = c.SaveAttestationForPubKey(context.Background(), pubKey, [32]byte{}, att)
+	assert.Equal(t, true, errors.Is(err, ErrSlashable))
+	assert.ErrorContains(t, "double vote", err)
+}
@@ -0,0 +1,81 @@
+package remote
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+)
+
+// Paths of the endpoints of a remote slashing protection service. The {pubkey} variable is the
+// 0x-prefixed hex encoding of a validator public key.
+const (
+	ProposalsPath                = "/slashing-protection/v1/{pubkey}/proposals"
+	ProposalAtSlotPath           = "/slashing-protection/v1/{pubkey}/proposals/{slot}"
+	LowestSignedProposalPath     = "/slashing-protection/v1/{pubkey}/proposals/lowest"
+	HighestSignedProposalPath    = "/slashing-protection/v1/{pubkey}/proposals/highest"
+	AttestationsPath             = "/slashing-protection/v1/{pubkey}/attestations"
+	CheckAttestationPath         = "/slashing-protection/v1/{pubkey}/attestations/check"
+	SigningRootAtTargetEpochPath = "/slashing-protection/v1/{pubkey}/attestations/signing_root/{target_epoch}"
+	LowestSignedSourceEpochPath  = "/slashing-protection/v1/{pubkey}/attestations/lowest_source"
+	LowestSignedTargetEpochPath  = "/slashing-protection/v1/{pubkey}/attestations/lowest_target"
+)
+
+// SlotResponse is the response of the lowest and highest signed proposal endpoints.
+type SlotResponse struct {
+	Slot   string `json:"slot"`
+	Exists bool   `json:"exists"`
+}
+
+// EpochResponse is the response of the lowest signed source and target epoch endpoints.
+type EpochResponse struct {
+	Epoch  string `json:"epoch"`
+	Exists bool   `json:"exists"`
+}
+
+// SigningRootResponse is the response of the endpoints returning the signing root of a proposal
+// at a slot, or of an attestation at a target epoch.
+type SigningRootResponse struct {
+	SigningRoot string `json:"signing_root"`
+	Exists      bool   `json:"exists"`
+}
+
+// ProposalRequest is the request body used to record a signed proposal.
+type ProposalRequest struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// AttestationRequest is the request body used to check or record a signed attestation.
+type AttestationRequest struct {
+	SourceEpoch string `json:"source_epoch"`
+	TargetEpoch string `json:"target_epoch"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// CheckResponse is the response of the attestation check endpoint, and the body of the
+// 409 Conflict responses returned when a record would be slashable.
+type CheckResponse struct {
+	SlashingKind string `json:"slashing_kind"`
+	Message      string `json:"message"`
+}
+
+var slashingKinds = map[kv.SlashingKind]string{
+	kv.NotSlashable:    "not_slashable",
+	kv.DoubleVote:      "double_vote",
+	kv.SurroundingVote: "surrounding_vote",
+	kv.SurroundedVote:  "surrounded_vote",
+}
+
+// SlashingKindToString returns the wire representation of a slashing kind.
+func SlashingKindToString(kind kv.SlashingKind) string {
+	return slashingKinds[kind]
+}
+
+// SlashingKindFromString parses the wire representation of a slashing kind.
+func SlashingKindFromString(s string) (kv.SlashingKind, error) {
+	for kind, str := range slashingKinds {
+		if str == s {
+			return kind, nil
+		}
+	}
+	return kv.NotSlashable, errors.Errorf("unknown slashing kind %q", s)
+}
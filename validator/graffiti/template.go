@@ -0,0 +1,71 @@
+package graffiti
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// Maximum length in bytes of the graffiti of a block.
+const maxGraffitiLength = 32
+
+// TemplateData holds the fields which can be used in a graffiti template, such as
+// "{{.CLVersion}} {{.ELClient}} #{{.ValidatorIndex}}".
+type TemplateData struct {
+	// CLVersion is the name and version of the beacon node, e.g. Prysm/v4.1.0.
+	CLVersion string
+	// ELClient is the name and version of the execution client of the beacon node, as returned
+	// by engine_getClientVersionV1, e.g. Geth/v1.13.5.
+	ELClient string
+	// ValidatorIndex is the index of the proposer.
+	ValidatorIndex primitives.ValidatorIndex
+	// Epoch is the epoch of the proposed block.
+	Epoch primitives.Epoch
+}
+
+// IsTemplate returns true if the graffiti contains template fields to render.
+func IsTemplate(graffiti string) bool {
+	return strings.Contains(graffiti, "{{")
+}
+
+// Render expands the template fields of the graffiti with the given data. The result is
+// truncated to the 32 bytes of a block graffiti, without splitting a UTF-8 character.
+func Render(graffiti string, data *TemplateData) ([]byte, error) {
+	tmpl, err := template.New("graffiti").Option("missingkey=error").Parse(graffiti)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse graffiti template")
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(err, "could not render graffiti template")
+	}
+	return truncate(buf.Bytes()), nil
+}
+
+// ValidateTemplate checks that the graffiti can be rendered, so that invalid templates are
+// rejected when set rather than when proposing a block.
+func ValidateTemplate(graffiti string) error {
+	if !IsTemplate(graffiti) {
+		if len(graffiti) > maxGraffitiLength {
+			return errors.Errorf("graffiti is longer than %d bytes", maxGraffitiLength)
+		}
+		return nil
+	}
+	_, err := Render(graffiti, &TemplateData{})
+	return err
+}
+
+func truncate(graffiti []byte) []byte {
+	if len(graffiti) <= maxGraffitiLength {
+		return graffiti
+	}
+	end := maxGraffitiLength
+	for end > 0 && !utf8.RuneStart(graffiti[end]) {
+		end--
+	}
+	return graffiti[:end]
+}
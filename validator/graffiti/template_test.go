@@ -0,0 +1,72 @@
+package graffiti
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestRender(t *testing.T) {
+	data := &TemplateData{
+		CLVersion:      "Prysm/v4.1.0",
+		ELClient:       "Geth/v1.13.5",
+		ValidatorIndex: 1234,
+		Epoch:          56,
+	}
+	tests := []struct {
+		name     string
+		graffiti string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "all fields",
+			graffiti: "{{.CLVersion}} {{.ELClient}}",
+			want:     "Prysm/v4.1.0 Geth/v1.13.5",
+		},
+		{
+			name:     "validator index and epoch",
+			graffiti: "#{{.ValidatorIndex}} at {{.Epoch}}",
+			want:     "#1234 at 56",
+		},
+		{
+			name:     "truncated to 32 bytes",
+			graffiti: "{{.CLVersion}} {{.ELClient}} validator {{.ValidatorIndex}}",
+			want:     "Prysm/v4.1.0 Geth/v1.13.5 valida",
+		},
+		{
+			name:     "truncated without splitting a character",
+			graffiti: "{{.CLVersion}} {{.ELClient}} 🦀🦀",
+			want:     "Prysm/v4.1.0 Geth/v1.13.5 🦀",
+		},
+		{
+			name:     "unknown field",
+			graffiti: "{{.Unknown}}",
+			wantErr:  "could not render graffiti template",
+		},
+		{
+			name:     "invalid template",
+			graffiti: "{{.CLVersion",
+			wantErr:  "could not parse graffiti template",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.graffiti, data)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	assert.NoError(t, ValidateTemplate("Mr T was here"))
+	assert.NoError(t, ValidateTemplate("{{.CLVersion}} {{.ELClient}} {{.ValidatorIndex}} {{.Epoch}}"))
+	assert.ErrorContains(t, "longer than 32 bytes", ValidateTemplate("this graffiti is longer than thirty two bytes"))
+	assert.ErrorContains(t, "could not render graffiti template", ValidateTemplate("{{.Slot}}"))
+}
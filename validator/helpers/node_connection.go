@@ -3,6 +3,7 @@ package validator_helpers
 import (
 	"time"
 
+	beaconApi "github.com/prysmaticlabs/prysm/v4/validator/client/beacon-api"
 	"google.golang.org/grpc"
 )
 
@@ -11,6 +12,7 @@ type NodeConnection interface {
 	GetGrpcClientConn() *grpc.ClientConn
 	GetBeaconApiUrl() string
 	GetBeaconApiTimeout() time.Duration
+	GetBeaconApiRestHandler() beaconApi.JsonRestHandler
 	dummy()
 }
 
@@ -18,6 +20,7 @@ type nodeConnection struct {
 	grpcClientConn   *grpc.ClientConn
 	beaconApiUrl     string
 	beaconApiTimeout time.Duration
+	beaconApiHandler beaconApi.JsonRestHandler
 }
 
 func (c *nodeConnection) GetGrpcClientConn() *grpc.ClientConn {
@@ -32,6 +35,12 @@ func (c *nodeConnection) GetBeaconApiTimeout() time.Duration {
 	return c.beaconApiTimeout
 }
 
+// GetBeaconApiRestHandler returns the handler of the beacon API requests, which is shared by the
+// REST clients created from the connection.
+func (c *nodeConnection) GetBeaconApiRestHandler() beaconApi.JsonRestHandler {
+	return c.beaconApiHandler
+}
+
 func (*nodeConnection) dummy() {}
 
 func NewNodeConnection(grpcConn *grpc.ClientConn, beaconApiUrl string, beaconApiTimeout time.Duration) NodeConnection {
@@ -39,5 +48,6 @@ func NewNodeConnection(grpcConn *grpc.ClientConn, beaconApiUrl string, beaconApi
 	conn.grpcClientConn = grpcConn
 	conn.beaconApiUrl = beaconApiUrl
 	conn.beaconApiTimeout = beaconApiTimeout
+	conn.beaconApiHandler = beaconApi.NewJsonRestHandler(beaconApiUrl, beaconApiTimeout)
 	return conn
 }
@@ -0,0 +1,235 @@
+package composite
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+)
+
+// RemoteKeymanager defines a keymanager signing with a remote signer, whose public keys
+// can be added and deleted at runtime, such as the web3signer keymanager.
+type RemoteKeymanager interface {
+	keymanager.IKeymanager
+	keymanager.PublicKeyAdder
+	keymanager.PublicKeyDeleter
+}
+
+// Keymanager signs with the keystores of a local or derived keymanager and with the
+// public keys of a remote keymanager at the same time. Requests for a public key held
+// by both are signed locally, so that a key can be migrated from one to the other by
+// adding it to the destination before deleting it from the source, without ever leaving
+// the validator without the key.
+type Keymanager struct {
+	local               keymanager.IKeymanager
+	remote              RemoteKeymanager
+	accountsChangedFeed *event.Feed
+	localPubKeys        map[[fieldparams.BLSPubkeyLength]byte]bool
+	lock                sync.RWMutex
+}
+
+// NewKeymanager instantiates a new composite keymanager from a local and a remote keymanager.
+// Account changes of both are forwarded to subscribers until the context is canceled.
+func NewKeymanager(ctx context.Context, local keymanager.IKeymanager, remote RemoteKeymanager) (*Keymanager, error) {
+	if local == nil || remote == nil {
+		return nil, errors.New("both a local and a remote keymanager are required")
+	}
+	km := &Keymanager{
+		local:               local,
+		remote:              remote,
+		accountsChangedFeed: new(event.Feed),
+	}
+	if err := km.refreshLocalPublicKeys(ctx); err != nil {
+		return nil, err
+	}
+	// Subscriptions are made before returning so that no account change is missed.
+	localChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	localSub := local.SubscribeAccountChanges(localChan)
+	remoteChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	remoteSub := remote.SubscribeAccountChanges(remoteChan)
+	go km.listenForAccountChanges(ctx, localChan, localSub, remoteChan, remoteSub)
+	return km, nil
+}
+
+// Forwards the account changes of the local and remote keymanagers to the subscribers of the
+// composite keymanager, with the public keys of both.
+func (km *Keymanager) listenForAccountChanges(
+	ctx context.Context,
+	localChan <-chan [][fieldparams.BLSPubkeyLength]byte,
+	localSub event.Subscription,
+	remoteChan <-chan [][fieldparams.BLSPubkeyLength]byte,
+	remoteSub event.Subscription,
+) {
+	defer localSub.Unsubscribe()
+	defer remoteSub.Unsubscribe()
+	for {
+		select {
+		case pubKeys := <-localChan:
+			km.setLocalPublicKeys(pubKeys)
+		case <-remoteChan:
+		case err := <-localSub.Err():
+			log.WithError(err).Error("Local keymanager account changes subscription failed")
+			return
+		case err := <-remoteSub.Err():
+			log.WithError(err).Error("Remote keymanager account changes subscription failed")
+			return
+		case <-ctx.Done():
+			return
+		}
+		pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			log.WithError(err).Error("Could not fetch validating public keys")
+			continue
+		}
+		km.accountsChangedFeed.Send(pubKeys)
+	}
+}
+
+func (km *Keymanager) refreshLocalPublicKeys(ctx context.Context) error {
+	pubKeys, err := km.local.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch local validating public keys")
+	}
+	km.setLocalPublicKeys(pubKeys)
+	return nil
+}
+
+func (km *Keymanager) setLocalPublicKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) {
+	localPubKeys := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		localPubKeys[pubKey] = true
+	}
+	km.lock.Lock()
+	km.localPubKeys = localPubKeys
+	km.lock.Unlock()
+}
+
+// FetchValidatingPublicKeys returns the public keys of the local keymanager, followed by the
+// public keys of the remote keymanager which are not held locally.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	localKeys, err := km.LocalPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remoteKeys, err := km.RemotePublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(localKeys))
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(localKeys)+len(remoteKeys))
+	for _, pubKey := range localKeys {
+		seen[pubKey] = true
+		pubKeys = append(pubKeys, pubKey)
+	}
+	for _, pubKey := range remoteKeys {
+		if !seen[pubKey] {
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	return pubKeys, nil
+}
+
+// LocalPublicKeys returns the public keys of the keystores held by the local keymanager.
+func (km *Keymanager) LocalPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys, err := km.local.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch local validating public keys")
+	}
+	return pubKeys, nil
+}
+
+// RemotePublicKeys returns the public keys signed with by the remote keymanager.
+func (km *Keymanager) RemotePublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys, err := km.remote.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch remote validating public keys")
+	}
+	return pubKeys, nil
+}
+
+// Sign signs the request with the local keymanager if it holds the public key of the request,
+// or with the remote keymanager otherwise.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	km.lock.RLock()
+	isLocal := km.localPubKeys[bytesutil.ToBytes48(req.PublicKey)]
+	km.lock.RUnlock()
+	if isLocal {
+		return km.local.Sign(ctx, req)
+	}
+	return km.remote.Sign(ctx, req)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes of either the local or the remote keymanager.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores extracts keystores from the local keymanager.
+func (km *Keymanager) ExtractKeystores(
+	ctx context.Context, publicKeys []bls.PublicKey, password string,
+) ([]*keymanager.Keystore, error) {
+	return km.local.ExtractKeystores(ctx, publicKeys, password)
+}
+
+// ImportKeystores imports keystores into the local keymanager.
+func (km *Keymanager) ImportKeystores(
+	ctx context.Context, keystores []*keymanager.Keystore, passwords []string,
+) ([]*ethpbservice.ImportedKeystoreStatus, error) {
+	importer, ok := km.local.(keymanager.Importer)
+	if !ok {
+		return nil, errors.New("local keymanager cannot import keystores")
+	}
+	statuses, err := importer.ImportKeystores(ctx, keystores, passwords)
+	if err != nil {
+		return nil, err
+	}
+	if err := km.refreshLocalPublicKeys(ctx); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// DeleteKeystores deletes keystores from the local keymanager. Public keys of the remote
+// keymanager are not affected.
+func (km *Keymanager) DeleteKeystores(
+	ctx context.Context, publicKeys [][]byte,
+) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	statuses, err := km.local.DeleteKeystores(ctx, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if err := km.refreshLocalPublicKeys(ctx); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// AddPublicKeys adds public keys to the remote keymanager.
+func (km *Keymanager) AddPublicKeys(
+	ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte,
+) ([]*ethpbservice.ImportedRemoteKeysStatus, error) {
+	return km.remote.AddPublicKeys(ctx, publicKeys)
+}
+
+// DeletePublicKeys deletes public keys from the remote keymanager.
+func (km *Keymanager) DeletePublicKeys(
+	ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte,
+) ([]*ethpbservice.DeletedRemoteKeysStatus, error) {
+	return km.remote.DeletePublicKeys(ctx, publicKeys)
+}
+
+// ListKeymanagerAccounts lists the accounts of the local keymanager, then of the remote keymanager.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, cfg keymanager.ListKeymanagerAccountConfig) error {
+	if err := km.local.ListKeymanagerAccounts(ctx, cfg); err != nil {
+		return err
+	}
+	return km.remote.ListKeymanagerAccounts(ctx, cfg)
+}
@@ -0,0 +1,242 @@
+package composite
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+)
+
+type mockKeymanager struct {
+	keymanager.IKeymanager
+	pubKeys   [][fieldparams.BLSPubkeyLength]byte
+	signature bls.Signature
+	feed      *event.Feed
+	lock      sync.Mutex
+}
+
+func newMockKeymanager(t *testing.T, pubKeys ...[fieldparams.BLSPubkeyLength]byte) *mockKeymanager {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	return &mockKeymanager{
+		pubKeys:   pubKeys,
+		signature: secretKey.Sign([]byte("composite")),
+		feed:      new(event.Feed),
+	}
+}
+
+func (m *mockKeymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([][fieldparams.BLSPubkeyLength]byte{}, m.pubKeys...), nil
+}
+
+func (m *mockKeymanager) setPubKeys(pubKeys ...[fieldparams.BLSPubkeyLength]byte) {
+	m.lock.Lock()
+	m.pubKeys = pubKeys
+	m.lock.Unlock()
+	m.feed.Send(pubKeys)
+}
+
+func (m *mockKeymanager) Sign(_ context.Context, _ *validatorpb.SignRequest) (bls.Signature, error) {
+	return m.signature, nil
+}
+
+func (m *mockKeymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return m.feed.Subscribe(pubKeysChan)
+}
+
+func (m *mockKeymanager) remove(pubKey [fieldparams.BLSPubkeyLength]byte) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for i, key := range m.pubKeys {
+		if key == pubKey {
+			m.pubKeys = append(m.pubKeys[:i], m.pubKeys[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockKeymanager) ImportKeystores(
+	_ context.Context, keystores []*keymanager.Keystore, _ []string,
+) ([]*ethpbservice.ImportedKeystoreStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	statuses := make([]*ethpbservice.ImportedKeystoreStatus, len(keystores))
+	for i := range keystores {
+		m.pubKeys = append(m.pubKeys, [fieldparams.BLSPubkeyLength]byte{byte(len(m.pubKeys) + 1)})
+		statuses[i] = &ethpbservice.ImportedKeystoreStatus{Status: ethpbservice.ImportedKeystoreStatus_IMPORTED}
+	}
+	return statuses, nil
+}
+
+func (m *mockKeymanager) DeleteKeystores(
+	_ context.Context, publicKeys [][]byte,
+) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	statuses := make([]*ethpbservice.DeletedKeystoreStatus, len(publicKeys))
+	for i, pubKey := range publicKeys {
+		statuses[i] = &ethpbservice.DeletedKeystoreStatus{Status: ethpbservice.DeletedKeystoreStatus_NOT_FOUND}
+		if m.remove(bytesutil.ToBytes48(pubKey)) {
+			statuses[i].Status = ethpbservice.DeletedKeystoreStatus_DELETED
+		}
+	}
+	return statuses, nil
+}
+
+func (m *mockKeymanager) AddPublicKeys(
+	_ context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte,
+) ([]*ethpbservice.ImportedRemoteKeysStatus, error) {
+	m.lock.Lock()
+	statuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(publicKeys))
+	for i, pubKey := range publicKeys {
+		m.pubKeys = append(m.pubKeys, pubKey)
+		statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{Status: ethpbservice.ImportedRemoteKeysStatus_IMPORTED}
+	}
+	pubKeys := append([][fieldparams.BLSPubkeyLength]byte{}, m.pubKeys...)
+	m.lock.Unlock()
+	m.feed.Send(pubKeys)
+	return statuses, nil
+}
+
+func (m *mockKeymanager) DeletePublicKeys(
+	ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte,
+) ([]*ethpbservice.DeletedRemoteKeysStatus, error) {
+	statuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(publicKeys))
+	for i, pubKey := range publicKeys {
+		statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{Status: ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND}
+		if m.remove(pubKey) {
+			statuses[i].Status = ethpbservice.DeletedRemoteKeysStatus_DELETED
+		}
+	}
+	pubKeys, err := m.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.feed.Send(pubKeys)
+	return statuses, nil
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	local := newMockKeymanager(t, [fieldparams.BLSPubkeyLength]byte{1}, [fieldparams.BLSPubkeyLength]byte{2})
+	remote := newMockKeymanager(t, [fieldparams.BLSPubkeyLength]byte{2}, [fieldparams.BLSPubkeyLength]byte{3})
+	km, err := NewKeymanager(ctx, local, remote)
+	require.NoError(t, err)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{{1}, {2}, {3}}, pubKeys)
+	localKeys, err := km.LocalPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}, localKeys)
+	remoteKeys, err := km.RemotePublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{{2}, {3}}, remoteKeys)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	local := newMockKeymanager(t, [fieldparams.BLSPubkeyLength]byte{1}, [fieldparams.BLSPubkeyLength]byte{2})
+	remote := newMockKeymanager(t, [fieldparams.BLSPubkeyLength]byte{2}, [fieldparams.BLSPubkeyLength]byte{3})
+	km, err := NewKeymanager(ctx, local, remote)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		pubKey [fieldparams.BLSPubkeyLength]byte
+		want   bls.Signature
+	}{
+		{name: "local key", pubKey: [fieldparams.BLSPubkeyLength]byte{1}, want: local.signature},
+		{name: "key held by both is signed locally", pubKey: [fieldparams.BLSPubkeyLength]byte{2}, want: local.signature},
+		{name: "remote key", pubKey: [fieldparams.BLSPubkeyLength]byte{3}, want: remote.signature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: tt.pubKey[:]})
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want.Marshal(), sig.Marshal())
+		})
+	}
+}
+
+func TestKeymanager_MigrateKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	local := newMockKeymanager(t)
+	remote := newMockKeymanager(t, [fieldparams.BLSPubkeyLength]byte{1})
+	km, err := NewKeymanager(ctx, local, remote)
+	require.NoError(t, err)
+	req := &validatorpb.SignRequest{PublicKey: []byte{1}}
+	req.PublicKey = append(req.PublicKey, make([]byte, fieldparams.BLSPubkeyLength-1)...)
+
+	// Remote to local: the key is signed locally as soon as its keystore is imported.
+	statuses, err := km.ImportKeystores(ctx, []*keymanager.Keystore{{}}, []string{""})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, statuses[0].Status)
+	sig, err := km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, local.signature.Marshal(), sig.Marshal())
+	remoteStatuses, err := km.DeletePublicKeys(ctx, [][fieldparams.BLSPubkeyLength]byte{{1}})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, remoteStatuses[0].Status)
+
+	// Local to remote: the key is signed remotely once its keystore is deleted.
+	importStatuses, err := km.AddPublicKeys(ctx, [][fieldparams.BLSPubkeyLength]byte{{1}})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, importStatuses[0].Status)
+	sig, err = km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, local.signature.Marshal(), sig.Marshal())
+	deleteStatuses, err := km.DeleteKeystores(ctx, [][]byte{req.PublicKey})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_DELETED, deleteStatuses[0].Status)
+	sig, err = km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, remote.signature.Marshal(), sig.Marshal())
+}
+
+func TestKeymanager_SubscribeAccountChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	local := newMockKeymanager(t, [fieldparams.BLSPubkeyLength]byte{1})
+	remote := newMockKeymanager(t)
+	km, err := NewKeymanager(ctx, local, remote)
+	require.NoError(t, err)
+	pubKeysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+
+	_, err = km.AddPublicKeys(ctx, [][fieldparams.BLSPubkeyLength]byte{{2}})
+	require.NoError(t, err)
+	select {
+	case pubKeys := <-pubKeysChan:
+		assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}, pubKeys)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for account changes")
+	}
+
+	// Changes to the local keys, such as from the wallet directory, are forwarded as well.
+	local.setPubKeys([fieldparams.BLSPubkeyLength]byte{3})
+	select {
+	case pubKeys := <-pubKeysChan:
+		assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{{3}, {2}}, pubKeys)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for account changes")
+	}
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: make([]byte, fieldparams.BLSPubkeyLength)})
+	require.NoError(t, err)
+	assert.DeepEqual(t, remote.signature.Marshal(), sig.Marshal())
+}
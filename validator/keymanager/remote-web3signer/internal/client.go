@@ -3,14 +3,18 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -25,6 +29,7 @@ import (
 
 const (
 	ethApiNamespace = "/api/v1/eth2/sign/"
+	upcheckPath     = "/upcheck"
 )
 
 type SignRequestJson []byte
@@ -44,27 +49,103 @@ type HttpSignerClient interface {
 type ApiClient struct {
 	BaseURL    *url.URL
 	RestClient *http.Client
+	// FailoverURLs are web3signer instances used, in order, when the base one is unavailable.
+	FailoverURLs []*url.URL
+
+	healthLock sync.RWMutex
+	unhealthy  map[string]bool
+}
+
+// ApiClientOption is an option to configure an ApiClient.
+type ApiClientOption func(*ApiClient) error
+
+// WithFailoverEndpoints adds web3signer instances which are used, in order, when the base one
+// or a previous failover instance is unavailable. All instances must hold the same keys.
+func WithFailoverEndpoints(endpoints ...string) ApiClientOption {
+	return func(client *ApiClient) error {
+		for _, endpoint := range endpoints {
+			u, err := parseEndpoint(endpoint)
+			if err != nil {
+				return err
+			}
+			client.FailoverURLs = append(client.FailoverURLs, u)
+		}
+		return nil
+	}
+}
+
+// WithTLSConfig makes the client connect to the web3signer instances with the given TLS configuration.
+func WithTLSConfig(cfg *tls.Config) ApiClientOption {
+	return func(client *ApiClient) error {
+		client.RestClient.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: cfg,
+		}
+		return nil
+	}
 }
 
 // NewApiClient method instantiates a new ApiClient object.
-func NewApiClient(baseEndpoint string) (*ApiClient, error) {
-	u, err := url.ParseRequestURI(baseEndpoint)
+func NewApiClient(baseEndpoint string, opts ...ApiClientOption) (*ApiClient, error) {
+	u, err := parseEndpoint(baseEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	client := &ApiClient{
+		BaseURL:    u,
+		RestClient: &http.Client{},
+	}
+	for _, opt := range opts {
+		if err := opt(client); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	u, err := url.ParseRequestURI(endpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid format, unable to parse url")
 	}
 	if u.Scheme == "" || u.Host == "" {
-		return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", baseEndpoint)
+		return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", endpoint)
 	}
-	return &ApiClient{
-		BaseURL:    u,
-		RestClient: &http.Client{},
-	}, nil
+	return u, nil
+}
+
+// LoadTLSConfig creates the TLS configuration to connect to web3signer with a client certificate,
+// and optionally a CA certificate to verify the web3signer server certificate with.
+func LoadTLSConfig(clientCertPath, clientKeyPath, caCertPath string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if clientCertPath != "" || clientKeyPath != "" {
+		if clientCertPath == "" || clientKeyPath == "" {
+			return nil, errors.New("both a client certificate and a client key are needed for web3signer TLS authentication")
+		}
+		cert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load web3signer client certificate")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if caCertPath != "" {
+		caCert, err := os.ReadFile(caCertPath) // #nosec G304
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read web3signer CA certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("could not parse web3signer CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
 }
 
 // Sign is a wrapper method around the web3signer sign api.
 func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRequestJson) (bls.Signature, error) {
 	requestPath := ethApiNamespace + pubKey
-	resp, err := client.doRequest(ctx, http.MethodPost, client.BaseURL.String()+requestPath, bytes.NewBuffer(request))
+	resp, fullPath, err := client.doEndpointRequest(ctx, http.MethodPost, requestPath, request)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +153,7 @@ func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRe
 		return nil, fmt.Errorf("public key not found")
 	}
 	if resp.StatusCode == http.StatusPreconditionFailed {
-		return nil, fmt.Errorf("signing operation failed due to slashing protection rules,  Signing Request URL: %v, Status: %v", client.BaseURL.String()+requestPath, resp.StatusCode)
+		return nil, fmt.Errorf("signing operation failed due to slashing protection rules,  Signing Request URL: %v, Status: %v", fullPath, resp.StatusCode)
 	}
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "application/json") {
@@ -87,8 +168,16 @@ func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRe
 }
 
 // GetPublicKeys is a wrapper method around the web3signer publickeys api (this may be removed in the future or moved to another location due to its usage).
+// When the url is on one of the web3signer instances of the client, the request fails over to the
+// other instances like signing requests do.
 func (client *ApiClient) GetPublicKeys(ctx context.Context, url string) ([][fieldparams.BLSPubkeyLength]byte, error) {
-	resp, err := client.doRequest(ctx, http.MethodGet, url, nil /* no body needed on get request */)
+	var resp *http.Response
+	var err error
+	if requestPath, ok := client.instancePath(url); ok {
+		resp, _, err = client.doEndpointRequest(ctx, http.MethodGet, requestPath, nil /* no body needed on get request */)
+	} else {
+		resp, err = client.doRequest(ctx, http.MethodGet, url, nil /* no body needed on get request */)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +204,7 @@ func (client *ApiClient) GetPublicKeys(ctx context.Context, url string) ([][fiel
 // ReloadSignerKeys is a wrapper method around the web3signer reload api.
 func (client *ApiClient) ReloadSignerKeys(ctx context.Context) error {
 	const requestPath = "/reload"
-	if _, err := client.doRequest(ctx, http.MethodPost, client.BaseURL.String()+requestPath, nil); err != nil {
+	if _, _, err := client.doEndpointRequest(ctx, http.MethodPost, requestPath, nil); err != nil {
 		return err
 	}
 	return nil
@@ -123,8 +212,7 @@ func (client *ApiClient) ReloadSignerKeys(ctx context.Context) error {
 
 // GetServerStatus is a wrapper method around the web3signer upcheck api
 func (client *ApiClient) GetServerStatus(ctx context.Context) (string, error) {
-	const requestPath = "/upcheck"
-	resp, err := client.doRequest(ctx, http.MethodGet, client.BaseURL.String()+requestPath, nil /* no body needed on get request */)
+	resp, _, err := client.doEndpointRequest(ctx, http.MethodGet, upcheckPath, nil /* no body needed on get request */)
 	if err != nil {
 		return "", err
 	}
@@ -135,6 +223,156 @@ func (client *ApiClient) GetServerStatus(ctx context.Context) (string, error) {
 	return status, nil
 }
 
+// MonitorHealth checks the upcheck api of every web3signer instance of the client on the given
+// interval until the context is canceled. Requests are sent to the first healthy instance, in
+// the order they were configured, so the client fails back to the base instance once it recovers.
+func (client *ApiClient) MonitorHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, u := range client.allURLs() {
+				reqCtx, cancel := context.WithTimeout(ctx, interval)
+				resp, err := client.doRequest(reqCtx, http.MethodGet, u.String()+upcheckPath, nil)
+				if err == nil {
+					closeBody(resp.Body)
+					err = statusError(resp)
+				}
+				cancel()
+				client.setHealthy(u, err == nil)
+			}
+		}
+	}
+}
+
+// Returns the base and failover instances in order, with the healthy ones first.
+func (client *ApiClient) endpoints() []*url.URL {
+	all := client.allURLs()
+	client.healthLock.RLock()
+	defer client.healthLock.RUnlock()
+	healthy := make([]*url.URL, 0, len(all))
+	unhealthy := make([]*url.URL, 0)
+	for _, u := range all {
+		if client.unhealthy[u.String()] {
+			unhealthy = append(unhealthy, u)
+		} else {
+			healthy = append(healthy, u)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// Returns the path of the url relative to the web3signer instance of the client it is on, if any.
+func (client *ApiClient) instancePath(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	for _, instance := range client.allURLs() {
+		if u.Scheme != instance.Scheme || u.Host != instance.Host || !strings.HasPrefix(u.Path, instance.Path) {
+			continue
+		}
+		requestPath := strings.TrimPrefix(u.Path, instance.Path)
+		if u.RawQuery != "" {
+			requestPath += "?" + u.RawQuery
+		}
+		return requestPath, true
+	}
+	return "", false
+}
+
+func (client *ApiClient) allURLs() []*url.URL {
+	return append([]*url.URL{client.BaseURL}, client.FailoverURLs...)
+}
+
+func (client *ApiClient) setHealthy(u *url.URL, healthy bool) {
+	client.healthLock.Lock()
+	defer client.healthLock.Unlock()
+	if client.unhealthy == nil {
+		client.unhealthy = make(map[string]bool)
+	}
+	wasHealthy := !client.unhealthy[u.String()]
+	if healthy {
+		delete(client.unhealthy, u.String())
+		endpointHealthy.WithLabelValues(u.Host).Set(1)
+	} else {
+		client.unhealthy[u.String()] = true
+		endpointHealthy.WithLabelValues(u.Host).Set(0)
+	}
+	if wasHealthy != healthy {
+		log.WithFields(logrus.Fields{
+			"endpoint": u.Host,
+			"healthy":  healthy,
+		}).Warn("Web3signer endpoint health changed")
+	}
+}
+
+// doEndpointRequest sends the request to the first available web3signer instance, failing over
+// to the next ones when an instance cannot be reached or responds with a server error. Only an
+// instance which cannot be reached is marked unhealthy, as a server error may be caused by the
+// request itself; the health checks mark it unhealthy if it is down. The full path of the request
+// sent to the instance which responded is returned along with its response.
+func (client *ApiClient) doEndpointRequest(ctx context.Context, httpMethod, requestPath string, body []byte) (*http.Response, string, error) {
+	var lastErr error
+	for i, u := range client.endpoints() {
+		if i > 0 {
+			failoversTotal.Inc()
+			log.WithError(lastErr).WithField("endpoint", u.Host).Warn("Failing over to the next web3signer endpoint")
+		}
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+		fullPath := u.String() + requestPath
+		resp, err := client.doRequest(ctx, httpMethod, fullPath, reqBody)
+		if err == nil {
+			err = statusError(resp)
+			if err != nil {
+				closeBody(resp.Body)
+			}
+		}
+		if err == nil {
+			client.setHealthy(u, true)
+			return resp, fullPath, nil
+		}
+		var unavailable *unavailableError
+		if !errors.As(err, &unavailable) || ctx.Err() != nil {
+			return nil, fullPath, err
+		}
+		if unavailable.unreachable {
+			client.setHealthy(u, false)
+		}
+		lastErr = err
+	}
+	return nil, "", lastErr
+}
+
+// unavailableError is returned when a web3signer instance cannot be reached or responds with
+// a server error, in which case the request can be sent to another instance.
+type unavailableError struct {
+	err         error
+	unreachable bool
+}
+
+func (e *unavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *unavailableError) Unwrap() error {
+	return e.err
+}
+
+// Returns an unavailable error for server errors other than 500, which doRequest already handles.
+func statusError(resp *http.Response) error {
+	if resp.StatusCode > http.StatusInternalServerError {
+		return &unavailableError{err: fmt.Errorf("web3signer server unavailable, Status: %v", resp.StatusCode)}
+	}
+	return nil
+}
+
 // doRequest is a utility method for requests.
 func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath string, body io.Reader) (*http.Response, error) {
 	var requestDump []byte
@@ -156,13 +394,21 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 	duration := time.Since(start)
 	if err != nil {
 		signRequestDurationSeconds.WithLabelValues(req.Method, "error").Observe(duration.Seconds())
-		err = errors.Wrap(err, "failed to execute json request")
+		endpointRequestDurationSeconds.WithLabelValues(req.URL.Host, req.Method, "error").Observe(duration.Seconds())
+		err = &unavailableError{err: errors.Wrap(err, "failed to execute json request"), unreachable: true}
 		tracing.AnnotateError(span, err)
 		return resp, err
 	} else {
 		signRequestDurationSeconds.WithLabelValues(req.Method, strconv.Itoa(resp.StatusCode)).Observe(duration.Seconds())
+		endpointRequestDurationSeconds.WithLabelValues(req.URL.Host, req.Method, strconv.Itoa(resp.StatusCode)).Observe(duration.Seconds())
 	}
 	if resp.StatusCode != http.StatusOK {
+		// The body of the request was consumed when sending it.
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
 		requestDump, err = httputil.DumpRequestOut(req, true)
 		if err != nil {
 			return nil, err
@@ -178,7 +424,7 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 		}).Error("web3signer request failed")
 	}
 	if resp.StatusCode == http.StatusInternalServerError {
-		err = fmt.Errorf("internal Web3Signer server error, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)
+		err = &unavailableError{err: fmt.Errorf("internal Web3Signer server error, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)}
 		tracing.AnnotateError(span, err)
 		return nil, err
 	} else if resp.StatusCode == http.StatusBadRequest {
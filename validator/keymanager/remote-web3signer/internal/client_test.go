@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -201,3 +203,93 @@ func TestClient_GetServerStatus_HappyPath(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Nil(t, err)
 }
+
+func TestClient_Sign_FailsOverToHealthyEndpoint(t *testing.T) {
+	jsonSig := `0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9`
+	var primaryCalls, failoverCalls int
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryCalls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+	failover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failoverCalls++
+		if r.URL.Path == "/upcheck" {
+			_, err := w.Write([]byte(`"OK"`))
+			require.NoError(t, err)
+			return
+		}
+		_, err := w.Write([]byte(jsonSig))
+		require.NoError(t, err)
+	}))
+	defer failover.Close()
+
+	cl, err := internal.NewApiClient(primary.URL, internal.WithFailoverEndpoints(failover.URL))
+	require.NoError(t, err)
+	jsonRequest, err := json.Marshal(`{message: "hello"}`)
+	require.NoError(t, err)
+	pubKey := "a2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	resp, err := cl.Sign(context.Background(), pubKey, jsonRequest)
+	require.NoError(t, err)
+	assert.EqualValues(t, jsonSig, fmt.Sprintf("%#x", resp.Marshal()))
+	assert.Equal(t, 1, primaryCalls)
+	assert.Equal(t, 1, failoverCalls)
+
+	// A server error may be caused by the request, so the endpoint is still tried first.
+	_, err = cl.Sign(context.Background(), pubKey, jsonRequest)
+	require.NoError(t, err)
+	assert.Equal(t, 2, primaryCalls)
+	assert.Equal(t, 2, failoverCalls)
+
+	// An endpoint which cannot be reached is not tried first anymore.
+	primary.Close()
+	_, err = cl.Sign(context.Background(), pubKey, jsonRequest)
+	require.NoError(t, err)
+	assert.Equal(t, 3, failoverCalls)
+	_, err = cl.Sign(context.Background(), pubKey, jsonRequest)
+	require.NoError(t, err)
+	assert.Equal(t, 4, failoverCalls)
+	assert.Equal(t, 2, primaryCalls)
+
+	status, err := cl.GetServerStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", status)
+}
+
+func TestClient_GetPublicKeys_FailsOver(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	primary.Close()
+	failover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/eth2/publicKeys", r.URL.Path)
+		_, err := w.Write([]byte(`["0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"]`))
+		require.NoError(t, err)
+	}))
+	defer failover.Close()
+
+	cl, err := internal.NewApiClient(primary.URL, internal.WithFailoverEndpoints(failover.URL))
+	require.NoError(t, err)
+	keys, err := cl.GetPublicKeys(context.Background(), primary.URL+"/api/v1/eth2/publicKeys")
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(keys))
+}
+
+func TestClient_Sign_AllEndpointsUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	cl, err := internal.NewApiClient(srv.URL, internal.WithFailoverEndpoints(srv.URL+"/failover"))
+	require.NoError(t, err)
+	_, err = cl.Sign(context.Background(), "a2b5", []byte("{}"))
+	require.ErrorContains(t, "internal Web3Signer server error", err)
+}
+
+func TestLoadTLSConfig(t *testing.T) {
+	_, err := internal.LoadTLSConfig("client.crt", "", "")
+	require.ErrorContains(t, "both a client certificate and a client key are needed", err)
+	_, err = internal.LoadTLSConfig("", "", filepath.Join(t.TempDir(), "missing.crt"))
+	require.ErrorContains(t, "could not read web3signer CA certificate", err)
+	cfg, err := internal.LoadTLSConfig("", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(cfg.Certificates))
+}
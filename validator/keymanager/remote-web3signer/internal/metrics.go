@@ -14,4 +14,23 @@ var (
 		},
 		[]string{"method", "status_code"},
 	)
+	endpointRequestDurationSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "remote_web3signer_internal_client_endpoint_request_duration_seconds",
+			Help:    "Time (in seconds) spent doing client HTTP requests, by web3signer endpoint",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"endpoint", "method", "status_code"},
+	)
+	endpointHealthy = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "remote_web3signer_internal_client_endpoint_healthy",
+			Help: "Whether a web3signer endpoint is considered healthy (1) or not (0)",
+		},
+		[]string{"endpoint"},
+	)
+	failoversTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_web3signer_internal_client_failovers_total",
+		Help: "Total number of requests sent to a failover web3signer endpoint",
+	})
 )
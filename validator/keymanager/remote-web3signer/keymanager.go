@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -24,6 +26,8 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const endpointHealthCheckInterval = 10 * time.Second
+
 // SetupConfig includes configuration values for initializing.
 // a keymanager, such as passwords, the wallet, and more.
 // Web3Signer contains one public keys option. Either through a URL or a static key list.
@@ -40,6 +44,21 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// PublicKeysPollingInterval, if set along with the URL, makes the keymanager fetch the public keys
+	// from the URL again on this interval, and notify account change subscribers of added or removed keys.
+	// The URL is then the source of truth, and keys added or deleted through the keymanager API are
+	// overridden on the next poll.
+	PublicKeysPollingInterval time.Duration
+
+	// FailoverEndpoints are web3signer instances used, in order, when the base endpoint is unavailable.
+	// They must hold the same keys and share the slashing protection database of the base endpoint.
+	FailoverEndpoints []string
+
+	// TLS client certificate and key to authenticate with web3signer, and CA certificate to verify it.
+	ClientCertPath string
+	ClientKeyPath  string
+	CACertPath     string
 }
 
 // Keymanager defines the web3signer keymanager.
@@ -51,18 +70,28 @@ type Keymanager struct {
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	lock                  sync.RWMutex
 }
 
-// NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+// NewKeymanager instantiates a new web3signer key manager. Polling of the public keys URL and
+// health checks of failover endpoints, if configured, run until the context is canceled.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
-	client, err := internal.NewApiClient(cfg.BaseEndpoint)
+	opts := []internal.ApiClientOption{internal.WithFailoverEndpoints(cfg.FailoverEndpoints...)}
+	if cfg.ClientCertPath != "" || cfg.ClientKeyPath != "" || cfg.CACertPath != "" {
+		tlsConfig, err := internal.LoadTLSConfig(cfg.ClientCertPath, cfg.ClientKeyPath, cfg.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load web3signer TLS configuration")
+		}
+		opts = append(opts, internal.WithTLSConfig(tlsConfig))
+	}
+	client, err := internal.NewApiClient(cfg.BaseEndpoint, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
@@ -70,26 +99,109 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
-	}, nil
+	}
+	if len(cfg.FailoverEndpoints) > 0 {
+		go client.MonitorHealth(ctx, endpointHealthCheckInterval)
+	}
+	if cfg.PublicKeysURL != "" && cfg.PublicKeysPollingInterval > 0 {
+		go km.pollPublicKeys(ctx, cfg.PublicKeysPollingInterval)
+	}
+	return km, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
 // or provides the existing keys in the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
-	if km.publicKeysURL != "" && !km.publicKeysUrlCalled {
-		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
-		if err != nil {
-			erroredResponsesTotal.Inc()
-			return nil, errors.Wrap(err, fmt.Sprintf("could not get public keys from remote server url: %v", km.publicKeysURL))
-		}
+	km.lock.RLock()
+	publicKeysUrlCalled := km.publicKeysUrlCalled
+	providedPublicKeys := km.providedPublicKeys
+	km.lock.RUnlock()
+	if km.publicKeysURL == "" || publicKeysUrlCalled {
+		return providedPublicKeys, nil
+	}
+	// The remote server is called without holding the lock, so that a slow server does not block signing.
+	fetchedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return nil, errors.Wrap(err, fmt.Sprintf("could not get public keys from remote server url: %v", km.publicKeysURL))
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	// The keys fetched concurrently by the poller are newer, they are kept.
+	if !km.publicKeysUrlCalled {
 		// makes sure that if the public keys are deleted the validator does not call URL again.
 		km.publicKeysUrlCalled = true
-		km.providedPublicKeys = providedPublicKeys
+		km.providedPublicKeys = fetchedPublicKeys
 	}
 	return km.providedPublicKeys, nil
 }
 
+// pollPublicKeys fetches the public keys from the remote server url on the given interval until
+// the context is canceled.
+func (km *Keymanager) pollPublicKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := km.refreshPublicKeys(ctx); err != nil {
+				erroredResponsesTotal.Inc()
+				log.WithError(err).Error("Could not poll public keys from web3signer")
+			}
+		}
+	}
+}
+
+// refreshPublicKeys fetches the public keys from the remote server url and, if they changed,
+// replaces the keys of the keymanager and sends them to the account change subscribers.
+func (km *Keymanager) refreshPublicKeys(ctx context.Context) error {
+	fetchedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not get public keys from remote server url: %v", km.publicKeysURL))
+	}
+	km.lock.Lock()
+	added, removed := diffPublicKeys(km.providedPublicKeys, fetchedPublicKeys)
+	km.publicKeysUrlCalled = true
+	km.providedPublicKeys = fetchedPublicKeys
+	km.lock.Unlock()
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	publicKeysAddedTotal.Add(float64(len(added)))
+	publicKeysRemovedTotal.Add(float64(len(removed)))
+	log.WithFields(log.Fields{
+		"added":   len(added),
+		"removed": len(removed),
+		"total":   len(fetchedPublicKeys),
+	}).Info("Public keys changed on web3signer")
+	km.accountsChangedFeed.Send(fetchedPublicKeys)
+	return nil
+}
+
+// diffPublicKeys returns the keys of next which are not in prev, and the keys of prev which are not in next.
+func diffPublicKeys(prev, next [][fieldparams.BLSPubkeyLength]byte) (added, removed [][fieldparams.BLSPubkeyLength]byte) {
+	prevSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(prev))
+	for _, key := range prev {
+		prevSet[key] = true
+	}
+	nextSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(next))
+	for _, key := range next {
+		nextSet[key] = true
+		if !prevSet[key] {
+			added = append(added, key)
+		}
+	}
+	for _, key := range prev {
+		if !nextSet[key] {
+			removed = append(removed, key)
+		}
+	}
+	return added, removed
+}
+
 // Sign signs the message by using a remote web3signer server.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
 	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
@@ -336,6 +448,7 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	importedRemoteKeysStatuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(pubKeys))
 	for i, pubKey := range pubKeys {
 		found := false
@@ -359,7 +472,10 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 		}
 		log.Debug("Added pubkey to keymanager for web3signer", "pubkey", hexutil.Encode(pubKey[:]))
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	publicKeys := km.providedPublicKeys
+	// The lock is released before notifying subscribers, which may fetch the public keys.
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(publicKeys)
 	return importedRemoteKeysStatuses, nil
 }
 
@@ -368,8 +484,10 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	deletedRemoteKeysStatuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(pubKeys))
 	if len(km.providedPublicKeys) == 0 {
+		km.lock.Unlock()
 		for i := range deletedRemoteKeysStatuses {
 			deletedRemoteKeysStatuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
 				Status:  ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND,
@@ -397,6 +515,8 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 			}
 		}
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	publicKeys := km.providedPublicKeys
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(publicKeys)
 	return deletedRemoteKeysStatuses, nil
 }
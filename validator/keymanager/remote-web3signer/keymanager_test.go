@@ -23,6 +23,7 @@ type MockClient struct {
 	Signature       string
 	PublicKeys      []string
 	isThrowingError bool
+	onGetPublicKeys func()
 }
 
 func (mc *MockClient) Sign(_ context.Context, _ string, _ internal.SignRequestJson) (bls.Signature, error) {
@@ -33,6 +34,9 @@ func (mc *MockClient) Sign(_ context.Context, _ string, _ internal.SignRequestJs
 	return bls.SignatureFromBytes(decoded)
 }
 func (mc *MockClient) GetPublicKeys(_ context.Context, _ string) ([][48]byte, error) {
+	if mc.onGetPublicKeys != nil {
+		mc.onGetPublicKeys()
+	}
 	var keys [][48]byte
 	for _, pk := range mc.PublicKeys {
 		decoded, err := hex.DecodeString(strings.TrimPrefix(pk, "0x"))
@@ -251,6 +255,37 @@ func TestKeymanager_FetchValidatingPublicKeys_HappyPath_WithExternalURL(t *testi
 	assert.EqualValues(t, resp, keys)
 }
 
+func TestKeymanager_FetchValidatingPublicKeys_WithExternalURL_Unlocked(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	config := &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	}
+	km, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	calls := 0
+	km.client = &MockClient{
+		PublicKeys: []string{"0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"},
+		onGetPublicKeys: func() {
+			calls++
+			// The keymanager is not locked while the remote server is called.
+			require.Equal(t, true, km.lock.TryLock())
+			km.lock.Unlock()
+		},
+	}
+	resp, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(resp))
+	// The remote server is only called once.
+	resp, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(resp))
+	assert.Equal(t, 1, calls)
+}
+
 func TestKeymanager_FetchValidatingPublicKeys_WithExternalURL_ThrowsError(t *testing.T) {
 	ctx := context.Background()
 	client := &MockClient{
@@ -345,3 +380,48 @@ func TestKeymanager_DeletePublicKeys(t *testing.T) {
 		require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, status.Status)
 	}
 }
+
+func TestKeymanager_RefreshPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	key1 := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	key2 := "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+	client := &MockClient{PublicKeys: []string{key1}}
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	km.client = client
+	_, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	pubKeysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+
+	// Unchanged keys are not sent to subscribers.
+	require.NoError(t, km.refreshPublicKeys(ctx))
+	require.Equal(t, 0, len(pubKeysChan))
+
+	// A key is added and another removed on web3signer.
+	client.PublicKeys = []string{key2}
+	require.NoError(t, km.refreshPublicKeys(ctx))
+	decodedKey2, err := hexutil.Decode(key2)
+	require.NoError(t, err)
+	wanted := [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(decodedKey2)}
+	assert.EqualValues(t, wanted, <-pubKeysChan)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, wanted, keys)
+}
+
+func Test_diffPublicKeys(t *testing.T) {
+	prev := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	next := [][fieldparams.BLSPubkeyLength]byte{{2}, {3}}
+	added, removed := diffPublicKeys(prev, next)
+	assert.EqualValues(t, [][fieldparams.BLSPubkeyLength]byte{{3}}, added)
+	assert.EqualValues(t, [][fieldparams.BLSPubkeyLength]byte{{1}}, removed)
+}
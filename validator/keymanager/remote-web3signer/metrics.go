@@ -74,4 +74,12 @@ var (
 		Name: "remote_web3signer_validator_registration_sign_requests_total",
 		Help: "Total number of validator registration sign requests",
 	})
+	publicKeysAddedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_web3signer_public_keys_added_total",
+		Help: "Total number of public keys added when polling the web3signer public keys url",
+	})
+	publicKeysRemovedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_web3signer_public_keys_removed_total",
+		Help: "Total number of public keys removed when polling the web3signer public keys url",
+	})
 )
@@ -39,6 +39,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/container/slice"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
+	"github.com/prysmaticlabs/prysm/v4/math"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/prometheus"
 	tracing2 "github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
@@ -53,6 +54,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/client"
 	"github.com/prysmaticlabs/prysm/v4/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/remote"
 	g "github.com/prysmaticlabs/prysm/v4/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
@@ -200,7 +202,7 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	dataDir := cliCtx.String(flags.WalletDirFlag.Name)
 	if !cliCtx.IsSet(flags.InteropNumValidators.Name) {
 		// Custom Check For Web3Signer
-		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) && !cliCtx.Bool(flags.Web3SignerWithLocalKeysFlag.Name) {
 			c.wallet = wallet.NewWalletForWeb3Signer()
 		} else {
 			w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
@@ -267,10 +269,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		return err
 	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
-		if err := c.registerRPCService(cliCtx); err != nil {
+		router := mux.NewRouter()
+		if err := c.registerRPCService(cliCtx, router); err != nil {
 			return err
 		}
-		if err := c.registerRPCGatewayService(cliCtx); err != nil {
+		if err := c.registerRPCGatewayService(cliCtx, router); err != nil {
 			return err
 		}
 	}
@@ -280,7 +283,7 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	var err error
 	dataDir := cliCtx.String(flags.WalletDirFlag.Name)
-	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) && !cliCtx.Bool(flags.Web3SignerWithLocalKeysFlag.Name) {
 		c.wallet = wallet.NewWalletForWeb3Signer()
 	} else {
 		// Read the wallet password file from the cli context.
@@ -342,10 +345,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
-	if err := c.registerRPCService(cliCtx); err != nil {
+	router := mux.NewRouter()
+	if err := c.registerRPCService(cliCtx, router); err != nil {
 		return err
 	}
-	if err := c.registerRPCGatewayService(cliCtx); err != nil {
+	if err := c.registerRPCGatewayService(cliCtx, router); err != nil {
 		return err
 	}
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
@@ -387,6 +391,10 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	maxCallRecvMsgSize := c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	grpcRetries := c.cliCtx.Uint(flags.GrpcRetriesFlag.Name)
 	grpcRetryDelay := c.cliCtx.Duration(flags.GrpcRetryDelayFlag.Name)
+	// Aggregated selection proofs are only available through the beacon REST API.
+	if c.cliCtx.Bool(flags.DistributedFlag.Name) && !features.Get().EnableBeaconRESTApi {
+		return errors.New("distributed validator mode requires the beacon REST API, enable it with --enable-beacon-rest-api")
+	}
 	var interopKeysConfig *local.InteropKeymanagerConfig
 	if c.cliCtx.IsSet(flags.InteropNumValidators.Name) {
 		interopKeysConfig = &local.InteropKeymanagerConfig{
@@ -415,6 +423,14 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	var slashingProtection iface.SlashingProtection
+	if c.cliCtx.IsSet(flags.SlashingProtectionURLFlag.Name) {
+		slashingProtection, err = remote.NewClient(c.cliCtx.String(flags.SlashingProtectionURLFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not create remote slashing protection client")
+		}
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -427,6 +443,7 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		GrpcRetryDelay:             grpcRetryDelay,
 		GrpcHeadersFlag:            c.cliCtx.String(flags.GrpcHeadersFlag.Name),
 		ValDB:                      c.db,
+		SlashingProtection:         slashingProtection,
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		InteropKeysConfig:          interopKeysConfig,
 		Wallet:                     c.wallet,
@@ -436,6 +453,8 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		ProposerSettings:           bpc,
 		BeaconApiTimeout:           time.Second * 30,
 		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		Distributed:                c.cliCtx.Bool(flags.DistributedFlag.Name),
+		AttestationDataMajority:    c.cliCtx.Bool(flags.AttestationDataMajorityFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -459,7 +478,20 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 			BaseEndpoint:          u.String(),
 			GenesisValidatorsRoot: nil,
 		}
-		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
+		for _, failoverURL := range cliCtx.StringSlice(flags.Web3SignerFailoverURLsFlag.Name) {
+			u, err := url.ParseRequestURI(failoverURL)
+			if err != nil {
+				return nil, errors.Wrapf(err, "web3signer failover url %s is invalid", failoverURL)
+			}
+			if u.Scheme == "" || u.Host == "" {
+				return nil, fmt.Errorf("web3signer failover url must be in the format of http(s)://host:port url used: %v", failoverURL)
+			}
+			web3signerConfig.FailoverEndpoints = append(web3signerConfig.FailoverEndpoints, u.String())
+		}
+		web3signerConfig.ClientCertPath = cliCtx.String(flags.Web3SignerTLSClientCertFlag.Name)
+		web3signerConfig.ClientKeyPath = cliCtx.String(flags.Web3SignerTLSClientKeyFlag.Name)
+		web3signerConfig.CACertPath = cliCtx.String(flags.Web3SignerTLSCACertFlag.Name)
+		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) && !cliCtx.Bool(flags.Web3SignerWithLocalKeysFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
 		}
 
@@ -469,6 +501,7 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 				pURL, err := url.ParseRequestURI(publicKeysSlice[0])
 				if err == nil && pURL.Scheme != "" && pURL.Host != "" {
 					web3signerConfig.PublicKeysURL = publicKeysSlice[0]
+					web3signerConfig.PublicKeysPollingInterval = cliCtx.Duration(flags.Web3SignerPublicKeysPollingIntervalFlag.Name)
 				} else {
 					pks = strings.Split(publicKeysSlice[0], ",")
 				}
@@ -551,11 +584,20 @@ func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*validatorServ
 	if err := warnNonChecksummedAddress(fileConfig.DefaultConfig.FeeRecipient); err != nil {
 		return nil, err
 	}
+	if err := g.ValidateTemplate(fileConfig.DefaultConfig.Graffiti); err != nil {
+		return nil, errors.Wrap(err, "default fileConfig graffiti is invalid")
+	}
+	if fileConfig.DefaultConfig.Builder != nil {
+		if _, err := math.ParseWei(fileConfig.DefaultConfig.Builder.MinBid); err != nil {
+			return nil, errors.Wrap(err, "default fileConfig builder min bid is invalid")
+		}
+	}
 	vpSettings.DefaultConfig = &validatorServiceConfig.ProposerOption{
 		FeeRecipientConfig: &validatorServiceConfig.FeeRecipientConfig{
 			FeeRecipient: common.HexToAddress(fileConfig.DefaultConfig.FeeRecipient),
 		},
 		BuilderConfig: validatorServiceConfig.ToBuilderConfig(fileConfig.DefaultConfig.Builder),
+		Graffiti:      fileConfig.DefaultConfig.Graffiti,
 	}
 
 	if builderConfigFromFlag != nil {
@@ -603,6 +645,7 @@ func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*validatorServ
 					FeeRecipient: common.HexToAddress(option.FeeRecipient),
 				},
 				BuilderConfig: currentBuilderConfig,
+				Graffiti:      option.Graffiti,
 			}
 			pubkeyB := bytesutil.ToBytes48(decodedKey)
 			vpSettings.ProposeConfig[pubkeyB] = o
@@ -633,6 +676,14 @@ func verifyOption(key string, option *validatorpb.ProposerOptionPayload) error {
 	if err := warnNonChecksummedAddress(option.FeeRecipient); err != nil {
 		return err
 	}
+	if err := g.ValidateTemplate(option.Graffiti); err != nil {
+		return errors.Wrapf(err, "graffiti of proposer %s is invalid", key)
+	}
+	if option.Builder != nil {
+		if _, err := math.ParseWei(option.Builder.MinBid); err != nil {
+			return errors.Wrapf(err, "builder min bid of proposer %s is invalid", key)
+		}
+	}
 	return nil
 }
 
@@ -722,7 +773,7 @@ func reviewGasLimit(gasLimit validator.Uint64) validator.Uint64 {
 	return gasLimit
 }
 
-func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, router *mux.Router) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
 		return err
@@ -762,11 +813,12 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 		ClientGrpcRetryDelay:     grpcRetryDelay,
 		ClientGrpcHeaders:        strings.Split(grpcHeaders, ","),
 		ClientWithCert:           clientCert,
+		Router:                   router,
 	})
 	return c.services.RegisterService(server)
 }
 
-func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context, router *mux.Router) error {
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
 	if gatewayHost != flags.DefaultGatewayHost {
 		log.WithField("web-host", gatewayHost).Warn(
@@ -841,7 +893,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		Mux:           gwmux,
 	}
 	opts := []gateway.Option{
-		gateway.WithRouter(mux.NewRouter()),
+		gateway.WithRouter(router),
 		gateway.WithRemoteAddr(rpcAddr),
 		gateway.WithGatewayAddr(gatewayAddress),
 		gateway.WithMaxCallRecvMsgSize(maxCallSize),
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -208,6 +209,44 @@ func TestWeb3SignerConfig(t *testing.T) {
 	}
 }
 
+func TestWeb3SignerConfig_FailoverAndPolling(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.Web3SignerURLFlag.Name, "http://localhost:9000", "")
+	require.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, "http://localhost:9000"))
+	for _, f := range []cli.Flag{
+		flags.Web3SignerPublicValidatorKeysFlag,
+		flags.Web3SignerFailoverURLsFlag,
+		flags.Web3SignerPublicKeysPollingIntervalFlag,
+		flags.Web3SignerTLSClientCertFlag,
+		flags.Web3SignerTLSClientKeyFlag,
+	} {
+		require.NoError(t, f.Apply(set))
+	}
+	require.NoError(t, set.Set(flags.Web3SignerPublicValidatorKeysFlag.Name, "http://localhost:9000/api/v1/eth2/publicKeys"))
+	require.NoError(t, set.Set(flags.Web3SignerFailoverURLsFlag.Name, "http://localhost:9001"))
+	require.NoError(t, set.Set(flags.Web3SignerFailoverURLsFlag.Name, "http://localhost:9002"))
+	require.NoError(t, set.Set(flags.Web3SignerPublicKeysPollingIntervalFlag.Name, "1m"))
+	require.NoError(t, set.Set(flags.Web3SignerTLSClientCertFlag.Name, "client.crt"))
+	require.NoError(t, set.Set(flags.Web3SignerTLSClientKeyFlag.Name, "client.key"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	got, err := Web3SignerConfig(cliCtx)
+	require.NoError(t, err)
+	require.DeepEqual(t, &remoteweb3signer.SetupConfig{
+		BaseEndpoint:              "http://localhost:9000",
+		PublicKeysURL:             "http://localhost:9000/api/v1/eth2/publicKeys",
+		PublicKeysPollingInterval: time.Minute,
+		FailoverEndpoints:         []string{"http://localhost:9001", "http://localhost:9002"},
+		ClientCertPath:            "client.crt",
+		ClientKeyPath:             "client.key",
+	}, got)
+
+	require.NoError(t, set.Set(flags.Web3SignerFailoverURLsFlag.Name, "localhost:9003"))
+	_, err = Web3SignerConfig(cliCtx)
+	require.ErrorContains(t, "web3signer failover url must be in the format of http(s)://host:port", err)
+}
+
 func TestProposerSettings(t *testing.T) {
 	hook := logtest.NewGlobal()
 
@@ -332,6 +371,65 @@ func TestProposerSettings(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "Happy Path Config file File with graffiti",
+			args: args{
+				proposerSettingsFlagValues: &proposerSettingsFlag{
+					dir:        "./testdata/graffiti-proposer-config.json",
+					url:        "",
+					defaultfee: "",
+				},
+			},
+			want: func() *validatorserviceconfig.ProposerSettings {
+				key1, err := hexutil.Decode("0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a")
+				require.NoError(t, err)
+				return &validatorserviceconfig.ProposerSettings{
+					ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+						bytesutil.ToBytes48(key1): {
+							FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{
+								FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
+							},
+							Graffiti: "{{.CLVersion}} #{{.ValidatorIndex}}",
+						},
+					},
+					DefaultConfig: &validatorserviceconfig.ProposerOption{
+						FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{
+							FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A"),
+						},
+						Graffiti: "prysm",
+					},
+				}
+			},
+			wantErr: "",
+		},
+		{
+			name: "Bad Graffiti template in Config file File",
+			args: args{
+				proposerSettingsFlagValues: &proposerSettingsFlag{
+					dir:        "./testdata/bad-graffiti-proposer-config.json",
+					url:        "",
+					defaultfee: "",
+				},
+			},
+			want: func() *validatorserviceconfig.ProposerSettings {
+				return nil
+			},
+			wantErr: "could not render graffiti template",
+		},
+		{
+			name: "Bad builder min bid in Config file File",
+			args: args{
+				proposerSettingsFlagValues: &proposerSettingsFlag{
+					dir:        "./testdata/bad-min-bid-proposer-config.json",
+					url:        "",
+					defaultfee: "",
+				},
+			},
+			want: func() *validatorserviceconfig.ProposerSettings {
+				return nil
+			},
+			wantErr: "builder min bid of proposer 0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a is invalid",
+		},
 		{
 			name: "Happy Path Config URL File",
 			args: args{
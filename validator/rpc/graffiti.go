@@ -0,0 +1,147 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	validatorServiceConfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/validator/graffiti"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type GraffitiResponse struct {
+	Pubkey   string `json:"pubkey"`
+	Graffiti string `json:"graffiti"`
+}
+
+type GetGraffitiResponse struct {
+	Data *GraffitiResponse `json:"data"`
+}
+
+type SetGraffitiRequest struct {
+	Graffiti string `json:"graffiti"`
+}
+
+// GetGraffiti returns the graffiti of the validator public key, or the default graffiti of the
+// validator client if none is set for the public key.
+func (s *Server) GetGraffiti(w http.ResponseWriter, r *http.Request) {
+	pubkey, ok := s.graffitiRequestPubkey(w, r)
+	if !ok {
+		return
+	}
+
+	g := ""
+	settings := s.validatorService.ProposerSettings()
+	if settings != nil && settings.ProposeConfig != nil {
+		if option, found := settings.ProposeConfig[pubkey]; found && option != nil {
+			g = option.Graffiti
+		}
+	}
+	if g == "" {
+		g = string(s.validatorService.Graffiti())
+	}
+	if g == "" && settings != nil && settings.DefaultConfig != nil {
+		g = settings.DefaultConfig.Graffiti
+	}
+
+	http2.WriteJson(w, &GetGraffitiResponse{
+		Data: &GraffitiResponse{
+			Pubkey:   hexutil.Encode(pubkey[:]),
+			Graffiti: g,
+		},
+	})
+}
+
+// SetGraffiti sets the graffiti of the validator public key. The graffiti may contain template
+// fields, which are rendered when proposing a block.
+func (s *Server) SetGraffiti(w http.ResponseWriter, r *http.Request) {
+	pubkey, ok := s.graffitiRequestPubkey(w, r)
+	if !ok {
+		return
+	}
+	var req SetGraffitiRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := graffiti.ValidateTemplate(req.Graffiti); err != nil {
+		http2.HandleError(w, "Invalid graffiti: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings == nil {
+		settings = &validatorServiceConfig.ProposerSettings{}
+	}
+	if settings.ProposeConfig == nil {
+		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption)
+	}
+	option, found := settings.ProposeConfig[pubkey]
+	if !found || option == nil {
+		option = &validatorServiceConfig.ProposerOption{}
+		if settings.DefaultConfig != nil && settings.DefaultConfig.BuilderConfig != nil {
+			option.BuilderConfig = settings.DefaultConfig.BuilderConfig.Clone()
+		}
+		settings.ProposeConfig[pubkey] = option
+	}
+	option.Graffiti = req.Graffiti
+
+	if err := s.validatorService.SetProposerSettings(r.Context(), settings); err != nil {
+		http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// DeleteGraffiti deletes the graffiti of the validator public key, so that the default graffiti
+// of the validator client is used instead.
+func (s *Server) DeleteGraffiti(w http.ResponseWriter, r *http.Request) {
+	pubkey, ok := s.graffitiRequestPubkey(w, r)
+	if !ok {
+		return
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings != nil && settings.ProposeConfig != nil {
+		if option, found := settings.ProposeConfig[pubkey]; found && option != nil {
+			option.Graffiti = ""
+			if err := s.validatorService.SetProposerSettings(r.Context(), settings); err != nil {
+				http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Authorizes the request and decodes the public key from its path, writing the error response
+// if either fails.
+func (s *Server) graffitiRequestPubkey(w http.ResponseWriter, r *http.Request) ([fieldparams.BLSPubkeyLength]byte, bool) {
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	if err := s.authorize(ctx); err != nil {
+		http2.HandleError(w, status.Convert(err).Message(), http.StatusUnauthorized)
+		return [fieldparams.BLSPubkeyLength]byte{}, false
+	}
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return [fieldparams.BLSPubkeyLength]byte{}, false
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	pubkey, err := hexutil.Decode(rawPubkey)
+	if err != nil {
+		http2.HandleError(w, "Could not decode public key: "+err.Error(), http.StatusBadRequest)
+		return [fieldparams.BLSPubkeyLength]byte{}, false
+	}
+	if len(pubkey) != fieldparams.BLSPubkeyLength {
+		http2.HandleError(w, fmt.Sprintf("Public key is not %d bytes long", fieldparams.BLSPubkeyLength), http.StatusBadRequest)
+		return [fieldparams.BLSPubkeyLength]byte{}, false
+	}
+	return bytesutil.ToBytes48(pubkey), true
+}
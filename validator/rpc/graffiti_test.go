@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v4/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v4/validator/client"
+)
+
+const graffitiTestPubkey = "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+
+func setupGraffitiServer(t *testing.T, settings *validatorserviceconfig.ProposerSettings, cliGraffiti string) (*Server, *mux.Router, string) {
+	ctx := context.Background()
+	m := &mock.MockValidator{}
+	require.NoError(t, m.SetProposerSettings(ctx, settings))
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator:    m,
+		GraffitiFlag: cliGraffiti,
+	})
+	require.NoError(t, err)
+	router := mux.NewRouter()
+	s := NewServer(ctx, &Config{
+		ValidatorService: vs,
+		Router:           router,
+	})
+	s.jwtSecret = []byte("secret")
+	token, err := createTokenString(s.jwtSecret)
+	require.NoError(t, err)
+	return s, router, token
+}
+
+func graffitiRequest(t *testing.T, router *mux.Router, token, method, pubkey string, body interface{}) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	req := httptest.NewRequest(method, "/eth/v1/validator/"+pubkey+"/graffiti", &buf)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestServer_Graffiti(t *testing.T) {
+	settings := &validatorserviceconfig.ProposerSettings{
+		DefaultConfig: &validatorserviceconfig.ProposerOption{
+			BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true},
+			Graffiti:      "default",
+		},
+	}
+	s, router, token := setupGraffitiServer(t, settings, "")
+
+	w := graffitiRequest(t, router, token, http.MethodGet, graffitiTestPubkey, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &GetGraffitiResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, graffitiTestPubkey, resp.Data.Pubkey)
+	assert.Equal(t, "default", resp.Data.Graffiti)
+
+	w = graffitiRequest(t, router, token, http.MethodPost, graffitiTestPubkey, &SetGraffitiRequest{Graffiti: "{{.CLVersion}} #{{.ValidatorIndex}}"})
+	require.Equal(t, http.StatusAccepted, w.Code)
+	w = graffitiRequest(t, router, token, http.MethodGet, graffitiTestPubkey, nil)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "{{.CLVersion}} #{{.ValidatorIndex}}", resp.Data.Graffiti)
+	pubkey, err := hexutil.Decode(graffitiTestPubkey)
+	require.NoError(t, err)
+	option := s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(pubkey)]
+	require.NotNil(t, option)
+	// The builder settings of a new public key are copied from the default settings.
+	assert.DeepEqual(t, settings.DefaultConfig.BuilderConfig, option.BuilderConfig)
+
+	w = graffitiRequest(t, router, token, http.MethodDelete, graffitiTestPubkey, nil)
+	require.Equal(t, http.StatusNoContent, w.Code)
+	w = graffitiRequest(t, router, token, http.MethodGet, graffitiTestPubkey, nil)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "default", resp.Data.Graffiti)
+}
+
+func TestServer_Graffiti_CLIDefault(t *testing.T) {
+	_, router, token := setupGraffitiServer(t, nil, "cli")
+
+	w := graffitiRequest(t, router, token, http.MethodGet, graffitiTestPubkey, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &GetGraffitiResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "cli", resp.Data.Graffiti)
+}
+
+func TestServer_Graffiti_Errors(t *testing.T) {
+	_, router, token := setupGraffitiServer(t, nil, "")
+
+	w := graffitiRequest(t, router, "", http.MethodGet, graffitiTestPubkey, nil)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.StringContains(t, "Invalid auth header", w.Body.String())
+
+	w = graffitiRequest(t, router, token, http.MethodGet, "0x1234", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.StringContains(t, "Public key is not 48 bytes long", w.Body.String())
+
+	w = graffitiRequest(t, router, token, http.MethodPost, graffitiTestPubkey, &SetGraffitiRequest{Graffiti: "{{.Unknown}}"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.StringContains(t, "could not render graffiti template", w.Body.String())
+
+	w = graffitiRequest(t, router, token, http.MethodPost, graffitiTestPubkey, &SetGraffitiRequest{Graffiti: "this graffiti is longer than 32 bytes"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.StringContains(t, "graffiti is longer than 32 bytes", w.Body.String())
+}
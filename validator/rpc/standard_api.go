@@ -18,6 +18,7 @@ import (
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/validator/client"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/composite"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/derived"
 	slashingprotection "github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
@@ -45,7 +46,12 @@ func (s *Server) ListKeystores(
 	if s.wallet.KeymanagerKind() != keymanager.Derived && s.wallet.KeymanagerKind() != keymanager.Local {
 		return nil, status.Errorf(codes.FailedPrecondition, "Prysm validator keys are not stored locally with this keymanager type.")
 	}
-	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	fetchPubKeys := km.FetchValidatingPublicKeys
+	if compositeKm, ok := km.(*composite.Keymanager); ok {
+		// Keys signed with web3signer are listed by ListRemoteKeys.
+		fetchPubKeys = compositeKm.LocalPublicKeys
+	}
+	pubKeys, err := fetchPubKeys(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve keystores: %v", err)
 	}
@@ -277,10 +283,15 @@ func (s *Server) ListRemoteKeys(ctx context.Context, _ *empty.Empty) (*ethpbserv
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get Prysm keymanager (possibly due to beacon node unavailable): %v", err)
 	}
-	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+	if !s.usesWeb3Signer(km) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.")
 	}
-	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	fetchPubKeys := km.FetchValidatingPublicKeys
+	if compositeKm, ok := km.(*composite.Keymanager); ok {
+		// Keys stored in the wallet are listed by ListKeystores.
+		fetchPubKeys = compositeKm.RemotePublicKeys
+	}
+	pubKeys, err := fetchPubKeys(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve keystores: %v", err)
 	}
@@ -309,7 +320,7 @@ func (s *Server) ImportRemoteKeys(ctx context.Context, req *ethpbservice.ImportR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Could not get Prysm keymanager (possibly due to beacon node unavailable): %v", err))
 	}
-	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+	if !s.usesWeb3Signer(km) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.")
 	}
 	adder, ok := km.(keymanager.PublicKeyAdder)
@@ -340,6 +351,15 @@ func (s *Server) ImportRemoteKeys(ctx context.Context, req *ethpbservice.ImportR
 	}, nil
 }
 
+// Whether the keymanager signs with web3signer, either as the keymanager of a web3signer wallet or
+// alongside the keystores of a local or derived wallet.
+func (s *Server) usesWeb3Signer(km keymanager.IKeymanager) bool {
+	if _, ok := km.(*composite.Keymanager); ok {
+		return true
+	}
+	return s.wallet.KeymanagerKind() == keymanager.Web3Signer
+}
+
 func groupImportRemoteKeysErrors(req *ethpbservice.ImportRemoteKeysRequest, errorMessage string) []*ethpbservice.ImportedRemoteKeysStatus {
 	statuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(req.RemoteKeys))
 	for i := 0; i < len(req.RemoteKeys); i++ {
@@ -363,7 +383,7 @@ func (s *Server) DeleteRemoteKeys(ctx context.Context, req *ethpbservice.DeleteR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get Prysm keymanager (possibly due to beacon node unavailable): %v", err)
 	}
-	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+	if !s.usesWeb3Signer(km) {
 		return nil, status.Errorf(codes.FailedPrecondition, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.")
 	}
 	deleter, ok := km.(keymanager.PublicKeyDeleter)
@@ -702,6 +702,91 @@ func TestServer_DeleteRemoteKeys(t *testing.T) {
 	})
 }
 
+func TestServer_RemoteKeys_LocalWalletWithWeb3Signer(t *testing.T) {
+	ctx := context.Background()
+	localWalletDir := setupWalletDir(t)
+	defaultWalletPath = localWalletDir
+	opts := []accounts.Option{
+		accounts.WithWalletDir(defaultWalletPath),
+		accounts.WithKeymanagerType(keymanager.Local),
+		accounts.WithWalletPassword(strongPass),
+		accounts.WithSkipMnemonicConfirm(true),
+	}
+	acc, err := accounts.NewCLIManager(opts...)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(ctx)
+	require.NoError(t, err)
+	root := make([]byte, fieldparams.RootLength)
+	root[0] = 1
+	remoteKey, err := hexutil.Decode("0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a")
+	require.NoError(t, err)
+	config := &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(remoteKey)},
+	}
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false, Web3SignerConfig: config})
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet: w,
+		Validator: &mock.MockValidator{
+			Km: km,
+		},
+		Web3SignerConfig: config,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}
+	password := "12345678"
+	localKeystore := createRandomKeystore(t, password)
+	localKey, err := hexutil.Decode("0x" + localKeystore.Pubkey)
+	require.NoError(t, err)
+	enc, err := json.Marshal(localKeystore)
+	require.NoError(t, err)
+
+	importResp, err := s.ImportKeystores(ctx, &ethpbservice.ImportKeystoresRequest{
+		Keystores: []string{string(enc)},
+		Passwords: []string{password},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(importResp.Data))
+	require.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, importResp.Data[0].Status)
+
+	listResp, err := s.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Data))
+	require.DeepEqual(t, localKey, listResp.Data[0].ValidatingPubkey)
+
+	remoteResp, err := s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(remoteResp.Data))
+	require.DeepEqual(t, remoteKey, remoteResp.Data[0].Pubkey)
+	require.Equal(t, config.BaseEndpoint, remoteResp.Data[0].Url)
+
+	// The local key can be added to web3signer while its keystore is still in the wallet.
+	importRemoteResp, err := s.ImportRemoteKeys(ctx, &ethpbservice.ImportRemoteKeysRequest{
+		RemoteKeys: []*ethpbservice.ImportRemoteKeysRequest_Keystore{{Pubkey: localKey}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, importRemoteResp.Data[0].Status)
+	remoteResp, err = s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(remoteResp.Data))
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubKeys))
+
+	deleteRemoteResp, err := s.DeleteRemoteKeys(ctx, &ethpbservice.DeleteRemoteKeysRequest{Pubkeys: [][]byte{remoteKey}})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, deleteRemoteResp.Data[0].Status)
+	pubKeys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(localKey)}, pubKeys)
+}
+
 func TestServer_ListFeeRecipientByPubkey(t *testing.T) {
 	ctx := context.Background()
 	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
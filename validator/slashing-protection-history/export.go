@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/progress"
 	"github.com/prysmaticlabs/prysm/v4/validator/db"
@@ -24,18 +26,9 @@ func ExportStandardProtectionJSON(
 	filteredKeys ...[]byte,
 ) (*format.EIPSlashingProtectionFormat, error) {
 	interchangeJSON := &format.EIPSlashingProtectionFormat{}
-	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	genesisRootHex, err := genesisValidatorsRootHex(ctx, validatorDB)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get genesis validators root from DB")
-	}
-	if genesisValidatorsRoot == nil || !bytesutil.IsValidRoot(genesisValidatorsRoot) {
-		return nil, errors.New(
-			"genesis validators root is empty, perhaps you are not connected to your beacon node",
-		)
-	}
-	genesisRootHex, err := rootToHexString(genesisValidatorsRoot)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not convert genesis validators root to hex string")
+		return nil, err
 	}
 	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRootHex
 	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
@@ -131,6 +124,200 @@ func ExportStandardProtectionJSON(
 	return interchangeJSON, nil
 }
 
+// StreamStandardProtectionJSON writes all slashing protection data from a validator database to
+// the writer as an EIP-3076 compliant JSON, one public key at a time, so that the history of
+// a large number of public keys is never held in memory at once. The number of public keys
+// written is returned.
+func StreamStandardProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	w io.Writer,
+	filteredKeys ...[]byte,
+) (int, error) {
+	return streamProtectionJSON(ctx, validatorDB, w, standardProtectionData, filteredKeys)
+}
+
+// StreamMinimalProtectionJSON writes the slashing protection data from a validator database to
+// the writer in the minimal format of EIP-3076, one public key at a time. For each public key,
+// only the highest signed proposal slot, and an attestation with the highest signed source and
+// target epochs are written, which is enough for an importer to refuse any slashable message.
+// The number of public keys written is returned.
+func StreamMinimalProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	w io.Writer,
+	filteredKeys ...[]byte,
+) (int, error) {
+	return streamProtectionJSON(ctx, validatorDB, w, minimalProtectionData, filteredKeys)
+}
+
+func streamProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	w io.Writer,
+	protectionData func(context.Context, db.Database, [fieldparams.BLSPubkeyLength]byte) (*format.ProtectionData, error),
+	filteredKeys [][]byte,
+) (int, error) {
+	genesisRootHex, err := genesisValidatorsRootHex(ctx, validatorDB)
+	if err != nil {
+		return 0, err
+	}
+
+	// Allow for filtering data for the keys we wish to export.
+	filteredKeysMap := make(map[string]bool, len(filteredKeys))
+	for _, k := range filteredKeys {
+		filteredKeysMap[string(k)] = true
+	}
+	proposedPublicKeys, err := validatorDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve proposer public keys from DB")
+	}
+	attestedPublicKeys, err := validatorDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve attested public keys from DB")
+	}
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(proposedPublicKeys)+len(attestedPublicKeys))
+	for _, pubKey := range append(proposedPublicKeys, attestedPublicKeys...) {
+		if _, ok := filteredKeysMap[string(pubKey[:])]; len(filteredKeys) > 0 && !ok {
+			continue
+		}
+		if seen[pubKey] {
+			continue
+		}
+		seen[pubKey] = true
+		pubKeys = append(pubKeys, pubKey)
+	}
+	// The data is sorted by public key, as in the standard export.
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+	})
+
+	writer, err := newProtectionJSONWriter(w, genesisRootHex)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not write slashing protection JSON metadata")
+	}
+	bar := progress.InitializeProgressBar(
+		len(pubKeys), "Exporting slashing protection history by validator public key",
+	)
+	for _, pubKey := range pubKeys {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		data, err := protectionData(ctx, validatorDB, pubKey)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not retrieve slashing protection data for public key %#x", pubKey)
+		}
+		if err := writer.write(data); err != nil {
+			return 0, errors.Wrap(err, "could not write slashing protection data")
+		}
+		if err := bar.Add(1); err != nil {
+			return 0, err
+		}
+	}
+	if err := writer.close(); err != nil {
+		return 0, errors.Wrap(err, "could not write slashing protection JSON")
+	}
+	return len(pubKeys), nil
+}
+
+func standardProtectionData(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte,
+) (*format.ProtectionData, error) {
+	pubKeyHex, err := pubKeyToHexString(pubKey[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert public key to hex string")
+	}
+	signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve signed blocks")
+	}
+	signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve signed attestations")
+	}
+	if signedBlocks == nil {
+		signedBlocks = make([]*format.SignedBlock, 0)
+	}
+	if signedAttestations == nil {
+		signedAttestations = make([]*format.SignedAttestation, 0)
+	}
+	return &format.ProtectionData{
+		Pubkey:             pubKeyHex,
+		SignedBlocks:       signedBlocks,
+		SignedAttestations: signedAttestations,
+	}, nil
+}
+
+func minimalProtectionData(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte,
+) (*format.ProtectionData, error) {
+	pubKeyHex, err := pubKeyToHexString(pubKey[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert public key to hex string")
+	}
+	data := &format.ProtectionData{
+		Pubkey:             pubKeyHex,
+		SignedBlocks:       make([]*format.SignedBlock, 0),
+		SignedAttestations: make([]*format.SignedAttestation, 0),
+	}
+	highestSlot, exists, err := validatorDB.HighestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve highest signed proposal")
+	}
+	if exists {
+		data.SignedBlocks = append(data.SignedBlocks, &format.SignedBlock{
+			Slot: fmt.Sprintf("%d", highestSlot),
+		})
+	}
+	signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve signed attestations")
+	}
+	if len(signedAttestations) == 0 {
+		return data, nil
+	}
+	var highestSource, highestTarget primitives.Epoch
+	for _, att := range signedAttestations {
+		source, err := EpochFromString(att.SourceEpoch)
+		if err != nil {
+			return nil, err
+		}
+		target, err := EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return nil, err
+		}
+		if source > highestSource {
+			highestSource = source
+		}
+		if target > highestTarget {
+			highestTarget = target
+		}
+	}
+	data.SignedAttestations = append(data.SignedAttestations, &format.SignedAttestation{
+		SourceEpoch: fmt.Sprintf("%d", highestSource),
+		TargetEpoch: fmt.Sprintf("%d", highestTarget),
+	})
+	return data, nil
+}
+
+func genesisValidatorsRootHex(ctx context.Context, validatorDB db.Database) (string, error) {
+	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "could not get genesis validators root from DB")
+	}
+	if genesisValidatorsRoot == nil || !bytesutil.IsValidRoot(genesisValidatorsRoot) {
+		return "", errors.New(
+			"genesis validators root is empty, perhaps you are not connected to your beacon node",
+		)
+	}
+	genesisRootHex, err := rootToHexString(genesisValidatorsRoot)
+	if err != nil {
+		return "", errors.Wrap(err, "could not convert genesis validators root to hex string")
+	}
+	return genesisRootHex, nil
+}
+
 func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
@@ -1,7 +1,9 @@
 package history
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	dbtest "github.com/prysmaticlabs/prysm/v4/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
+	valtest "github.com/prysmaticlabs/prysm/v4/validator/testing"
 )
 
 func TestExportStandardProtectionJSON_EmptyGenesisRoot(t *testing.T) {
@@ -28,6 +31,50 @@ func TestExportStandardProtectionJSON_EmptyGenesisRoot(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestStreamMinimalProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+
+	t.Run("export", func(t *testing.T) {
+		validatorDB := dbtest.SetupDB(t, publicKeys)
+		require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, bytes.Repeat([]byte{1}, 32)))
+		for slot := primitives.Slot(3); slot <= 5; slot++ {
+			require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, publicKeys[0], slot, []byte{1}))
+		}
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, publicKeys[0], [32]byte{1}, createAttestation(1, 2)))
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, publicKeys[0], [32]byte{2}, createAttestation(2, 3)))
+
+		numKeys, err := StreamMinimalProtectionJSON(ctx, validatorDB, buf)
+		require.NoError(t, err)
+		assert.Equal(t, 1, numKeys)
+		minimal := &format.EIPSlashingProtectionFormat{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), minimal))
+		require.Equal(t, 1, len(minimal.Data))
+		assert.DeepEqual(t, []*format.SignedBlock{{Slot: "5"}}, minimal.Data[0].SignedBlocks)
+		assert.DeepEqual(t, []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "3"}}, minimal.Data[0].SignedAttestations)
+	})
+
+	// Importing the minimal file protects against any message at or below the exported history.
+	t.Run("import", func(t *testing.T) {
+		validatorDB := dbtest.SetupDB(t, publicKeys)
+		require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, buf))
+		source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, publicKeys[0])
+		require.NoError(t, err)
+		assert.Equal(t, true, exists)
+		assert.Equal(t, primitives.Epoch(2), source)
+		target, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, publicKeys[0])
+		require.NoError(t, err)
+		assert.Equal(t, true, exists)
+		assert.Equal(t, primitives.Epoch(3), target)
+		highest, exists, err := validatorDB.HighestSignedProposal(ctx, publicKeys[0])
+		require.NoError(t, err)
+		assert.Equal(t, true, exists)
+		assert.Equal(t, primitives.Slot(5), highest)
+	})
+}
+
 func Test_getSignedAttestationsByPubKey(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
 		pubKeys := [][fieldparams.BLSPubkeyLength]byte{
@@ -3,7 +3,6 @@ package history
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -18,36 +17,75 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
 )
 
+// importBatchSize bounds the number of protection data entries held in memory during an import.
+// Entries are validated and saved to the database one batch at a time.
+const importBatchSize = 256
+
 // ImportStandardProtectionJSON takes in EIP-3076 compliant JSON file used for slashing protection
 // by Ethereum validators and imports its data into Prysm's internal representation of slashing
 // protection in the validator client's database. For more information, see the EIP document here:
 // https://eips.ethereum.org/EIPS/eip-3076.
+//
+// The file is decoded and saved in batches of entries, so that files with a large number of public
+// keys are never held in memory at once. Each batch is only saved once all of its entries are
+// parsed, but the batches preceding a malformed entry remain imported.
 func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
-	encodedJSON, err := io.ReadAll(r)
-	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
+	imp := &protectionImporter{
+		validatorDB:          validatorDB,
+		savedProposerPubKeys: make(map[[fieldparams.BLSPubkeyLength]byte]bool),
 	}
-	interchangeJSON := &format.EIPSlashingProtectionFormat{}
-	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
+	batch := make([]*format.ProtectionData, 0, importBatchSize)
+	hasValidMetadata := false
+	var importErr error
+	_, hasData, err := decodeProtectionJSON(r, func(interchangeJSON *format.EIPSlashingProtectionFormat, validatorData *format.ProtectionData) error {
+		if !hasValidMetadata {
+			// We validate the `MetadataV0` field of the slashing protection JSON file.
+			if err := validateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
+				importErr = errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+				return importErr
+			}
+			hasValidMetadata = true
+		}
+		batch = append(batch, validatorData)
+		if len(batch) < importBatchSize {
+			return nil
+		}
+		importErr = imp.importBatch(ctx, batch)
+		batch = batch[:0]
+		return importErr
+	})
+	if importErr != nil {
+		return importErr
+	}
+	if err != nil {
 		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
 	}
-	if interchangeJSON.Data == nil {
+	if !hasData {
 		log.Warn("No slashing protection data to import")
 		return nil
 	}
+	return imp.importBatch(ctx, batch)
+}
 
-	// We validate the `MetadataV0` field of the slashing protection JSON file.
-	if err := validateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
-		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
-	}
+// protectionImporter saves the entries of a slashing protection JSON file one batch at a time. A public
+// key may have entries in several batches, so its blocks are also checked against the proposals saved
+// from the previous batches. Attestations are always checked against the database.
+type protectionImporter struct {
+	validatorDB          db.Database
+	savedProposerPubKeys map[[fieldparams.BLSPubkeyLength]byte]bool
+}
 
-	// We need to handle duplicate public keys in the JSON file, with potentially
-	// different signing histories for both attestations and blocks.
-	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(interchangeJSON.Data)
+func (p *protectionImporter) importBatch(ctx context.Context, batch []*format.ProtectionData) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	// We handle duplicate public keys in the batch, with potentially different signing
+	// histories for both attestations and blocks.
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(batch)
 	if err != nil {
 		return errors.Wrap(err, "could not parse unique entries for blocks by public key")
 	}
-	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(interchangeJSON.Data)
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(batch)
 	if err != nil {
 		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
 	}
@@ -77,13 +115,20 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	// We validate and filter out public keys parsed from JSON to ensure we are
 	// not importing those which are slashable with respect to other data within the same JSON.
 	slashableProposerKeys := filterSlashablePubKeysFromBlocks(ctx, proposalHistoryByPubKey)
+	savedSlashableProposerKeys, err := filterSlashablePubKeysFromSavedBlocks(
+		ctx, p.validatorDB, proposalHistoryByPubKey, p.savedProposerPubKeys,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter slashable proposer public keys from JSON data")
+	}
 	slashableAttesterKeys, err := filterSlashablePubKeysFromAttestations(
-		ctx, validatorDB, attestingHistoryByPubKey,
+		ctx, p.validatorDB, attestingHistoryByPubKey,
 	)
 	if err != nil {
 		return errors.Wrap(err, "could not filter slashable attester public keys from JSON data")
 	}
 
+	slashableProposerKeys = append(slashableProposerKeys, savedSlashableProposerKeys...)
 	slashablePublicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(slashableAttesterKeys)+len(slashableProposerKeys))
 	for _, pubKey := range slashableProposerKeys {
 		delete(proposalHistoryByPubKey, pubKey)
@@ -94,12 +139,11 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		slashablePublicKeys = append(slashablePublicKeys, pubKey)
 	}
 
-	if err := validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, slashablePublicKeys); err != nil {
+	if err := p.validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, slashablePublicKeys); err != nil {
 		return errors.Wrap(err, "could not save slashable public keys to database")
 	}
 
-	// We save the histories to disk as atomic operations, ensuring that this only occurs
-	// until after we successfully parse all data from the JSON file. If there is any error
+	// We save the histories to disk once all data of the batch is parsed. If there is any error
 	// in parsing the JSON proposal and attesting histories, we will not reach this point.
 	for pubKey, proposalHistory := range proposalHistoryByPubKey {
 		bar := initializeProgressBar(
@@ -110,10 +154,11 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 			if err := bar.Add(1); err != nil {
 				log.WithError(err).Debug("Could not increase progress bar")
 			}
-			if err = validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, proposal.Slot, proposal.SigningRoot); err != nil {
+			if err = p.validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, proposal.Slot, proposal.SigningRoot); err != nil {
 				return errors.Wrap(err, "could not save proposal history from imported JSON to database")
 			}
 		}
+		p.savedProposerPubKeys[pubKey] = true
 	}
 	bar := initializeProgressBar(
 		len(attestingHistoryByPubKey),
@@ -130,7 +175,7 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 			indexedAtts[i] = indexedAtt
 			signingRoots[i] = att.SigningRoot
 		}
-		if err := validatorDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, indexedAtts); err != nil {
+		if err := p.validatorDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, indexedAtts); err != nil {
 			return errors.Wrap(err, "could not save attestations from imported JSON to database")
 		}
 	}
@@ -260,6 +305,33 @@ func filterSlashablePubKeysFromBlocks(_ context.Context, historyByPubKey map[[fi
 	return slashablePubKeys
 }
 
+// Public keys with entries in several batches of the JSON file are slashable if one of their
+// blocks is at the same slot as a proposal saved from a previous batch, with a different signing root.
+func filterSlashablePubKeysFromSavedBlocks(
+	ctx context.Context,
+	validatorDB db.Database,
+	historyByPubKey map[[fieldparams.BLSPubkeyLength]byte]kv.ProposalHistoryForPubkey,
+	savedPubKeys map[[fieldparams.BLSPubkeyLength]byte]bool,
+) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	slashablePubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for pubKey, proposals := range historyByPubKey {
+		if !savedPubKeys[pubKey] {
+			continue
+		}
+		for _, blk := range proposals.Proposals {
+			signingRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, blk.Slot)
+			if err != nil {
+				return nil, err
+			}
+			if exists && !bytes.Equal(signingRoot[:], blk.SigningRoot) {
+				slashablePubKeys = append(slashablePubKeys, pubKey)
+				break
+			}
+		}
+	}
+	return slashablePubKeys, nil
+}
+
 func filterSlashablePubKeysFromAttestations(
 	ctx context.Context,
 	validatorDB db.Database,
@@ -168,6 +168,63 @@ func TestStore_ImportInterchangeData_OK(t *testing.T) {
 	}
 }
 
+func TestStore_ImportInterchangeData_Batches(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(importBatchSize)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	for _, pubKey := range publicKeys {
+		interchangeJSON.Data = append(interchangeJSON.Data, &format.ProtectionData{
+			Pubkey:       fmt.Sprintf("%#x", pubKey),
+			SignedBlocks: []*format.SignedBlock{{Slot: "1", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})}},
+		})
+	}
+	// The next batch contains a double proposal of the first public key, with respect to the proposal
+	// saved from the first batch, and a proposal already saved for the second public key.
+	interchangeJSON.Data = append(interchangeJSON.Data,
+		&format.ProtectionData{
+			Pubkey:       fmt.Sprintf("%#x", publicKeys[0]),
+			SignedBlocks: []*format.SignedBlock{{Slot: "1", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})}},
+		},
+		&format.ProtectionData{
+			Pubkey:       fmt.Sprintf("%#x", publicKeys[1]),
+			SignedBlocks: []*format.SignedBlock{{Slot: "1", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})}, {Slot: "2"}},
+		},
+	)
+	blob, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{publicKeys[0]}, blacklisted)
+	signingRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, publicKeys[0], 1)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, [32]byte{1}, signingRoot)
+	_, exists, err = validatorDB.ProposalHistoryForSlot(ctx, publicKeys[1], 2)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+}
+
+func TestStore_ImportInterchangeData_MetadataAfterData(t *testing.T) {
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, nil)
+
+	blob := fmt.Sprintf(
+		`{"data": [{"pubkey": "%#x", "signed_blocks": [], "signed_attestations": []}], "metadata": {"interchange_format_version": "%s", "genesis_validators_root": "%#x"}}`,
+		[fieldparams.BLSPubkeyLength]byte{1},
+		format.InterchangeFormatVersion,
+		[32]byte{1},
+	)
+	err := ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBufferString(blob))
+	require.ErrorContains(t, "metadata must precede data", err)
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))
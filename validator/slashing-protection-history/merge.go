@@ -0,0 +1,280 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
+)
+
+// MergeConflict describes a public key whose signing history is slashable once the
+// histories of all the merged files are combined.
+type MergeConflict struct {
+	Pubkey string
+	Reason string
+}
+
+type mergedBlock struct {
+	slot        primitives.Slot
+	signingRoot string
+}
+
+type mergedAttestation struct {
+	source      primitives.Epoch
+	target      primitives.Epoch
+	signingRoot string
+}
+
+// mergeBatchSize bounds the number of protection data entries held in memory while merging.
+// Entries are written to a temporary database one batch at a time.
+const mergeBatchSize = 256
+
+var (
+	mergedPubKeysBucket      = []byte("pubkeys")
+	mergedBlocksBucket       = []byte("blocks")
+	mergedAttestationsBucket = []byte("attestations")
+)
+
+// The signed blocks and attestations of a protection data entry, with its public key and signing
+// roots normalized so that equal entries from different files are deduplicated.
+type mergedEntry struct {
+	pubKey       [fieldparams.BLSPubkeyLength]byte
+	blocks       []mergedBlock
+	attestations []mergedAttestation
+}
+
+// MergeProtectionJSON combines several EIP-3076 slashing protection JSON files, for example
+// exported from different machines which ran the same keys, into a single file written to w.
+// All files must share the same interchange format version and genesis validators root.
+// Identical entries are deduplicated. Public keys whose combined history contains a double
+// proposal, a double vote or a surround vote are reported as conflicts, but are still written
+// to the merged file so that no signing history is lost. The histories are combined in a
+// temporary database, so that only the history of a single public key is held in memory.
+func MergeProtectionJSON(ctx context.Context, readers []io.Reader, w io.Writer) ([]*MergeConflict, error) {
+	if len(readers) == 0 {
+		return nil, errors.New("no slashing protection JSON files to merge")
+	}
+	store, err := newMergeStore()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create merge database")
+	}
+	defer func() {
+		if err := store.close(); err != nil {
+			log.WithError(err).Error("Could not remove merge database")
+		}
+	}()
+	var genesisRootHex string
+	for i, r := range readers {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		var storeErr error
+		interchangeJSON, _, err := decodeProtectionJSON(r, func(_ *format.EIPSlashingProtectionFormat, validatorData *format.ProtectionData) error {
+			entry, err := mergeProtectionData(validatorData)
+			if err != nil {
+				return err
+			}
+			storeErr = store.add(entry)
+			return storeErr
+		})
+		if storeErr != nil {
+			return nil, errors.Wrap(storeErr, "could not write to merge database")
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal slashing protection JSON file %d", i)
+		}
+		version := interchangeJSON.Metadata.InterchangeFormatVersion
+		if version != format.InterchangeFormatVersion {
+			return nil, fmt.Errorf(
+				"slashing protection JSON file %d version '%s' is not supported, wanted '%s'",
+				i,
+				version,
+				format.InterchangeFormatVersion,
+			)
+		}
+		gvr, err := RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+		}
+		gvrHex, err := rootToHexString(gvr[:])
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			genesisRootHex = gvrHex
+		} else if gvrHex != genesisRootHex {
+			return nil, fmt.Errorf(
+				"slashing protection JSON file %d has genesis validators root %s, wanted %s",
+				i,
+				gvrHex,
+				genesisRootHex,
+			)
+		}
+	}
+	if err := store.flush(); err != nil {
+		return nil, errors.Wrap(err, "could not write to merge database")
+	}
+
+	writer, err := newProtectionJSONWriter(w, genesisRootHex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not write slashing protection JSON metadata")
+	}
+	conflicts := make([]*MergeConflict, 0)
+	err = store.forEach(func(entry *mergedEntry) error {
+		pubKeyHex, err := pubKeyToHexString(entry.pubKey[:])
+		if err != nil {
+			return err
+		}
+		blocks, atts := entry.blocks, entry.attestations
+		if reason := blocksConflict(blocks); reason != "" {
+			conflicts = append(conflicts, &MergeConflict{Pubkey: pubKeyHex, Reason: reason})
+		} else if reason := attestationsConflict(atts); reason != "" {
+			conflicts = append(conflicts, &MergeConflict{Pubkey: pubKeyHex, Reason: reason})
+		}
+		data := &format.ProtectionData{
+			Pubkey:             pubKeyHex,
+			SignedBlocks:       make([]*format.SignedBlock, len(blocks)),
+			SignedAttestations: make([]*format.SignedAttestation, len(atts)),
+		}
+		for i, blk := range blocks {
+			data.SignedBlocks[i] = &format.SignedBlock{
+				Slot:        fmt.Sprintf("%d", blk.slot),
+				SigningRoot: blk.signingRoot,
+			}
+		}
+		for i, att := range atts {
+			data.SignedAttestations[i] = &format.SignedAttestation{
+				SourceEpoch: fmt.Sprintf("%d", att.source),
+				TargetEpoch: fmt.Sprintf("%d", att.target),
+				SigningRoot: att.signingRoot,
+			}
+		}
+		if err := writer.write(data); err != nil {
+			return errors.Wrap(err, "could not write slashing protection data")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.close(); err != nil {
+		return nil, errors.Wrap(err, "could not write slashing protection JSON")
+	}
+	return conflicts, nil
+}
+
+// Parses the signed blocks and attestations of an entry. Public keys and signing roots are
+// normalized so that equal entries from different files, which may differ in hex casing,
+// are deduplicated.
+func mergeProtectionData(validatorData *format.ProtectionData) (*mergedEntry, error) {
+	pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+	}
+	entry := &mergedEntry{pubKey: pubKey}
+	for _, sBlock := range validatorData.SignedBlocks {
+		if sBlock == nil {
+			continue
+		}
+		slot, err := SlotFromString(sBlock.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid slot: %w", sBlock.Slot, err)
+		}
+		signingRoot, err := normalizeRootHex(sBlock.SigningRoot)
+		if err != nil {
+			return nil, err
+		}
+		entry.blocks = append(entry.blocks, mergedBlock{slot: slot, signingRoot: signingRoot})
+	}
+	for _, sAtt := range validatorData.SignedAttestations {
+		if sAtt == nil {
+			continue
+		}
+		source, err := EpochFromString(sAtt.SourceEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", sAtt.SourceEpoch, err)
+		}
+		target, err := EpochFromString(sAtt.TargetEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid epoch: %w", sAtt.TargetEpoch, err)
+		}
+		signingRoot, err := normalizeRootHex(sAtt.SigningRoot)
+		if err != nil {
+			return nil, err
+		}
+		entry.attestations = append(entry.attestations, mergedAttestation{source: source, target: target, signingRoot: signingRoot})
+	}
+	return entry, nil
+}
+
+func normalizeRootHex(str string) (string, error) {
+	// Signing roots are optional in the standard JSON file.
+	if str == "" {
+		return "", nil
+	}
+	root, err := RootFromHex(str)
+	if err != nil {
+		return "", fmt.Errorf("%s is not a valid root: %w", str, err)
+	}
+	return rootToHexString(root[:])
+}
+
+// Blocks must be sorted by slot. As signing roots are optional, two distinct entries at
+// the same slot are always a double proposal: either their roots differ, or one of them
+// has no root and cannot be told apart from a different block.
+func blocksConflict(blocks []mergedBlock) string {
+	for i := 1; i < len(blocks); i++ {
+		if blocks[i].slot == blocks[i-1].slot {
+			return fmt.Sprintf("double proposal at slot %d", blocks[i].slot)
+		}
+	}
+	return ""
+}
+
+// Attestations must be sorted by source epoch, then target epoch. Double votes are found by
+// grouping attestations by target epoch, and surround votes with a single sweep in source
+// order: an attestation is surrounded by a previous one with a lower source if its target
+// is lower than the highest target seen so far.
+func attestationsConflict(atts []mergedAttestation) string {
+	byTarget := make(map[primitives.Epoch]string, len(atts))
+	for _, att := range atts {
+		if root, ok := byTarget[att.target]; ok && (root == "" || root != att.signingRoot) {
+			return fmt.Sprintf("double vote at target epoch %d", att.target)
+		}
+		byTarget[att.target] = att.signingRoot
+	}
+	var (
+		maxTarget         primitives.Epoch
+		maxTargetSource   primitives.Epoch
+		seenLowerSource   bool
+		groupMaxTarget    primitives.Epoch
+		groupMaxTargetSrc primitives.Epoch
+	)
+	for i, att := range atts {
+		if i > 0 && att.source != atts[i-1].source {
+			// Attestations with the previous source epoch can now surround the next ones.
+			if !seenLowerSource || groupMaxTarget > maxTarget {
+				maxTarget, maxTargetSource = groupMaxTarget, groupMaxTargetSrc
+			}
+			seenLowerSource = true
+			groupMaxTarget = 0
+		}
+		if seenLowerSource && att.target < maxTarget {
+			return fmt.Sprintf(
+				"attestation with source %d and target %d is surrounded by one with source %d and target %d",
+				att.source,
+				att.target,
+				maxTargetSource,
+				maxTarget,
+			)
+		}
+		if att.target >= groupMaxTarget {
+			groupMaxTarget, groupMaxTargetSrc = att.target, att.source
+		}
+	}
+	return ""
+}
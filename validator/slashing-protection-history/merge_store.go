@@ -0,0 +1,145 @@
+package history
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Accumulates the merged histories in a temporary database. Blocks are keyed by public key, slot
+// and signing root, and attestations by public key, source epoch, target epoch and signing root,
+// so that identical entries are deduplicated and iterating over the keys returns the histories
+// sorted by public key, then by slot or by source and target epochs.
+type mergeStore struct {
+	dir     string
+	db      *bolt.DB
+	pending []*mergedEntry
+}
+
+func newMergeStore() (*mergeStore, error) {
+	dir, err := os.MkdirTemp("", "slashing-protection-merge")
+	if err != nil {
+		return nil, err
+	}
+	// The database is removed once the merge is done, there is no need to sync it to disk.
+	db, err := bolt.Open(filepath.Join(dir, "merge.db"), params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		NoSync:         true,
+		NoFreelistSync: true,
+	})
+	if err != nil {
+		if rmErr := os.RemoveAll(dir); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove merge database")
+		}
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bkt := range [][]byte{mergedPubKeysBucket, mergedBlocksBucket, mergedAttestationsBucket} {
+			if _, err := tx.CreateBucket(bkt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	store := &mergeStore{dir: dir, db: db, pending: make([]*mergedEntry, 0, mergeBatchSize)}
+	if err != nil {
+		if closeErr := store.close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not remove merge database")
+		}
+		return nil, err
+	}
+	return store, nil
+}
+
+func (m *mergeStore) close() error {
+	if err := m.db.Close(); err != nil {
+		return err
+	}
+	return os.RemoveAll(m.dir)
+}
+
+// Adds an entry to the merged histories. Entries are written one batch at a time.
+func (m *mergeStore) add(entry *mergedEntry) error {
+	m.pending = append(m.pending, entry)
+	if len(m.pending) < mergeBatchSize {
+		return nil
+	}
+	return m.flush()
+}
+
+// Writes the pending entries to the database.
+func (m *mergeStore) flush() error {
+	if len(m.pending) == 0 {
+		return nil
+	}
+	err := m.db.Update(func(tx *bolt.Tx) error {
+		pubKeys := tx.Bucket(mergedPubKeysBucket)
+		blocks := tx.Bucket(mergedBlocksBucket)
+		atts := tx.Bucket(mergedAttestationsBucket)
+		for _, entry := range m.pending {
+			if err := pubKeys.Put(entry.pubKey[:], []byte{1}); err != nil {
+				return err
+			}
+			for _, blk := range entry.blocks {
+				key := mergedKey(entry.pubKey, uint64(blk.slot))
+				if err := blocks.Put(append(key, blk.signingRoot...), []byte{1}); err != nil {
+					return err
+				}
+			}
+			for _, att := range entry.attestations {
+				key := mergedKey(entry.pubKey, uint64(att.source), uint64(att.target))
+				if err := atts.Put(append(key, att.signingRoot...), []byte{1}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	m.pending = m.pending[:0]
+	return err
+}
+
+// Calls fn with the merged history of every public key, in public key order. Only the history
+// of the current public key is held in memory.
+func (m *mergeStore) forEach(fn func(*mergedEntry) error) error {
+	return m.db.View(func(tx *bolt.Tx) error {
+		blocks := tx.Bucket(mergedBlocksBucket).Cursor()
+		atts := tx.Bucket(mergedAttestationsBucket).Cursor()
+		return tx.Bucket(mergedPubKeysBucket).ForEach(func(pubKey, _ []byte) error {
+			entry := &mergedEntry{}
+			copy(entry.pubKey[:], pubKey)
+			for k, _ := blocks.Seek(pubKey); k != nil && bytes.HasPrefix(k, pubKey); k, _ = blocks.Next() {
+				k = k[fieldparams.BLSPubkeyLength:]
+				entry.blocks = append(entry.blocks, mergedBlock{
+					slot:        primitives.Slot(binary.BigEndian.Uint64(k)),
+					signingRoot: string(k[8:]),
+				})
+			}
+			for k, _ := atts.Seek(pubKey); k != nil && bytes.HasPrefix(k, pubKey); k, _ = atts.Next() {
+				k = k[fieldparams.BLSPubkeyLength:]
+				entry.attestations = append(entry.attestations, mergedAttestation{
+					source:      primitives.Epoch(binary.BigEndian.Uint64(k)),
+					target:      primitives.Epoch(binary.BigEndian.Uint64(k[8:])),
+					signingRoot: string(k[16:]),
+				})
+			}
+			return fn(entry)
+		})
+	})
+}
+
+// Returns the public key followed by the big endian encoding of the given values. The normalized
+// hex signing root is appended to it, which sorts the entries without a signing root first.
+func mergedKey(pubKey [fieldparams.BLSPubkeyLength]byte, values ...uint64) []byte {
+	key := make([]byte, fieldparams.BLSPubkeyLength, fieldparams.BLSPubkeyLength+8*len(values)+fieldparams.RootLength*2+2)
+	copy(key, pubKey[:])
+	for _, v := range values {
+		key = binary.BigEndian.AppendUint64(key, v)
+	}
+	return key
+}
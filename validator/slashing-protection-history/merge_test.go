@@ -0,0 +1,222 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
+)
+
+const (
+	mergeTestPubKey1 = "0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	mergeTestPubKey2 = "0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	mergeTestRoot1   = "0x0100000000000000000000000000000000000000000000000000000000000000"
+	mergeTestRoot2   = "0x0200000000000000000000000000000000000000000000000000000000000000"
+)
+
+func mergeTestFile(t *testing.T, genesisRoot string, data ...*format.ProtectionData) io.Reader {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{Data: data}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRoot
+	blob, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	return bytes.NewReader(blob)
+}
+
+func TestMergeProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	first := mergeTestFile(t, mergeTestRoot1,
+		&format.ProtectionData{
+			Pubkey:       mergeTestPubKey2,
+			SignedBlocks: []*format.SignedBlock{{Slot: "2", SigningRoot: mergeTestRoot1}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: mergeTestRoot1},
+			},
+		},
+	)
+	second := mergeTestFile(t, mergeTestRoot1,
+		&format.ProtectionData{
+			Pubkey: mergeTestPubKey1,
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "0", TargetEpoch: "1"},
+			},
+		},
+		&format.ProtectionData{
+			Pubkey:       mergeTestPubKey2,
+			SignedBlocks: []*format.SignedBlock{{Slot: "2", SigningRoot: mergeTestRoot1}, {Slot: "1"}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: mergeTestRoot1},
+				{SourceEpoch: "2", TargetEpoch: "3"},
+			},
+		},
+	)
+	buf := new(bytes.Buffer)
+	conflicts, err := MergeProtectionJSON(ctx, []io.Reader{first, second}, buf)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(conflicts))
+
+	merged := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), merged))
+	assert.Equal(t, mergeTestRoot1, merged.Metadata.GenesisValidatorsRoot)
+	require.Equal(t, 2, len(merged.Data))
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey:       mergeTestPubKey1,
+		SignedBlocks: []*format.SignedBlock{},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "0", TargetEpoch: "1"},
+		},
+	}, merged.Data[0])
+	// Identical entries are deduplicated, and entries are sorted.
+	assert.DeepEqual(t, &format.ProtectionData{
+		Pubkey:       mergeTestPubKey2,
+		SignedBlocks: []*format.SignedBlock{{Slot: "1"}, {Slot: "2", SigningRoot: mergeTestRoot1}},
+		SignedAttestations: []*format.SignedAttestation{
+			{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: mergeTestRoot1},
+			{SourceEpoch: "2", TargetEpoch: "3"},
+		},
+	}, merged.Data[1])
+}
+
+func TestMergeProtectionJSON_Batches(t *testing.T) {
+	ctx := context.Background()
+	numKeys := 2*mergeBatchSize + 1
+	data := make([]*format.ProtectionData, numKeys)
+	for i := range data {
+		var pubKey [fieldparams.BLSPubkeyLength]byte
+		binary.BigEndian.PutUint64(pubKey[:], uint64(numKeys-i))
+		data[i] = &format.ProtectionData{
+			Pubkey:             fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       []*format.SignedBlock{{Slot: fmt.Sprintf("%d", i), SigningRoot: mergeTestRoot1}},
+			SignedAttestations: []*format.SignedAttestation{},
+		}
+	}
+	// Both files hold every public key, with the same history.
+	first := mergeTestFile(t, mergeTestRoot1, data...)
+	second := mergeTestFile(t, mergeTestRoot1, data...)
+	buf := new(bytes.Buffer)
+	conflicts, err := MergeProtectionJSON(ctx, []io.Reader{first, second}, buf)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(conflicts))
+
+	merged := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), merged))
+	require.Equal(t, numKeys, len(merged.Data))
+	for i, d := range merged.Data {
+		// Public keys are sorted, which reverses their order in the files.
+		assert.DeepEqual(t, data[numKeys-1-i], d)
+	}
+}
+
+func TestMergeProtectionJSON_Conflicts(t *testing.T) {
+	tests := []struct {
+		name       string
+		first      *format.ProtectionData
+		second     *format.ProtectionData
+		wantReason string
+	}{
+		{
+			name: "double proposal",
+			first: &format.ProtectionData{
+				Pubkey:       mergeTestPubKey1,
+				SignedBlocks: []*format.SignedBlock{{Slot: "3", SigningRoot: mergeTestRoot1}},
+			},
+			second: &format.ProtectionData{
+				Pubkey:       mergeTestPubKey1,
+				SignedBlocks: []*format.SignedBlock{{Slot: "3", SigningRoot: mergeTestRoot2}},
+			},
+			wantReason: "double proposal at slot 3",
+		},
+		{
+			name: "double proposal without signing root",
+			first: &format.ProtectionData{
+				Pubkey:       mergeTestPubKey1,
+				SignedBlocks: []*format.SignedBlock{{Slot: "3", SigningRoot: mergeTestRoot1}},
+			},
+			second: &format.ProtectionData{
+				Pubkey:       mergeTestPubKey1,
+				SignedBlocks: []*format.SignedBlock{{Slot: "3"}},
+			},
+			wantReason: "double proposal at slot 3",
+		},
+		{
+			name: "double vote",
+			first: &format.ProtectionData{
+				Pubkey:             mergeTestPubKey1,
+				SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "1", TargetEpoch: "4", SigningRoot: mergeTestRoot1}},
+			},
+			second: &format.ProtectionData{
+				Pubkey:             mergeTestPubKey1,
+				SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "4", SigningRoot: mergeTestRoot2}},
+			},
+			wantReason: "double vote at target epoch 4",
+		},
+		{
+			name: "surround vote",
+			first: &format.ProtectionData{
+				Pubkey: mergeTestPubKey1,
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "1", TargetEpoch: "2"},
+					{SourceEpoch: "3", TargetEpoch: "7"},
+				},
+			},
+			second: &format.ProtectionData{
+				Pubkey:             mergeTestPubKey1,
+				SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "4", TargetEpoch: "5"}},
+			},
+			wantReason: "attestation with source 4 and target 5 is surrounded by one with source 3 and target 7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := &format.ProtectionData{
+				Pubkey:       mergeTestPubKey2,
+				SignedBlocks: []*format.SignedBlock{{Slot: "3"}},
+			}
+			readers := []io.Reader{
+				mergeTestFile(t, mergeTestRoot1, tt.first, other),
+				mergeTestFile(t, mergeTestRoot1, tt.second),
+			}
+			buf := new(bytes.Buffer)
+			conflicts, err := MergeProtectionJSON(context.Background(), readers, buf)
+			require.NoError(t, err)
+			assert.DeepEqual(t, []*MergeConflict{{Pubkey: mergeTestPubKey1, Reason: tt.wantReason}}, conflicts)
+
+			// Conflicting public keys are still part of the merged file.
+			merged := &format.EIPSlashingProtectionFormat{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), merged))
+			assert.Equal(t, 2, len(merged.Data))
+		})
+	}
+}
+
+func TestMergeProtectionJSON_MismatchedMetadata(t *testing.T) {
+	readers := []io.Reader{
+		mergeTestFile(t, mergeTestRoot1),
+		mergeTestFile(t, mergeTestRoot2),
+	}
+	_, err := MergeProtectionJSON(context.Background(), readers, io.Discard)
+	assert.ErrorContains(t, "slashing protection JSON file 1 has genesis validators root", err)
+
+	_, err = MergeProtectionJSON(context.Background(), []io.Reader{
+		bytes.NewBufferString(`{"metadata":{"interchange_format_version":"4","genesis_validators_root":"` + mergeTestRoot1 + `"}}`),
+	}, io.Discard)
+	assert.ErrorContains(t, "version '4' is not supported", err)
+}
+
+func Test_attestationsConflict_SameSourceDoesNotSurround(t *testing.T) {
+	atts := []mergedAttestation{
+		{source: 1, target: 2},
+		{source: 1, target: 5},
+		{source: 5, target: 6},
+		{source: 5, target: 7},
+	}
+	assert.Equal(t, "", attestationsConflict(atts))
+}
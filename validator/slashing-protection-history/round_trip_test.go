@@ -373,3 +373,34 @@ func TestStore_ImportInterchangeData_BadFormat_PreventsDBWrites(t *testing.T) {
 		)
 	}
 }
+
+func TestImportExport_StreamMatchesStandardExport(t *testing.T) {
+	ctx := context.Background()
+	numValidators := 10
+	publicKeys, err := slashtest.CreateRandomPubKeys(numValidators)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	attestingHistory, proposalHistory := slashtest.MockAttestingAndProposalHistories(publicKeys)
+	wanted, err := slashtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	blob, err := json.Marshal(wanted)
+	require.NoError(t, err)
+	require.NoError(t, history.ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// The streamed export is byte for byte the indented encoding of the standard export.
+	eipStandard, err := history.ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	wantedJSON, err := json.MarshalIndent(eipStandard, "", "\t")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	numKeys, err := history.StreamStandardProtectionJSON(ctx, validatorDB, buf)
+	require.NoError(t, err)
+	assert.Equal(t, numValidators, numKeys)
+	assert.Equal(t, string(wantedJSON), buf.String())
+
+	// The streamed file decodes into the same interchange data.
+	streamed := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), streamed))
+	require.DeepEqual(t, eipStandard, streamed)
+}
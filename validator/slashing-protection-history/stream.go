@@ -0,0 +1,137 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
+)
+
+// Decodes an EIP-3076 interchange JSON from the reader one protection data entry at a time,
+// calling fn with the metadata of the file and each of the entries, so that files with a large
+// number of public keys are never held in memory at once. As the entries are processed as they
+// are decoded, the metadata must precede them in the file. The metadata of the file is returned
+// without its data, along with whether the file has a non-null data field.
+func decodeProtectionJSON(
+	r io.Reader, fn func(*format.EIPSlashingProtectionFormat, *format.ProtectionData) error,
+) (*format.EIPSlashingProtectionFormat, bool, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, false, err
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	hasData := false
+	hasMetadata := false
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false, err
+		}
+		switch tok {
+		case "metadata":
+			if err := dec.Decode(&interchangeJSON.Metadata); err != nil {
+				return nil, false, errors.Wrap(err, "could not decode metadata")
+			}
+			hasMetadata = true
+		case "data":
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, false, err
+			}
+			if tok == nil {
+				continue
+			}
+			if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+				return nil, false, fmt.Errorf("expected data to be an array, got %v", tok)
+			}
+			hasData = true
+			for dec.More() {
+				if !hasMetadata {
+					return nil, false, errors.New("metadata must precede data")
+				}
+				item := &format.ProtectionData{}
+				if err := dec.Decode(item); err != nil {
+					return nil, false, errors.Wrap(err, "could not decode protection data")
+				}
+				if err := fn(interchangeJSON, item); err != nil {
+					return nil, false, err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return nil, false, err
+			}
+		default:
+			// Unknown fields are ignored.
+			var skipped json.RawMessage
+			if err := dec.Decode(&skipped); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, false, err
+	}
+	return interchangeJSON, hasData, nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %v, got %v", want, tok)
+	}
+	return nil
+}
+
+// Writes an EIP-3076 interchange JSON one protection data entry at a time. The output is
+// identical to the tab-indented encoding of the whole interchange JSON.
+type protectionJSONWriter struct {
+	w        io.Writer
+	numItems int
+}
+
+func newProtectionJSONWriter(w io.Writer, genesisValidatorsRoot string) (*protectionJSONWriter, error) {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisValidatorsRoot
+	metadata, err := json.MarshalIndent(interchangeJSON.Metadata, "\t", "\t")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal metadata")
+	}
+	if _, err := fmt.Fprintf(w, "{\n\t\"metadata\": %s,\n\t\"data\": [", metadata); err != nil {
+		return nil, err
+	}
+	return &protectionJSONWriter{w: w}, nil
+}
+
+func (p *protectionJSONWriter) write(item *format.ProtectionData) error {
+	enc, err := json.MarshalIndent(item, "\t\t", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal protection data")
+	}
+	sep := ",\n\t\t"
+	if p.numItems == 0 {
+		sep = "\n\t\t"
+	}
+	if _, err := io.WriteString(p.w, sep); err != nil {
+		return err
+	}
+	if _, err := p.w.Write(enc); err != nil {
+		return err
+	}
+	p.numItems++
+	return nil
+}
+
+func (p *protectionJSONWriter) close() error {
+	end := "\n\t]\n}"
+	if p.numItems == 0 {
+		end = "]\n}"
+	}
+	_, err := io.WriteString(p.w, end)
+	return err
+}